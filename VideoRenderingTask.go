@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/janction/videoRendering/db"
 )
@@ -19,12 +20,21 @@ func GetEmptyVideoRenderingTaskList() []IndexedVideoRenderingTask {
 func (t *VideoRenderingTask) GenerateThreads(taskId string) (res []*VideoRenderingThread) {
 	// Split frames among the threads
 	frameRanges := splitFrames(int(t.StartFrame), int(t.EndFrame), int(t.ThreadAmount))
+	if t.Split.IsPreview() && len(t.Split.Costs) > 0 {
+		// frames are split by the render times of the preview pass instead
+		frameRanges = splitFramesByCost(int(t.StartFrame), t.frameCosts(), int(t.ThreadAmount))
+	}
 
 	// Print the result
 
+	// tiled tasks have a thread for every tile of each frame range
+	tiles := t.TileGrid.Tiles()
 	for i, r := range frameRanges {
-		thread := VideoRenderingThread{ThreadId: t.TaskId + strconv.FormatInt(int64(i), 10), StartFrame: int64(r.StartFrame), EndFrame: int64(r.EndFrame), TaskId: taskId}
-		res = append(res, &thread)
+		for tile := 0; tile < tiles; tile++ {
+			index := i*tiles + tile
+			thread := VideoRenderingThread{ThreadId: t.TaskId + strconv.FormatInt(int64(index), 10), StartFrame: int64(r.StartFrame), EndFrame: int64(r.EndFrame), TaskId: taskId, Comparison: t.Comparison, RenderSettings: t.RenderSettings, TileGrid: t.TileGrid, Tile: uint32(tile), BlenderVersion: t.BlenderVersion}
+			res = append(res, &thread)
+		}
 	}
 
 	return res
@@ -76,10 +86,18 @@ func (t VideoRenderingTask) SubscribeWorkerToTask(ctx context.Context, workerAdd
 
 func (t *VideoRenderingTask) GetWinnerReward() types.Coin {
 	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+	return types.NewCoin(t.Reward.Denom, t.renderingReward().QuoRaw(2).QuoRaw(int64(amountThreads)))
 }
 
 func (t *VideoRenderingTask) GetValidatorsReward() types.Coin {
 	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+	return types.NewCoin(t.Reward.Denom, t.renderingReward().QuoRaw(2).QuoRaw(int64(amountThreads)))
+}
+
+// renderingReward is the reward of the task without the slice of the assembler
+func (t *VideoRenderingTask) renderingReward() math.Int {
+	if t.Assembly == nil || t.Assembly.Reward == nil {
+		return t.Reward.Amount
+	}
+	return t.Reward.Amount.Sub(t.Assembly.Reward.Amount)
 }
@@ -2,6 +2,9 @@ package videoRendering
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	fmt "fmt"
 	"os"
 	"path"
@@ -20,24 +23,25 @@ import (
 	"github.com/janction/videoRendering/vm"
 )
 
-func (t *VideoRenderingThread) StartWork(ctx context.Context, worker string, cid string, path string, db *db.DB) error {
+// StartWork renders the frames of the thread with the renderer image selected for it
+func (t *VideoRenderingThread) StartWork(ctx context.Context, worker string, cid string, path string, renderer vm.Renderer, db db.Database) error {
 	// ctx := context.Background()
 
 	if err := db.UpdateThread(t.ThreadId, false, false, true, false, false, false, false, false); err != nil {
 		videoRenderingLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
 	}
 
-	isRunning := vm.IsContainerRunning(ctx, t.ThreadId)
-	if !isRunning {
-		// task is not running,
+	// the work resumes from the frames recorded as rendered, so a container left by a previous run is removed
+	renderer.RemoveContainer(ctx, vm.RendererContainerName(t.ThreadId, path))
 
-		// we remove the container just in case it already exists.
-		vm.RemoveContainer(ctx, "myBlender"+t.ThreadId)
-
-		videoRenderingLogger.Logger.Info("No solution for thread %s. Starting work", t.ThreadId)
-		// we don't have a solution, start working
-		started := time.Now().Unix()
-		ipfs.EnsureIPFSRunning()
+	videoRenderingLogger.Logger.Info("No solution for thread %s. Starting work", t.ThreadId)
+	// we don't have a solution, start working
+	started := time.Now().Unix()
+	ipfs.EnsureIPFSRunning()
+	if ipfs.IsDownloaded(cid, path) {
+		// a restarted thread keeps the file it already downloaded
+		db.AddLogEntry(t.ThreadId, fmt.Sprintf("IPFS file %s already downloaded.", cid), started, 0)
+	} else {
 		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading IPFS file %s...", cid), started, 0)
 		if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
 			videoRenderingLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
@@ -51,52 +55,74 @@ func (t *VideoRenderingThread) StartWork(ctx context.Context, worker string, cid
 			videoRenderingLogger.Logger.Error("Error getting cid %s", cid)
 			return err
 		}
-		// download completed successfuly
-		if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
-			videoRenderingLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
-		}
-
-		finish := time.Now().Unix()
-		difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
-
-		// we start rendering
-		vm.RenderVideo(ctx, cid, t.StartFrame, t.EndFrame, t.ThreadId, path, t.IsReverse(worker), db)
+	}
+	// download completed successfuly
+	if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
+		videoRenderingLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+	}
 
-		rendersPath := filepath.Join(path, "output")
-		_, err = os.Stat(rendersPath)
-		finish = time.Now().Unix()
-		difference = time.Unix(finish, 0).Sub(time.Unix(started, 0))
-		if err != nil {
-			// output path was not created so no rendering happened. we will start over
-			videoRenderingLogger.Logger.Error("Unable to complete rendering of task, retrying. No files at %s", rendersPath)
+	finish := time.Now().Unix()
+	difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
+	db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
 
+	// we start rendering. Frames completed by a previous run are skipped, and frames other workers
+	// couldn't render go first, to confirm or refute their failure. Frames we already reported aren't rendered again
+	reported, err := db.ReadReportedFrames(t.ThreadId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read reported frames of thread %s: %s", t.ThreadId, err.Error())
+	}
+	unconfirmed := slices.DeleteFunc(t.UnconfirmedFailures(worker), func(frame int64) bool {
+		return reported[frame]
+	})
+	frames := append(unconfirmed, slices.DeleteFunc(t.RenderOrder(worker), func(frame int64) bool {
+		return slices.Contains(unconfirmed, frame) || reported[frame]
+	})...)
+	settings := t.VMSettings()
+	settings.Image = renderer.Image
+	if err := renderer.RenderVideo(ctx, cid, frames, t.ThreadId, path, settings, db); err != nil {
+		var failure *vm.FrameFailure
+		if !errors.As(err, &failure) {
+			// the worker couldn't render, the frame is rendered again on the next run
 			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
-			return nil
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error rendering thread %s. %s", t.ThreadId, err.Error()), time.Now().Unix(), 2)
+			videoRenderingLogger.Logger.Error("Unable to render thread %s: %s", t.ThreadId, err.Error())
+			return err
 		}
-		files, _ := os.ReadDir(rendersPath)
-		if len(files) != int(t.EndFrame)-int(t.StartFrame)+1 {
-			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
-			videoRenderingLogger.Logger.Error("Not the amount we expected. retrying. Amount of files %v", len(files))
-			return nil
+		// the frame failed every attempt, so it's reported instead of rendered again. The thread stays marked as
+		// working until the report is recorded, so the next block doesn't render the frame again meanwhile
+		err := t.ReportTaskFailure(failure, worker, path)
+		if err == nil {
+			db.AddReportedFrame(t.ThreadId, failure.Frame)
 		}
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s completed succesfully in %v seconds.", t.ThreadId, int(difference.Seconds())), finish, 1)
-	} else {
-		// Container is running, so we update worker status
-		// if worker status is idle, we change it
-		videoRenderingLogger.Logger.Info("Work for thread %s is already going", t.ThreadId)
+		db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+		if err != nil {
+			videoRenderingLogger.Logger.Error("Unable to report unrenderable frame %v of thread %s: %s", failure.Frame, t.ThreadId, err.Error())
+			return err
+		}
+		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Reported frame %v as unrenderable after %v attempts.", failure.Frame, failure.Attempts), time.Now().Unix(), 2)
+		return failure
+	}
 
+	finish = time.Now().Unix()
+	difference = time.Unix(finish, 0).Sub(time.Unix(started, 0))
+	// every frame must be recorded and valid, a restart renders the missing ones
+	missing := vm.MissingFrames(frames, t.ThreadId, path, vm.FrameExtension(settings.Format), db)
+	if len(missing) > 0 {
+		db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+		videoRenderingLogger.Logger.Error("Frames %v are missing. retrying", missing)
+		return nil
 	}
+	db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+	db.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s completed succesfully in %v seconds.", t.ThreadId, int(difference.Seconds())), finish, 1)
 
 	return nil
 }
 
-func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAddress string, rootPath string, db *db.DB) error {
+func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAddress string, rootPath string, renderer vm.Renderer, db db.Database) error {
 	db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
 
 	output := path.Join(rootPath, "renders", t.ThreadId, "output")
-	count := vm.CountFilesInDirectory(output)
+	count := vm.CountFilesInDirectory(output, t.FrameExtension())
 
 	if count != (int(t.EndFrame)-int(t.StartFrame))+1 {
 		videoRenderingLogger.Logger.Error("not enought local frames to propose solution: %v", count)
@@ -104,7 +130,7 @@ func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAd
 		return nil
 	}
 
-	hashes, err := GenerateDirectoryFileHashes(output)
+	hashes, err := t.directoryHashes(output, CurrentPixelHashVersion, renderer)
 	if err != nil {
 		videoRenderingLogger.Logger.Error("Unable to calculate CIDs: %s", err.Error())
 		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
@@ -120,27 +146,27 @@ func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAd
 
 	publicKey := videoRenderingCrypto.EncodePublicKeyForCLI(pkey)
 
-	for filename, hash := range hashes {
-		sigMsg, err := videoRenderingCrypto.GenerateSignableMessage(hash, workerAddress)
-
-		if err != nil {
-			videoRenderingLogger.Logger.Error("Unable to generate message for worker %s and hash %s: %s", workerAddress, hash, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-			return err
-		}
-
-		signature, _, err := videoRenderingCrypto.SignMessage(rootPath, alias, sigMsg, codec)
+	salt, err := commitmentSalt(t.ProposalId(), db)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Unable to get commitment salt of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+		return err
+	}
 
-		if err != nil {
-			videoRenderingLogger.Logger.Error("Unable to sign message for worker %s and hash %s: %s", workerAddress, hash, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-			return err
-		}
-		// We rewrite the hash with the signature
-		hashes[filename] = videoRenderingCrypto.EncodeSignatureForCLI(signature)
+	// we only commit to the merkle root of the salted hashes. Sampled frames are revealed against it later
+	tree, err := t.NewFrameMerkleTree(hashes, salt, workerAddress)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Unable to build merkle tree of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+		return err
 	}
 
-	solution := MapToKeyValueFormat(hashes)
+	proof, circuitVersion, err := t.generateSolutionProof(tree.Root(), hashes, salt, workerAddress, rootPath)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Unable to generate zk proof of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
+		return err
+	}
 
 	// Base arguments
 	args := []string{
@@ -150,7 +176,17 @@ func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAd
 
 	// Append solution arguments
 	args = append(args, publicKey)
-	args = append(args, solution...)
+	args = append(args, tree.Root())
+	args = append(args, "--hash-version", CurrentPixelHashVersion)
+	args = append(args, "--render-settings", t.VMSettings().Fingerprint())
+	args = append(args, "--renderer-digest", renderer.Image.Digest)
+	// validators get a commit window long enough to render the thread at our render time
+	if duration, err := db.GetAverageRenderTime(t.ThreadId); err == nil && duration > 0 {
+		args = append(args, "--average-render-seconds", strconv.Itoa(duration))
+	}
+	if proof != "" {
+		args = append(args, "--zk-proof", proof, "--zk-circuit-version", circuitVersion)
+	}
 
 	// Append flags
 	args = append(args, "--yes", "--from", workerAddress)
@@ -165,30 +201,37 @@ func (t VideoRenderingThread) ProposeSolution(codec codec.Codec, alias, workerAd
 	return nil
 }
 
-func (t VideoRenderingThread) SubmitVerification(codec codec.Codec, alias, workerAddress string, rootPath string, db *db.DB) error {
-	// we will verify any file we already have rendered.
+// SubmitVerification commits to the hashes of the sampled frames. Hashes are revealed once the commit deadline is over
+func (t VideoRenderingThread) SubmitVerification(codec codec.Codec, alias, workerAddress string, rootPath string, renderer vm.Renderer, db db.Database) error {
+	// we only verify the frames sampled when the solution was proposed
 	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, false, false)
 	output := path.Join(rootPath, "renders", t.ThreadId, "output")
-	files := vm.CountFilesInDirectory(output)
-	if files == 0 {
-		videoRenderingLogger.Logger.Error("found %v files in path %s", files, output)
+
+	// our frames are only comparable if we rendered them under the same settings
+	if settings := t.VMSettings().Fingerprint(); t.Solution != nil && t.Solution.RenderSettings != "" && t.Solution.RenderSettings != settings {
+		videoRenderingLogger.Logger.Error("solution of thread %s was rendered with settings %s, but we render with %s", t.ThreadId, t.Solution.RenderSettings, settings)
+		db.AddLogEntry(t.ThreadId, "Solution was rendered with other render settings. Unable to verify it.", time.Now().Unix(), 2)
+		return fmt.Errorf("solution of thread %s was rendered with settings %s, but we render with %s", t.ThreadId, t.Solution.RenderSettings, settings)
+	}
+	// and with the same blender build
+	if digest := renderer.Image.Digest; t.Solution != nil && t.Solution.RendererDigest != "" && t.Solution.RendererDigest != digest {
+		videoRenderingLogger.Logger.Error("solution of thread %s was rendered with image %s, but we render with %s", t.ThreadId, t.Solution.RendererDigest, digest)
+		db.AddLogEntry(t.ThreadId, "Solution was rendered with another renderer image. Unable to verify it.", time.Now().Unix(), 2)
+		return fmt.Errorf("solution of thread %s was rendered with image %s, but we render with %s", t.ThreadId, t.Solution.RendererDigest, digest)
+	}
+	if len(t.SampledFrames) == 0 {
+		videoRenderingLogger.Logger.Error("thread %s doesn't have sampled frames to verify", t.ThreadId)
 		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
 		return nil
 	}
 
-	// Before we calculate verification, we need to make sure we have enought rendered files to submit one.
-	totalFiles := t.EndFrame - t.StartFrame
-	threshold := float64(totalFiles) * 0.2 // TODO this percentage should be in params
-
-	if float64(files) > threshold {
-		videoRenderingLogger.Logger.Info("rendered files %v at %sis enought to generate verification", files, output)
-	} else {
-		videoRenderingLogger.Logger.Error("not enought files %v at %s to generate validation. Rendering should continue", files, output)
+	// Before we calculate verification, we need to make sure we have rendered every sampled frame.
+	myWork, err := t.sampledFrameHashes(output, renderer)
+	if errors.Is(err, os.ErrNotExist) {
+		videoRenderingLogger.Logger.Info("sampled frames at %s are not rendered yet. Rendering should continue: %s", output, err.Error())
 		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
 		return nil
 	}
-	// we do have some work, lets compare it with the solution
-	myWork, err := GenerateDirectoryFileHashes(output)
 
 	if err != nil {
 		videoRenderingLogger.Logger.Error("error getting hashes. Err: %s", err.Error())
@@ -203,23 +246,16 @@ func (t VideoRenderingThread) SubmitVerification(codec codec.Codec, alias, worke
 		return err
 	}
 
-	for filename, hash := range myWork {
-		message, err := videoRenderingCrypto.GenerateSignableMessage(hash, workerAddress)
-		if err != nil {
-			videoRenderingLogger.Logger.Error("unable to generate message to sign %s: %s", message, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
-			return err
-		}
-
-		signature, _, err := videoRenderingCrypto.SignMessage(rootPath, alias, message, codec)
+	salt, err := commitmentSalt(t.CommitmentId(), db)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Unable to get commitment salt of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		return err
+	}
 
-		if err != nil {
-			videoRenderingLogger.Logger.Error("unable to sign message %s: %s", message, err.Error())
-			db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
-			return err
-		}
-		// we replace the hash for the signature
-		myWork[filename] = videoRenderingCrypto.EncodeSignatureForCLI(signature)
+	// we replace the hash for the commitment, so other validators can't copy it
+	for filename, hash := range myWork {
+		myWork[filename] = FrameCommitment(hash, salt, workerAddress)
 	}
 
 	db.AddLogEntry(t.ThreadId, "Starting verification of solution...", time.Now().Unix(), 0)
@@ -231,18 +267,18 @@ func (t VideoRenderingThread) SubmitVerification(codec codec.Codec, alias, worke
 		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
 		return err
 	}
-	db.AddLogEntry(t.ThreadId, "Solution verified", time.Now().Unix(), 0)
+	db.AddLogEntry(t.ThreadId, "Verification committed. Waiting for the commit deadline to reveal it...", time.Now().Unix(), 0)
 	return nil
 }
 
-func submitValidation(validator string, taskId, threadId, publicKey string, signatures []string) error {
+func submitValidation(validator string, taskId, threadId, publicKey string, commitments []string) error {
 	// Base arguments
 	args := []string{
 		"tx", "videoRendering", "submit-validation",
 		taskId, threadId,
 	}
 	args = append(args, publicKey)
-	args = append(args, signatures...)
+	args = append(args, commitments...)
 	args = append(args, "--from")
 	args = append(args, validator)
 	args = append(args, "--yes")
@@ -253,7 +289,75 @@ func submitValidation(validator string, taskId, threadId, publicKey string, sign
 	return nil
 }
 
-func (t VideoRenderingThread) SubmitSolution(ctx context.Context, workerAddress, rootPath string, db *db.DB) error {
+// RevealVerification reveals the hashes committed in our validation, signing the frame sign doc of each of them
+func (t VideoRenderingThread) RevealVerification(codec codec.Codec, alias, workerAddress, chainId string, rootPath string, renderer vm.Renderer, db db.Database) error {
+	commitment, err := db.ReadCommitment(t.CommitmentId())
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read commitment of thread %s: %s", t.ThreadId, err.Error())
+		return err
+	}
+	if commitment.Salt == "" {
+		videoRenderingLogger.Logger.Error("thread %s doesn't have a local commitment to reveal", t.ThreadId)
+		return fmt.Errorf("thread %s doesn't have a local commitment to reveal", t.ThreadId)
+	}
+	db.UpdateCommitment(t.CommitmentId(), commitment.Salt, true)
+
+	output := path.Join(rootPath, "renders", t.ThreadId, "output")
+	myWork, err := t.sampledFrameHashes(output, renderer)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("error getting hashes. Err: %s", err.Error())
+		db.UpdateCommitment(t.CommitmentId(), commitment.Salt, false)
+		return err
+	}
+
+	var frames []string
+	for filename, hash := range myWork {
+		signDoc, err := t.NewFrameSignDoc(chainId, filename, hash, workerAddress)
+		if err != nil {
+			videoRenderingLogger.Logger.Error("unable to generate sign doc for frame %s: %s", filename, err.Error())
+			db.UpdateCommitment(t.CommitmentId(), commitment.Salt, false)
+			return err
+		}
+
+		message, err := videoRenderingCrypto.GenerateSignableMessage(signDoc)
+		if err != nil {
+			videoRenderingLogger.Logger.Error("unable to generate message to sign %s: %s", message, err.Error())
+			db.UpdateCommitment(t.CommitmentId(), commitment.Salt, false)
+			return err
+		}
+
+		signature, _, err := videoRenderingCrypto.SignMessage(rootPath, alias, message, codec)
+		if err != nil {
+			videoRenderingLogger.Logger.Error("unable to sign message %s: %s", message, err.Error())
+			db.UpdateCommitment(t.CommitmentId(), commitment.Salt, false)
+			return err
+		}
+		frames = append(frames, fmt.Sprintf("%s=%s:%s", filename, hash, videoRenderingCrypto.EncodeSignatureForCLI(signature)))
+	}
+	// map order is random, we sort them so transactions are reproducible
+	slices.Sort(frames)
+
+	args := []string{
+		"tx", "videoRendering", "reveal-validation",
+		t.TaskId, t.ThreadId,
+	}
+	args = append(args, commitment.Salt)
+	args = append(args, frames...)
+	args = append(args, "--from")
+	args = append(args, workerAddress)
+	args = append(args, "--yes")
+	err = ExecuteCli(args)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("error revealing verification: %s", err.Error())
+		db.UpdateCommitment(t.CommitmentId(), commitment.Salt, false)
+		return err
+	}
+
+	db.AddLogEntry(t.ThreadId, "Verification revealed", time.Now().Unix(), 0)
+	return nil
+}
+
+func (t VideoRenderingThread) SubmitSolution(ctx context.Context, workerAddress, rootPath string, db db.Database) error {
 	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, true)
 
 	db.AddLogEntry(t.ThreadId, "Submiting solution to IPFS...", time.Now().Unix(), 0)
@@ -301,13 +405,231 @@ func submitSolution(address, taskId, threadId string, cid string, duration int64
 	return nil
 }
 
-func (t VideoRenderingThread) IsReverse(worker string) bool {
+// percentage of the thread frames validators must render and sign
+const sampledFramesPercentage = 20
+
+// SampleFrames derives, from the block hash at proposal time, the frames validators will render and sign.
+// Every node gets the same sample, but the proposer can't know it before proposing.
+func (t VideoRenderingThread) SampleFrames(blockHash []byte) []int64 {
+	total := int(t.EndFrame - t.StartFrame + 1)
+	if total <= 0 {
+		return nil
+	}
+
+	// rounded up, so we always sample at least one frame
+	amount := (total*sampledFramesPercentage + 99) / 100
+
+	seed := sha256.Sum256(append(append([]byte{}, blockHash...), []byte(t.ThreadId)...))
+
+	// partial Fisher-Yates shuffle over the frame range, using the seed as the only source of randomness
+	frames := make([]int64, total)
+	for i := range frames {
+		frames[i] = t.StartFrame + int64(i)
+	}
+	for i := 0; i < amount; i++ {
+		var counter [8]byte
+		binary.BigEndian.PutUint64(counter[:], uint64(i))
+		random := sha256.Sum256(append(seed[:], counter[:]...))
+		j := i + int(binary.BigEndian.Uint64(random[:8])%uint64(total-i))
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+
+	sample := frames[:amount]
+	slices.Sort(sample)
+	return sample
+}
+
+// IsSampledFrame returns true if the filename belongs to one of the sampled frames
+func (t VideoRenderingThread) IsSampledFrame(filename string) bool {
+	for _, frame := range t.SampledFrames {
+		if vm.FormatFrameFilename(int(frame), t.FrameExtension()) == filename {
+			return true
+		}
+	}
+	return false
+}
+
+// StartCommitPhase moves the thread to the commit phase once a solution is proposed at the given height.
+// Validators can commit to their hashes until commitBlocks blocks later.
+func (t *VideoRenderingThread) StartCommitPhase(height, commitBlocks int64) {
+	t.Phase = VideoRenderingThread_COMMIT
+	t.CommitBlocks = commitBlocks
+	t.CommitDeadline = height + commitBlocks
+}
+
+// IsCommitOpen returns true if commitments are accepted at the given height
+func (t VideoRenderingThread) IsCommitOpen(height int64) bool {
+	return t.Phase == VideoRenderingThread_COMMIT && height <= t.CommitDeadline
+}
+
+// CloseCommitPhase moves the thread to the reveal phase once the commit deadline is over.
+// Validators can reveal their hashes until revealBlocks blocks later. Returns true if the phase changed.
+func (t *VideoRenderingThread) CloseCommitPhase(height, revealBlocks int64) bool {
+	if t.Phase != VideoRenderingThread_COMMIT || height <= t.CommitDeadline {
+		return false
+	}
+	t.Phase = VideoRenderingThread_REVEAL
+	t.RevealDeadline = height + revealBlocks
+	return true
+}
+
+// HasRevealQuorum returns true once enough validators revealed their hashes to evaluate the solution
+func (t VideoRenderingThread) HasRevealQuorum() bool {
+	revealed := t.RevealedValidations()
+	return revealed > 1 || revealed == len(t.Workers)
+}
+
+// IsRevealExpired returns true if the reveal deadline is over without enough validators revealing their hashes
+func (t VideoRenderingThread) IsRevealExpired(height int64) bool {
+	return t.Phase == VideoRenderingThread_REVEAL && t.RevealDeadline != 0 && height > t.RevealDeadline && !t.HasRevealQuorum()
+}
+
+// ReopenCommitPhase starts another round of the commit phase with the commit window of the thread. Validations that
+// weren't revealed are dropped, so their validators, and the ones that didn't commit in time, can commit again
+func (t *VideoRenderingThread) ReopenCommitPhase(height int64) {
+	t.Validations = slices.DeleteFunc(t.Validations, func(validation *VideoRenderingThread_Validation) bool {
+		return !validation.IsRevealed()
+	})
+	t.CommitRound++
+	t.RevealDeadline = 0
+	t.StartCommitPhase(height, t.CommitBlocks)
+}
+
+// StartSolutionReveal gives the proposer revealBlocks blocks to reveal the sampled frames, once enough validators revealed
+// their hashes. Returns true if the deadline was set
+func (t *VideoRenderingThread) StartSolutionReveal(height, revealBlocks int64) bool {
+	if t.Phase != VideoRenderingThread_REVEAL || t.SolutionRevealDeadline != 0 || !t.HasRevealQuorum() {
+		return false
+	}
+	t.SolutionRevealDeadline = height + revealBlocks
+	return true
+}
+
+// IsSolutionRevealExpired returns true if the solution reveal deadline is over without the proposer revealing the sampled frames
+func (t VideoRenderingThread) IsSolutionRevealExpired(height int64) bool {
+	return t.Phase == VideoRenderingThread_REVEAL && t.SolutionRevealDeadline != 0 && height > t.SolutionRevealDeadline && (t.Solution == nil || len(t.Solution.Frames) == 0)
+}
+
+// DropSolution drops the solution its proposer didn't reveal in time and removes the proposer from the workers of the thread.
+// The thread goes back to rendering, so another worker proposes a solution in the next proposal round, and validators
+// commit to it with new salts. Returns the dropped proposer
+func (t *VideoRenderingThread) DropSolution() string {
+	var proposer string
+	if t.Solution != nil {
+		proposer = t.Solution.ProposedBy
+	}
+	t.Workers = slices.DeleteFunc(t.Workers, func(worker string) bool {
+		return worker == proposer
+	})
+	t.Solution = nil
+	t.Validations = nil
+	t.SampledFrames = nil
+	t.Phase = VideoRenderingThread_RENDERING
+	t.CommitDeadline = 0
+	t.RevealDeadline = 0
+	t.SolutionRevealDeadline = 0
+	t.CommitRound++
+	t.ProposalRound++
+	return proposer
+}
+
+// ProposalId identifies the local commitment of the proposer to the thread, which is new on every proposal round
+func (t VideoRenderingThread) ProposalId() string {
+	if t.ProposalRound == 0 {
+		return t.ThreadId
+	}
+	return fmt.Sprintf("%s/proposal/%d", t.ThreadId, t.ProposalRound)
+}
+
+// StartProposal marks the solution of the thread as proposed and generates the salt of the commitment of the proposal round
+// before the solution is proposed, so the next blocks don't propose it again
+func (t VideoRenderingThread) StartProposal(db db.Database) error {
+	if err := db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false); err != nil {
+		return err
+	}
+	_, err := commitmentSalt(t.ProposalId(), db)
+	return err
+}
+
+// CommitmentId identifies the local commitment of a validator to the thread, which is new on every round of the commit phase
+func (t VideoRenderingThread) CommitmentId() string {
+	if t.CommitRound == 0 {
+		return t.ThreadId
+	}
+	return fmt.Sprintf("%s/%d", t.ThreadId, t.CommitRound)
+}
+
+// StartVerification marks the verification of the thread as started and generates the salt of the commitment of the round
+// before the verification runs, so the next blocks don't start it again
+func (t VideoRenderingThread) StartVerification(db db.Database) error {
+	if err := db.UpdateThread(t.ThreadId, true, true, true, true, true, true, false, false); err != nil {
+		return err
+	}
+	_, err := commitmentSalt(t.CommitmentId(), db)
+	return err
+}
+
+// GetValidation returns the validation submitted by the validator, if any
+func (t VideoRenderingThread) GetValidation(validator string) *VideoRenderingThread_Validation {
+	for _, validation := range t.Validations {
+		if validation.Validator == validator {
+			return validation
+		}
+	}
+	return nil
+}
+
+// RevealedValidations returns the amount of validations with their hashes revealed
+func (t VideoRenderingThread) RevealedValidations() int {
+	count := 0
+	for _, validation := range t.Validations {
+		if validation.IsRevealed() {
+			count++
+		}
+	}
+	return count
+}
+
+// IsRevealed returns true once the validator revealed the signed hashes it committed to
+func (v VideoRenderingThread_Validation) IsRevealed() bool {
+	if len(v.Frames) == 0 {
+		return false
+	}
+	for _, frame := range v.Frames {
+		if frame.Signature == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// RenderSlot returns the interleaved render order assigned to the worker, based on its subscription position
+func (t VideoRenderingThread) RenderSlot(worker string) int {
+	slots := max(int(t.RenderSlots), 1)
 	for i, v := range t.Workers {
 		if v == worker {
-			return i%2 != 0
+			return i % slots
 		}
 	}
-	return false
+	return 0
+}
+
+// RenderOrder returns the order in which the worker renders the thread frames.
+// The range is split in strides of RenderSlots frames. The worker in slot k renders
+// every frame at offset k of each stride first, then offset k+1 and so on, cyclically.
+// At any given step, workers of different slots are rendering disjoint frames.
+func (t VideoRenderingThread) RenderOrder(worker string) []int64 {
+	slots := max(int(t.RenderSlots), 1)
+	slot := t.RenderSlot(worker)
+
+	var order []int64
+	for i := 0; i < slots; i++ {
+		offset := int64((slot + i) % slots)
+		for frame := t.StartFrame + offset; frame <= t.EndFrame; frame += int64(slots) {
+			order = append(order, frame)
+		}
+	}
+	return order
 }
 
 func (t *VideoRenderingThread) GetValidatorReward(worker string, totalReward types.Coin) types.Coin {
@@ -334,8 +656,14 @@ func calculateValidatorPayment(filesValidated, totalFilesValidated int, totalVal
 	return totalValidatorReward.Mul(math.NewInt(int64(filesValidated))).Quo(math.NewInt(int64(totalFilesValidated)))
 }
 
-// Once validations are ready, we show blockchain the solution
-func (t *VideoRenderingThread) RevealSolution(rootPath string, db *db.DB) error {
+// Once validations are ready, we show blockchain the sampled frames of the solution
+func (t *VideoRenderingThread) RevealSolution(rootPath string, renderer vm.Renderer, db db.Database) error {
+	commitment, err := db.ReadCommitment(t.ProposalId())
+	if err != nil {
+		videoRenderingLogger.Logger.Error(err.Error())
+		return err
+	}
+
 	output := path.Join(rootPath, "renders", t.ThreadId, "output")
 	cids, err := ipfs.CalculateCIDs(output)
 	if err != nil {
@@ -343,18 +671,31 @@ func (t *VideoRenderingThread) RevealSolution(rootPath string, db *db.DB) error
 		return err
 	}
 
-	solution := make(map[string]VideoRenderingThread_Frame)
-	for filename, cid := range cids {
-		path := filepath.Join(output, filename)
-		hash, err := CalculateFileHash(path)
+	// we need every hash and the salt to rebuild the merkle tree we proposed
+	hashes, err := t.directoryHashes(output, t.PixelHashVersion(), renderer)
+	if err != nil {
+		videoRenderingLogger.Logger.Error(err.Error())
+		return err
+	}
 
+	tree, err := t.NewFrameMerkleTree(hashes, commitment.Salt, t.Solution.ProposedBy)
+	if err != nil {
+		videoRenderingLogger.Logger.Error(err.Error())
+		return err
+	}
+
+	solution := make(map[string]VideoRenderingThread_Frame)
+	proofs := make(map[string][]string)
+	for _, sampled := range t.SampledFrames {
+		filename := vm.FormatFrameFilename(int(sampled), t.FrameExtension())
+		proof, err := tree.Proof(int(sampled - t.StartFrame))
 		if err != nil {
 			videoRenderingLogger.Logger.Error(err.Error())
 			return err
 		}
 
-		frame := VideoRenderingThread_Frame{Filename: filename, Cid: cid, Hash: hash}
-		solution[filename] = frame
+		solution[filename] = VideoRenderingThread_Frame{Filename: filename, Cid: cids[filename], Hash: hashes[filename]}
+		proofs[filename] = proof
 	}
 
 	// Base arguments
@@ -362,7 +703,8 @@ func (t *VideoRenderingThread) RevealSolution(rootPath string, db *db.DB) error
 		"tx", "videoRendering", "reveal-solution",
 		t.TaskId, t.ThreadId,
 	}
-	args = append(args, FromFramesToCli(solution)...)
+	args = append(args, commitment.Salt)
+	args = append(args, FromFramesToCli(solution, proofs)...)
 	args = append(args, "--from")
 	args = append(args, t.Solution.ProposedBy)
 	args = append(args, "--yes")
@@ -378,10 +720,16 @@ func (t *VideoRenderingThread) RevealSolution(rootPath string, db *db.DB) error
 	return nil
 }
 
-// Evaluates if the verifications sent are valid
-func (t *VideoRenderingThread) EvaluateVerifications() error {
+// Evaluates if the revealed verifications are valid.
+// Signatures must be over the frame sign doc of this chain, task, thread and frame.
+func (t *VideoRenderingThread) EvaluateVerifications(chainId string) error {
 	for _, frame := range t.Solution.Frames {
 		for _, validation := range t.Validations {
+			if !validation.IsRevealed() {
+				// validator only committed, there is nothing to verify yet
+				continue
+			}
+
 			idx := slices.IndexFunc(validation.Frames, func(f *VideoRenderingThread_Frame) bool { return f.Filename == frame.Filename })
 
 			if idx < 0 {
@@ -396,7 +744,19 @@ func (t *VideoRenderingThread) EvaluateVerifications() error {
 				return err
 			}
 
-			message, err := videoRenderingCrypto.GenerateSignableMessage(frame.Hash, validation.Validator)
+			// validators sign their own render. Unless frames are compared perceptually, it must be the hash of the solution
+			hash := frame.Hash
+			if t.Comparison.IsPerceptual() {
+				hash = validation.Frames[idx].Hash
+			}
+
+			signDoc, err := t.NewFrameSignDoc(chainId, frame.Filename, hash, validation.Validator)
+			if err != nil {
+				videoRenderingLogger.Logger.Error("unable to recreate sign doc of frame %s: %s", frame.Filename, err.Error())
+				return err
+			}
+
+			message, err := videoRenderingCrypto.GenerateSignableMessage(signDoc)
 			if err != nil {
 				videoRenderingLogger.Logger.Error("unable to recreate original message %sto verify: %s", message, err.Error())
 				return err
@@ -407,7 +767,7 @@ func (t *VideoRenderingThread) EvaluateVerifications() error {
 				return err
 			}
 
-			valid := pk.VerifySignature(message, sig)
+			valid := pk.VerifySignature(message, sig) && t.Comparison.Matches(frame.Hash, hash)
 
 			if valid {
 				// verification passed
@@ -422,44 +782,47 @@ func (t *VideoRenderingThread) EvaluateVerifications() error {
 	return nil
 }
 
-// for those frames evaluated, if we have at least one that has more
-// invalid counts than valid ones, we rejected. Otherwise is accepted
+// The solution is accepted when every sampled frame has enough valid verifications
+// and more valid than invalid ones.
 func (t *VideoRenderingThread) IsSolutionAccepted() bool {
-	validFrameCount := 0
-
 	minValidValidations := 2
 	if len(t.Workers) == 1 {
 		minValidValidations = 1
 	}
 
-	totalFrames := len(t.Solution.Frames)
-	if totalFrames == 0 {
+	if len(t.Solution.Frames) == 0 || len(t.SampledFrames) == 0 {
 		return false // no frames to evaluate
 	}
 
-	for _, frame := range t.Solution.Frames {
-		if int(frame.ValidCount) >= minValidValidations {
-			validFrameCount++
+	for _, sampled := range t.SampledFrames {
+		frame := GetFrame(t.Solution.Frames, vm.FormatFrameFilename(int(sampled), t.FrameExtension()))
+		if frame == nil {
+			return false
 		}
-	}
 
-	// Require at least 20% of frames to be valid
-	required := int(float64(totalFrames) * 0.2)
-	if required == 0 && totalFrames > 0 {
-		required = 1 // always require at least 1 if there are frames
+		if int(frame.ValidCount) < minValidValidations || frame.InvalidCount >= frame.ValidCount {
+			return false
+		}
 	}
 
-	return validFrameCount >= required
+	return true
 }
 
-// validates the IPFS dir contains all files in the solution
+// validates the IPFS dir contains all files in the solution, in the output format of the task
 func (t *VideoRenderingThread) VerifySubmittedSolution(dir string) error {
 	files, err := ipfs.ListDirectory(dir)
 	if err != nil {
 		videoRenderingLogger.Logger.Error("VerifySubmittedSolution dir: %s:%s", dir, err.Error())
 		return err
 	}
+	extension := t.FrameExtension()
 	for _, frame := range t.Solution.Frames {
+		// frames must be in the output format of the task
+		if filepath.Ext(frame.Filename) != "."+extension {
+			err := fmt.Errorf("frame %s isn't a .%s frame", frame.Filename, extension)
+			videoRenderingLogger.Logger.Error(err.Error())
+			return err
+		}
 		if frame.Cid != files[frame.Filename] {
 			err := fmt.Errorf("frame %s [%s] doesn't exists in %s", frame.Filename, frame.Cid, dir)
 			videoRenderingLogger.Logger.Error(err.Error())
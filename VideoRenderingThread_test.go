@@ -2,10 +2,13 @@ package videoRendering
 
 import (
 	"context"
+	"encoding/hex"
 	fmt "fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
-	"time"
 
 	"bou.ke/monkey"
 	sdkmath "cosmossdk.io/math"
@@ -14,6 +17,7 @@ import (
 	secp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	c_types "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	videoRenderingCrypto "github.com/janction/videoRendering/crypto"
 	"github.com/janction/videoRendering/db"
 	"github.com/janction/videoRendering/ipfs"
@@ -25,7 +29,7 @@ import (
 )
 
 // --- Test for StartWork ---
-func TestStartWork_ContainerRunning(t *testing.T) {
+func TestStartWork_RemovesLeftoverContainer(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -33,29 +37,34 @@ func TestStartWork_ContainerRunning(t *testing.T) {
 		StartFrame: 0,
 		EndFrame:   1,
 	}
+	path := t.TempDir()
 
-	// Mock DB methods
-	mockDB.On("UpdateThread", "thread123", false, false, true, false, false, false, false, false).Return(nil).Once()
+	// a render interrupted by a restart of the node leaves its container
+	fake := vm.NewFakeRuntime()
+	fake.AddContainer(vm.RendererContainerName(thread.ThreadId, path), vm.ContainerRunning)
+	renderer := vm.Renderer{VM: &vm.VM{Runtime: fake, Sandbox: vm.DefaultSandbox(), Policy: vm.DefaultRenderPolicy()}, Image: testRendererImage}
 
+	// Mock DB methods
+	mockDB.On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return true // Simulate that the container is running
-	})
+	patch1 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {})
 	defer patch1.Unpatch()
 
-	// Prepare test context and input values
-	cid := "fakeCID"
-	path := t.TempDir()
-	ctx := context.Background()
+	patch2 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
+		return fmt.Errorf("IPFS file is not available")
+	})
+	defer patch2.Unpatch()
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
-	require.NoError(t, err)
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", path, renderer, mockDB)
+	require.Error(t, err)
 
-	// Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// the work starts again instead of waiting for the leftover container
+	require.Nil(t, fake.Container(vm.RendererContainerName(thread.ThreadId, path)))
+	mockDB.AssertCalled(t, "UpdateThread", "thread123", false, false, true, false, false, false, false, false)
 }
 
 func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetKo(t *testing.T) {
@@ -74,12 +83,7 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetKo(t *testing.T) {
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
 		return nil // Simulate successful removal of container
 	})
 	defer patch2.Unpatch()
@@ -101,7 +105,7 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetKo(t *testing.T) {
 	ctx := context.Background()
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(ctx, "worker1", cid, path, testRenderer, mockDB)
 
 	// Verify that we got the expected error (IPFS file not available)
 	require.Error(t, err)
@@ -114,6 +118,7 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetKo(t *testing.T) {
 func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{}, nil).Once()
 	thread := &VideoRenderingThread{
 		ThreadId:   "thread123",
 		StartFrame: 0,
@@ -141,21 +146,20 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoKo(t *te
 		Return(nil).
 		Times(4)
 
+	// no frame was rendered
+	mockDB.On("ReadRenderedFrames", "thread123").Return(map[int64]bool{}, nil)
+
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
 		return nil // Simulate successful removal of container
 	})
 	defer patch2.Unpatch()
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
 		// no-op, simulate video rendering
+		return nil
 	})
 	defer patch3.Unpatch()
 
@@ -181,7 +185,7 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoKo(t *te
 	ctx := context.Background()
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(ctx, "worker1", cid, path, testRenderer, mockDB)
 
 	// Verify that we got the expected thread status (video rendering error)
 	require.NoError(t, err)
@@ -191,23 +195,135 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoKo(t *te
 	mockDB.AssertExpectations(t)
 }
 
-func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_FilesKo(t *testing.T) {
+func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoError(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{}, nil).Once()
 	thread := &VideoRenderingThread{
 		ThreadId:   "thread123",
 		StartFrame: 0,
 		EndFrame:   1,
 	}
-	file := mocks.MockFileInfo{
-		Filename: "video1.mp4",
-		Filesize: 2048,
-		Filemode: 0644,
-		ModTime_: time.Date(2025, 4, 11, 10, 0, 0, 0, time.UTC),
-		IsDir_:   false,
+
+	mockDB.On("UpdateThread", "thread123", false, false, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, false, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, true, true, false, false, false, false, false).Return(nil).Once()
+	// the thread stops rendering, so the next run renders the frame again
+	mockDB.On("UpdateThread", "thread123", true, true, false, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Monkey patching
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
+		return nil
+	})
+	defer patch2.Unpatch()
+
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
+		return fmt.Errorf("failed to check container existence: docker unreachable")
+	})
+	defer patch3.Unpatch()
+
+	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {})
+	defer patch4.Unpatch()
+
+	patch5 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
+		return nil
+	})
+	defer patch5.Unpatch()
+
+	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
+		t.Fatal("a frame the worker couldn't render is reported as unrenderable")
+		return nil
+	})
+	defer patch6.Unpatch()
+
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), testRenderer, mockDB)
+
+	require.ErrorContains(t, err, "docker unreachable")
+	mockDB.AssertExpectations(t)
+}
+
+func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoUnrenderable(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{}, nil).Once()
+	mockDB.On("AddReportedFrame", "thread123", int64(1)).Return(nil).Once()
+	thread := &VideoRenderingThread{
+		TaskId:     "task123",
+		ThreadId:   "thread123",
+		StartFrame: 0,
+		EndFrame:   1,
 	}
-	files := []os.DirEntry{
-		mocks.MockDirEntry{Filename: "video1.mp4", IsDir_: false},
+
+	// Mock DB methods. The thread stops working, and isn't completed
+	mockDB.On("UpdateThread", "thread123", false, false, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, false, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, true, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, true, false, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Monkey patching
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
+		return nil // Simulate successful removal of container
+	})
+	defer patch2.Unpatch()
+
+	failure := &vm.FrameFailure{Frame: 1, Kind: vm.FailureTimeout, Attempts: 3, Logs: "Fra:1 Mem:12.00M | Syncing Cube", Err: fmt.Errorf("render didn't finish in 1h0m0s")}
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
+		return failure // Simulate blender hanging on frame 1
+	})
+	defer patch3.Unpatch()
+
+	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {
+		// no-op, simulate IPFS is running
+	})
+	defer patch4.Unpatch()
+
+	patch5 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
+		// Simulate IPFS file is available
+		return nil
+	})
+	defer patch5.Unpatch()
+
+	var reported []string
+	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
+		reported = args
+		return nil
+	})
+	defer patch6.Unpatch()
+
+	// the log is uploaded to an in memory store instead of the IPFS daemon
+	store := ipfs.NewMemoryStore()
+	previousStore := ipfs.SetStore(store)
+	defer ipfs.SetStore(previousStore)
+
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), testRenderer, mockDB)
+
+	// Verify the frame was reported with the log of its last attempt instead of rendered again
+	require.ErrorIs(t, err, failure)
+	require.Len(t, reported, 12)
+	logCid := reported[8]
+	require.Equal(t, []string{"tx", "videoRendering", "report-task-failure", "task123", "thread123", "1", "timeout", "3", logCid, "--yes", "--from", "worker1"}, reported)
+	downloads := t.TempDir()
+	require.NoError(t, store.Get(logCid, downloads))
+	uploaded, err := os.ReadFile(filepath.Join(downloads, logCid))
+	require.NoError(t, err)
+	require.Equal(t, failure.Logs, string(uploaded))
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
+func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_FilesKo(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{}, nil).Once()
+	thread := &VideoRenderingThread{
+		ThreadId:   "thread123",
+		StartFrame: 0,
+		EndFrame:   1,
 	}
 	expected := false
 
@@ -231,21 +347,20 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 		Return(nil).
 		Times(4)
 
+	// only the first frame was rendered
+	mockDB.On("ReadRenderedFrames", "thread123").Return(map[int64]bool{0: true}, nil)
+
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
 		return nil // Simulate successful removal of container
 	})
 	defer patch2.Unpatch()
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
 		// no-op, simulate video rendering
+		return nil
 	})
 	defer patch3.Unpatch()
 
@@ -260,23 +375,13 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 	})
 	defer patch5.Unpatch()
 
-	patch6 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return file, nil
-	})
-	defer patch6.Unpatch()
-
-	patch7 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return files, nil
-	})
-	defer patch7.Unpatch()
-
 	// Prepare test context and input values
 	cid := "fakeCID"
 	path := t.TempDir()
 	ctx := context.Background()
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(ctx, "worker1", cid, path, testRenderer, mockDB)
 
 	// Verify that we got the expected thread status (incorrect amount of files)
 	require.NoError(t, err)
@@ -289,22 +394,12 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_FilesOk(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{}, nil).Once()
 	thread := &VideoRenderingThread{
 		ThreadId:   "thread123",
 		StartFrame: 0,
 		EndFrame:   1,
 	}
-	file := mocks.MockFileInfo{
-		Filename: "video1.mp4",
-		Filesize: 2048,
-		Filemode: 0644,
-		ModTime_: time.Date(2025, 4, 11, 10, 0, 0, 0, time.UTC),
-		IsDir_:   false,
-	}
-	files := []os.DirEntry{
-		mocks.MockDirEntry{Filename: "video1.mp4", IsDir_: false},
-		mocks.MockDirEntry{Filename: "video2.mp4", IsDir_: false},
-	}
 	expected := false
 
 	// Mock DB methods
@@ -327,21 +422,19 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 		Return(nil).
 		Times(4)
 
+	mockDB.On("ReadRenderedFrames", "thread123").Return(map[int64]bool{0: true, 1: true}, nil)
+
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.IsContainerRunning, func(ctx context.Context, threadId string) bool {
-		return false // Simulate container is not running
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(vm.RemoveContainer, func(ctx context.Context, name string) error {
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
 		return nil // Simulate successful removal of container
 	})
 	defer patch2.Unpatch()
 
-	patch3 := monkey.Patch(vm.RenderVideo, func(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db db.Database) {
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
 		// no-op, simulate video rendering
+		return nil
 	})
 	defer patch3.Unpatch()
 
@@ -356,23 +449,18 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 	})
 	defer patch5.Unpatch()
 
-	patch6 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return file, nil
-	})
-	defer patch6.Unpatch()
-
-	patch7 := monkey.Patch(os.ReadDir, func(name string) ([]os.DirEntry, error) {
-		return files, nil
-	})
-	defer patch7.Unpatch()
-
 	// Prepare test context and input values
 	cid := "fakeCID"
 	path := t.TempDir()
+	// both frames were rendered by a previous run
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "output"), 0755))
+	for frame := 0; frame <= 1; frame++ {
+		require.NoError(t, createTestImage(filepath.Join(path, "output", vm.FormatFrameFilename(frame, "png"))))
+	}
 	ctx := context.Background()
 
 	// Execute method under test
-	err := thread.StartWork(ctx, "worker1", cid, path, mockDB)
+	err := thread.StartWork(ctx, "worker1", cid, path, testRenderer, mockDB)
 
 	// Verify that we got the expected thread status (video rendering error)
 	require.NoError(t, err)
@@ -382,6 +470,63 @@ func TestStartWork_ContainerNotRunning_IPFSRunning_IPFSGetOk_RenderVideoOk_Files
 	mockDB.AssertExpectations(t)
 }
 
+func TestStartWork_AlreadyDownloaded(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		ThreadId:   "thread123",
+		StartFrame: 0,
+		EndFrame:   2,
+	}
+	var rendered []int64
+
+	// the restarted thread doesn't go through the download statuses again
+	mockDB.On("UpdateThread", "thread123", false, false, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, true, true, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("UpdateThread", "thread123", true, true, false, false, false, false, false, false).Return(nil).Once()
+	mockDB.On("ReadRenderedFrames", "thread123").Return(map[int64]bool{}, nil)
+	mockDB.On("ReadReportedFrames", "thread123").Return(map[int64]bool{2: true}, nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Monkey patching
+	patch2 := monkey.Patch((*vm.VM).RemoveContainer, func(v *vm.VM, ctx context.Context, name string) error {
+		return nil
+	})
+	defer patch2.Unpatch()
+
+	var image vm.RendererImage
+	patch3 := monkey.Patch((*vm.VM).RenderVideo, func(v *vm.VM, ctx context.Context, cid string, frames []int64, id string, path string, settings vm.RenderSettings, db db.Database) error {
+		rendered = append([]int64(nil), frames...)
+		image = settings.Image
+		return nil
+	})
+	defer patch3.Unpatch()
+
+	patch4 := monkey.Patch(ipfs.EnsureIPFSRunning, func() {})
+	defer patch4.Unpatch()
+
+	patch5 := monkey.Patch(ipfs.IsDownloaded, func(cid string, dir string) bool {
+		return true
+	})
+	defer patch5.Unpatch()
+
+	patch6 := monkey.Patch(ipfs.IPFSGet, func(cid string, outputPath string) error {
+		t.Fatal("file is downloaded again")
+		return nil
+	})
+	defer patch6.Unpatch()
+
+	// Execute method under test
+	err := thread.StartWork(context.Background(), "worker1", "fakeCID", t.TempDir(), testRenderer, mockDB)
+
+	// frames are still missing, so the thread is rendered again. The frame this node reported isn't
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{0, 1}, rendered)
+	// frames are rendered with the image selected for the thread
+	require.Equal(t, testRendererImage, image)
+	mockDB.AssertExpectations(t)
+}
+
 // --- Test for ProposeSolution ---
 func TestProposeSolution_FrameAmountKo(t *testing.T) {
 	// Setup
@@ -415,12 +560,12 @@ func TestProposeSolution_FrameAmountKo(t *testing.T) {
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 1
 	})
 	defer patch1.Unpatch()
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected thread status (frame amount error)
 	require.NoError(t, err)
@@ -448,17 +593,17 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesKo(t *testing.T) {
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return nil, fmt.Errorf("Generate hash error")
 	})
 	defer patch2.Unpatch()
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -486,15 +631,15 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyKo(t *te
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
+			"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "1234567890abcdef1234",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -504,7 +649,7 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyKo(t *te
 	})
 	defer patch3.Unpatch()
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -514,7 +659,7 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyKo(t *te
 	mockDB.AssertExpectations(t)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageKo(t *testing.T) {
+func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_ReadCommitmentKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -530,17 +675,18 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Twice()
+	mockDB.On("ReadCommitment", "thread123").Return((*db.Commitment)(nil), fmt.Errorf("ReadCommitment error")).Once()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
+			"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "1234567890abcdef1234",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -550,22 +696,17 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), fmt.Errorf("GenerateSignableMessage error")
-	})
-	defer patch4.Unpatch()
-
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "GenerateSignableMessage error")
+	require.Contains(t, err.Error(), "ReadCommitment error")
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageKo(t *testing.T) {
+func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_MerkleTreeKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -582,16 +723,18 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 		Return(nil).
 		Twice()
 
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
+			"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000002.png": "1234567890abcdef1234",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -601,27 +744,17 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Signing message error")
-	})
-	defer patch5.Unpatch()
-
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Signing message error")
+	require.Contains(t, err.Error(), "frame_000001.png is missing")
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageOk_SolutionKo(t *testing.T) {
+func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_MerkleTreeOk_SolutionKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -638,16 +771,19 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 		Return(nil).
 		Once()
 
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("GetAverageRenderTime", "thread123").Return(0, nil).Once()
+
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
+			"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "1234567890abcdef1234",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -657,22 +793,12 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
+	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
 		return fmt.Errorf("Solution error")
 	})
-	defer patch6.Unpatch()
+	defer patch4.Unpatch()
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -682,7 +808,7 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 	mockDB.AssertExpectations(t)
 }
 
-func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_GenerateMessageOk_SignMessageOk_SolutionOk(t *testing.T) {
+func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_MerkleTreeOk_SolutionOk(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -701,16 +827,19 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("GetAverageRenderTime", "thread123").Return(12, nil).Once()
+
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
+	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string, extension string) int {
 		return 2
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
-			"video2.mp4": "1234567890abcdef1234",
+			"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "1234567890abcdef1234",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -720,32 +849,36 @@ func TestProposeSolution_FrameAmountOk_GenerateHashesOk_ExtractPublicKeyOk_Gener
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(ExecuteCli, func(args []string) error {
+	var proposed []string
+	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
+		proposed = args
 		return nil
 	})
-	defer patch6.Unpatch()
+	defer patch4.Unpatch()
 
-	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.ProposeSolution(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
-	// Verify that we got no error
+	// Verify that we got no error and only the merkle root is proposed
+	require.NoError(t, err)
+	tree, err := thread.NewFrameMerkleTree(map[string]string{
+		"frame_000000.png": "a1b2c3d4e5f6g7h8i9j0",
+		"frame_000001.png": "1234567890abcdef1234",
+	}, testCommitmentSalt, "cosmos1abcdefg1234567")
 	require.NoError(t, err)
+	require.Equal(t, tree.Root(), proposed[6])
+	require.Equal(t, []string{"--hash-version", CurrentPixelHashVersion}, proposed[7:9])
+	require.Equal(t, []string{"--render-settings", thread.VMSettings().Fingerprint()}, proposed[9:11])
+	require.Equal(t, []string{"--renderer-digest", testRendererImage.Digest}, proposed[11:13])
+	// the render time sizes the commit window of the validators
+	require.Equal(t, []string{"--average-render-seconds", "12"}, proposed[13:15])
+	require.Equal(t, "--yes", proposed[15])
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
 // --- Test for SubmitVerification ---
-func TestSubmitVerification_FileCountKo(t *testing.T) {
+func TestSubmitVerification_NoSampledFrames(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
@@ -776,29 +909,53 @@ func TestSubmitVerification_FileCountKo(t *testing.T) {
 		Return(nil).
 		Twice()
 
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 0
-	})
-	defer patch1.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
-	// Verify that we got the expected thread status (file amount error)
+	// Verify that we got the expected thread status (no sampled frames)
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (file amount error)")
+	require.True(t, expected, "Expected thread status (no sampled frames)")
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdKo(t *testing.T) {
+func TestSubmitVerification_OtherRenderSettings(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   10,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{0},
+		Solution:      &VideoRenderingThread_Solution{ProposedBy: "alice", RenderSettings: strings.Repeat("ab", 32)},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	mockDB.On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockDB.On("AddLogEntry", "thread123", mock.Anything, mock.Anything, int64(2)).Return(nil).Once()
+
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		t.Fatalf("frames rendered with other settings must not be hashed")
+		return nil, nil
+	})
+	defer patch1.Unpatch()
+
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// we can't verify frames rendered under other conditions
+	require.Error(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSubmitVerification_SampledFramesNotRendered(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      10,
+		SampledFrames: []int64{3, 7},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 	expected := false
@@ -824,28 +981,29 @@ func TestSubmitVerification_FileCountOk_FileThresholdKo(t *testing.T) {
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return nil, fmt.Errorf("open frame_000007.png: %w", os.ErrNotExist)
 	})
 	defer patch1.Unpatch()
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
-	// Verify that we got the expected error
+	// Verify that we keep rendering instead of failing
 	require.NoError(t, err)
-	require.True(t, expected, "Expected thread status (file threshold error)")
+	require.True(t, expected, "Expected thread status (sampled frames not rendered)")
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesKo(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -857,17 +1015,12 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesKo(t *
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return nil, fmt.Errorf("Generate hash error")
 	})
 	defer patch2.Unpatch()
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -877,13 +1030,14 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesKo(t *
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyKo(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesOk_GetPublicKeyKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -895,14 +1049,9 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 		Twice()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -912,7 +1061,7 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	})
 	defer patch3.Unpatch()
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -922,13 +1071,14 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageKo(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesOk_GetPublicKeyOk_ReadCommitmentKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -938,16 +1088,12 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Twice()
+	mockDB.On("ReadCommitment", "thread123").Return((*db.Commitment)(nil), fmt.Errorf("ReadCommitment error")).Once()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -957,28 +1103,24 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), fmt.Errorf("GenerateSignableMessage error")
-	})
-	defer patch4.Unpatch()
-
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "GenerateSignableMessage error")
+	require.Contains(t, err.Error(), "ReadCommitment error")
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageKo(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesOk_GetPublicKeyOk_NewSaltOk_SubmitValidationOk(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -987,17 +1129,18 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 		On("UpdateThread", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
-		Twice()
-
-	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
+		Once()
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123"}, nil).Once()
+	var salt string
+	mockDB.On("UpdateCommitment", "thread123", mock.Anything, false).Run(func(args mock.Arguments) {
+		salt = args.String(1)
+	}).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	// Monkey patching
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -1007,33 +1150,32 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
+	var submitted []string
+	patch4 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, commitments []string) error {
+		submitted = commitments
+		return nil
 	})
 	defer patch4.Unpatch()
 
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), fmt.Errorf("Signing message error")
-	})
-	defer patch5.Unpatch()
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
-
-	// Verify that we got the expected error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Signing message error")
+	// Verify that a new salt was stored and used to commit to the sampled frame
+	require.NoError(t, err)
+	require.True(t, IsValidCommitmentSalt(salt))
+	require.Equal(t, []string{"frame_000001.png=" + FrameCommitment("a1b2c3d4e5f6g7h8i9j0", salt, "cosmos1abcdefg1234567")}, submitted)
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageOk_SubmitValidationKo(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesOk_GetPublicKeyOk_ReadCommitmentOk_SubmitValidationKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -1043,18 +1185,13 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Twice()
-
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -1064,22 +1201,12 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, signatures []string) error {
+	patch4 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, commitments []string) error {
 		return fmt.Errorf("Submit validation error")
 	})
-	defer patch6.Unpatch()
+	defer patch4.Unpatch()
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -1089,13 +1216,14 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	mockDB.AssertExpectations(t)
 }
 
-func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_GetPublicKeyOk_GenerateSignableMessageOk_SignMessageOk_SubmitValidationOk(t *testing.T) {
+func TestSubmitVerification_GenerateFrameHashesOk_GetPublicKeyOk_ReadCommitmentOk_SubmitValidationOk(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
 
@@ -1105,18 +1233,13 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil).
 		Once()
-
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
-	patch1 := monkey.Patch(vm.CountFilesInDirectory, func(directoryPath string) int {
-		return 1
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string) (map[string]string, error) {
+	patch2 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
 		return map[string]string{
-			"video1.mp4": "a1b2c3d4e5f6g7h8i9j0",
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
 		}, nil
 	})
 	defer patch2.Unpatch()
@@ -1126,25 +1249,18 @@ func TestSubmitVerification_FileCountOk_FileThresholdOk_GenerateFileHashesOk_Get
 	})
 	defer patch3.Unpatch()
 
-	patch4 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
-		return []byte("fake-signable-message"), nil
-	})
-	defer patch4.Unpatch()
-
-	patch5 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
-		return []byte("fake-signable-message"), secp256k1.GenPrivKey().PubKey(), nil
-	})
-	defer patch5.Unpatch()
-
-	patch6 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, signatures []string) error {
+	var submitted []string
+	patch4 := monkey.Patch(submitValidation, func(validator string, taskId, threadId, publicKey string, commitments []string) error {
+		submitted = commitments
 		return nil
 	})
-	defer patch6.Unpatch()
+	defer patch4.Unpatch()
 
-	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", mockDB)
+	err := thread.SubmitVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "/tmp/test-rendering", testRenderer, mockDB)
 
-	// Verify that we got no error
+	// Verify that we got no error and only the commitment of the sampled frame was submitted
 	require.NoError(t, err)
+	require.Equal(t, []string{"frame_000001.png=" + FrameCommitment("a1b2c3d4e5f6g7h8i9j0", testCommitmentSalt, "cosmos1abcdefg1234567")}, submitted)
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
@@ -1193,6 +1309,216 @@ func TestSubmitValidationOk(t *testing.T) {
 }
 
 // --- Test for SubmitSolution ---
+// --- Test for RevealVerification ---
+func TestRevealVerification_NoCommitment(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		TaskId:        "task456",
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123"}, nil).Once()
+
+	err := thread.RevealVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "janction-test", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// Verify that we got the expected error
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't have a local commitment")
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
+func TestRevealVerification_CommitmentOk_GenerateFrameHashesKo(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		TaskId:        "task456",
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, true).Return(nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, false).Return(nil).Once()
+
+	// Monkey patching
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return nil, fmt.Errorf("Generate hash error")
+	})
+	defer patch1.Unpatch()
+
+	err := thread.RevealVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "janction-test", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// Verify that we got the expected error and the commitment can be revealed again
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Generate hash error")
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
+func TestRevealVerification_CommitmentOk_GenerateFrameHashesOk_SignMessageKo(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		TaskId:        "task456",
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, true).Return(nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, false).Return(nil).Once()
+
+	// Monkey patching
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
+		}, nil
+	})
+	defer patch1.Unpatch()
+
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
+		return []byte("fake-signable-message"), nil
+	})
+	defer patch2.Unpatch()
+
+	patch3 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
+		return nil, nil, fmt.Errorf("Signing message error")
+	})
+	defer patch3.Unpatch()
+
+	err := thread.RevealVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "janction-test", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// Verify that we got the expected error
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Signing message error")
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
+func TestRevealVerification_CommitmentOk_GenerateFrameHashesOk_SignMessageOk_ExecuteCliKo(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		TaskId:        "task456",
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, true).Return(nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, false).Return(nil).Once()
+
+	// Monkey patching
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
+		}, nil
+	})
+	defer patch1.Unpatch()
+
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
+		return []byte("fake-signable-message"), nil
+	})
+	defer patch2.Unpatch()
+
+	patch3 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
+		return []byte("signature"), secp256k1.GenPrivKey().PubKey(), nil
+	})
+	defer patch3.Unpatch()
+
+	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
+		return fmt.Errorf("ExecuteCLI error")
+	})
+	defer patch4.Unpatch()
+
+	err := thread.RevealVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "janction-test", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// Verify that we got the expected error
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ExecuteCLI error")
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
+func TestRevealVerification_CommitmentOk_GenerateFrameHashesOk_SignMessageOk_ExecuteCliOk(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		TaskId:        "task456",
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+	mockDB.On("UpdateCommitment", "thread123", testCommitmentSalt, true).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Monkey patching
+	patch1 := monkey.Patch(GenerateFrameHashes, func(directoryPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000001.png": "a1b2c3d4e5f6g7h8i9j0",
+		}, nil
+	})
+	defer patch1.Unpatch()
+
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
+		return []byte("fake-signable-message"), nil
+	})
+	defer patch2.Unpatch()
+
+	patch3 := monkey.Patch(videoRenderingCrypto.SignMessage, func(rootDir, alias string, message []byte, codec codec.Codec) ([]byte, cryptotypes.PubKey, error) {
+		return []byte("signature"), secp256k1.GenPrivKey().PubKey(), nil
+	})
+	defer patch3.Unpatch()
+
+	var revealed []string
+	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
+		revealed = args
+		return nil
+	})
+	defer patch4.Unpatch()
+
+	err := thread.RevealVerification(cdc, "worker-alias-001", "cosmos1abcdefg1234567", "janction-test", "/tmp/test-rendering", testRenderer, mockDB)
+
+	// Verify that the salt and the signed hash of the sampled frame are revealed
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"tx", "videoRendering", "reveal-validation", "task456", "thread123", testCommitmentSalt,
+		"frame_000001.png=a1b2c3d4e5f6g7h8i9j0:" + videoRenderingCrypto.EncodeSignatureForCLI([]byte("signature")),
+		"--from", "cosmos1abcdefg1234567", "--yes",
+	}, revealed)
+
+	// Verify mock expectations
+	mockDB.AssertExpectations(t)
+}
+
 func TestSubmitSolution_IpfsKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
@@ -1249,6 +1575,7 @@ func TestSubmitSolution_IpfsOk_SubmitSolutionKo(t *testing.T) {
 		Return(nil).
 		Twice()
 
+	mockDB.On("GetAverageRenderTime", "thread123").Return(30, nil)
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
@@ -1257,7 +1584,7 @@ func TestSubmitSolution_IpfsOk_SubmitSolutionKo(t *testing.T) {
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string) error {
+	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string, duration int64) error {
 		return fmt.Errorf("submit solution error")
 	})
 	defer patch2.Unpatch()
@@ -1291,6 +1618,7 @@ func TestSubmitSolution_IpfsOk_SubmitSolutionOk(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockDB.On("GetAverageRenderTime", "thread123").Return(30, nil)
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	// Monkey patching
@@ -1299,7 +1627,7 @@ func TestSubmitSolution_IpfsOk_SubmitSolutionOk(t *testing.T) {
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string) error {
+	patch2 := monkey.Patch(submitSolution, func(address string, taskId string, threadId string, cid string, duration int64) error {
 		return nil
 	})
 	defer patch2.Unpatch()
@@ -1327,7 +1655,7 @@ func TestSubmitSolutionKo(t *testing.T) {
 	})
 	defer patch1.Unpatch()
 
-	err := submitSolution(address, taskId, threadId, cid)
+	err := submitSolution(address, taskId, threadId, cid, 0)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -1347,30 +1675,195 @@ func TestSubmitSolutionOk(t *testing.T) {
 	})
 	defer patch1.Unpatch()
 
-	err := submitSolution(address, taskId, threadId, cid)
+	err := submitSolution(address, taskId, threadId, cid, 0)
 
 	// Verify that we got no error
 	require.NoError(t, err)
 }
 
-// --- Test for IsReverse ---
-func TestIsReverse(t *testing.T) {
+// --- Test for RenderOrder ---
+// --- Test for the commit-reveal phases ---
+func TestCommitPhase(t *testing.T) {
+	thread := &VideoRenderingThread{ThreadId: "thread123"}
+
+	require.False(t, thread.IsCommitOpen(100))
+
+	thread.StartCommitPhase(100, 10)
+	require.Equal(t, VideoRenderingThread_COMMIT, thread.Phase)
+	require.Equal(t, int64(110), thread.CommitDeadline)
+	require.True(t, thread.IsCommitOpen(110))
+
+	// the deadline is inclusive, reveals open on the next block
+	require.False(t, thread.CloseCommitPhase(110, 5))
+	require.Equal(t, VideoRenderingThread_COMMIT, thread.Phase)
+
+	require.True(t, thread.CloseCommitPhase(111, 5))
+	require.Equal(t, VideoRenderingThread_REVEAL, thread.Phase)
+	require.Equal(t, int64(116), thread.RevealDeadline)
+	require.False(t, thread.IsCommitOpen(111))
+
+	// only a thread in the commit phase can be closed
+	require.False(t, thread.CloseCommitPhase(112, 5))
+}
+
+// --- Test for reopening the commit phase ---
+func TestReopenCommitPhase(t *testing.T) {
+	revealed := &VideoRenderingThread_Validation{Validator: "validator1", Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Hash: "hash", Signature: "signature"}}}
+	committed := &VideoRenderingThread_Validation{Validator: "validator2", Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Commitment: "commitment"}}}
+	thread := &VideoRenderingThread{ThreadId: "thread123", Workers: []string{"proposer", "validator1", "validator2", "validator3"}, Validations: []*VideoRenderingThread_Validation{revealed, committed}}
+	require.Equal(t, "thread123", thread.CommitmentId())
+
+	thread.StartCommitPhase(100, 10)
+	require.True(t, thread.CloseCommitPhase(111, 5))
+
+	// a single reveal isn't enough, but validators have until the deadline
+	require.False(t, thread.HasRevealQuorum())
+	require.False(t, thread.IsRevealExpired(116))
+	require.True(t, thread.IsRevealExpired(117))
+
+	// the validation that wasn't revealed is dropped, and validators commit again with the same window
+	thread.ReopenCommitPhase(117)
+	require.Equal(t, VideoRenderingThread_COMMIT, thread.Phase)
+	require.Equal(t, int64(127), thread.CommitDeadline)
+	require.Equal(t, uint32(1), thread.CommitRound)
+	require.Equal(t, []*VideoRenderingThread_Validation{revealed}, thread.Validations)
+	require.Equal(t, "thread123/1", thread.CommitmentId())
+	require.False(t, thread.IsRevealExpired(200))
+
+	// threads with enough reveals don't expire
+	require.True(t, thread.CloseCommitPhase(128, 5))
+	thread.Validations = append(thread.Validations, &VideoRenderingThread_Validation{Validator: "validator2", Frames: revealed.Frames})
+	require.True(t, thread.HasRevealQuorum())
+	require.False(t, thread.IsRevealExpired(200))
+}
+
+// --- Test for dropping solutions that weren't revealed in time ---
+func TestDropSolution(t *testing.T) {
+	revealed := []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Hash: "hash", Signature: "signature"}}
+	thread := &VideoRenderingThread{ThreadId: "thread123", Workers: []string{"proposer", "validator1", "validator2"},
+		Solution:    &VideoRenderingThread_Solution{ProposedBy: "proposer"},
+		Validations: []*VideoRenderingThread_Validation{{Validator: "validator1", Frames: revealed}}}
+	thread.StartCommitPhase(100, 10)
+	require.True(t, thread.CloseCommitPhase(111, 5))
+
+	// the proposer only gets a deadline once enough validators revealed
+	require.False(t, thread.StartSolutionReveal(112, 5))
+	thread.Validations = append(thread.Validations, &VideoRenderingThread_Validation{Validator: "validator2", Frames: revealed})
+	require.True(t, thread.StartSolutionReveal(112, 5))
+	require.Equal(t, int64(117), thread.SolutionRevealDeadline)
+	require.False(t, thread.StartSolutionReveal(113, 5))
+	require.False(t, thread.IsSolutionRevealExpired(117))
+	require.True(t, thread.IsSolutionRevealExpired(118))
+
+	// the proposer is dropped, and the thread goes back to rendering for a new proposal round
+	require.Equal(t, "thread123", thread.ProposalId())
+	require.Equal(t, "proposer", thread.DropSolution())
+	require.Nil(t, thread.Solution)
+	require.Empty(t, thread.Validations)
+	require.Equal(t, []string{"validator1", "validator2"}, thread.Workers)
+	require.Equal(t, VideoRenderingThread_RENDERING, thread.Phase)
+	require.Zero(t, thread.SolutionRevealDeadline)
+	require.Equal(t, "thread123/proposal/1", thread.ProposalId())
+	require.Equal(t, "thread123/1", thread.CommitmentId())
+	require.False(t, thread.IsSolutionRevealExpired(200))
+}
+
+func TestIsSolutionRevealExpired_Revealed(t *testing.T) {
+	thread := &VideoRenderingThread{ThreadId: "thread123", Phase: VideoRenderingThread_REVEAL, SolutionRevealDeadline: 117,
+		Solution: &VideoRenderingThread_Solution{ProposedBy: "proposer", Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Hash: "hash"}}}}
+
+	require.False(t, thread.IsSolutionRevealExpired(200))
+}
+
+// --- Test for StartProposal ---
+func TestStartProposal(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", ProposalRound: 1}
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, false, false, false).Return(nil)
+	mockDB.On("ReadCommitment", "thread123/proposal/1").Return(&db.Commitment{}, nil)
+	mockDB.On("UpdateCommitment", "thread123/proposal/1", mock.Anything, false).Return(nil)
+
+	require.NoError(t, thread.StartProposal(mockDB))
+	mockDB.AssertExpectations(t)
+}
+
+// --- Test for StartVerification ---
+func TestStartVerification(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", CommitRound: 2}
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, true, false, false).Return(nil)
+	mockDB.On("ReadCommitment", "thread123/2").Return(&db.Commitment{}, nil)
+	mockDB.On("UpdateCommitment", "thread123/2", mock.Anything, false).Return(nil)
+
+	require.NoError(t, thread.StartVerification(mockDB))
+	mockDB.AssertExpectations(t)
+}
+
+func TestRevealedValidations(t *testing.T) {
 	thread := VideoRenderingThread{
-		Workers: []string{"alice", "bob", "carol", "dave"},
+		ThreadId: "thread123",
+		Validations: []*VideoRenderingThread_Validation{
+			{Validator: "alice", Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Commitment: "c1", Hash: "hash1", Signature: "sig1"}}},
+			{Validator: "bob", Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Commitment: "c2"}}},
+			{Validator: "carol"},
+		},
 	}
 
-	t.Run("worker in odd position returns true", func(t *testing.T) {
-		require.True(t, thread.IsReverse("bob"))  // index 1
-		require.True(t, thread.IsReverse("dave")) // index 3
+	require.Equal(t, 1, thread.RevealedValidations())
+	require.True(t, thread.GetValidation("alice").IsRevealed())
+	require.False(t, thread.GetValidation("bob").IsRevealed())
+	require.Nil(t, thread.GetValidation("dave"))
+}
+
+func TestRenderSlot(t *testing.T) {
+	thread := VideoRenderingThread{
+		Workers:     []string{"alice", "bob", "carol", "dave"},
+		RenderSlots: 3,
+	}
+
+	t.Run("worker gets its subscription position", func(t *testing.T) {
+		require.Equal(t, 0, thread.RenderSlot("alice"))
+		require.Equal(t, 1, thread.RenderSlot("bob"))
+		require.Equal(t, 2, thread.RenderSlot("carol"))
+	})
+
+	t.Run("worker beyond the slots wraps around", func(t *testing.T) {
+		require.Equal(t, 0, thread.RenderSlot("dave"))
+	})
+
+	t.Run("worker not in list gets first slot", func(t *testing.T) {
+		require.Equal(t, 0, thread.RenderSlot("eve"))
 	})
+}
+
+func TestRenderOrder(t *testing.T) {
+	thread := VideoRenderingThread{
+		StartFrame:  1,
+		EndFrame:    8,
+		Workers:     []string{"alice", "bob", "carol"},
+		RenderSlots: 3,
+	}
 
-	t.Run("worker in even position returns false", func(t *testing.T) {
-		require.False(t, thread.IsReverse("alice")) // index 0
-		require.False(t, thread.IsReverse("carol")) // index 2
+	t.Run("each slot starts on its own stride", func(t *testing.T) {
+		require.Equal(t, []int64{1, 4, 7, 2, 5, 8, 3, 6}, thread.RenderOrder("alice"))
+		require.Equal(t, []int64{2, 5, 8, 3, 6, 1, 4, 7}, thread.RenderOrder("bob"))
+		require.Equal(t, []int64{3, 6, 1, 4, 7, 2, 5, 8}, thread.RenderOrder("carol"))
 	})
 
-	t.Run("worker not in list returns false", func(t *testing.T) {
-		require.False(t, thread.IsReverse("eve"))
+	t.Run("workers render disjoint frames at the same step", func(t *testing.T) {
+		alice := thread.RenderOrder("alice")
+		bob := thread.RenderOrder("bob")
+		carol := thread.RenderOrder("carol")
+		for i := range alice {
+			require.NotEqual(t, alice[i], bob[i])
+			require.NotEqual(t, alice[i], carol[i])
+			require.NotEqual(t, bob[i], carol[i])
+		}
+	})
+
+	t.Run("without slots renders in order", func(t *testing.T) {
+		single := VideoRenderingThread{StartFrame: 1, EndFrame: 4, Workers: []string{"alice"}}
+		require.Equal(t, []int64{1, 2, 3, 4}, single.RenderOrder("alice"))
 	})
 }
 
@@ -1459,67 +1952,97 @@ func TestCalculateValidatorPayment(t *testing.T) {
 }
 
 // --- Test for RevealSolution ---
+func TestRevealSolution_ReadCommitmentKo(t *testing.T) {
+	// Setup
+	mockDB := new(mocks.DB)
+	thread := &VideoRenderingThread{
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
+	}
+	rootPath := "/tmp/rendering/thread123"
+
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return((*db.Commitment)(nil), fmt.Errorf("ReadCommitment error")).Once()
+
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
+
+	// Verify that we got the expected error
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ReadCommitment error")
+}
+
 func TestRevealSolution_CalculateCIDsKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	rootPath := "/tmp/rendering/thread123"
 
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+
 	// Monkey patching
 	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
 		return nil, fmt.Errorf("Calculate CIDs error")
 	})
 	defer patch1.Unpatch()
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Calculate CIDs error")
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_GenerateHashesKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 	}
 	rootPath := "/tmp/rendering/thread123"
 
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+
 	// Monkey patching
 	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
 		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
+			"frame_000000.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
+			"frame_000001.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
 		}, nil
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "", fmt.Errorf("Calculate file hash error")
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return nil, fmt.Errorf("Calculate file hash error")
 	})
 	defer patch2.Unpatch()
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Calculate file hash error")
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_GenerateHashesOk_ExecuteCliKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -1546,17 +2069,23 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliKo(t *test
 		}}
 	rootPath := "/tmp/rendering/thread123"
 
+	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
+
 	// Monkey patching
 	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
 		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
+			"frame_000000.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
+			"frame_000001.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
 		}, nil
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000000.png": "6b1b36cbb04b41490bfc0ab2bfa26f86",
+			"frame_000001.png": "9c4e2f7a1d3b5e6f8a0b2c4d6e8f0a1b",
+		}, nil
 	})
 	defer patch2.Unpatch()
 
@@ -1565,20 +2094,21 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliKo(t *test
 	})
 	defer patch4.Unpatch()
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "FromFramesToCli error")
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateThreadKo(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_GenerateHashesOk_ExecuteCliOk_UpdateThreadKo(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -1606,19 +2136,23 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateT
 	rootPath := "/tmp/rendering/thread123"
 
 	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
 	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, true, true, false).Return(fmt.Errorf("UpdateThread error")).Once()
 
 	// Monkey patching
 	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
 		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
+			"frame_000000.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
+			"frame_000001.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
 		}, nil
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000000.png": "6b1b36cbb04b41490bfc0ab2bfa26f86",
+			"frame_000001.png": "9c4e2f7a1d3b5e6f8a0b2c4d6e8f0a1b",
+		}, nil
 	})
 	defer patch2.Unpatch()
 
@@ -1627,7 +2161,7 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateT
 	})
 	defer patch4.Unpatch()
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -1637,13 +2171,14 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateT
 	mockDB.AssertExpectations(t)
 }
 
-func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateThreadOk(t *testing.T) {
+func TestRevealSolution_CalculateCIDsOk_GenerateHashesOk_ExecuteCliOk_UpdateThreadOk(t *testing.T) {
 	// Setup
 	mockDB := new(mocks.DB)
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -1671,31 +2206,45 @@ func TestRevealSolution_CalculateCIDsOk_CalculateFileHashOk_ExecuteCliOk_UpdateT
 	rootPath := "/tmp/rendering/thread123"
 
 	// Mock DB methods
+	mockDB.On("ReadCommitment", "thread123").Return(&db.Commitment{ThreadId: "thread123", Salt: testCommitmentSalt}, nil).Once()
 	mockDB.On("UpdateThread", "thread123", true, true, true, true, true, true, true, false).Return(nil).Once()
 
 	// Monkey patching
 	patch1 := monkey.Patch(ipfs.CalculateCIDs, func(dirPath string) (map[string]string, error) {
 		return map[string]string{
-			"frame1.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
-			"frame2.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
+			"frame_000000.png": "bafybeibwzifkxwq6oyp3dp3ewr2lsccfveq5r7oe3jq2l6efzdr4hw2kdi",
+			"frame_000001.png": "bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe",
 		}, nil
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(CalculateFileHash, func(filePath string) (string, error) {
-		return "6b1b36cbb04b41490bfc0ab2bfa26f86", nil
+	patch2 := monkey.Patch(GenerateDirectoryFileHashes, func(directoryPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+		return map[string]string{
+			"frame_000000.png": "6b1b36cbb04b41490bfc0ab2bfa26f86",
+			"frame_000001.png": "9c4e2f7a1d3b5e6f8a0b2c4d6e8f0a1b",
+		}, nil
 	})
 	defer patch2.Unpatch()
 
+	var revealed []string
 	patch4 := monkey.Patch(ExecuteCli, func(args []string) error {
+		revealed = args
 		return nil
 	})
 	defer patch4.Unpatch()
 
-	err := thread.RevealSolution(rootPath, mockDB)
+	err := thread.RevealSolution(rootPath, testRenderer, mockDB)
 
-	// Verify that we got no error
+	// Verify that we got no error and only the sampled frame is revealed with its merkle proof
+	require.NoError(t, err)
+	sibling, err := FrameMerkleLeaf(0, FrameCommitment("6b1b36cbb04b41490bfc0ab2bfa26f86", testCommitmentSalt, "alice"))
 	require.NoError(t, err)
+	proof := hex.EncodeToString(sibling)
+	require.Equal(t, []string{
+		"tx", "videoRendering", "reveal-solution", "", "thread123", testCommitmentSalt,
+		"frame_000001.png=bafybeia6zjsa6uhjqmtn4azj3k74sjn3wsb2elxek6nnvysxug4vqwhwqe:9c4e2f7a1d3b5e6f8a0b2c4d6e8f0a1b:" + proof,
+		"--from", "alice", "--yes",
+	}, revealed)
 
 	// Verify mock expectations
 	mockDB.AssertExpectations(t)
@@ -1715,7 +2264,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -1723,7 +2272,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -1737,7 +2286,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -1745,7 +2294,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -1753,14 +2302,14 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -1768,7 +2317,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 						InvalidCount: 1,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -1776,8 +2325,8 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -1788,7 +2337,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIKo(t *testing.T) {
 	})
 	defer patch1.Unpatch()
 
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -1807,7 +2356,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -1815,7 +2364,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -1829,7 +2378,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -1837,7 +2386,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -1845,14 +2394,14 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -1860,7 +2409,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 						InvalidCount: 1,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -1868,8 +2417,8 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -1880,12 +2429,12 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageK
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
 		return nil, fmt.Errorf("GenerateSignableMessage error")
 	})
 	defer patch2.Unpatch()
 
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got the expected error
 	require.Error(t, err)
@@ -1904,7 +2453,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -1912,7 +2461,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -1926,47 +2475,47 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
+						Filename:     "frame_000001.png",
+						Signature:    "not base64!",
 						Cid:          "cid1",
 						Hash:         "hash1",
 						ValidCount:   1,
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
+						Filename:     "frame_000002.png",
+						Signature:    "not base64!",
 						Cid:          "cid2",
 						Hash:         "hash2",
 						ValidCount:   2,
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
-						Signature:    "sig1",
+						Filename:     "frame_000001.png",
+						Signature:    "not base64!",
 						Cid:          "cid1",
 						Hash:         "hash1",
 						ValidCount:   0,
 						InvalidCount: 1,
 					},
 					{
-						Filename:     "frame2.png",
-						Signature:    "sig2",
+						Filename:     "frame_000002.png",
+						Signature:    "not base64!",
 						Cid:          "cid2",
 						Hash:         "hash2",
 						ValidCount:   3,
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -1977,21 +2526,16 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
 		return []byte("fake-signable-message"), nil
 	})
 	defer patch2.Unpatch()
 
-	patch3 := monkey.Patch(videoRenderingCrypto.DecodeSignatureFromCLI, func(encodedSig string) ([]byte, error) {
-		return nil, fmt.Errorf("DecodeSignatureFromCLI error")
-	})
-	defer patch3.Unpatch()
-
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got the expected error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "DecodeSignatureFromCLI error")
+	require.Contains(t, err.Error(), "illegal base64 data")
 }
 func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageOk_DecodeSignatureFromCLIOk_VerifySignatureFalse(t *testing.T) {
 	// Setup
@@ -2006,7 +2550,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -2014,7 +2558,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -2028,7 +2572,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -2036,7 +2580,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -2044,14 +2588,14 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -2059,7 +2603,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 1,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -2067,8 +2611,8 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -2083,7 +2627,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
 		return []byte("fake-signable-message"), nil
 	})
 	defer patch2.Unpatch()
@@ -2093,7 +2637,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch3.Unpatch()
 
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got no error
 	require.NoError(t, err)
@@ -2111,7 +2655,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -2119,7 +2663,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -2133,7 +2677,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -2141,7 +2685,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -2149,14 +2693,14 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -2164,7 +2708,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 1,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -2172,8 +2716,8 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -2188,7 +2732,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
 		return []byte("fake-signable-message"), nil
 	})
 	defer patch2.Unpatch()
@@ -2198,7 +2742,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch3.Unpatch()
 
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got no error
 	require.NoError(t, err)
@@ -2217,7 +2761,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -2225,7 +2769,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000002.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -2239,7 +2783,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 				Validator: "alice",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
@@ -2247,7 +2791,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 					{
-						Filename:     "frame2.png",
+						Filename:     "frame_000002.png",
 						Signature:    "sig2",
 						Cid:          "cid2",
 						Hash:         "hash2",
@@ -2255,24 +2799,24 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 						InvalidCount: 0,
 					},
 				},
-				PublicKey: "pubkey-alice",
-				IsReverse: false,
+				PublicKey:  "pubkey-alice",
+				RenderSlot: 0,
 			},
 			{
 				Validator: "bob",
 				Frames: []*VideoRenderingThread_Frame{
 					{
-						Filename:     "frame1.png",
+						Filename:     "frame_000001.png",
 						Signature:    "sig1",
 						Cid:          "cid1",
 						Hash:         "hash1",
 						ValidCount:   0,
 						InvalidCount: 1,
 					},
-					// "frame2.png" eliminated
+					// "frame_000002.png" eliminated
 				},
-				PublicKey: "pubkey-bob",
-				IsReverse: true,
+				PublicKey:  "pubkey-bob",
+				RenderSlot: 1,
 			},
 		},
 	}
@@ -2287,7 +2831,7 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch1.Unpatch()
 
-	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(hash, workerAddr string) ([]byte, error) {
+	patch2 := monkey.Patch(videoRenderingCrypto.GenerateSignableMessage, func(doc proto.Message) ([]byte, error) {
 		return []byte("fake-signable-message"), nil
 	})
 	defer patch2.Unpatch()
@@ -2297,19 +2841,132 @@ func TestEvaluateVerifications_DecodePublicKeyFromCLIOk_GenerateSignableMessageO
 	})
 	defer patch3.Unpatch()
 
-	err := thread.EvaluateVerifications()
+	err := thread.EvaluateVerifications("janction-test")
 
 	// Verify that we got no error
 	require.NoError(t, err)
 }
 
-// --- Test for IsSolutionAccepted ---
-func TestIsSolutionAccepted_NoFrames(t *testing.T) {
+func TestEvaluateVerifications_SignatureBoundToThread(t *testing.T) {
 	// Setup
+	privKey := secp256k1.GenPrivKey()
+	validator := "cosmos1abcdefg1234567"
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
+		TaskId:     "1",
+		ThreadId:   "10",
+		StartFrame: 0,
+		EndFrame:   1,
+		Solution: &VideoRenderingThread_Solution{
+			ProposedBy: "alice",
+			Frames: []*VideoRenderingThread_Frame{
+				{Filename: "frame_000001.png", Hash: "hash1"},
+			},
+		},
+	}
+
+	// the validator signs the same hash for another thread
+	otherThread := VideoRenderingThread{TaskId: "1", ThreadId: "11"}
+	replayedDoc, err := otherThread.NewFrameSignDoc("janction-test", "frame_000001.png", "hash1", validator)
+	require.NoError(t, err)
+	replayedMessage, err := videoRenderingCrypto.GenerateSignableMessage(replayedDoc)
+	require.NoError(t, err)
+	replayedSignature, err := privKey.Sign(replayedMessage)
+	require.NoError(t, err)
+
+	// and the right thread
+	doc, err := thread.NewFrameSignDoc("janction-test", "frame_000001.png", "hash1", validator)
+	require.NoError(t, err)
+	message, err := videoRenderingCrypto.GenerateSignableMessage(doc)
+	require.NoError(t, err)
+	signature, err := privKey.Sign(message)
+	require.NoError(t, err)
+
+	publicKey := videoRenderingCrypto.EncodePublicKeyForCLI(privKey.PubKey())
+	thread.Validations = []*VideoRenderingThread_Validation{
+		{Validator: validator, PublicKey: publicKey, Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Signature: videoRenderingCrypto.EncodeSignatureForCLI(replayedSignature)}}},
+		{Validator: validator, PublicKey: publicKey, Frames: []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Signature: videoRenderingCrypto.EncodeSignatureForCLI(signature)}}},
+	}
+
+	err = thread.EvaluateVerifications("janction-test")
+
+	// Only the signature of this thread is valid
+	require.NoError(t, err)
+	require.Equal(t, int64(1), thread.Solution.Frames[0].ValidCount)
+	require.Equal(t, int64(1), thread.Solution.Frames[0].InvalidCount)
+
+	// and none is valid on another chain
+	thread.Solution.Frames[0].ValidCount = 0
+	thread.Solution.Frames[0].InvalidCount = 0
+	err = thread.EvaluateVerifications("janction-other")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(0), thread.Solution.Frames[0].ValidCount)
+	require.Equal(t, int64(2), thread.Solution.Frames[0].InvalidCount)
+}
+
+func TestEvaluateVerifications_PerceptualComparison(t *testing.T) {
+	// Setup
+	privKey := secp256k1.GenPrivKey()
+	validator := "cosmos1abcdefg1234567"
+	var near, far FrameFingerprint
+	for i := range near.TileMeans {
+		near.TileMeans[i], near.TileDeviations[i] = 120, 30
+		far.TileMeans[i], far.TileDeviations[i] = 20, 5
+	}
+	far.BlockHash = 0xffffffffffffffff
+	closeToNear := near
+	closeToNear.BlockHash = 1
+
+	thread := &VideoRenderingThread{
+		TaskId:     "1",
+		ThreadId:   "10",
 		StartFrame: 0,
 		EndFrame:   1,
+		Comparison: &FrameComparison{Mode: FrameComparison_PERCEPTUAL, MaxHashDistance: 2, MinTileSimilarity: 950},
+		Solution: &VideoRenderingThread_Solution{
+			ProposedBy: "alice",
+			Frames: []*VideoRenderingThread_Frame{
+				{Filename: "frame_000001.png", Hash: FrameDigest("hash1", near)},
+			},
+		},
+	}
+
+	// validators sign their own render, which isn't byte identical to the solution
+	sign := func(digest string) *VideoRenderingThread_Validation {
+		doc, err := thread.NewFrameSignDoc("janction-test", "frame_000001.png", digest, validator)
+		require.NoError(t, err)
+		require.Equal(t, FrameHashAlgorithm+FramePerceptualAlgorithmSuffix, doc.HashAlgorithm)
+		message, err := videoRenderingCrypto.GenerateSignableMessage(doc)
+		require.NoError(t, err)
+		signature, err := privKey.Sign(message)
+		require.NoError(t, err)
+		return &VideoRenderingThread_Validation{
+			Validator: validator,
+			PublicKey: videoRenderingCrypto.EncodePublicKeyForCLI(privKey.PubKey()),
+			Frames:    []*VideoRenderingThread_Frame{{Filename: "frame_000001.png", Hash: digest, Signature: videoRenderingCrypto.EncodeSignatureForCLI(signature)}},
+		}
+	}
+	thread.Validations = []*VideoRenderingThread_Validation{
+		sign(FrameDigest("hash2", closeToNear)),
+		sign(FrameDigest("hash3", far)),
+	}
+
+	err := thread.EvaluateVerifications("janction-test")
+
+	// Only the render within the tolerance is valid
+	require.NoError(t, err)
+	require.Equal(t, int64(1), thread.Solution.Frames[0].ValidCount)
+	require.Equal(t, int64(1), thread.Solution.Frames[0].InvalidCount)
+}
+
+// --- Test for IsSolutionAccepted ---
+func TestIsSolutionAccepted_NoFrames(t *testing.T) {
+	// Setup
+	thread := &VideoRenderingThread{
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		SampledFrames: []int64{1},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -2324,12 +2981,33 @@ func TestIsSolutionAccepted_NoFrames(t *testing.T) {
 	require.False(t, valid)
 }
 
-func TestIsSolutionAccepted_OneWorker_FrameAmountValidKo(t *testing.T) {
+func TestIsSolutionAccepted_NoSampledFrames(t *testing.T) {
 	thread := &VideoRenderingThread{
 		ThreadId:   "thread123",
 		StartFrame: 0,
 		EndFrame:   1,
 		Workers:    []string{"alice"},
+		Solution: &VideoRenderingThread_Solution{
+			ProposedBy: "alice",
+			Frames: []*VideoRenderingThread_Frame{
+				{Filename: "frame_000000.png", ValidCount: 1},
+				{Filename: "frame_000001.png", ValidCount: 1},
+			},
+		},
+	}
+
+	valid := thread.IsSolutionAccepted()
+
+	require.False(t, valid)
+}
+
+func TestIsSolutionAccepted_OneWorker_SampledFrameValidKo(t *testing.T) {
+	thread := &VideoRenderingThread{
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		Workers:       []string{"alice"},
+		SampledFrames: []int64{0},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -2337,7 +3015,7 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidKo(t *testing.T) {
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000000.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -2345,11 +3023,11 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidKo(t *testing.T) {
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
-					ValidCount:   0,
+					ValidCount:   1,
 					InvalidCount: 0,
 				},
 			},
@@ -2361,12 +3039,13 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidKo(t *testing.T) {
 	require.False(t, valid)
 }
 
-func TestIsSolutionAccepted_OneWorker_FrameAmountValidOk(t *testing.T) {
+func TestIsSolutionAccepted_OneWorker_SampledFrameValidOk(t *testing.T) {
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice"},
+		ThreadId:      "thread123",
+		StartFrame:    0,
+		EndFrame:      1,
+		Workers:       []string{"alice"},
+		SampledFrames: []int64{1},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
 			PublicKey:  "alicePublicKey123",
@@ -2374,7 +3053,7 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidOk(t *testing.T) {
 			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
 				{
-					Filename:     "frame1.png",
+					Filename:     "frame_000000.png",
 					Signature:    "sig1",
 					Cid:          "cid1",
 					Hash:         "hash1",
@@ -2382,7 +3061,7 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidOk(t *testing.T) {
 					InvalidCount: 0,
 				},
 				{
-					Filename:     "frame2.png",
+					Filename:     "frame_000001.png",
 					Signature:    "sig2",
 					Cid:          "cid2",
 					Hash:         "hash2",
@@ -2398,98 +3077,20 @@ func TestIsSolutionAccepted_OneWorker_FrameAmountValidOk(t *testing.T) {
 	require.True(t, valid)
 }
 
-func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidKo(t *testing.T) {
+func TestIsSolutionAccepted_MultipleWorkers_SampledFramesValidKo(t *testing.T) {
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice", "bob"},
+		ThreadId:      "thread123",
+		StartFrame:    1,
+		EndFrame:      4,
+		Workers:       []string{"alice", "bob", "carol"},
+		SampledFrames: []int64{2, 4},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame3.png",
-					Signature:    "sig3",
-					Cid:          "cid3",
-					Hash:         "hash3",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame4.png",
-					Signature:    "sig4",
-					Cid:          "cid4",
-					Hash:         "hash4",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame5.png",
-					Signature:    "sig5",
-					Cid:          "cid5",
-					Hash:         "hash5",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame6.png",
-					Signature:    "sig6",
-					Cid:          "cid6",
-					Hash:         "hash6",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame7.png",
-					Signature:    "sig7",
-					Cid:          "cid7",
-					Hash:         "hash7",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame8.png",
-					Signature:    "sig8",
-					Cid:          "cid8",
-					Hash:         "hash8",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame9.png",
-					Signature:    "sig9",
-					Cid:          "cid9",
-					Hash:         "hash9",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame10.png",
-					Signature:    "sig10",
-					Cid:          "cid10",
-					Hash:         "hash10",
-					ValidCount:   0,
-					InvalidCount: 0,
-				},
+				{Filename: "frame_000001.png", ValidCount: 0},
+				{Filename: "frame_000002.png", ValidCount: 2},
+				{Filename: "frame_000003.png", ValidCount: 0},
+				{Filename: "frame_000004.png", ValidCount: 1},
 			},
 		},
 	}
@@ -2499,66 +3100,43 @@ func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidKo(t *testing.T) {
 	require.False(t, valid)
 }
 
-func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidOk(t *testing.T) {
+func TestIsSolutionAccepted_MultipleWorkers_SampledFrameInvalidKo(t *testing.T) {
 	thread := &VideoRenderingThread{
-		ThreadId:   "thread123",
-		StartFrame: 0,
-		EndFrame:   1,
-		Workers:    []string{"alice", "bob"},
+		ThreadId:      "thread123",
+		StartFrame:    1,
+		EndFrame:      4,
+		Workers:       []string{"alice", "bob", "carol"},
+		SampledFrames: []int64{2, 4},
 		Solution: &VideoRenderingThread_Solution{
 			ProposedBy: "alice",
-			PublicKey:  "alicePublicKey123",
-			Dir:        "/tmp/rendered_frames/solution1",
-			Accepted:   true,
 			Frames: []*VideoRenderingThread_Frame{
-				{
-					Filename:     "frame1.png",
-					Signature:    "sig1",
-					Cid:          "cid1",
-					Hash:         "hash1",
-					ValidCount:   1,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame2.png",
-					Signature:    "sig2",
-					Cid:          "cid2",
-					Hash:         "hash2",
-					ValidCount:   2,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame3.png",
-					Signature:    "sig3",
-					Cid:          "cid3",
-					Hash:         "hash3",
-					ValidCount:   3,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame4.png",
-					Signature:    "sig4",
-					Cid:          "cid4",
-					Hash:         "hash4",
-					ValidCount:   4,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame5.png",
-					Signature:    "sig5",
-					Cid:          "cid5",
-					Hash:         "hash5",
-					ValidCount:   5,
-					InvalidCount: 0,
-				},
-				{
-					Filename:     "frame6.png",
-					Signature:    "sig6",
-					Cid:          "cid6",
-					Hash:         "hash6",
-					ValidCount:   6,
-					InvalidCount: 0,
-				},
+				{Filename: "frame_000001.png", ValidCount: 0},
+				{Filename: "frame_000002.png", ValidCount: 2},
+				{Filename: "frame_000003.png", ValidCount: 0},
+				{Filename: "frame_000004.png", ValidCount: 2, InvalidCount: 2},
+			},
+		},
+	}
+
+	valid := thread.IsSolutionAccepted()
+
+	require.False(t, valid)
+}
+
+func TestIsSolutionAccepted_MultipleWorkers_SampledFramesValidOk(t *testing.T) {
+	thread := &VideoRenderingThread{
+		ThreadId:      "thread123",
+		StartFrame:    1,
+		EndFrame:      4,
+		Workers:       []string{"alice", "bob", "carol"},
+		SampledFrames: []int64{2, 4},
+		Solution: &VideoRenderingThread_Solution{
+			ProposedBy: "alice",
+			Frames: []*VideoRenderingThread_Frame{
+				{Filename: "frame_000001.png", ValidCount: 0},
+				{Filename: "frame_000002.png", ValidCount: 2},
+				{Filename: "frame_000003.png", ValidCount: 0},
+				{Filename: "frame_000004.png", ValidCount: 3, InvalidCount: 1},
 			},
 		},
 	}
@@ -2568,6 +3146,39 @@ func TestIsSolutionAccepted_MultipleWorkers_FrameAmountValidOk(t *testing.T) {
 	require.True(t, valid)
 }
 
+// --- Test for SampleFrames ---
+func TestSampleFrames_Deterministic(t *testing.T) {
+	thread := &VideoRenderingThread{ThreadId: "thread123", StartFrame: 10, EndFrame: 59}
+	blockHash := []byte("block-hash-1")
+
+	sample := thread.SampleFrames(blockHash)
+
+	// 20% of 50 frames, sorted and inside the thread range
+	require.Len(t, sample, 10)
+	require.True(t, slices.IsSorted(sample))
+	require.Equal(t, len(sample), len(slices.Compact(slices.Clone(sample))))
+	for _, frame := range sample {
+		require.GreaterOrEqual(t, frame, thread.StartFrame)
+		require.LessOrEqual(t, frame, thread.EndFrame)
+	}
+
+	// every node must derive the same sample
+	require.Equal(t, sample, thread.SampleFrames(blockHash))
+	require.NotEqual(t, sample, thread.SampleFrames([]byte("block-hash-2")))
+}
+
+func TestSampleFrames_AtLeastOneFrame(t *testing.T) {
+	thread := &VideoRenderingThread{ThreadId: "thread123", StartFrame: 5, EndFrame: 5}
+
+	sample := thread.SampleFrames([]byte("block-hash"))
+
+	require.Equal(t, []int64{5}, sample)
+
+	thread.SampledFrames = sample
+	require.True(t, thread.IsSampledFrame("frame_000005.png"))
+	require.False(t, thread.IsSampledFrame("frame_000006.png"))
+}
+
 // --- Test for VerifySubmittedSolution ---
 func TestVerifySubmittedSolution_ListDirectoryKo(t *testing.T) {
 	// Setup
@@ -2603,10 +3214,9 @@ func TestVerifySubmittedSolution_ListDirectoryKo(t *testing.T) {
 	}
 
 	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
+	useStubStore(t, stubStore{list: func(cid string) (map[string]string, error) {
 		return nil, fmt.Errorf("ListDirectory error")
-	})
-	defer patch1.Unpatch()
+	}})
 
 	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
 
@@ -2649,13 +3259,12 @@ func TestVerifySubmittedSolution_ListDirectoryOk_FilesExistKo(t *testing.T) {
 	}
 
 	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
+	useStubStore(t, stubStore{list: func(cid string) (map[string]string, error) {
 		return map[string]string{
 			"frame1.png": "cid1",
 			"frame2.png": "cid",
 		}, nil
-	})
-	defer patch1.Unpatch()
+	}})
 
 	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
 
@@ -2698,16 +3307,87 @@ func TestVerifySubmittedSolution_ListDirectoryOk_FilesExistOk(t *testing.T) {
 	}
 
 	// Monkey patching
-	patch1 := monkey.Patch(ipfs.ListDirectory, func(cid string) (map[string]string, error) {
+	useStubStore(t, stubStore{list: func(cid string) (map[string]string, error) {
 		return map[string]string{
 			"frame1.png": "cid1",
 			"frame2.png": "cid2",
 		}, nil
-	})
-	defer patch1.Unpatch()
+	}})
 
 	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
 
 	// Verify that we got no error
 	require.NoError(t, err)
 }
+
+func TestVerifySubmittedSolution_OtherOutputFormat(t *testing.T) {
+	// Setup
+	thread := &VideoRenderingThread{
+		ThreadId:       "thread123",
+		StartFrame:     0,
+		EndFrame:       0,
+		Workers:        []string{"alice"},
+		RenderSettings: &RenderSettings{OutputFormat: RenderSettings_OPEN_EXR},
+		Solution: &VideoRenderingThread_Solution{
+			ProposedBy: "alice",
+			PublicKey:  "alicePublicKey123",
+			Dir:        "/tmp/rendered_frames/solution1",
+			Frames: []*VideoRenderingThread_Frame{
+				{
+					Filename: "frame_000000.png",
+					Cid:      "cid1",
+					Hash:     "hash1",
+				},
+			},
+		},
+	}
+
+	// Monkey patching
+	useStubStore(t, stubStore{list: func(cid string) (map[string]string, error) {
+		return map[string]string{
+			"frame_000000.png": "cid1",
+		}, nil
+	}})
+
+	err := thread.VerifySubmittedSolution("/tmp/rendered_frames/solution1")
+
+	// the frame isn't in the output format of the task
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't a .exr frame")
+}
+
+// stubStore is an in memory store whose files are added, hashed and listed by the functions that are set
+type stubStore struct {
+	*ipfs.MemoryStore
+	add  func(path string) (string, error)
+	hash func(path string) (string, error)
+	list func(cid string) (map[string]string, error)
+}
+
+func (s stubStore) Add(path string) (string, error) {
+	if s.add != nil {
+		return s.add(path)
+	}
+	return s.MemoryStore.Add(path)
+}
+
+func (s stubStore) Hash(path string) (string, error) {
+	if s.hash != nil {
+		return s.hash(path)
+	}
+	return s.MemoryStore.Hash(path)
+}
+
+func (s stubStore) List(cid string) (map[string]string, error) {
+	if s.list != nil {
+		return s.list(cid)
+	}
+	return s.MemoryStore.List(cid)
+}
+
+// useStubStore makes the ipfs functions use the store until the test ends
+func useStubStore(t *testing.T, store stubStore) {
+	store.MemoryStore = ipfs.NewMemoryStore()
+	previous := ipfs.SetStore(store)
+	t.Cleanup(func() { ipfs.SetStore(previous) })
+}
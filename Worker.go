@@ -9,9 +9,10 @@ import (
 	"github.com/janction/videoRendering/db"
 	"github.com/janction/videoRendering/ipfs"
 	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
 )
 
-func (w Worker) RegisterWorker(address string, stake types.Coin, db *db.DB) error {
+func (w Worker) RegisterWorker(address string, stake types.Coin, blenderVersions []string, db *db.DB) error {
 	db.Addworker(address)
 	// Base arguments
 	args := []string{
@@ -22,6 +23,11 @@ func (w Worker) RegisterWorker(address string, stake types.Coin, db *db.DB) erro
 	args = append(args, ip)
 	args = append(args, ipfsId)
 	args = append(args, stake.String())
+	// tasks needing a blender version are only assigned to workers that can render it
+	args = append(args, "--architecture", vm.Architecture())
+	if len(blenderVersions) > 0 {
+		args = append(args, "--blender-versions", strings.Join(blenderVersions, ","))
+	}
 	args = append(args, "--from")
 	args = append(args, address)
 	args = append(args, "--yes")
@@ -23,6 +23,7 @@ const (
 	Query_GetVideoRenderingLogs_FullMethodName         = "/janction.videoRendering.v1.Query/GetVideoRenderingLogs"
 	Query_GetWorker_FullMethodName                     = "/janction.videoRendering.v1.Query/GetWorker"
 	Query_GetPendingVideoRenderingTasks_FullMethodName = "/janction.videoRendering.v1.Query/GetPendingVideoRenderingTasks"
+	Query_GetVerifyingKey_FullMethodName               = "/janction.videoRendering.v1.Query/GetVerifyingKey"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,8 @@ type QueryClient interface {
 	GetVideoRenderingLogs(ctx context.Context, in *QueryGetVideoRenderingLogsRequest, opts ...grpc.CallOption) (*QueryGetVideoRenderingLogsResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingVideoRenderingTasks(ctx context.Context, in *QueryGetPendingVideoRenderingTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingVideoRenderingTaskResponse, error)
+	// GetVerifyingKey returns the verifying key registered for the circuit version
+	GetVerifyingKey(ctx context.Context, in *QueryGetVerifyingKeyRequest, opts ...grpc.CallOption) (*QueryGetVerifyingKeyResponse, error)
 }
 
 type queryClient struct {
@@ -80,6 +83,15 @@ func (c *queryClient) GetPendingVideoRenderingTasks(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) GetVerifyingKey(ctx context.Context, in *QueryGetVerifyingKeyRequest, opts ...grpc.CallOption) (*QueryGetVerifyingKeyResponse, error) {
+	out := new(QueryGetVerifyingKeyResponse)
+	err := c.cc.Invoke(ctx, Query_GetVerifyingKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -89,6 +101,8 @@ type QueryServer interface {
 	GetVideoRenderingLogs(context.Context, *QueryGetVideoRenderingLogsRequest) (*QueryGetVideoRenderingLogsResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingVideoRenderingTasks(context.Context, *QueryGetPendingVideoRenderingTaskRequest) (*QueryGetPendingVideoRenderingTaskResponse, error)
+	// GetVerifyingKey returns the verifying key registered for the circuit version
+	GetVerifyingKey(context.Context, *QueryGetVerifyingKeyRequest) (*QueryGetVerifyingKeyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -108,6 +122,9 @@ func (UnimplementedQueryServer) GetWorker(context.Context, *QueryGetWorkerReques
 func (UnimplementedQueryServer) GetPendingVideoRenderingTasks(context.Context, *QueryGetPendingVideoRenderingTaskRequest) (*QueryGetPendingVideoRenderingTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingVideoRenderingTasks not implemented")
 }
+func (UnimplementedQueryServer) GetVerifyingKey(context.Context, *QueryGetVerifyingKeyRequest) (*QueryGetVerifyingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifyingKey not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +210,24 @@ func _Query_GetPendingVideoRenderingTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetVerifyingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifyingKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerifyingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetVerifyingKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerifyingKey(ctx, req.(*QueryGetVerifyingKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,6 +251,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingVideoRenderingTasks",
 			Handler:    _Query_GetPendingVideoRenderingTasks_Handler,
 		},
+		{
+			MethodName: "GetVerifyingKey",
+			Handler:    _Query_GetVerifyingKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoRendering/v1/query.proto",
@@ -18,13 +18,19 @@ import (
 )
 
 var (
-	md_MsgCreateVideoRenderingTask            protoreflect.MessageDescriptor
-	fd_MsgCreateVideoRenderingTask_creator    protoreflect.FieldDescriptor
-	fd_MsgCreateVideoRenderingTask_cid        protoreflect.FieldDescriptor
-	fd_MsgCreateVideoRenderingTask_startFrame protoreflect.FieldDescriptor
-	fd_MsgCreateVideoRenderingTask_endFrame   protoreflect.FieldDescriptor
-	fd_MsgCreateVideoRenderingTask_threads    protoreflect.FieldDescriptor
-	fd_MsgCreateVideoRenderingTask_reward     protoreflect.FieldDescriptor
+	md_MsgCreateVideoRenderingTask                 protoreflect.MessageDescriptor
+	fd_MsgCreateVideoRenderingTask_creator         protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_cid             protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_startFrame      protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_endFrame        protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_threads         protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_reward          protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_comparison      protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_render_settings protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_frame_rate      protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_tile_grid       protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_split           protoreflect.FieldDescriptor
+	fd_MsgCreateVideoRenderingTask_blender_version protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -36,6 +42,12 @@ func init() {
 	fd_MsgCreateVideoRenderingTask_endFrame = md_MsgCreateVideoRenderingTask.Fields().ByName("endFrame")
 	fd_MsgCreateVideoRenderingTask_threads = md_MsgCreateVideoRenderingTask.Fields().ByName("threads")
 	fd_MsgCreateVideoRenderingTask_reward = md_MsgCreateVideoRenderingTask.Fields().ByName("reward")
+	fd_MsgCreateVideoRenderingTask_comparison = md_MsgCreateVideoRenderingTask.Fields().ByName("comparison")
+	fd_MsgCreateVideoRenderingTask_render_settings = md_MsgCreateVideoRenderingTask.Fields().ByName("render_settings")
+	fd_MsgCreateVideoRenderingTask_frame_rate = md_MsgCreateVideoRenderingTask.Fields().ByName("frame_rate")
+	fd_MsgCreateVideoRenderingTask_tile_grid = md_MsgCreateVideoRenderingTask.Fields().ByName("tile_grid")
+	fd_MsgCreateVideoRenderingTask_split = md_MsgCreateVideoRenderingTask.Fields().ByName("split")
+	fd_MsgCreateVideoRenderingTask_blender_version = md_MsgCreateVideoRenderingTask.Fields().ByName("blender_version")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateVideoRenderingTask)(nil)
@@ -139,6 +151,42 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Range(f func(protoreflect.F
 			return
 		}
 	}
+	if x.Comparison != nil {
+		value := protoreflect.ValueOfMessage(x.Comparison.ProtoReflect())
+		if !f(fd_MsgCreateVideoRenderingTask_comparison, value) {
+			return
+		}
+	}
+	if x.RenderSettings != nil {
+		value := protoreflect.ValueOfMessage(x.RenderSettings.ProtoReflect())
+		if !f(fd_MsgCreateVideoRenderingTask_render_settings, value) {
+			return
+		}
+	}
+	if x.FrameRate != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FrameRate)
+		if !f(fd_MsgCreateVideoRenderingTask_frame_rate, value) {
+			return
+		}
+	}
+	if x.TileGrid != nil {
+		value := protoreflect.ValueOfMessage(x.TileGrid.ProtoReflect())
+		if !f(fd_MsgCreateVideoRenderingTask_tile_grid, value) {
+			return
+		}
+	}
+	if x.Split != nil {
+		value := protoreflect.ValueOfMessage(x.Split.ProtoReflect())
+		if !f(fd_MsgCreateVideoRenderingTask_split, value) {
+			return
+		}
+	}
+	if x.BlenderVersion != "" {
+		value := protoreflect.ValueOfString(x.BlenderVersion)
+		if !f(fd_MsgCreateVideoRenderingTask_blender_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -166,6 +214,18 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Has(fd protoreflect.FieldDe
 		return x.Threads != int32(0)
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward":
 		return x.Reward != nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		return x.Comparison != nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		return x.RenderSettings != nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		return x.FrameRate != uint32(0)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		return x.TileGrid != nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		return x.Split != nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		return x.BlenderVersion != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -194,6 +254,18 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Clear(fd protoreflect.Field
 		x.Threads = int32(0)
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward":
 		x.Reward = nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		x.Comparison = nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		x.RenderSettings = nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		x.FrameRate = uint32(0)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		x.TileGrid = nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		x.Split = nil
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		x.BlenderVersion = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -228,6 +300,24 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Get(descriptor protoreflect
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward":
 		value := x.Reward
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		value := x.Comparison
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		value := x.RenderSettings
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		value := x.FrameRate
+		return protoreflect.ValueOfUint32(value)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		value := x.TileGrid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		value := x.Split
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		value := x.BlenderVersion
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -260,6 +350,18 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Set(fd protoreflect.FieldDe
 		x.Threads = int32(value.Int())
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward":
 		x.Reward = value.Message().Interface().(*v1beta1.Coin)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		x.Comparison = value.Message().Interface().(*FrameComparison)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		x.RenderSettings = value.Message().Interface().(*RenderSettings)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		x.FrameRate = uint32(value.Uint())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		x.TileGrid = value.Message().Interface().(*TileGrid)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		x.Split = value.Message().Interface().(*ThreadSplit)
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		x.BlenderVersion = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -285,6 +387,26 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Mutable(fd protoreflect.Fie
 			x.Reward = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Reward.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		if x.Comparison == nil {
+			x.Comparison = new(FrameComparison)
+		}
+		return protoreflect.ValueOfMessage(x.Comparison.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		if x.RenderSettings == nil {
+			x.RenderSettings = new(RenderSettings)
+		}
+		return protoreflect.ValueOfMessage(x.RenderSettings.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		if x.TileGrid == nil {
+			x.TileGrid = new(TileGrid)
+		}
+		return protoreflect.ValueOfMessage(x.TileGrid.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		if x.Split == nil {
+			x.Split = new(ThreadSplit)
+		}
+		return protoreflect.ValueOfMessage(x.Split.ProtoReflect())
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.creator":
 		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgCreateVideoRenderingTask is not mutable"))
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.cid":
@@ -295,6 +417,10 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) Mutable(fd protoreflect.Fie
 		panic(fmt.Errorf("field endFrame of message janction.videoRendering.v1.MsgCreateVideoRenderingTask is not mutable"))
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.threads":
 		panic(fmt.Errorf("field threads of message janction.videoRendering.v1.MsgCreateVideoRenderingTask is not mutable"))
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		panic(fmt.Errorf("field frame_rate of message janction.videoRendering.v1.MsgCreateVideoRenderingTask is not mutable"))
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		panic(fmt.Errorf("field blender_version of message janction.videoRendering.v1.MsgCreateVideoRenderingTask is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -321,6 +447,22 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) NewField(fd protoreflect.Fi
 	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison":
+		m := new(FrameComparison)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings":
+		m := new(RenderSettings)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.frame_rate":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid":
+		m := new(TileGrid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.split":
+		m := new(ThreadSplit)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgCreateVideoRenderingTask.blender_version":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgCreateVideoRenderingTask"))
@@ -411,6 +553,29 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) ProtoMethods() *protoiface.
 			l = options.Size(x.Reward)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Comparison != nil {
+			l = options.Size(x.Comparison)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RenderSettings != nil {
+			l = options.Size(x.RenderSettings)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FrameRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.FrameRate))
+		}
+		if x.TileGrid != nil {
+			l = options.Size(x.TileGrid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Split != nil {
+			l = options.Size(x.Split)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlenderVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -440,6 +605,74 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BlenderVersion) > 0 {
+			i -= len(x.BlenderVersion)
+			copy(dAtA[i:], x.BlenderVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlenderVersion)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Split != nil {
+			encoded, err := options.Marshal(x.Split)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.TileGrid != nil {
+			encoded, err := options.Marshal(x.TileGrid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.FrameRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FrameRate))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RenderSettings != nil {
+			encoded, err := options.Marshal(x.RenderSettings)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Comparison != nil {
+			encoded, err := options.Marshal(x.Comparison)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.Reward != nil {
 			encoded, err := options.Marshal(x.Reward)
 			if err != nil {
@@ -689,6 +922,201 @@ func (x *fastReflection_MsgCreateVideoRenderingTask) ProtoMethods() *protoiface.
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Comparison == nil {
+					x.Comparison = &FrameComparison{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Comparison); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RenderSettings == nil {
+					x.RenderSettings = &RenderSettings{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RenderSettings); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrameRate", wireType)
+				}
+				x.FrameRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FrameRate |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TileGrid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TileGrid == nil {
+					x.TileGrid = &TileGrid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TileGrid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Split == nil {
+					x.Split = &ThreadSplit{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Split); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlenderVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlenderVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1144,12 +1572,60 @@ func (x *fastReflection_MsgCreateVideoRenderingTaskResponse) ProtoMethods() *pro
 	}
 }
 
+var _ protoreflect.List = (*_MsgAddWorker_6_list)(nil)
+
+type _MsgAddWorker_6_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddWorker_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddWorker_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddWorker_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddWorker_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddWorker_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddWorker at list field BlenderVersions as it is not of Message kind"))
+}
+
+func (x *_MsgAddWorker_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddWorker_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddWorker_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgAddWorker           protoreflect.MessageDescriptor
-	fd_MsgAddWorker_creator   protoreflect.FieldDescriptor
-	fd_MsgAddWorker_public_ip protoreflect.FieldDescriptor
-	fd_MsgAddWorker_ipfs_id   protoreflect.FieldDescriptor
-	fd_MsgAddWorker_stake     protoreflect.FieldDescriptor
+	md_MsgAddWorker                  protoreflect.MessageDescriptor
+	fd_MsgAddWorker_creator          protoreflect.FieldDescriptor
+	fd_MsgAddWorker_public_ip        protoreflect.FieldDescriptor
+	fd_MsgAddWorker_ipfs_id          protoreflect.FieldDescriptor
+	fd_MsgAddWorker_stake            protoreflect.FieldDescriptor
+	fd_MsgAddWorker_architecture     protoreflect.FieldDescriptor
+	fd_MsgAddWorker_blender_versions protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1159,6 +1635,8 @@ func init() {
 	fd_MsgAddWorker_public_ip = md_MsgAddWorker.Fields().ByName("public_ip")
 	fd_MsgAddWorker_ipfs_id = md_MsgAddWorker.Fields().ByName("ipfs_id")
 	fd_MsgAddWorker_stake = md_MsgAddWorker.Fields().ByName("stake")
+	fd_MsgAddWorker_architecture = md_MsgAddWorker.Fields().ByName("architecture")
+	fd_MsgAddWorker_blender_versions = md_MsgAddWorker.Fields().ByName("blender_versions")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgAddWorker)(nil)
@@ -1250,6 +1728,18 @@ func (x *fastReflection_MsgAddWorker) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Architecture != "" {
+		value := protoreflect.ValueOfString(x.Architecture)
+		if !f(fd_MsgAddWorker_architecture, value) {
+			return
+		}
+	}
+	if len(x.BlenderVersions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddWorker_6_list{list: &x.BlenderVersions})
+		if !f(fd_MsgAddWorker_blender_versions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1273,6 +1763,10 @@ func (x *fastReflection_MsgAddWorker) Has(fd protoreflect.FieldDescriptor) bool
 		return x.IpfsId != ""
 	case "janction.videoRendering.v1.MsgAddWorker.stake":
 		return x.Stake != nil
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		return x.Architecture != ""
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		return len(x.BlenderVersions) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1297,6 +1791,10 @@ func (x *fastReflection_MsgAddWorker) Clear(fd protoreflect.FieldDescriptor) {
 		x.IpfsId = ""
 	case "janction.videoRendering.v1.MsgAddWorker.stake":
 		x.Stake = nil
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		x.Architecture = ""
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		x.BlenderVersions = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1325,6 +1823,15 @@ func (x *fastReflection_MsgAddWorker) Get(descriptor protoreflect.FieldDescripto
 	case "janction.videoRendering.v1.MsgAddWorker.stake":
 		value := x.Stake
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		value := x.Architecture
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		if len(x.BlenderVersions) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddWorker_6_list{})
+		}
+		listValue := &_MsgAddWorker_6_list{list: &x.BlenderVersions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1353,6 +1860,12 @@ func (x *fastReflection_MsgAddWorker) Set(fd protoreflect.FieldDescriptor, value
 		x.IpfsId = value.Interface().(string)
 	case "janction.videoRendering.v1.MsgAddWorker.stake":
 		x.Stake = value.Message().Interface().(*v1beta1.Coin)
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		x.Architecture = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		lv := value.List()
+		clv := lv.(*_MsgAddWorker_6_list)
+		x.BlenderVersions = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1378,12 +1891,20 @@ func (x *fastReflection_MsgAddWorker) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Stake = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Stake.ProtoReflect())
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		if x.BlenderVersions == nil {
+			x.BlenderVersions = []string{}
+		}
+		value := &_MsgAddWorker_6_list{list: &x.BlenderVersions}
+		return protoreflect.ValueOfList(value)
 	case "janction.videoRendering.v1.MsgAddWorker.creator":
 		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgAddWorker is not mutable"))
 	case "janction.videoRendering.v1.MsgAddWorker.public_ip":
 		panic(fmt.Errorf("field public_ip of message janction.videoRendering.v1.MsgAddWorker is not mutable"))
 	case "janction.videoRendering.v1.MsgAddWorker.ipfs_id":
 		panic(fmt.Errorf("field ipfs_id of message janction.videoRendering.v1.MsgAddWorker is not mutable"))
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		panic(fmt.Errorf("field architecture of message janction.videoRendering.v1.MsgAddWorker is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1406,6 +1927,11 @@ func (x *fastReflection_MsgAddWorker) NewField(fd protoreflect.FieldDescriptor)
 	case "janction.videoRendering.v1.MsgAddWorker.stake":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgAddWorker.architecture":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgAddWorker.blender_versions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddWorker_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgAddWorker"))
@@ -1491,6 +2017,16 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Stake)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Architecture)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BlenderVersions) > 0 {
+			for _, s := range x.BlenderVersions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1520,6 +2056,22 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BlenderVersions) > 0 {
+			for iNdEx := len(x.BlenderVersions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlenderVersions[iNdEx])
+				copy(dAtA[i:], x.BlenderVersions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlenderVersions[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Architecture) > 0 {
+			i -= len(x.Architecture)
+			copy(dAtA[i:], x.Architecture)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Architecture)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.Stake != nil {
 			encoded, err := options.Marshal(x.Stake)
 			if err != nil {
@@ -1736,6 +2288,70 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Architecture = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlenderVersions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlenderVersions = append(x.BlenderVersions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3213,59 +3829,19 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_MsgProposeSolution_5_list)(nil)
-
-type _MsgProposeSolution_5_list struct {
-	list *[]string
-}
-
-func (x *_MsgProposeSolution_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgProposeSolution_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgProposeSolution_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgProposeSolution_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgProposeSolution_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgProposeSolution at list field Signatures as it is not of Message kind"))
-}
-
-func (x *_MsgProposeSolution_5_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgProposeSolution_5_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgProposeSolution_5_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgProposeSolution            protoreflect.MessageDescriptor
-	fd_MsgProposeSolution_creator    protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_taskId     protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_threadId   protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_public_key protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_signatures protoreflect.FieldDescriptor
+	md_MsgProposeSolution                        protoreflect.MessageDescriptor
+	fd_MsgProposeSolution_creator                protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_taskId                 protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_threadId               protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_public_key             protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_merkle_root            protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_zk_proof               protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_zk_circuit_version     protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_hash_version           protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_render_settings        protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_renderer_digest        protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_average_render_seconds protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3275,7 +3851,13 @@ func init() {
 	fd_MsgProposeSolution_taskId = md_MsgProposeSolution.Fields().ByName("taskId")
 	fd_MsgProposeSolution_threadId = md_MsgProposeSolution.Fields().ByName("threadId")
 	fd_MsgProposeSolution_public_key = md_MsgProposeSolution.Fields().ByName("public_key")
-	fd_MsgProposeSolution_signatures = md_MsgProposeSolution.Fields().ByName("signatures")
+	fd_MsgProposeSolution_merkle_root = md_MsgProposeSolution.Fields().ByName("merkle_root")
+	fd_MsgProposeSolution_zk_proof = md_MsgProposeSolution.Fields().ByName("zk_proof")
+	fd_MsgProposeSolution_zk_circuit_version = md_MsgProposeSolution.Fields().ByName("zk_circuit_version")
+	fd_MsgProposeSolution_hash_version = md_MsgProposeSolution.Fields().ByName("hash_version")
+	fd_MsgProposeSolution_render_settings = md_MsgProposeSolution.Fields().ByName("render_settings")
+	fd_MsgProposeSolution_renderer_digest = md_MsgProposeSolution.Fields().ByName("renderer_digest")
+	fd_MsgProposeSolution_average_render_seconds = md_MsgProposeSolution.Fields().ByName("average_render_seconds")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgProposeSolution)(nil)
@@ -3367,9 +3949,45 @@ func (x *fastReflection_MsgProposeSolution) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &x.Signatures})
-		if !f(fd_MsgProposeSolution_signatures, value) {
+	if x.MerkleRoot != "" {
+		value := protoreflect.ValueOfString(x.MerkleRoot)
+		if !f(fd_MsgProposeSolution_merkle_root, value) {
+			return
+		}
+	}
+	if x.ZkProof != "" {
+		value := protoreflect.ValueOfString(x.ZkProof)
+		if !f(fd_MsgProposeSolution_zk_proof, value) {
+			return
+		}
+	}
+	if x.ZkCircuitVersion != "" {
+		value := protoreflect.ValueOfString(x.ZkCircuitVersion)
+		if !f(fd_MsgProposeSolution_zk_circuit_version, value) {
+			return
+		}
+	}
+	if x.HashVersion != "" {
+		value := protoreflect.ValueOfString(x.HashVersion)
+		if !f(fd_MsgProposeSolution_hash_version, value) {
+			return
+		}
+	}
+	if x.RenderSettings != "" {
+		value := protoreflect.ValueOfString(x.RenderSettings)
+		if !f(fd_MsgProposeSolution_render_settings, value) {
+			return
+		}
+	}
+	if x.RendererDigest != "" {
+		value := protoreflect.ValueOfString(x.RendererDigest)
+		if !f(fd_MsgProposeSolution_renderer_digest, value) {
+			return
+		}
+	}
+	if x.AverageRenderSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AverageRenderSeconds)
+		if !f(fd_MsgProposeSolution_average_render_seconds, value) {
 			return
 		}
 	}
@@ -3396,8 +4014,20 @@ func (x *fastReflection_MsgProposeSolution) Has(fd protoreflect.FieldDescriptor)
 		return x.ThreadId != ""
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		return x.PublicKey != ""
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		return len(x.Signatures) != 0
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		return x.MerkleRoot != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		return x.ZkProof != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		return x.ZkCircuitVersion != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		return x.HashVersion != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		return x.RenderSettings != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		return x.RendererDigest != ""
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		return x.AverageRenderSeconds != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3422,8 +4052,20 @@ func (x *fastReflection_MsgProposeSolution) Clear(fd protoreflect.FieldDescripto
 		x.ThreadId = ""
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		x.PublicKey = ""
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		x.Signatures = nil
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		x.MerkleRoot = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		x.ZkProof = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		x.ZkCircuitVersion = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		x.HashVersion = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		x.RenderSettings = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		x.RendererDigest = ""
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		x.AverageRenderSeconds = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3452,12 +4094,27 @@ func (x *fastReflection_MsgProposeSolution) Get(descriptor protoreflect.FieldDes
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		value := x.PublicKey
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{})
-		}
-		listValue := &_MsgProposeSolution_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(listValue)
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		value := x.ZkProof
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		value := x.ZkCircuitVersion
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		value := x.HashVersion
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		value := x.RenderSettings
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		value := x.RendererDigest
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		value := x.AverageRenderSeconds
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3486,10 +4143,20 @@ func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor,
 		x.ThreadId = value.Interface().(string)
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		x.PublicKey = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		lv := value.List()
-		clv := lv.(*_MsgProposeSolution_5_list)
-		x.Signatures = *clv.list
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		x.MerkleRoot = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		x.ZkProof = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		x.ZkCircuitVersion = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		x.HashVersion = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		x.RenderSettings = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		x.RendererDigest = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		x.AverageRenderSeconds = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3510,12 +4177,6 @@ func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
-		}
-		value := &_MsgProposeSolution_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(value)
 	case "janction.videoRendering.v1.MsgProposeSolution.creator":
 		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
 	case "janction.videoRendering.v1.MsgProposeSolution.taskId":
@@ -3524,6 +4185,20 @@ func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field threadId of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		panic(fmt.Errorf("field public_key of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		panic(fmt.Errorf("field merkle_root of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		panic(fmt.Errorf("field zk_proof of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		panic(fmt.Errorf("field zk_circuit_version of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		panic(fmt.Errorf("field hash_version of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		panic(fmt.Errorf("field render_settings of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		panic(fmt.Errorf("field renderer_digest of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		panic(fmt.Errorf("field average_render_seconds of message janction.videoRendering.v1.MsgProposeSolution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3545,9 +4220,20 @@ func (x *fastReflection_MsgProposeSolution) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "janction.videoRendering.v1.MsgProposeSolution.public_key":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgProposeSolution.signatures":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &list})
+	case "janction.videoRendering.v1.MsgProposeSolution.merkle_root":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_proof":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.zk_circuit_version":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.hash_version":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.render_settings":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.renderer_digest":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgProposeSolution.average_render_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgProposeSolution"))
@@ -3633,11 +4319,32 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ZkProof)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ZkCircuitVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HashVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RenderSettings)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RendererDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AverageRenderSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.AverageRenderSeconds))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3668,14 +4375,52 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
-				i--
-				dAtA[i] = 0x2a
-			}
+		if x.AverageRenderSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageRenderSeconds))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.RendererDigest) > 0 {
+			i -= len(x.RendererDigest)
+			copy(dAtA[i:], x.RendererDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RendererDigest)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.RenderSettings) > 0 {
+			i -= len(x.RenderSettings)
+			copy(dAtA[i:], x.RenderSettings)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RenderSettings)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.HashVersion) > 0 {
+			i -= len(x.HashVersion)
+			copy(dAtA[i:], x.HashVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HashVersion)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ZkCircuitVersion) > 0 {
+			i -= len(x.ZkCircuitVersion)
+			copy(dAtA[i:], x.ZkCircuitVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ZkCircuitVersion)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ZkProof) > 0 {
+			i -= len(x.ZkProof)
+			copy(dAtA[i:], x.ZkProof)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ZkProof)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if len(x.PublicKey) > 0 {
 			i -= len(x.PublicKey)
@@ -3882,9 +4627,9 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				}
 				x.PublicKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3912,19 +4657,198 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				x.MerkleRoot = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ZkProof", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ZkProof = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ZkCircuitVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ZkCircuitVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HashVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HashVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RenderSettings = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RendererDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RendererDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+				}
+				x.AverageRenderSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AverageRenderSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -4357,6 +5281,7 @@ var (
 	fd_MsgRevealSolution_taskId   protoreflect.FieldDescriptor
 	fd_MsgRevealSolution_threadId protoreflect.FieldDescriptor
 	fd_MsgRevealSolution_frames   protoreflect.FieldDescriptor
+	fd_MsgRevealSolution_salt     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4366,6 +5291,7 @@ func init() {
 	fd_MsgRevealSolution_taskId = md_MsgRevealSolution.Fields().ByName("taskId")
 	fd_MsgRevealSolution_threadId = md_MsgRevealSolution.Fields().ByName("threadId")
 	fd_MsgRevealSolution_frames = md_MsgRevealSolution.Fields().ByName("frames")
+	fd_MsgRevealSolution_salt = md_MsgRevealSolution.Fields().ByName("salt")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRevealSolution)(nil)
@@ -4457,6 +5383,12 @@ func (x *fastReflection_MsgRevealSolution) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_MsgRevealSolution_salt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4480,6 +5412,8 @@ func (x *fastReflection_MsgRevealSolution) Has(fd protoreflect.FieldDescriptor)
 		return x.ThreadId != ""
 	case "janction.videoRendering.v1.MsgRevealSolution.frames":
 		return len(x.Frames) != 0
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		return x.Salt != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4504,6 +5438,8 @@ func (x *fastReflection_MsgRevealSolution) Clear(fd protoreflect.FieldDescriptor
 		x.ThreadId = ""
 	case "janction.videoRendering.v1.MsgRevealSolution.frames":
 		x.Frames = nil
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		x.Salt = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4535,6 +5471,9 @@ func (x *fastReflection_MsgRevealSolution) Get(descriptor protoreflect.FieldDesc
 		}
 		listValue := &_MsgRevealSolution_4_list{list: &x.Frames}
 		return protoreflect.ValueOfList(listValue)
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		value := x.Salt
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4565,6 +5504,8 @@ func (x *fastReflection_MsgRevealSolution) Set(fd protoreflect.FieldDescriptor,
 		lv := value.List()
 		clv := lv.(*_MsgRevealSolution_4_list)
 		x.Frames = *clv.list
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		x.Salt = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4597,6 +5538,8 @@ func (x *fastReflection_MsgRevealSolution) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field taskId of message janction.videoRendering.v1.MsgRevealSolution is not mutable"))
 	case "janction.videoRendering.v1.MsgRevealSolution.threadId":
 		panic(fmt.Errorf("field threadId of message janction.videoRendering.v1.MsgRevealSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		panic(fmt.Errorf("field salt of message janction.videoRendering.v1.MsgRevealSolution is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4619,6 +5562,8 @@ func (x *fastReflection_MsgRevealSolution) NewField(fd protoreflect.FieldDescrip
 	case "janction.videoRendering.v1.MsgRevealSolution.frames":
 		list := []string{}
 		return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &list})
+	case "janction.videoRendering.v1.MsgRevealSolution.salt":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealSolution"))
@@ -4706,6 +5651,10 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4735,6 +5684,13 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Frames) > 0 {
 			for iNdEx := len(x.Frames) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Frames[iNdEx])
@@ -4942,6 +5898,38 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 				}
 				x.Frames = append(x.Frames, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5333,59 +6321,59 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitValidation_5_list)(nil)
+var _ protoreflect.List = (*_MsgSubmitValidation_6_list)(nil)
 
-type _MsgSubmitValidation_5_list struct {
+type _MsgSubmitValidation_6_list struct {
 	list *[]string
 }
 
-func (x *_MsgSubmitValidation_5_list) Len() int {
+func (x *_MsgSubmitValidation_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgSubmitValidation_5_list) Get(i int) protoreflect.Value {
+func (x *_MsgSubmitValidation_6_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_MsgSubmitValidation_5_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgSubmitValidation_6_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgSubmitValidation_5_list) Append(value protoreflect.Value) {
+func (x *_MsgSubmitValidation_6_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgSubmitValidation_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitValidation at list field Signatures as it is not of Message kind"))
+func (x *_MsgSubmitValidation_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitValidation at list field Commitments as it is not of Message kind"))
 }
 
-func (x *_MsgSubmitValidation_5_list) Truncate(n int) {
+func (x *_MsgSubmitValidation_6_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgSubmitValidation_5_list) NewElement() protoreflect.Value {
+func (x *_MsgSubmitValidation_6_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_MsgSubmitValidation_5_list) IsValid() bool {
+func (x *_MsgSubmitValidation_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgSubmitValidation            protoreflect.MessageDescriptor
-	fd_MsgSubmitValidation_creator    protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_taskId     protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_threadId   protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_public_key protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_signatures protoreflect.FieldDescriptor
+	md_MsgSubmitValidation             protoreflect.MessageDescriptor
+	fd_MsgSubmitValidation_creator     protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_taskId      protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_threadId    protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_public_key  protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_commitments protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5395,7 +6383,7 @@ func init() {
 	fd_MsgSubmitValidation_taskId = md_MsgSubmitValidation.Fields().ByName("taskId")
 	fd_MsgSubmitValidation_threadId = md_MsgSubmitValidation.Fields().ByName("threadId")
 	fd_MsgSubmitValidation_public_key = md_MsgSubmitValidation.Fields().ByName("public_key")
-	fd_MsgSubmitValidation_signatures = md_MsgSubmitValidation.Fields().ByName("signatures")
+	fd_MsgSubmitValidation_commitments = md_MsgSubmitValidation.Fields().ByName("commitments")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSubmitValidation)(nil)
@@ -5487,9 +6475,9 @@ func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &x.Signatures})
-		if !f(fd_MsgSubmitValidation_signatures, value) {
+	if len(x.Commitments) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitValidation_6_list{list: &x.Commitments})
+		if !f(fd_MsgSubmitValidation_commitments, value) {
 			return
 		}
 	}
@@ -5516,8 +6504,8 @@ func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor
 		return x.ThreadId != ""
 	case "janction.videoRendering.v1.MsgSubmitValidation.public_key":
 		return x.PublicKey != ""
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
-		return len(x.Signatures) != 0
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
+		return len(x.Commitments) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitValidation"))
@@ -5542,8 +6530,8 @@ func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescript
 		x.ThreadId = ""
 	case "janction.videoRendering.v1.MsgSubmitValidation.public_key":
 		x.PublicKey = ""
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
-		x.Signatures = nil
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
+		x.Commitments = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitValidation"))
@@ -5572,11 +6560,11 @@ func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDe
 	case "janction.videoRendering.v1.MsgSubmitValidation.public_key":
 		value := x.PublicKey
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{})
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
+		if len(x.Commitments) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitValidation_6_list{})
 		}
-		listValue := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		listValue := &_MsgSubmitValidation_6_list{list: &x.Commitments}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
@@ -5606,10 +6594,10 @@ func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor
 		x.ThreadId = value.Interface().(string)
 	case "janction.videoRendering.v1.MsgSubmitValidation.public_key":
 		x.PublicKey = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
 		lv := value.List()
-		clv := lv.(*_MsgSubmitValidation_5_list)
-		x.Signatures = *clv.list
+		clv := lv.(*_MsgSubmitValidation_6_list)
+		x.Commitments = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitValidation"))
@@ -5630,11 +6618,11 @@ func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSubmitValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
+		if x.Commitments == nil {
+			x.Commitments = []string{}
 		}
-		value := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		value := &_MsgSubmitValidation_6_list{list: &x.Commitments}
 		return protoreflect.ValueOfList(value)
 	case "janction.videoRendering.v1.MsgSubmitValidation.creator":
 		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgSubmitValidation is not mutable"))
@@ -5665,9 +6653,9 @@ func (x *fastReflection_MsgSubmitValidation) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "janction.videoRendering.v1.MsgSubmitValidation.public_key":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgSubmitValidation.signatures":
+	case "janction.videoRendering.v1.MsgSubmitValidation.commitments":
 		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &list})
+		return protoreflect.ValueOfList(&_MsgSubmitValidation_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitValidation"))
@@ -5753,8 +6741,8 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
+		if len(x.Commitments) > 0 {
+			for _, s := range x.Commitments {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -5788,13 +6776,13 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+		if len(x.Commitments) > 0 {
+			for iNdEx := len(x.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Commitments[iNdEx])
+				copy(dAtA[i:], x.Commitments[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitments[iNdEx])))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x32
 			}
 		}
 		if len(x.PublicKey) > 0 {
@@ -6002,9 +6990,9 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 				}
 				x.PublicKey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6032,7 +7020,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				x.Commitments = append(x.Commitments, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6425,82 +7413,128 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 	}
 }
 
-var (
-	md_MsgSubmitSolution                        protoreflect.MessageDescriptor
-	fd_MsgSubmitSolution_creator                protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_taskId                 protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_threadId               protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_dir                    protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_average_render_seconds protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MsgRevealValidation_5_list)(nil)
 
-func init() {
-	file_janction_videoRendering_v1_tx_proto_init()
-	md_MsgSubmitSolution = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
-	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
-	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
-	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
-	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
-	fd_MsgSubmitSolution_average_render_seconds = md_MsgSubmitSolution.Fields().ByName("average_render_seconds")
+type _MsgRevealValidation_5_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
-
-type fastReflection_MsgSubmitSolution MsgSubmitSolution
-
-func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(x)
+func (x *_MsgRevealValidation_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_MsgRevealValidation_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
+func (x *_MsgRevealValidation_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_MsgSubmitSolution_messageType struct{}
+func (x *_MsgRevealValidation_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(nil)
+func (x *_MsgRevealValidation_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevealValidation at list field Frames as it is not of Message kind"))
 }
-func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
+
+func (x *_MsgRevealValidation_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
+
+func (x *_MsgRevealValidation_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRevealValidation_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRevealValidation          protoreflect.MessageDescriptor
+	fd_MsgRevealValidation_creator  protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_taskId   protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_threadId protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_salt     protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_frames   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgRevealValidation = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRevealValidation")
+	fd_MsgRevealValidation_creator = md_MsgRevealValidation.Fields().ByName("creator")
+	fd_MsgRevealValidation_taskId = md_MsgRevealValidation.Fields().ByName("taskId")
+	fd_MsgRevealValidation_threadId = md_MsgRevealValidation.Fields().ByName("threadId")
+	fd_MsgRevealValidation_salt = md_MsgRevealValidation.Fields().ByName("salt")
+	fd_MsgRevealValidation_frames = md_MsgRevealValidation.Fields().ByName("frames")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidation)(nil)
+
+type fastReflection_MsgRevealValidation MsgRevealValidation
+
+func (x *MsgRevealValidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(x)
+}
+
+func (x *MsgRevealValidation) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealValidation_messageType fastReflection_MsgRevealValidation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidation_messageType{}
+
+type fastReflection_MsgRevealValidation_messageType struct{}
+
+func (x fastReflection_MsgRevealValidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(nil)
+}
+func (x fastReflection_MsgRevealValidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
+}
+func (x fastReflection_MsgRevealValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
+func (x *fastReflection_MsgRevealValidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolution_messageType
+func (x *fastReflection_MsgRevealValidation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
+func (x *fastReflection_MsgRevealValidation) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolution)(x)
+func (x *fastReflection_MsgRevealValidation) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6508,34 +7542,34 @@ func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevealValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSubmitSolution_creator, value) {
+		if !f(fd_MsgRevealValidation_creator, value) {
 			return
 		}
 	}
 	if x.TaskId != "" {
 		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubmitSolution_taskId, value) {
+		if !f(fd_MsgRevealValidation_taskId, value) {
 			return
 		}
 	}
 	if x.ThreadId != "" {
 		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubmitSolution_threadId, value) {
+		if !f(fd_MsgRevealValidation_threadId, value) {
 			return
 		}
 	}
-	if x.Dir != "" {
-		value := protoreflect.ValueOfString(x.Dir)
-		if !f(fd_MsgSubmitSolution_dir, value) {
+	if x.Salt != "" {
+		value := protoreflect.ValueOfString(x.Salt)
+		if !f(fd_MsgRevealValidation_salt, value) {
 			return
 		}
 	}
-	if x.AverageRenderSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.AverageRenderSeconds)
-		if !f(fd_MsgSubmitSolution_average_render_seconds, value) {
+	if len(x.Frames) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRevealValidation_5_list{list: &x.Frames})
+		if !f(fd_MsgRevealValidation_frames, value) {
 			return
 		}
 	}
@@ -6552,23 +7586,23 @@ func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealValidation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
 		return x.Creator != ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
 		return x.TaskId != ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
 		return x.ThreadId != ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
-		return x.Dir != ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		return x.AverageRenderSeconds != int64(0)
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
+		return x.Salt != ""
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		return len(x.Frames) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6578,23 +7612,23 @@ func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealValidation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
 		x.Creator = ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
 		x.TaskId = ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
 		x.ThreadId = ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
-		x.Dir = ""
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		x.AverageRenderSeconds = int64(0)
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
+		x.Salt = ""
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		x.Frames = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6604,28 +7638,31 @@ func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
 		value := x.TaskId
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
 		value := x.ThreadId
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
-		value := x.Dir
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
+		value := x.Salt
 		return protoreflect.ValueOfString(value)
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		value := x.AverageRenderSeconds
-		return protoreflect.ValueOfInt64(value)
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		if len(x.Frames) == 0 {
+			return protoreflect.ValueOfList(&_MsgRevealValidation_5_list{})
+		}
+		listValue := &_MsgRevealValidation_5_list{list: &x.Frames}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6639,23 +7676,25 @@ func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
 		x.Creator = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
 		x.TaskId = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
 		x.ThreadId = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
-		x.Dir = value.Interface().(string)
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		x.AverageRenderSeconds = value.Int()
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
+		x.Salt = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		lv := value.List()
+		clv := lv.(*_MsgRevealValidation_5_list)
+		x.Frames = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6669,56 +7708,61 @@ func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
-		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
-		panic(fmt.Errorf("field dir of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		panic(fmt.Errorf("field average_render_seconds of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		if x.Frames == nil {
+			x.Frames = []string{}
+		}
+		value := &_MsgRevealValidation_5_list{list: &x.Frames}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
+		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgRevealValidation is not mutable"))
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoRendering.v1.MsgRevealValidation is not mutable"))
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoRendering.v1.MsgRevealValidation is not mutable"))
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
+		panic(fmt.Errorf("field salt of message janction.videoRendering.v1.MsgRevealValidation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+	case "janction.videoRendering.v1.MsgRevealValidation.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+	case "janction.videoRendering.v1.MsgRevealValidation.taskId":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+	case "janction.videoRendering.v1.MsgRevealValidation.threadId":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+	case "janction.videoRendering.v1.MsgRevealValidation.salt":
 		return protoreflect.ValueOfString("")
-	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.videoRendering.v1.MsgRevealValidation.frames":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRevealValidation_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidation"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitSolution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRevealValidation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6726,7 +7770,7 @@ func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealValidation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6737,7 +7781,7 @@ func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealValidation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6749,7 +7793,7 @@ func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+func (x *fastReflection_MsgRevealValidation) IsValid() bool {
 	return x != nil
 }
 
@@ -6759,9 +7803,9 @@ func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealValidation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6785,12 +7829,15 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Dir)
+		l = len(x.Salt)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AverageRenderSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.AverageRenderSeconds))
+		if len(x.Frames) > 0 {
+			for _, s := range x.Frames {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6802,7 +7849,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6821,15 +7868,19 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AverageRenderSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageRenderSeconds))
-			i--
-			dAtA[i] = 0x28
+		if len(x.Frames) > 0 {
+			for iNdEx := len(x.Frames) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Frames[iNdEx])
+				copy(dAtA[i:], x.Frames[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Frames[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
 		}
-		if len(x.Dir) > 0 {
-			i -= len(x.Dir)
-			copy(dAtA[i:], x.Dir)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
 			i--
 			dAtA[i] = 0x22
 		}
@@ -6865,7 +7916,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgRevealValidation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6897,10 +7948,10 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7001,7 +8052,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7029,13 +8080,13 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Dir = string(dAtA[iNdEx:postIndex])
+				x.Salt = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 				}
-				x.AverageRenderSeconds = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7045,11 +8096,24 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AverageRenderSeconds |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Frames = append(x.Frames, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7086,23 +8150,23 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+	md_MsgRevealValidationResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoRendering_v1_tx_proto_init()
-	md_MsgSubmitSolutionResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+	md_MsgRevealValidationResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRevealValidationResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidationResponse)(nil)
 
-type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+type fastReflection_MsgRevealValidationResponse MsgRevealValidationResponse
 
-func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+func (x *MsgRevealValidationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(x)
 }
 
-func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevealValidationResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7114,43 +8178,43 @@ func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+var _fastReflection_MsgRevealValidationResponse_messageType fastReflection_MsgRevealValidationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidationResponse_messageType{}
 
-type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+type fastReflection_MsgRevealValidationResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+func (x fastReflection_MsgRevealValidationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(nil)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x fastReflection_MsgRevealValidationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x fastReflection_MsgRevealValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x *fastReflection_MsgRevealValidationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolutionResponse_messageType
+func (x *fastReflection_MsgRevealValidationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidationResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x *fastReflection_MsgRevealValidationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolutionResponse)(x)
+func (x *fastReflection_MsgRevealValidationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7158,7 +8222,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevealValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7172,13 +8236,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevealValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7188,13 +8252,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevealValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7204,13 +8268,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7224,13 +8288,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevealValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7244,36 +8308,36 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevealValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRevealValidationResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevealValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitSolutionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRevealValidationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7281,7 +8345,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevealValidationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7292,7 +8356,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevealValidationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7304,7 +8368,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+func (x *fastReflection_MsgRevealValidationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7314,9 +8378,9 @@ func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevealValidationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7338,7 +8402,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7368,7 +8432,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7400,10 +8464,10 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7441,688 +8505,7235 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: janction/videoRendering/v1/tx.proto
+var (
+	md_MsgSubmitSolution                        protoreflect.MessageDescriptor
+	fd_MsgSubmitSolution_creator                protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_taskId                 protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_threadId               protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_dir                    protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_average_render_seconds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitSolution = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
+	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
+	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
+	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
+	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
+	fd_MsgSubmitSolution_average_render_seconds = md_MsgSubmitSolution.Fields().ByName("average_render_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
+
+type fastReflection_MsgSubmitSolution MsgSubmitSolution
+
+func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(x)
+}
+
+func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
+
+type fastReflection_MsgSubmitSolution_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(nil)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitSolution_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitSolution_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubmitSolution_threadId, value) {
+			return
+		}
+	}
+	if x.Dir != "" {
+		value := protoreflect.ValueOfString(x.Dir)
+		if !f(fd_MsgSubmitSolution_dir, value) {
+			return
+		}
+	}
+	if x.AverageRenderSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AverageRenderSeconds)
+		if !f(fd_MsgSubmitSolution_average_render_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		return x.Creator != ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		return x.TaskId != ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		return x.ThreadId != ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		return x.Dir != ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		return x.AverageRenderSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		x.Creator = ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		x.TaskId = ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		x.Dir = ""
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		x.AverageRenderSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		value := x.Dir
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		value := x.AverageRenderSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		x.Dir = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		x.AverageRenderSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		panic(fmt.Errorf("field dir of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		panic(fmt.Errorf("field average_render_seconds of message janction.videoRendering.v1.MsgSubmitSolution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitSolution.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitSolution.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitSolution.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitSolution.dir":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitSolution.average_render_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitSolution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Dir)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AverageRenderSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.AverageRenderSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AverageRenderSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageRenderSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Dir) > 0 {
+			i -= len(x.Dir)
+			copy(dAtA[i:], x.Dir)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dir = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+				}
+				x.AverageRenderSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AverageRenderSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitSolutionResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+
+type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+
+func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+}
+
+func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+
+type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitSolutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterVerifyingKey                 protoreflect.MessageDescriptor
+	fd_MsgRegisterVerifyingKey_authority       protoreflect.FieldDescriptor
+	fd_MsgRegisterVerifyingKey_circuit_version protoreflect.FieldDescriptor
+	fd_MsgRegisterVerifyingKey_verifying_key   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgRegisterVerifyingKey = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRegisterVerifyingKey")
+	fd_MsgRegisterVerifyingKey_authority = md_MsgRegisterVerifyingKey.Fields().ByName("authority")
+	fd_MsgRegisterVerifyingKey_circuit_version = md_MsgRegisterVerifyingKey.Fields().ByName("circuit_version")
+	fd_MsgRegisterVerifyingKey_verifying_key = md_MsgRegisterVerifyingKey.Fields().ByName("verifying_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterVerifyingKey)(nil)
+
+type fastReflection_MsgRegisterVerifyingKey MsgRegisterVerifyingKey
+
+func (x *MsgRegisterVerifyingKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterVerifyingKey)(x)
+}
+
+func (x *MsgRegisterVerifyingKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterVerifyingKey_messageType fastReflection_MsgRegisterVerifyingKey_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterVerifyingKey_messageType{}
+
+type fastReflection_MsgRegisterVerifyingKey_messageType struct{}
+
+func (x fastReflection_MsgRegisterVerifyingKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterVerifyingKey)(nil)
+}
+func (x fastReflection_MsgRegisterVerifyingKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterVerifyingKey)
+}
+func (x fastReflection_MsgRegisterVerifyingKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterVerifyingKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterVerifyingKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterVerifyingKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterVerifyingKey) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterVerifyingKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterVerifyingKey) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterVerifyingKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterVerifyingKey) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterVerifyingKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterVerifyingKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRegisterVerifyingKey_authority, value) {
+			return
+		}
+	}
+	if x.CircuitVersion != "" {
+		value := protoreflect.ValueOfString(x.CircuitVersion)
+		if !f(fd_MsgRegisterVerifyingKey_circuit_version, value) {
+			return
+		}
+	}
+	if len(x.VerifyingKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.VerifyingKey)
+		if !f(fd_MsgRegisterVerifyingKey_verifying_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterVerifyingKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		return x.Authority != ""
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		return x.CircuitVersion != ""
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		return len(x.VerifyingKey) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		x.Authority = ""
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		x.CircuitVersion = ""
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		x.VerifyingKey = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterVerifyingKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		value := x.CircuitVersion
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		value := x.VerifyingKey
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		x.Authority = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		x.CircuitVersion = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		x.VerifyingKey = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		panic(fmt.Errorf("field authority of message janction.videoRendering.v1.MsgRegisterVerifyingKey is not mutable"))
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		panic(fmt.Errorf("field circuit_version of message janction.videoRendering.v1.MsgRegisterVerifyingKey is not mutable"))
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		panic(fmt.Errorf("field verifying_key of message janction.videoRendering.v1.MsgRegisterVerifyingKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterVerifyingKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.authority":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.circuit_version":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgRegisterVerifyingKey.verifying_key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKey"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterVerifyingKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRegisterVerifyingKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterVerifyingKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterVerifyingKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterVerifyingKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CircuitVersion)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerifyingKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerifyingKey) > 0 {
+			i -= len(x.VerifyingKey)
+			copy(dAtA[i:], x.VerifyingKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifyingKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CircuitVersion) > 0 {
+			i -= len(x.CircuitVersion)
+			copy(dAtA[i:], x.CircuitVersion)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CircuitVersion)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterVerifyingKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterVerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitVersion", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitVersion = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifyingKey = append(x.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.VerifyingKey == nil {
+					x.VerifyingKey = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterVerifyingKeyResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgRegisterVerifyingKeyResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRegisterVerifyingKeyResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterVerifyingKeyResponse)(nil)
+
+type fastReflection_MsgRegisterVerifyingKeyResponse MsgRegisterVerifyingKeyResponse
+
+func (x *MsgRegisterVerifyingKeyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterVerifyingKeyResponse)(x)
+}
+
+func (x *MsgRegisterVerifyingKeyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterVerifyingKeyResponse_messageType fastReflection_MsgRegisterVerifyingKeyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterVerifyingKeyResponse_messageType{}
+
+type fastReflection_MsgRegisterVerifyingKeyResponse_messageType struct{}
+
+func (x fastReflection_MsgRegisterVerifyingKeyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterVerifyingKeyResponse)(nil)
+}
+func (x fastReflection_MsgRegisterVerifyingKeyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterVerifyingKeyResponse)
+}
+func (x fastReflection_MsgRegisterVerifyingKeyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterVerifyingKeyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterVerifyingKeyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterVerifyingKeyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterVerifyingKeyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterVerifyingKeyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterVerifyingKeyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKeyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKeyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterVerifyingKeyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterVerifyingKeyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterVerifyingKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitAssembledVideo            protoreflect.MessageDescriptor
+	fd_MsgSubmitAssembledVideo_creator    protoreflect.FieldDescriptor
+	fd_MsgSubmitAssembledVideo_task_id    protoreflect.FieldDescriptor
+	fd_MsgSubmitAssembledVideo_video_cid  protoreflect.FieldDescriptor
+	fd_MsgSubmitAssembledVideo_video_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitAssembledVideo = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitAssembledVideo")
+	fd_MsgSubmitAssembledVideo_creator = md_MsgSubmitAssembledVideo.Fields().ByName("creator")
+	fd_MsgSubmitAssembledVideo_task_id = md_MsgSubmitAssembledVideo.Fields().ByName("task_id")
+	fd_MsgSubmitAssembledVideo_video_cid = md_MsgSubmitAssembledVideo.Fields().ByName("video_cid")
+	fd_MsgSubmitAssembledVideo_video_hash = md_MsgSubmitAssembledVideo.Fields().ByName("video_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitAssembledVideo)(nil)
+
+type fastReflection_MsgSubmitAssembledVideo MsgSubmitAssembledVideo
+
+func (x *MsgSubmitAssembledVideo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitAssembledVideo)(x)
+}
+
+func (x *MsgSubmitAssembledVideo) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitAssembledVideo_messageType fastReflection_MsgSubmitAssembledVideo_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitAssembledVideo_messageType{}
+
+type fastReflection_MsgSubmitAssembledVideo_messageType struct{}
+
+func (x fastReflection_MsgSubmitAssembledVideo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitAssembledVideo)(nil)
+}
+func (x fastReflection_MsgSubmitAssembledVideo_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitAssembledVideo)
+}
+func (x fastReflection_MsgSubmitAssembledVideo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitAssembledVideo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitAssembledVideo) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitAssembledVideo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitAssembledVideo) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitAssembledVideo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitAssembledVideo) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitAssembledVideo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitAssembledVideo) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitAssembledVideo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitAssembledVideo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitAssembledVideo_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitAssembledVideo_task_id, value) {
+			return
+		}
+	}
+	if x.VideoCid != "" {
+		value := protoreflect.ValueOfString(x.VideoCid)
+		if !f(fd_MsgSubmitAssembledVideo_video_cid, value) {
+			return
+		}
+	}
+	if x.VideoHash != "" {
+		value := protoreflect.ValueOfString(x.VideoHash)
+		if !f(fd_MsgSubmitAssembledVideo_video_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitAssembledVideo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		return x.Creator != ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		return x.TaskId != ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		return x.VideoCid != ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		return x.VideoHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		x.Creator = ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		x.TaskId = ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		x.VideoCid = ""
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		x.VideoHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitAssembledVideo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		value := x.VideoCid
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		value := x.VideoHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		x.VideoCid = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		x.VideoHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgSubmitAssembledVideo is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		panic(fmt.Errorf("field task_id of message janction.videoRendering.v1.MsgSubmitAssembledVideo is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		panic(fmt.Errorf("field video_cid of message janction.videoRendering.v1.MsgSubmitAssembledVideo is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		panic(fmt.Errorf("field video_hash of message janction.videoRendering.v1.MsgSubmitAssembledVideo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitAssembledVideo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.task_id":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_cid":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitAssembledVideo.video_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitAssembledVideo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitAssembledVideo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitAssembledVideo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitAssembledVideo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitAssembledVideo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VideoCid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VideoHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VideoHash) > 0 {
+			i -= len(x.VideoHash)
+			copy(dAtA[i:], x.VideoHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VideoHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VideoCid) > 0 {
+			i -= len(x.VideoCid)
+			copy(dAtA[i:], x.VideoCid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VideoCid)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitAssembledVideo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitAssembledVideo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VideoCid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VideoCid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VideoHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VideoHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitAssembledVideoResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitAssembledVideoResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitAssembledVideoResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitAssembledVideoResponse)(nil)
+
+type fastReflection_MsgSubmitAssembledVideoResponse MsgSubmitAssembledVideoResponse
+
+func (x *MsgSubmitAssembledVideoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitAssembledVideoResponse)(x)
+}
+
+func (x *MsgSubmitAssembledVideoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitAssembledVideoResponse_messageType fastReflection_MsgSubmitAssembledVideoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitAssembledVideoResponse_messageType{}
+
+type fastReflection_MsgSubmitAssembledVideoResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitAssembledVideoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitAssembledVideoResponse)(nil)
+}
+func (x fastReflection_MsgSubmitAssembledVideoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitAssembledVideoResponse)
+}
+func (x fastReflection_MsgSubmitAssembledVideoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitAssembledVideoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitAssembledVideoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitAssembledVideoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitAssembledVideoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitAssembledVideoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitAssembledVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitAssembledVideoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitAssembledVideoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitAssembledVideoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitAssembledVideoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitAssembledVideoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitPreview_3_list)(nil)
+
+type _MsgSubmitPreview_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitPreview_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitPreview_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitPreview_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitPreview_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitPreview_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitPreview at list field Costs as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitPreview_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitPreview_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitPreview_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitPreview         protoreflect.MessageDescriptor
+	fd_MsgSubmitPreview_creator protoreflect.FieldDescriptor
+	fd_MsgSubmitPreview_task_id protoreflect.FieldDescriptor
+	fd_MsgSubmitPreview_costs   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitPreview = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitPreview")
+	fd_MsgSubmitPreview_creator = md_MsgSubmitPreview.Fields().ByName("creator")
+	fd_MsgSubmitPreview_task_id = md_MsgSubmitPreview.Fields().ByName("task_id")
+	fd_MsgSubmitPreview_costs = md_MsgSubmitPreview.Fields().ByName("costs")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitPreview)(nil)
+
+type fastReflection_MsgSubmitPreview MsgSubmitPreview
+
+func (x *MsgSubmitPreview) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPreview)(x)
+}
+
+func (x *MsgSubmitPreview) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitPreview_messageType fastReflection_MsgSubmitPreview_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitPreview_messageType{}
+
+type fastReflection_MsgSubmitPreview_messageType struct{}
+
+func (x fastReflection_MsgSubmitPreview_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPreview)(nil)
+}
+func (x fastReflection_MsgSubmitPreview_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPreview)
+}
+func (x fastReflection_MsgSubmitPreview_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPreview
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitPreview) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPreview
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitPreview) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitPreview_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitPreview) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPreview)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitPreview) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitPreview)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitPreview) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitPreview_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitPreview_task_id, value) {
+			return
+		}
+	}
+	if len(x.Costs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitPreview_3_list{list: &x.Costs})
+		if !f(fd_MsgSubmitPreview_costs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitPreview) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		return x.Creator != ""
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		return x.TaskId != ""
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		return len(x.Costs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreview) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		x.Creator = ""
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		x.TaskId = ""
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		x.Costs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitPreview) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		if len(x.Costs) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitPreview_3_list{})
+		}
+		listValue := &_MsgSubmitPreview_3_list{list: &x.Costs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreview) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitPreview_3_list)
+		x.Costs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreview) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		if x.Costs == nil {
+			x.Costs = []string{}
+		}
+		value := &_MsgSubmitPreview_3_list{list: &x.Costs}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgSubmitPreview is not mutable"))
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		panic(fmt.Errorf("field task_id of message janction.videoRendering.v1.MsgSubmitPreview is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitPreview) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgSubmitPreview.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitPreview.task_id":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgSubmitPreview.costs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitPreview_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreview"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreview does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitPreview) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitPreview", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitPreview) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreview) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitPreview) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitPreview) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitPreview)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Costs) > 0 {
+			for _, s := range x.Costs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitPreview)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Costs) > 0 {
+			for iNdEx := len(x.Costs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Costs[iNdEx])
+				copy(dAtA[i:], x.Costs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Costs[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitPreview)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPreview: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Costs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Costs = append(x.Costs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitPreviewResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgSubmitPreviewResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgSubmitPreviewResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitPreviewResponse)(nil)
+
+type fastReflection_MsgSubmitPreviewResponse MsgSubmitPreviewResponse
+
+func (x *MsgSubmitPreviewResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPreviewResponse)(x)
+}
+
+func (x *MsgSubmitPreviewResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitPreviewResponse_messageType fastReflection_MsgSubmitPreviewResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitPreviewResponse_messageType{}
+
+type fastReflection_MsgSubmitPreviewResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitPreviewResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitPreviewResponse)(nil)
+}
+func (x fastReflection_MsgSubmitPreviewResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPreviewResponse)
+}
+func (x fastReflection_MsgSubmitPreviewResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPreviewResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitPreviewResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitPreviewResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitPreviewResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitPreviewResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitPreviewResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitPreviewResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitPreviewResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitPreviewResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitPreviewResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitPreviewResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreviewResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitPreviewResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreviewResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreviewResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitPreviewResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgSubmitPreviewResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgSubmitPreviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitPreviewResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgSubmitPreviewResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitPreviewResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitPreviewResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitPreviewResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitPreviewResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitPreviewResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitPreviewResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitPreviewResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPreviewResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterRendererImage           protoreflect.MessageDescriptor
+	fd_MsgRegisterRendererImage_authority protoreflect.FieldDescriptor
+	fd_MsgRegisterRendererImage_image     protoreflect.FieldDescriptor
+	fd_MsgRegisterRendererImage_revoke    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgRegisterRendererImage = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRegisterRendererImage")
+	fd_MsgRegisterRendererImage_authority = md_MsgRegisterRendererImage.Fields().ByName("authority")
+	fd_MsgRegisterRendererImage_image = md_MsgRegisterRendererImage.Fields().ByName("image")
+	fd_MsgRegisterRendererImage_revoke = md_MsgRegisterRendererImage.Fields().ByName("revoke")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterRendererImage)(nil)
+
+type fastReflection_MsgRegisterRendererImage MsgRegisterRendererImage
+
+func (x *MsgRegisterRendererImage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterRendererImage)(x)
+}
+
+func (x *MsgRegisterRendererImage) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterRendererImage_messageType fastReflection_MsgRegisterRendererImage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterRendererImage_messageType{}
+
+type fastReflection_MsgRegisterRendererImage_messageType struct{}
+
+func (x fastReflection_MsgRegisterRendererImage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterRendererImage)(nil)
+}
+func (x fastReflection_MsgRegisterRendererImage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterRendererImage)
+}
+func (x fastReflection_MsgRegisterRendererImage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterRendererImage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterRendererImage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterRendererImage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterRendererImage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterRendererImage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterRendererImage) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterRendererImage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterRendererImage) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterRendererImage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterRendererImage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRegisterRendererImage_authority, value) {
+			return
+		}
+	}
+	if x.Image != nil {
+		value := protoreflect.ValueOfMessage(x.Image.ProtoReflect())
+		if !f(fd_MsgRegisterRendererImage_image, value) {
+			return
+		}
+	}
+	if x.Revoke != false {
+		value := protoreflect.ValueOfBool(x.Revoke)
+		if !f(fd_MsgRegisterRendererImage_revoke, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterRendererImage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		return x.Authority != ""
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		return x.Image != nil
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		return x.Revoke != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		x.Authority = ""
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		x.Image = nil
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		x.Revoke = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterRendererImage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		value := x.Image
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		value := x.Revoke
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		x.Authority = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		x.Image = value.Message().Interface().(*RendererImage)
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		x.Revoke = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		if x.Image == nil {
+			x.Image = new(RendererImage)
+		}
+		return protoreflect.ValueOfMessage(x.Image.ProtoReflect())
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		panic(fmt.Errorf("field authority of message janction.videoRendering.v1.MsgRegisterRendererImage is not mutable"))
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		panic(fmt.Errorf("field revoke of message janction.videoRendering.v1.MsgRegisterRendererImage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterRendererImage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.authority":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.image":
+		m := new(RendererImage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoRendering.v1.MsgRegisterRendererImage.revoke":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImage"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterRendererImage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRegisterRendererImage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterRendererImage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterRendererImage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterRendererImage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterRendererImage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Image != nil {
+			l = options.Size(x.Image)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Revoke {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterRendererImage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Revoke {
+			i--
+			if x.Revoke {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Image != nil {
+			encoded, err := options.Marshal(x.Image)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterRendererImage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterRendererImage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterRendererImage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Image == nil {
+					x.Image = &RendererImage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Image); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revoke = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterRendererImageResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgRegisterRendererImageResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgRegisterRendererImageResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterRendererImageResponse)(nil)
+
+type fastReflection_MsgRegisterRendererImageResponse MsgRegisterRendererImageResponse
+
+func (x *MsgRegisterRendererImageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterRendererImageResponse)(x)
+}
+
+func (x *MsgRegisterRendererImageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterRendererImageResponse_messageType fastReflection_MsgRegisterRendererImageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterRendererImageResponse_messageType{}
+
+type fastReflection_MsgRegisterRendererImageResponse_messageType struct{}
+
+func (x fastReflection_MsgRegisterRendererImageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterRendererImageResponse)(nil)
+}
+func (x fastReflection_MsgRegisterRendererImageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterRendererImageResponse)
+}
+func (x fastReflection_MsgRegisterRendererImageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterRendererImageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterRendererImageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterRendererImageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterRendererImageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterRendererImageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterRendererImageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterRendererImageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgRegisterRendererImageResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgRegisterRendererImageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterRendererImageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgRegisterRendererImageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterRendererImageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterRendererImageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterRendererImageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterRendererImageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterRendererImageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterRendererImageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterRendererImageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterRendererImageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterRendererImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportTaskFailure           protoreflect.MessageDescriptor
+	fd_MsgReportTaskFailure_creator   protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_task_id   protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_thread_id protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_frame     protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_reason    protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_attempts  protoreflect.FieldDescriptor
+	fd_MsgReportTaskFailure_log_cid   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgReportTaskFailure = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgReportTaskFailure")
+	fd_MsgReportTaskFailure_creator = md_MsgReportTaskFailure.Fields().ByName("creator")
+	fd_MsgReportTaskFailure_task_id = md_MsgReportTaskFailure.Fields().ByName("task_id")
+	fd_MsgReportTaskFailure_thread_id = md_MsgReportTaskFailure.Fields().ByName("thread_id")
+	fd_MsgReportTaskFailure_frame = md_MsgReportTaskFailure.Fields().ByName("frame")
+	fd_MsgReportTaskFailure_reason = md_MsgReportTaskFailure.Fields().ByName("reason")
+	fd_MsgReportTaskFailure_attempts = md_MsgReportTaskFailure.Fields().ByName("attempts")
+	fd_MsgReportTaskFailure_log_cid = md_MsgReportTaskFailure.Fields().ByName("log_cid")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportTaskFailure)(nil)
+
+type fastReflection_MsgReportTaskFailure MsgReportTaskFailure
+
+func (x *MsgReportTaskFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportTaskFailure)(x)
+}
+
+func (x *MsgReportTaskFailure) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportTaskFailure_messageType fastReflection_MsgReportTaskFailure_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportTaskFailure_messageType{}
+
+type fastReflection_MsgReportTaskFailure_messageType struct{}
+
+func (x fastReflection_MsgReportTaskFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportTaskFailure)(nil)
+}
+func (x fastReflection_MsgReportTaskFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportTaskFailure)
+}
+func (x fastReflection_MsgReportTaskFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportTaskFailure
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportTaskFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportTaskFailure
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportTaskFailure) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportTaskFailure_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportTaskFailure) New() protoreflect.Message {
+	return new(fastReflection_MsgReportTaskFailure)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportTaskFailure) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportTaskFailure)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportTaskFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgReportTaskFailure_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgReportTaskFailure_task_id, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgReportTaskFailure_thread_id, value) {
+			return
+		}
+	}
+	if x.Frame != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Frame)
+		if !f(fd_MsgReportTaskFailure_frame, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MsgReportTaskFailure_reason, value) {
+			return
+		}
+	}
+	if x.Attempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempts)
+		if !f(fd_MsgReportTaskFailure_attempts, value) {
+			return
+		}
+	}
+	if x.LogCid != "" {
+		value := protoreflect.ValueOfString(x.LogCid)
+		if !f(fd_MsgReportTaskFailure_log_cid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportTaskFailure) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		return x.Creator != ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		return x.TaskId != ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		return x.ThreadId != ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		return x.Frame != int64(0)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		return x.Reason != 0
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		return x.Attempts != uint32(0)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		return x.LogCid != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailure) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		x.Creator = ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		x.TaskId = ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		x.ThreadId = ""
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		x.Frame = int64(0)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		x.Reason = 0
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		x.Attempts = uint32(0)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		x.LogCid = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportTaskFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		value := x.Frame
+		return protoreflect.ValueOfInt64(value)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint32(value)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		value := x.LogCid
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		x.Frame = value.Int()
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		x.Reason = (VideoRenderingThread_UnrenderableFrame_Reason)(value.Enum())
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		x.Attempts = uint32(value.Uint())
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		x.LogCid = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		panic(fmt.Errorf("field creator of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		panic(fmt.Errorf("field task_id of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		panic(fmt.Errorf("field thread_id of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		panic(fmt.Errorf("field frame of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		panic(fmt.Errorf("field reason of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		panic(fmt.Errorf("field attempts of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		panic(fmt.Errorf("field log_cid of message janction.videoRendering.v1.MsgReportTaskFailure is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportTaskFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoRendering.v1.MsgReportTaskFailure.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgReportTaskFailure.task_id":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgReportTaskFailure.thread_id":
+		return protoreflect.ValueOfString("")
+	case "janction.videoRendering.v1.MsgReportTaskFailure.frame":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "janction.videoRendering.v1.MsgReportTaskFailure.attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "janction.videoRendering.v1.MsgReportTaskFailure.log_cid":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportTaskFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgReportTaskFailure", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportTaskFailure) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailure) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportTaskFailure) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportTaskFailure) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportTaskFailure)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Frame != 0 {
+			n += 1 + runtime.Sov(uint64(x.Frame))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		l = len(x.LogCid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportTaskFailure)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LogCid) > 0 {
+			i -= len(x.LogCid)
+			copy(dAtA[i:], x.LogCid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LogCid)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Frame != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Frame))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportTaskFailure)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportTaskFailure: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportTaskFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+				}
+				x.Frame = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Frame |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= VideoRenderingThread_UnrenderableFrame_Reason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LogCid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LogCid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportTaskFailureResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoRendering_v1_tx_proto_init()
+	md_MsgReportTaskFailureResponse = File_janction_videoRendering_v1_tx_proto.Messages().ByName("MsgReportTaskFailureResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportTaskFailureResponse)(nil)
+
+type fastReflection_MsgReportTaskFailureResponse MsgReportTaskFailureResponse
+
+func (x *MsgReportTaskFailureResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportTaskFailureResponse)(x)
+}
+
+func (x *MsgReportTaskFailureResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportTaskFailureResponse_messageType fastReflection_MsgReportTaskFailureResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportTaskFailureResponse_messageType{}
+
+type fastReflection_MsgReportTaskFailureResponse_messageType struct{}
+
+func (x fastReflection_MsgReportTaskFailureResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportTaskFailureResponse)(nil)
+}
+func (x fastReflection_MsgReportTaskFailureResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportTaskFailureResponse)
+}
+func (x fastReflection_MsgReportTaskFailureResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportTaskFailureResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportTaskFailureResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportTaskFailureResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportTaskFailureResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportTaskFailureResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportTaskFailureResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReportTaskFailureResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportTaskFailureResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportTaskFailureResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportTaskFailureResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportTaskFailureResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailureResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportTaskFailureResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailureResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailureResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportTaskFailureResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoRendering.v1.MsgReportTaskFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoRendering.v1.MsgReportTaskFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportTaskFailureResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoRendering.v1.MsgReportTaskFailureResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportTaskFailureResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportTaskFailureResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportTaskFailureResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportTaskFailureResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportTaskFailureResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportTaskFailureResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportTaskFailureResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportTaskFailureResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportTaskFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: janction/videoRendering/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateGame defines the Msg/CreateGame request type.
+type MsgCreateVideoRenderingTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the message sender.
+	Creator    string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Cid        string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	StartFrame int32         `protobuf:"varint,3,opt,name=startFrame,proto3" json:"startFrame,omitempty"`
+	EndFrame   int32         `protobuf:"varint,4,opt,name=endFrame,proto3" json:"endFrame,omitempty"`
+	Threads    int32         `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	Reward     *v1beta1.Coin `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	// optional tolerance for renderers that aren't deterministic. Frames must be identical when empty
+	Comparison *FrameComparison `protobuf:"bytes,7,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// optional render settings overriding the ones of the .blend file
+	RenderSettings *RenderSettings `protobuf:"bytes,8,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// frames per second of the assembled video. 24 when empty
+	FrameRate uint32 `protobuf:"varint,9,opt,name=frame_rate,json=frameRate,proto3" json:"frame_rate,omitempty"`
+	// optional grid splitting every frame into tiles rendered by different threads
+	TileGrid *TileGrid `protobuf:"bytes,10,opt,name=tile_grid,json=tileGrid,proto3" json:"tile_grid,omitempty"`
+	// optional preview pass splitting the frames into threads of similar render time
+	Split *ThreadSplit `protobuf:"bytes,11,opt,name=split,proto3" json:"split,omitempty"`
+	// optional blender version the scene needs. A version like 4.2 is rendered by any approved 4.2.x image
+	BlenderVersion string `protobuf:"bytes,12,opt,name=blender_version,json=blenderVersion,proto3" json:"blender_version,omitempty"`
+}
+
+func (x *MsgCreateVideoRenderingTask) Reset() {
+	*x = MsgCreateVideoRenderingTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateVideoRenderingTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateVideoRenderingTask) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateVideoRenderingTask.ProtoReflect.Descriptor instead.
+func (*MsgCreateVideoRenderingTask) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreateVideoRenderingTask) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateVideoRenderingTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *MsgCreateVideoRenderingTask) GetStartFrame() int32 {
+	if x != nil {
+		return x.StartFrame
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoRenderingTask) GetEndFrame() int32 {
+	if x != nil {
+		return x.EndFrame
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoRenderingTask) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoRenderingTask) GetReward() *v1beta1.Coin {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoRenderingTask) GetComparison() *FrameComparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoRenderingTask) GetRenderSettings() *RenderSettings {
+	if x != nil {
+		return x.RenderSettings
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoRenderingTask) GetFrameRate() uint32 {
+	if x != nil {
+		return x.FrameRate
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoRenderingTask) GetTileGrid() *TileGrid {
+	if x != nil {
+		return x.TileGrid
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoRenderingTask) GetSplit() *ThreadSplit {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoRenderingTask) GetBlenderVersion() string {
+	if x != nil {
+		return x.BlenderVersion
+	}
+	return ""
+}
+
+// MsgCreateGameResponse defines the Msg/CreateGame response type.
+type MsgCreateVideoRenderingTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *MsgCreateVideoRenderingTaskResponse) Reset() {
+	*x = MsgCreateVideoRenderingTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateVideoRenderingTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateVideoRenderingTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateVideoRenderingTaskResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateVideoRenderingTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgCreateVideoRenderingTaskResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type MsgAddWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	// GOARCH of the worker
+	Architecture string `protobuf:"bytes,5,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// blender versions the worker renders. Empty renders every version with an approved image for its architecture
+	BlenderVersions []string `protobuf:"bytes,6,rep,name=blender_versions,json=blenderVersions,proto3" json:"blender_versions,omitempty"`
+}
+
+func (x *MsgAddWorker) Reset() {
+	*x = MsgAddWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddWorker) ProtoMessage() {}
+
+// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
+func (*MsgAddWorker) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgAddWorker) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetIpfsId() string {
+	if x != nil {
+		return x.IpfsId
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *MsgAddWorker) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetBlenderVersions() []string {
+	if x != nil {
+		return x.BlenderVersions
+	}
+	return nil
+}
+
+type MsgAddWorkerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgAddWorkerResponse) Reset() {
+	*x = MsgAddWorkerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddWorkerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgAddWorkerResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *MsgAddWorkerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MsgSubscribeWorkerToTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+}
+
+func (x *MsgSubscribeWorkerToTask) Reset() {
+	*x = MsgSubscribeWorkerToTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubscribeWorkerToTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubscribeWorkerToTask) ProtoMessage() {}
+
+// Deprecated: Use MsgSubscribeWorkerToTask.ProtoReflect.Descriptor instead.
+func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgSubscribeWorkerToTask) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MsgSubscribeWorkerToTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgSubscribeWorkerToTask) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type MsgSubscribeWorkerToTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) Reset() {
+	*x = MsgSubscribeWorkerToTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubscribeWorkerToTaskResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+// Msg to Propose a solution to an specific thread
+// Actual solution is a map of hashes
+type MsgProposeSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId  string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// hex encoded merkle root of the MiMC commitments of all frames, ordered by frame number
+	MerkleRoot string `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// optional hex encoded groth16 proof of knowledge of the hashes and salt committed in the merkle root by the creator
+	ZkProof string `protobuf:"bytes,7,opt,name=zk_proof,json=zkProof,proto3" json:"zk_proof,omitempty"`
+	// version of the circuit zk_proof was generated with
+	ZkCircuitVersion string `protobuf:"bytes,9,opt,name=zk_circuit_version,json=zkCircuitVersion,proto3" json:"zk_circuit_version,omitempty"`
+	// version of the pixel hashes committed in the merkle root. First version when empty
+	HashVersion string `protobuf:"bytes,10,opt,name=hash_version,json=hashVersion,proto3" json:"hash_version,omitempty"`
+	// hex encoded fingerprint of the render settings enforced while rendering
+	RenderSettings string `protobuf:"bytes,11,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// digest of the approved renderer image the frames were rendered with
+	RendererDigest string `protobuf:"bytes,12,opt,name=renderer_digest,json=rendererDigest,proto3" json:"renderer_digest,omitempty"`
+	// average seconds the creator took to render a frame, which sizes the commit window of the validators
+	AverageRenderSeconds int64 `protobuf:"varint,13,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+}
+
+func (x *MsgProposeSolution) Reset() {
+	*x = MsgProposeSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProposeSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProposeSolution) ProtoMessage() {}
+
+// Deprecated: Use MsgProposeSolution.ProtoReflect.Descriptor instead.
+func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgProposeSolution) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetZkProof() string {
+	if x != nil {
+		return x.ZkProof
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetZkCircuitVersion() string {
+	if x != nil {
+		return x.ZkCircuitVersion
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetHashVersion() string {
+	if x != nil {
+		return x.HashVersion
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetRenderSettings() string {
+	if x != nil {
+		return x.RenderSettings
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetRendererDigest() string {
+	if x != nil {
+		return x.RendererDigest
+	}
+	return ""
+}
+
+func (x *MsgProposeSolution) GetAverageRenderSeconds() int64 {
+	if x != nil {
+		return x.AverageRenderSeconds
+	}
+	return 0
+}
+
+// no response needed to a proposed solution
+type MsgProposeSolutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgProposeSolutionResponse) Reset() {
+	*x = MsgProposeSolutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProposeSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProposeSolutionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgProposeSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// Msg to reveal the solution of an specific thread
+// Only sampled frames are revealed, as filename=cid:hash:proof with the merkle proof of the commitment
+type MsgRevealSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Frames   []string `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+	// hex encoded salt used in the commitments of the merkle root
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *MsgRevealSolution) Reset() {
+	*x = MsgRevealSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealSolution) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealSolution.ProtoReflect.Descriptor instead.
+func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRevealSolution) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgRevealSolution) GetFrames() []string {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *MsgRevealSolution) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+// no response needed to a proposed solution
+type MsgRevealSolutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRevealSolutionResponse) Reset() {
+	*x = MsgRevealSolutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealSolutionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// Commits a validator to the hashes of the sampled frames, as filename=commitment
+// where commitment is the hex encoded H(hash || salt || address)
+type MsgSubmitValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId      string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId    string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey   string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Commitments []string `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (x *MsgSubmitValidation) Reset() {
+	*x = MsgSubmitValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitValidation) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitValidation.ProtoReflect.Descriptor instead.
+func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgSubmitValidation) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgSubmitValidation) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgSubmitValidation) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgSubmitValidation) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *MsgSubmitValidation) GetCommitments() []string {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+type MsgSubmitValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitValidationResponse) Reset() {
+	*x = MsgSubmitValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*MsgSubmitValidationResponse) ProtoMessage() {}
 
-// MsgCreateGame defines the Msg/CreateGame request type.
-type MsgCreateVideoRenderingTask struct {
+// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// Reveals the committed hashes of a validation once the commit deadline is over,
+// as filename=hash:signature with the signature of the frame sign doc
+type MsgRevealValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// creator is the message sender.
-	Creator    string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Cid        string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	StartFrame int32         `protobuf:"varint,3,opt,name=startFrame,proto3" json:"startFrame,omitempty"`
-	EndFrame   int32         `protobuf:"varint,4,opt,name=endFrame,proto3" json:"endFrame,omitempty"`
-	Threads    int32         `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
-	Reward     *v1beta1.Coin `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Salt     string   `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	Frames   []string `protobuf:"bytes,5,rep,name=frames,proto3" json:"frames,omitempty"`
 }
 
-func (x *MsgCreateVideoRenderingTask) Reset() {
-	*x = MsgCreateVideoRenderingTask{}
+func (x *MsgRevealValidation) Reset() {
+	*x = MsgRevealValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[0]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateVideoRenderingTask) String() string {
+func (x *MsgRevealValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateVideoRenderingTask) ProtoMessage() {}
+func (*MsgRevealValidation) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateVideoRenderingTask.ProtoReflect.Descriptor instead.
-func (*MsgCreateVideoRenderingTask) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgRevealValidation.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgCreateVideoRenderingTask) GetCreator() string {
+func (x *MsgRevealValidation) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreateVideoRenderingTask) GetCid() string {
+func (x *MsgRevealValidation) GetTaskId() string {
 	if x != nil {
-		return x.Cid
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgCreateVideoRenderingTask) GetStartFrame() int32 {
-	if x != nil {
-		return x.StartFrame
-	}
-	return 0
-}
-
-func (x *MsgCreateVideoRenderingTask) GetEndFrame() int32 {
+func (x *MsgRevealValidation) GetThreadId() string {
 	if x != nil {
-		return x.EndFrame
+		return x.ThreadId
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgCreateVideoRenderingTask) GetThreads() int32 {
+func (x *MsgRevealValidation) GetSalt() string {
 	if x != nil {
-		return x.Threads
+		return x.Salt
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgCreateVideoRenderingTask) GetReward() *v1beta1.Coin {
+func (x *MsgRevealValidation) GetFrames() []string {
 	if x != nil {
-		return x.Reward
+		return x.Frames
 	}
 	return nil
 }
 
-// MsgCreateGameResponse defines the Msg/CreateGame response type.
-type MsgCreateVideoRenderingTaskResponse struct {
+type MsgRevealValidationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (x *MsgCreateVideoRenderingTaskResponse) Reset() {
-	*x = MsgCreateVideoRenderingTaskResponse{}
+func (x *MsgRevealValidationResponse) Reset() {
+	*x = MsgRevealValidationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[1]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateVideoRenderingTaskResponse) String() string {
+func (x *MsgRevealValidationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateVideoRenderingTaskResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateVideoRenderingTaskResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateVideoRenderingTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{1}
-}
+func (*MsgRevealValidationResponse) ProtoMessage() {}
 
-func (x *MsgCreateVideoRenderingTaskResponse) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use MsgRevealValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-type MsgAddWorker struct {
+type MsgSubmitSolution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
-	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
-	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
 }
 
-func (x *MsgAddWorker) Reset() {
-	*x = MsgAddWorker{}
+func (x *MsgSubmitSolution) Reset() {
+	*x = MsgSubmitSolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[2]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorker) String() string {
+func (x *MsgSubmitSolution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorker) ProtoMessage() {}
+func (*MsgSubmitSolution) ProtoMessage() {}
 
-// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
-func (*MsgAddWorker) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgAddWorker) GetCreator() string {
+func (x *MsgSubmitSolution) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetPublicIp() string {
+func (x *MsgSubmitSolution) GetTaskId() string {
 	if x != nil {
-		return x.PublicIp
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetIpfsId() string {
+func (x *MsgSubmitSolution) GetThreadId() string {
 	if x != nil {
-		return x.IpfsId
+		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+func (x *MsgSubmitSolution) GetDir() string {
 	if x != nil {
-		return x.Stake
+		return x.Dir
 	}
-	return nil
+	return ""
 }
 
-type MsgAddWorkerResponse struct {
+func (x *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
+	if x != nil {
+		return x.AverageRenderSeconds
+	}
+	return 0
+}
+
+type MsgSubmitSolutionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MsgAddWorkerResponse) Reset() {
-	*x = MsgAddWorkerResponse{}
+func (x *MsgSubmitSolutionResponse) Reset() {
+	*x = MsgSubmitSolutionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[3]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorkerResponse) String() string {
+func (x *MsgSubmitSolutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorkerResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *MsgAddWorkerResponse) GetOk() bool {
-	if x != nil {
-		return x.Ok
-	}
-	return false
-}
+func (*MsgSubmitSolutionResponse) ProtoMessage() {}
 
-func (x *MsgAddWorkerResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-type MsgSubscribeWorkerToTask struct {
+// MsgRegisterVerifyingKey registers the verifying key every node uses to verify
+// proofs of a frame proof circuit version
+type MsgRegisterVerifyingKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CircuitVersion string `protobuf:"bytes,2,opt,name=circuit_version,json=circuitVersion,proto3" json:"circuit_version,omitempty"`
+	VerifyingKey   []byte `protobuf:"bytes,3,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
 }
 
-func (x *MsgSubscribeWorkerToTask) Reset() {
-	*x = MsgSubscribeWorkerToTask{}
+func (x *MsgRegisterVerifyingKey) Reset() {
+	*x = MsgRegisterVerifyingKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[4]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubscribeWorkerToTask) String() string {
+func (x *MsgRegisterVerifyingKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubscribeWorkerToTask) ProtoMessage() {}
+func (*MsgRegisterVerifyingKey) ProtoMessage() {}
 
-// Deprecated: Use MsgSubscribeWorkerToTask.ProtoReflect.Descriptor instead.
-func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgRegisterVerifyingKey.ProtoReflect.Descriptor instead.
+func (*MsgRegisterVerifyingKey) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgSubscribeWorkerToTask) GetAddress() string {
+func (x *MsgRegisterVerifyingKey) GetAuthority() string {
 	if x != nil {
-		return x.Address
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgSubscribeWorkerToTask) GetTaskId() string {
+func (x *MsgRegisterVerifyingKey) GetCircuitVersion() string {
 	if x != nil {
-		return x.TaskId
+		return x.CircuitVersion
 	}
 	return ""
 }
 
-func (x *MsgSubscribeWorkerToTask) GetThreadId() string {
+func (x *MsgRegisterVerifyingKey) GetVerifyingKey() []byte {
 	if x != nil {
-		return x.ThreadId
+		return x.VerifyingKey
 	}
-	return ""
+	return nil
 }
 
-type MsgSubscribeWorkerToTaskResponse struct {
+type MsgRegisterVerifyingKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) Reset() {
-	*x = MsgSubscribeWorkerToTaskResponse{}
+func (x *MsgRegisterVerifyingKeyResponse) Reset() {
+	*x = MsgRegisterVerifyingKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[5]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) String() string {
+func (x *MsgRegisterVerifyingKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgSubscribeWorkerToTaskResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{5}
-}
+func (*MsgRegisterVerifyingKeyResponse) ProtoMessage() {}
 
-func (x *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
-	if x != nil {
-		return x.ThreadId
-	}
-	return ""
+// Deprecated: Use MsgRegisterVerifyingKeyResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterVerifyingKeyResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgProposeSolution struct {
+// MsgSubmitAssembledVideo submits the video the assembler encoded from the frames of every thread.
+// Other workers of the task submit the CID and hash of their own encode to confirm it.
+type MsgSubmitAssembledVideo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VideoCid string `protobuf:"bytes,3,opt,name=video_cid,json=videoCid,proto3" json:"video_cid,omitempty"`
+	// hex encoded sha256 of the video file
+	VideoHash string `protobuf:"bytes,4,opt,name=video_hash,json=videoHash,proto3" json:"video_hash,omitempty"`
 }
 
-func (x *MsgProposeSolution) Reset() {
-	*x = MsgProposeSolution{}
+func (x *MsgSubmitAssembledVideo) Reset() {
+	*x = MsgSubmitAssembledVideo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[6]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgProposeSolution) String() string {
+func (x *MsgSubmitAssembledVideo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgProposeSolution) ProtoMessage() {}
+func (*MsgSubmitAssembledVideo) ProtoMessage() {}
 
-// Deprecated: Use MsgProposeSolution.ProtoReflect.Descriptor instead.
-func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgSubmitAssembledVideo.ProtoReflect.Descriptor instead.
+func (*MsgSubmitAssembledVideo) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgProposeSolution) GetCreator() string {
+func (x *MsgSubmitAssembledVideo) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetTaskId() string {
+func (x *MsgSubmitAssembledVideo) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetThreadId() string {
+func (x *MsgSubmitAssembledVideo) GetVideoCid() string {
 	if x != nil {
-		return x.ThreadId
+		return x.VideoCid
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetPublicKey() string {
+func (x *MsgSubmitAssembledVideo) GetVideoHash() string {
 	if x != nil {
-		return x.PublicKey
+		return x.VideoHash
 	}
 	return ""
 }
 
-func (x *MsgProposeSolution) GetSignatures() []string {
-	if x != nil {
-		return x.Signatures
-	}
-	return nil
-}
-
-// no response needed to a proposed solution
-type MsgProposeSolutionResponse struct {
+type MsgSubmitAssembledVideoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgProposeSolutionResponse) Reset() {
-	*x = MsgProposeSolutionResponse{}
+func (x *MsgSubmitAssembledVideoResponse) Reset() {
+	*x = MsgSubmitAssembledVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[7]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgProposeSolutionResponse) String() string {
+func (x *MsgSubmitAssembledVideoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgProposeSolutionResponse) ProtoMessage() {}
+func (*MsgSubmitAssembledVideoResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgProposeSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgSubmitAssembledVideoResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitAssembledVideoResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgRevealSolution struct {
+type MsgSubmitPreview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Frames   []string `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// render seconds of every previewed frame, as frame=seconds
+	Costs []string `protobuf:"bytes,3,rep,name=costs,proto3" json:"costs,omitempty"`
 }
 
-func (x *MsgRevealSolution) Reset() {
-	*x = MsgRevealSolution{}
+func (x *MsgSubmitPreview) Reset() {
+	*x = MsgSubmitPreview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[8]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealSolution) String() string {
+func (x *MsgSubmitPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevealSolution) ProtoMessage() {}
+func (*MsgSubmitPreview) ProtoMessage() {}
 
-// Deprecated: Use MsgRevealSolution.ProtoReflect.Descriptor instead.
-func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgSubmitPreview.ProtoReflect.Descriptor instead.
+func (*MsgSubmitPreview) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgRevealSolution) GetCreator() string {
+func (x *MsgSubmitPreview) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRevealSolution) GetTaskId() string {
+func (x *MsgSubmitPreview) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgRevealSolution) GetThreadId() string {
-	if x != nil {
-		return x.ThreadId
-	}
-	return ""
-}
-
-func (x *MsgRevealSolution) GetFrames() []string {
+func (x *MsgSubmitPreview) GetCosts() []string {
 	if x != nil {
-		return x.Frames
+		return x.Costs
 	}
 	return nil
 }
 
-// no response needed to a proposed solution
-type MsgRevealSolutionResponse struct {
+type MsgSubmitPreviewResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRevealSolutionResponse) Reset() {
-	*x = MsgRevealSolutionResponse{}
+func (x *MsgSubmitPreviewResponse) Reset() {
+	*x = MsgSubmitPreviewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[9]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevealSolutionResponse) String() string {
+func (x *MsgSubmitPreviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevealSolutionResponse) ProtoMessage() {}
+func (*MsgSubmitPreviewResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevealSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgSubmitPreviewResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-type MsgSubmitValidation struct {
+// MsgRegisterRendererImage approves the renderer image workers of its architecture render with, or revokes it
+type MsgRegisterRendererImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string         `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Image     *RendererImage `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// removes the image with the digest instead of approving it
+	Revoke bool `protobuf:"varint,3,opt,name=revoke,proto3" json:"revoke,omitempty"`
 }
 
-func (x *MsgSubmitValidation) Reset() {
-	*x = MsgSubmitValidation{}
+func (x *MsgRegisterRendererImage) Reset() {
+	*x = MsgRegisterRendererImage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[10]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitValidation) String() string {
+func (x *MsgRegisterRendererImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitValidation) ProtoMessage() {}
-
-// Deprecated: Use MsgSubmitValidation.ProtoReflect.Descriptor instead.
-func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *MsgSubmitValidation) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
+func (*MsgRegisterRendererImage) ProtoMessage() {}
 
-func (x *MsgSubmitValidation) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use MsgRegisterRendererImage.ProtoReflect.Descriptor instead.
+func (*MsgRegisterRendererImage) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgSubmitValidation) GetThreadId() string {
+func (x *MsgRegisterRendererImage) GetAuthority() string {
 	if x != nil {
-		return x.ThreadId
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgSubmitValidation) GetPublicKey() string {
+func (x *MsgRegisterRendererImage) GetImage() *RendererImage {
 	if x != nil {
-		return x.PublicKey
+		return x.Image
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgSubmitValidation) GetSignatures() []string {
+func (x *MsgRegisterRendererImage) GetRevoke() bool {
 	if x != nil {
-		return x.Signatures
+		return x.Revoke
 	}
-	return nil
+	return false
 }
 
-type MsgSubmitValidationResponse struct {
+type MsgRegisterRendererImageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitValidationResponse) Reset() {
-	*x = MsgSubmitValidationResponse{}
+func (x *MsgRegisterRendererImageResponse) Reset() {
+	*x = MsgRegisterRendererImageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[11]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitValidationResponse) String() string {
+func (x *MsgRegisterRendererImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitValidationResponse) ProtoMessage() {}
+func (*MsgRegisterRendererImageResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgRegisterRendererImageResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterRendererImageResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-type MsgSubmitSolution struct {
+// MsgReportTaskFailure reports a frame the worker couldn't render within the attempts of its render policy.
+// The task fails once enough workers report the same reason
+type MsgReportTaskFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+	Creator  string                                        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string                                        `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ThreadId string                                        `protobuf:"bytes,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Frame    int64                                         `protobuf:"varint,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Reason   VideoRenderingThread_UnrenderableFrame_Reason `protobuf:"varint,5,opt,name=reason,proto3,enum=janction.videoRendering.v1.VideoRenderingThread_UnrenderableFrame_Reason" json:"reason,omitempty"`
+	// renders of the frame the worker tried
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// cid of the blender log of the last attempt, so the requester can fix the scene
+	LogCid string `protobuf:"bytes,7,opt,name=log_cid,json=logCid,proto3" json:"log_cid,omitempty"`
 }
 
-func (x *MsgSubmitSolution) Reset() {
-	*x = MsgSubmitSolution{}
+func (x *MsgReportTaskFailure) Reset() {
+	*x = MsgReportTaskFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[12]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolution) String() string {
+func (x *MsgReportTaskFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolution) ProtoMessage() {}
+func (*MsgReportTaskFailure) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgReportTaskFailure.ProtoReflect.Descriptor instead.
+func (*MsgReportTaskFailure) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgSubmitSolution) GetCreator() string {
+func (x *MsgReportTaskFailure) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetTaskId() string {
+func (x *MsgReportTaskFailure) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetThreadId() string {
+func (x *MsgReportTaskFailure) GetThreadId() string {
 	if x != nil {
 		return x.ThreadId
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetDir() string {
+func (x *MsgReportTaskFailure) GetFrame() int64 {
 	if x != nil {
-		return x.Dir
+		return x.Frame
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
+func (x *MsgReportTaskFailure) GetReason() VideoRenderingThread_UnrenderableFrame_Reason {
 	if x != nil {
-		return x.AverageRenderSeconds
+		return x.Reason
+	}
+	return VideoRenderingThread_UnrenderableFrame_TIMEOUT
+}
+
+func (x *MsgReportTaskFailure) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
 	}
 	return 0
 }
 
-type MsgSubmitSolutionResponse struct {
+func (x *MsgReportTaskFailure) GetLogCid() string {
+	if x != nil {
+		return x.LogCid
+	}
+	return ""
+}
+
+type MsgReportTaskFailureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitSolutionResponse) Reset() {
-	*x = MsgSubmitSolutionResponse{}
+func (x *MsgReportTaskFailureResponse) Reset() {
+	*x = MsgReportTaskFailureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[13]
+		mi := &file_janction_videoRendering_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolutionResponse) String() string {
+func (x *MsgReportTaskFailureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolutionResponse) ProtoMessage() {}
+func (*MsgReportTaskFailureResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgReportTaskFailureResponse.ProtoReflect.Descriptor instead.
+func (*MsgReportTaskFailureResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoRendering_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
 var File_janction_videoRendering_v1_tx_proto protoreflect.FileDescriptor
@@ -8143,7 +15754,7 @@ var file_janction_videoRendering_v1_tx_proto_rawDesc = []byte{
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
 	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe0, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x22, 0xcc, 0x04, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
 	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73,
 	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63,
@@ -8156,161 +15767,339 @@ var file_janction_videoRendering_v1_tx_proto_rawDesc = []byte{
 	0x61, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
-	0x70, 0x66, 0x73, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x0c, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x14, 0x4d, 0x73,
-	0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02,
-	0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x18,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65,
-	0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b,
-	0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x13,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1d,
-	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01,
-	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x69, 0x6c, 0x65, 0x5f,
+	0x67, 0x72, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x47, 0x72, 0x69, 0x64,
+	0x52, 0x08, 0x74, 0x69, 0x6c, 0x65, 0x47, 0x72, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x3e, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x66, 0x73, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x62, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
 	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
 	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
 	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x69, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x83, 0x07, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x94, 0x01, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x3e, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22,
+	0xcb, 0x03, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x6b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x2c, 0x0a, 0x12, 0x7a, 0x6b, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x7a, 0x6b, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x08,
+	0x10, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0d,
+	0x7a, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x0a,
+	0x1a, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x11,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x05, 0x10,
+	0x06, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x1d, 0x0a,
+	0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1d, 0x0a, 0x1b,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x72, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f,
+	0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x43, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x48, 0x61,
+	0x73, 0x68, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73,
+	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1a,
+	0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x18, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x45, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x0e,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x22,
+	0x0a, 0x20, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x12, 0x61, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x49, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x43, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9b, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x94, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x3f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a, 0x30, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8b, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61,
-	0x73, 0x6b, 0x1a, 0x3f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x12, 0x28, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x1a,
+	0x3c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54,
+	0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
+	0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
 	0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a, 0x30, 0x2e, 0x6a, 0x61, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a,
-	0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x3c, 0x2e, 0x6a,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x36, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6a,
 	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e,
 	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x0f, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e,
+	0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c,
+	0x0a, 0x10, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x2e,
 	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x36, 0x2e,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x1a, 0x3b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x88, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x33, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x1a, 0x3b, 0x2e,
 	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x64, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0d, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2c, 0x2e, 0x6a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
 	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37, 0x2e, 0x6a, 0x61, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8b, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a,
+	0x3c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
+	0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x12, 0x30, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x1a, 0x38, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x87, 0x02, 0x0a, 0x1e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a,
-	0x56, 0x58, 0xaa, 0x02, 0x1a, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x1a, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x4a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x3a, 0x3a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
+	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x87, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x56, 0x58, 0xaa, 0x02, 0x1a,
+	0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x4a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x1c, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8325,46 +16114,82 @@ func file_janction_videoRendering_v1_tx_proto_rawDescGZIP() []byte {
 	return file_janction_videoRendering_v1_tx_proto_rawDescData
 }
 
-var file_janction_videoRendering_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_janction_videoRendering_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_janction_videoRendering_v1_tx_proto_goTypes = []interface{}{
-	(*MsgCreateVideoRenderingTask)(nil),         // 0: janction.videoRendering.v1.MsgCreateVideoRenderingTask
-	(*MsgCreateVideoRenderingTaskResponse)(nil), // 1: janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse
-	(*MsgAddWorker)(nil),                        // 2: janction.videoRendering.v1.MsgAddWorker
-	(*MsgAddWorkerResponse)(nil),                // 3: janction.videoRendering.v1.MsgAddWorkerResponse
-	(*MsgSubscribeWorkerToTask)(nil),            // 4: janction.videoRendering.v1.MsgSubscribeWorkerToTask
-	(*MsgSubscribeWorkerToTaskResponse)(nil),    // 5: janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse
-	(*MsgProposeSolution)(nil),                  // 6: janction.videoRendering.v1.MsgProposeSolution
-	(*MsgProposeSolutionResponse)(nil),          // 7: janction.videoRendering.v1.MsgProposeSolutionResponse
-	(*MsgRevealSolution)(nil),                   // 8: janction.videoRendering.v1.MsgRevealSolution
-	(*MsgRevealSolutionResponse)(nil),           // 9: janction.videoRendering.v1.MsgRevealSolutionResponse
-	(*MsgSubmitValidation)(nil),                 // 10: janction.videoRendering.v1.MsgSubmitValidation
-	(*MsgSubmitValidationResponse)(nil),         // 11: janction.videoRendering.v1.MsgSubmitValidationResponse
-	(*MsgSubmitSolution)(nil),                   // 12: janction.videoRendering.v1.MsgSubmitSolution
-	(*MsgSubmitSolutionResponse)(nil),           // 13: janction.videoRendering.v1.MsgSubmitSolutionResponse
-	(*v1beta1.Coin)(nil),                        // 14: cosmos.base.v1beta1.Coin
+	(*MsgCreateVideoRenderingTask)(nil),                // 0: janction.videoRendering.v1.MsgCreateVideoRenderingTask
+	(*MsgCreateVideoRenderingTaskResponse)(nil),        // 1: janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse
+	(*MsgAddWorker)(nil),                               // 2: janction.videoRendering.v1.MsgAddWorker
+	(*MsgAddWorkerResponse)(nil),                       // 3: janction.videoRendering.v1.MsgAddWorkerResponse
+	(*MsgSubscribeWorkerToTask)(nil),                   // 4: janction.videoRendering.v1.MsgSubscribeWorkerToTask
+	(*MsgSubscribeWorkerToTaskResponse)(nil),           // 5: janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse
+	(*MsgProposeSolution)(nil),                         // 6: janction.videoRendering.v1.MsgProposeSolution
+	(*MsgProposeSolutionResponse)(nil),                 // 7: janction.videoRendering.v1.MsgProposeSolutionResponse
+	(*MsgRevealSolution)(nil),                          // 8: janction.videoRendering.v1.MsgRevealSolution
+	(*MsgRevealSolutionResponse)(nil),                  // 9: janction.videoRendering.v1.MsgRevealSolutionResponse
+	(*MsgSubmitValidation)(nil),                        // 10: janction.videoRendering.v1.MsgSubmitValidation
+	(*MsgSubmitValidationResponse)(nil),                // 11: janction.videoRendering.v1.MsgSubmitValidationResponse
+	(*MsgRevealValidation)(nil),                        // 12: janction.videoRendering.v1.MsgRevealValidation
+	(*MsgRevealValidationResponse)(nil),                // 13: janction.videoRendering.v1.MsgRevealValidationResponse
+	(*MsgSubmitSolution)(nil),                          // 14: janction.videoRendering.v1.MsgSubmitSolution
+	(*MsgSubmitSolutionResponse)(nil),                  // 15: janction.videoRendering.v1.MsgSubmitSolutionResponse
+	(*MsgRegisterVerifyingKey)(nil),                    // 16: janction.videoRendering.v1.MsgRegisterVerifyingKey
+	(*MsgRegisterVerifyingKeyResponse)(nil),            // 17: janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse
+	(*MsgSubmitAssembledVideo)(nil),                    // 18: janction.videoRendering.v1.MsgSubmitAssembledVideo
+	(*MsgSubmitAssembledVideoResponse)(nil),            // 19: janction.videoRendering.v1.MsgSubmitAssembledVideoResponse
+	(*MsgSubmitPreview)(nil),                           // 20: janction.videoRendering.v1.MsgSubmitPreview
+	(*MsgSubmitPreviewResponse)(nil),                   // 21: janction.videoRendering.v1.MsgSubmitPreviewResponse
+	(*MsgRegisterRendererImage)(nil),                   // 22: janction.videoRendering.v1.MsgRegisterRendererImage
+	(*MsgRegisterRendererImageResponse)(nil),           // 23: janction.videoRendering.v1.MsgRegisterRendererImageResponse
+	(*MsgReportTaskFailure)(nil),                       // 24: janction.videoRendering.v1.MsgReportTaskFailure
+	(*MsgReportTaskFailureResponse)(nil),               // 25: janction.videoRendering.v1.MsgReportTaskFailureResponse
+	(*v1beta1.Coin)(nil),                               // 26: cosmos.base.v1beta1.Coin
+	(*FrameComparison)(nil),                            // 27: janction.videoRendering.v1.FrameComparison
+	(*RenderSettings)(nil),                             // 28: janction.videoRendering.v1.RenderSettings
+	(*TileGrid)(nil),                                   // 29: janction.videoRendering.v1.TileGrid
+	(*ThreadSplit)(nil),                                // 30: janction.videoRendering.v1.ThreadSplit
+	(*RendererImage)(nil),                              // 31: janction.videoRendering.v1.RendererImage
+	(VideoRenderingThread_UnrenderableFrame_Reason)(0), // 32: janction.videoRendering.v1.VideoRenderingThread.UnrenderableFrame.Reason
 }
 var file_janction_videoRendering_v1_tx_proto_depIdxs = []int32{
-	14, // 0: janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward:type_name -> cosmos.base.v1beta1.Coin
-	14, // 1: janction.videoRendering.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 2: janction.videoRendering.v1.Msg.CreateVideoRenderingTask:input_type -> janction.videoRendering.v1.MsgCreateVideoRenderingTask
-	2,  // 3: janction.videoRendering.v1.Msg.AddWorker:input_type -> janction.videoRendering.v1.MsgAddWorker
-	4,  // 4: janction.videoRendering.v1.Msg.SubscribeWorkerToTask:input_type -> janction.videoRendering.v1.MsgSubscribeWorkerToTask
-	6,  // 5: janction.videoRendering.v1.Msg.ProposeSolution:input_type -> janction.videoRendering.v1.MsgProposeSolution
-	10, // 6: janction.videoRendering.v1.Msg.SubmitValidation:input_type -> janction.videoRendering.v1.MsgSubmitValidation
-	8,  // 7: janction.videoRendering.v1.Msg.RevealSolution:input_type -> janction.videoRendering.v1.MsgRevealSolution
-	12, // 8: janction.videoRendering.v1.Msg.SubmitSolution:input_type -> janction.videoRendering.v1.MsgSubmitSolution
-	1,  // 9: janction.videoRendering.v1.Msg.CreateVideoRenderingTask:output_type -> janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse
-	3,  // 10: janction.videoRendering.v1.Msg.AddWorker:output_type -> janction.videoRendering.v1.MsgAddWorkerResponse
-	5,  // 11: janction.videoRendering.v1.Msg.SubscribeWorkerToTask:output_type -> janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse
-	7,  // 12: janction.videoRendering.v1.Msg.ProposeSolution:output_type -> janction.videoRendering.v1.MsgProposeSolutionResponse
-	11, // 13: janction.videoRendering.v1.Msg.SubmitValidation:output_type -> janction.videoRendering.v1.MsgSubmitValidationResponse
-	9,  // 14: janction.videoRendering.v1.Msg.RevealSolution:output_type -> janction.videoRendering.v1.MsgRevealSolutionResponse
-	13, // 15: janction.videoRendering.v1.Msg.SubmitSolution:output_type -> janction.videoRendering.v1.MsgSubmitSolutionResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	26, // 0: janction.videoRendering.v1.MsgCreateVideoRenderingTask.reward:type_name -> cosmos.base.v1beta1.Coin
+	27, // 1: janction.videoRendering.v1.MsgCreateVideoRenderingTask.comparison:type_name -> janction.videoRendering.v1.FrameComparison
+	28, // 2: janction.videoRendering.v1.MsgCreateVideoRenderingTask.render_settings:type_name -> janction.videoRendering.v1.RenderSettings
+	29, // 3: janction.videoRendering.v1.MsgCreateVideoRenderingTask.tile_grid:type_name -> janction.videoRendering.v1.TileGrid
+	30, // 4: janction.videoRendering.v1.MsgCreateVideoRenderingTask.split:type_name -> janction.videoRendering.v1.ThreadSplit
+	26, // 5: janction.videoRendering.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
+	31, // 6: janction.videoRendering.v1.MsgRegisterRendererImage.image:type_name -> janction.videoRendering.v1.RendererImage
+	32, // 7: janction.videoRendering.v1.MsgReportTaskFailure.reason:type_name -> janction.videoRendering.v1.VideoRenderingThread.UnrenderableFrame.Reason
+	0,  // 8: janction.videoRendering.v1.Msg.CreateVideoRenderingTask:input_type -> janction.videoRendering.v1.MsgCreateVideoRenderingTask
+	2,  // 9: janction.videoRendering.v1.Msg.AddWorker:input_type -> janction.videoRendering.v1.MsgAddWorker
+	4,  // 10: janction.videoRendering.v1.Msg.SubscribeWorkerToTask:input_type -> janction.videoRendering.v1.MsgSubscribeWorkerToTask
+	6,  // 11: janction.videoRendering.v1.Msg.ProposeSolution:input_type -> janction.videoRendering.v1.MsgProposeSolution
+	10, // 12: janction.videoRendering.v1.Msg.SubmitValidation:input_type -> janction.videoRendering.v1.MsgSubmitValidation
+	8,  // 13: janction.videoRendering.v1.Msg.RevealSolution:input_type -> janction.videoRendering.v1.MsgRevealSolution
+	12, // 14: janction.videoRendering.v1.Msg.RevealValidation:input_type -> janction.videoRendering.v1.MsgRevealValidation
+	14, // 15: janction.videoRendering.v1.Msg.SubmitSolution:input_type -> janction.videoRendering.v1.MsgSubmitSolution
+	16, // 16: janction.videoRendering.v1.Msg.RegisterVerifyingKey:input_type -> janction.videoRendering.v1.MsgRegisterVerifyingKey
+	18, // 17: janction.videoRendering.v1.Msg.SubmitAssembledVideo:input_type -> janction.videoRendering.v1.MsgSubmitAssembledVideo
+	20, // 18: janction.videoRendering.v1.Msg.SubmitPreview:input_type -> janction.videoRendering.v1.MsgSubmitPreview
+	22, // 19: janction.videoRendering.v1.Msg.RegisterRendererImage:input_type -> janction.videoRendering.v1.MsgRegisterRendererImage
+	24, // 20: janction.videoRendering.v1.Msg.ReportTaskFailure:input_type -> janction.videoRendering.v1.MsgReportTaskFailure
+	1,  // 21: janction.videoRendering.v1.Msg.CreateVideoRenderingTask:output_type -> janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse
+	3,  // 22: janction.videoRendering.v1.Msg.AddWorker:output_type -> janction.videoRendering.v1.MsgAddWorkerResponse
+	5,  // 23: janction.videoRendering.v1.Msg.SubscribeWorkerToTask:output_type -> janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse
+	7,  // 24: janction.videoRendering.v1.Msg.ProposeSolution:output_type -> janction.videoRendering.v1.MsgProposeSolutionResponse
+	11, // 25: janction.videoRendering.v1.Msg.SubmitValidation:output_type -> janction.videoRendering.v1.MsgSubmitValidationResponse
+	9,  // 26: janction.videoRendering.v1.Msg.RevealSolution:output_type -> janction.videoRendering.v1.MsgRevealSolutionResponse
+	13, // 27: janction.videoRendering.v1.Msg.RevealValidation:output_type -> janction.videoRendering.v1.MsgRevealValidationResponse
+	15, // 28: janction.videoRendering.v1.Msg.SubmitSolution:output_type -> janction.videoRendering.v1.MsgSubmitSolutionResponse
+	17, // 29: janction.videoRendering.v1.Msg.RegisterVerifyingKey:output_type -> janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse
+	19, // 30: janction.videoRendering.v1.Msg.SubmitAssembledVideo:output_type -> janction.videoRendering.v1.MsgSubmitAssembledVideoResponse
+	21, // 31: janction.videoRendering.v1.Msg.SubmitPreview:output_type -> janction.videoRendering.v1.MsgSubmitPreviewResponse
+	23, // 32: janction.videoRendering.v1.Msg.RegisterRendererImage:output_type -> janction.videoRendering.v1.MsgRegisterRendererImageResponse
+	25, // 33: janction.videoRendering.v1.Msg.ReportTaskFailure:output_type -> janction.videoRendering.v1.MsgReportTaskFailureResponse
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_janction_videoRendering_v1_tx_proto_init() }
@@ -8519,7 +16344,7 @@ func file_janction_videoRendering_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoRendering_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitSolution); i {
+			switch v := v.(*MsgRevealValidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8531,6 +16356,30 @@ func file_janction_videoRendering_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoRendering_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealValidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgSubmitSolutionResponse); i {
 			case 0:
 				return &v.state
@@ -8542,6 +16391,126 @@ func file_janction_videoRendering_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterVerifyingKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterVerifyingKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitAssembledVideo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitAssembledVideoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterRendererImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterRendererImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportTaskFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoRendering_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportTaskFailureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8549,7 +16518,7 @@ func file_janction_videoRendering_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_janction_videoRendering_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,7 +25,13 @@ const (
 	Msg_ProposeSolution_FullMethodName          = "/janction.videoRendering.v1.Msg/ProposeSolution"
 	Msg_SubmitValidation_FullMethodName         = "/janction.videoRendering.v1.Msg/SubmitValidation"
 	Msg_RevealSolution_FullMethodName           = "/janction.videoRendering.v1.Msg/RevealSolution"
+	Msg_RevealValidation_FullMethodName         = "/janction.videoRendering.v1.Msg/RevealValidation"
 	Msg_SubmitSolution_FullMethodName           = "/janction.videoRendering.v1.Msg/SubmitSolution"
+	Msg_RegisterVerifyingKey_FullMethodName     = "/janction.videoRendering.v1.Msg/RegisterVerifyingKey"
+	Msg_SubmitAssembledVideo_FullMethodName     = "/janction.videoRendering.v1.Msg/SubmitAssembledVideo"
+	Msg_SubmitPreview_FullMethodName            = "/janction.videoRendering.v1.Msg/SubmitPreview"
+	Msg_RegisterRendererImage_FullMethodName    = "/janction.videoRendering.v1.Msg/RegisterRendererImage"
+	Msg_ReportTaskFailure_FullMethodName        = "/janction.videoRendering.v1.Msg/ReportTaskFailure"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,8 +49,20 @@ type MsgClient interface {
 	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
+	// Reveals the hashes a validator committed to
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+	// Registers the verifying key of a frame proof circuit version. Authority-gated
+	RegisterVerifyingKey(ctx context.Context, in *MsgRegisterVerifyingKey, opts ...grpc.CallOption) (*MsgRegisterVerifyingKeyResponse, error)
+	// Submits the assembled video of a completed task, or confirms it by hash
+	SubmitAssembledVideo(ctx context.Context, in *MsgSubmitAssembledVideo, opts ...grpc.CallOption) (*MsgSubmitAssembledVideoResponse, error)
+	// Submits the render times of the preview pass of a task, splitting its threads
+	SubmitPreview(ctx context.Context, in *MsgSubmitPreview, opts ...grpc.CallOption) (*MsgSubmitPreviewResponse, error)
+	// Approves or revokes a renderer image. Authority-gated
+	RegisterRendererImage(ctx context.Context, in *MsgRegisterRendererImage, opts ...grpc.CallOption) (*MsgRegisterRendererImageResponse, error)
+	// Reports the frame of a thread the worker couldn't render, failing the task once enough workers report it
+	ReportTaskFailure(ctx context.Context, in *MsgReportTaskFailure, opts ...grpc.CallOption) (*MsgReportTaskFailureResponse, error)
 }
 
 type msgClient struct {
@@ -109,6 +127,15 @@ func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, o
 	return out, nil
 }
 
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealValidation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
 	out := new(MsgSubmitSolutionResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSolution_FullMethodName, in, out, opts...)
@@ -118,6 +145,51 @@ func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, o
 	return out, nil
 }
 
+func (c *msgClient) RegisterVerifyingKey(ctx context.Context, in *MsgRegisterVerifyingKey, opts ...grpc.CallOption) (*MsgRegisterVerifyingKeyResponse, error) {
+	out := new(MsgRegisterVerifyingKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterVerifyingKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitAssembledVideo(ctx context.Context, in *MsgSubmitAssembledVideo, opts ...grpc.CallOption) (*MsgSubmitAssembledVideoResponse, error) {
+	out := new(MsgSubmitAssembledVideoResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitAssembledVideo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitPreview(ctx context.Context, in *MsgSubmitPreview, opts ...grpc.CallOption) (*MsgSubmitPreviewResponse, error) {
+	out := new(MsgSubmitPreviewResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterRendererImage(ctx context.Context, in *MsgRegisterRendererImage, opts ...grpc.CallOption) (*MsgRegisterRendererImageResponse, error) {
+	out := new(MsgRegisterRendererImageResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterRendererImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportTaskFailure(ctx context.Context, in *MsgReportTaskFailure, opts ...grpc.CallOption) (*MsgReportTaskFailureResponse, error) {
+	out := new(MsgReportTaskFailureResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportTaskFailure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -133,8 +205,20 @@ type MsgServer interface {
 	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
+	// Reveals the hashes a validator committed to
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+	// Registers the verifying key of a frame proof circuit version. Authority-gated
+	RegisterVerifyingKey(context.Context, *MsgRegisterVerifyingKey) (*MsgRegisterVerifyingKeyResponse, error)
+	// Submits the assembled video of a completed task, or confirms it by hash
+	SubmitAssembledVideo(context.Context, *MsgSubmitAssembledVideo) (*MsgSubmitAssembledVideoResponse, error)
+	// Submits the render times of the preview pass of a task, splitting its threads
+	SubmitPreview(context.Context, *MsgSubmitPreview) (*MsgSubmitPreviewResponse, error)
+	// Approves or revokes a renderer image. Authority-gated
+	RegisterRendererImage(context.Context, *MsgRegisterRendererImage) (*MsgRegisterRendererImageResponse, error)
+	// Reports the frame of a thread the worker couldn't render, failing the task once enough workers report it
+	ReportTaskFailure(context.Context, *MsgReportTaskFailure) (*MsgReportTaskFailureResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -160,9 +244,27 @@ func (UnimplementedMsgServer) SubmitValidation(context.Context, *MsgSubmitValida
 func (UnimplementedMsgServer) RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
 }
+func (UnimplementedMsgServer) RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealValidation not implemented")
+}
 func (UnimplementedMsgServer) SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
 }
+func (UnimplementedMsgServer) RegisterVerifyingKey(context.Context, *MsgRegisterVerifyingKey) (*MsgRegisterVerifyingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterVerifyingKey not implemented")
+}
+func (UnimplementedMsgServer) SubmitAssembledVideo(context.Context, *MsgSubmitAssembledVideo) (*MsgSubmitAssembledVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAssembledVideo not implemented")
+}
+func (UnimplementedMsgServer) SubmitPreview(context.Context, *MsgSubmitPreview) (*MsgSubmitPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPreview not implemented")
+}
+func (UnimplementedMsgServer) RegisterRendererImage(context.Context, *MsgRegisterRendererImage) (*MsgRegisterRendererImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRendererImage not implemented")
+}
+func (UnimplementedMsgServer) ReportTaskFailure(context.Context, *MsgReportTaskFailure) (*MsgReportTaskFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTaskFailure not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +386,24 @@ func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSolution)
 	if err := dec(in); err != nil {
@@ -302,6 +422,96 @@ func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterVerifyingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterVerifyingKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterVerifyingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterVerifyingKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterVerifyingKey(ctx, req.(*MsgRegisterVerifyingKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAssembledVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAssembledVideo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAssembledVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitAssembledVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAssembledVideo(ctx, req.(*MsgSubmitAssembledVideo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPreview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPreview(ctx, req.(*MsgSubmitPreview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterRendererImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRendererImage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRendererImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterRendererImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRendererImage(ctx, req.(*MsgRegisterRendererImage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportTaskFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportTaskFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportTaskFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportTaskFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportTaskFailure(ctx, req.(*MsgReportTaskFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,10 +543,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevealSolution",
 			Handler:    _Msg_RevealSolution_Handler,
 		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
 		{
 			MethodName: "SubmitSolution",
 			Handler:    _Msg_SubmitSolution_Handler,
 		},
+		{
+			MethodName: "RegisterVerifyingKey",
+			Handler:    _Msg_RegisterVerifyingKey_Handler,
+		},
+		{
+			MethodName: "SubmitAssembledVideo",
+			Handler:    _Msg_SubmitAssembledVideo_Handler,
+		},
+		{
+			MethodName: "SubmitPreview",
+			Handler:    _Msg_SubmitPreview_Handler,
+		},
+		{
+			MethodName: "RegisterRendererImage",
+			Handler:    _Msg_RegisterRendererImage_Handler,
+		},
+		{
+			MethodName: "ReportTaskFailure",
+			Handler:    _Msg_ReportTaskFailure_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoRendering/v1/tx.proto",
@@ -0,0 +1,255 @@
+package videoRendering
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	fmt "fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/janction/videoRendering/db"
+	"github.com/janction/videoRendering/ipfs"
+	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
+)
+
+const (
+	// frames per second of the video of tasks that don't set it
+	DefaultFrameRate = 24
+	// max frames per second of the video of a task
+	maxFrameRate = 240
+	// encodes of other workers matching the video before the assembler is paid
+	minAssemblyConfirmations = 1
+	// encodes of other workers differing from the video before it's rejected and the assembler penalized
+	minAssemblyRejections = 1
+)
+
+// NewVideoAssembly returns the assembly of a new task, keeping the given percentage of the reward for the assembler.
+// Videos are encoded with the given ffmpeg image
+func NewVideoAssembly(reward types.Coin, percentage uint32, frameRate uint32, encoderImage string) (*VideoAssembly, error) {
+	if frameRate == 0 {
+		frameRate = DefaultFrameRate
+	}
+	if frameRate > maxFrameRate {
+		return nil, fmt.Errorf("frame rate must be at most %v, got %v", maxFrameRate, frameRate)
+	}
+
+	if err := vm.ValidateEncoderImage(encoderImage); err != nil {
+		return nil, err
+	}
+
+	assemblyReward := types.NewCoin(reward.Denom, reward.Amount.MulRaw(int64(percentage)).QuoRaw(100))
+	return &VideoAssembly{Reward: &assemblyReward, FrameRate: frameRate, EncoderImage: encoderImage}, nil
+}
+
+// DesignateAssembler designates the winner of the first thread to assemble the video of a completed task,
+// since its IPFS node already holds frames of the task. Its video must be confirmed within the given blocks
+func (t *VideoRenderingTask) DesignateAssembler(height int64, phaseBlocks int64) {
+	if t.Assembly == nil || t.Assembly.Assembler != "" || len(t.Threads) == 0 || t.Threads[0].Solution == nil {
+		return
+	}
+	t.Assembly.Assembler = t.Threads[0].Solution.ProposedBy
+	t.Assembly.Deadline = height + phaseBlocks
+}
+
+// AssemblyValidators returns the workers of the task that confirm the video, which are all of them but the assembler
+// and the assemblers that already failed
+func (t VideoRenderingTask) AssemblyValidators() []string {
+	var validators []string
+	for _, thread := range t.Threads {
+		for _, worker := range thread.Workers {
+			if worker != t.Assembly.GetAssembler() && !slices.Contains(t.Assembly.GetFailedAssemblers(), worker) && !slices.Contains(validators, worker) {
+				validators = append(validators, worker)
+			}
+		}
+	}
+	return validators
+}
+
+// ReassignAssembler records the assembler as failed and designates the first validator to assemble the video again,
+// with a new deadline. Returns the failed assembler, and false if no worker of the task is left to assemble the video
+func (t *VideoRenderingTask) ReassignAssembler(height int64, phaseBlocks int64) (string, bool) {
+	failed := t.Assembly.Assembler
+	validators := t.AssemblyValidators()
+
+	t.Assembly.FailedAssemblers = append(t.Assembly.FailedAssemblers, failed)
+	t.Assembly.Assembler = ""
+	t.Assembly.VideoCid = ""
+	t.Assembly.VideoHash = ""
+	t.Assembly.Confirmations = nil
+	t.Assembly.Rejections = nil
+	if len(validators) == 0 {
+		return failed, false
+	}
+	t.Assembly.Assembler = validators[0]
+	t.Assembly.Deadline = height + phaseBlocks
+	return failed, true
+}
+
+// AssemblyId returns the id this node tracks the current attempt of the assembly with. Reassigned assemblies are
+// encoded and confirmed again, even by workers that already took part in a previous attempt
+func (t VideoRenderingTask) AssemblyId() string {
+	if attempt := len(t.Assembly.GetFailedAssemblers()); attempt > 0 {
+		return fmt.Sprintf("%s/%d", t.TaskId, attempt)
+	}
+	return t.TaskId
+}
+
+// IsAssemblyExpired returns true if the video of the assembler wasn't confirmed before its deadline
+func (t VideoRenderingTask) IsAssemblyExpired(height int64) bool {
+	return t.Completed && t.Assembly != nil && t.Assembly.Assembler != "" && !t.Assembly.Verified && height > t.Assembly.Deadline
+}
+
+// IsAssemblyPending returns true if the worker has to assemble the video of the task, or confirm the submitted one
+func (t VideoRenderingTask) IsAssemblyPending(workerAddress string) bool {
+	if !t.Completed || t.Assembly == nil || t.Assembly.Assembler == "" || t.Assembly.Verified {
+		return false
+	}
+	if t.Assembly.VideoCid == "" {
+		return t.Assembly.Assembler == workerAddress
+	}
+	return slices.Contains(t.AssemblyValidators(), workerAddress) && !t.Assembly.IsChecked(workerAddress)
+}
+
+// IsChecked returns true if the worker already confirmed or rejected the video
+func (a *VideoAssembly) IsChecked(workerAddress string) bool {
+	return slices.Contains(a.Confirmations, workerAddress) || slices.Contains(a.Rejections, workerAddress)
+}
+
+// IsConfirmed returns true if enough workers encoded the same video
+func (a *VideoAssembly) IsConfirmed() bool {
+	return len(a.Confirmations) >= minAssemblyConfirmations
+}
+
+// IsRejected returns true if enough workers encoded another video
+func (a *VideoAssembly) IsRejected() bool {
+	return len(a.Rejections) >= minAssemblyRejections
+}
+
+// AssembleVideo downloads the frames of every thread, encodes them into the video of the task and submits it.
+// Frames of tiled tasks are stitched from the tiles of their threads first.
+// The assembler uploads the video to IPFS, other workers only calculate the CID of their encode to confirm the submitted one.
+// The video is encoded in the vm of the worker.
+func (t VideoRenderingTask) AssembleVideo(ctx context.Context, workerAddress, rootPath string, machine *vm.VM, db db.Database) error {
+	db.UpdateAssembly(t.AssemblyId(), true)
+	fail := func(err error) error {
+		db.UpdateAssembly(t.AssemblyId(), false)
+		db.AddLogEntry(t.TaskId, fmt.Sprintf("Error assembling video. %s", err.Error()), time.Now().Unix(), 2)
+		videoRenderingLogger.Logger.Error("unable to assemble video of task %s: %s", t.TaskId, err.Error())
+		return err
+	}
+
+	// encodes start from a clean directory, so no frame of a previous attempt is used
+	dir := filepath.Join(rootPath, "assemblies", t.TaskId)
+	if err := os.RemoveAll(dir); err != nil {
+		return fail(err)
+	}
+	framesDir := filepath.Join(dir, vm.AssemblyFramesDir)
+	if err := os.MkdirAll(framesDir, 0755); err != nil {
+		return fail(err)
+	}
+
+	extension := t.FrameExtension()
+	for _, thread := range t.Threads {
+		if thread.Solution == nil || thread.Solution.Dir == "" {
+			return fail(fmt.Errorf("thread %s has no submitted solution", thread.ThreadId))
+		}
+
+		threadDir := filepath.Join(dir, "threads", thread.ThreadId)
+		if err := ipfs.IPFSGet(thread.Solution.Dir, threadDir); err != nil {
+			return fail(err)
+		}
+		if t.TileGrid.IsTiled() {
+			continue
+		}
+		// frames are named by their number in the task, so frames of different threads don't collide
+		for frame := thread.StartFrame; frame <= thread.EndFrame; frame++ {
+			filename := vm.FormatFrameFilename(int(frame), extension)
+			if err := os.Rename(filepath.Join(threadDir, filename), filepath.Join(framesDir, filename)); err != nil {
+				return fail(fmt.Errorf("frame %s of thread %s is missing: %w", filename, thread.ThreadId, err))
+			}
+		}
+	}
+
+	if t.TileGrid.IsTiled() {
+		if err := t.stitchFrames(dir, extension); err != nil {
+			return fail(err)
+		}
+	}
+
+	db.AddLogEntry(t.TaskId, "Encoding video...", time.Now().Unix(), 0)
+	videoPath, err := machine.EncodeVideo(ctx, t.Assembly.EncoderImage, dir, int64(t.StartFrame), t.Assembly.FrameRate, extension)
+	if err != nil {
+		return fail(err)
+	}
+
+	hash, err := calculateVideoHash(videoPath)
+	if err != nil {
+		return fail(err)
+	}
+
+	var cid string
+	if t.Assembly.Assembler == workerAddress {
+		cid, err = ipfs.UploadFile(videoPath)
+	} else {
+		cid, err = ipfs.CalculateCID(videoPath)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	if err := submitAssembledVideo(workerAddress, t.TaskId, cid, hash); err != nil {
+		return fail(err)
+	}
+
+	db.AddLogEntry(t.TaskId, fmt.Sprintf("Assembled video %s submitted.", cid), time.Now().Unix(), 0)
+	return nil
+}
+
+// stitchFrames stitches the tiles the threads downloaded into the frames of the task
+func (t VideoRenderingTask) stitchFrames(dir, extension string) error {
+	for frame := int64(t.StartFrame); frame <= int64(t.EndFrame); frame++ {
+		filename := vm.FormatFrameFilename(int(frame), extension)
+		tilePaths := make([]string, t.TileGrid.Tiles())
+		for tile := range tilePaths {
+			thread := t.tileThread(frame, uint32(tile))
+			if thread == nil {
+				return fmt.Errorf("no thread renders tile %v of frame %v", tile, frame)
+			}
+			tilePaths[tile] = filepath.Join(dir, "threads", thread.ThreadId, filename)
+		}
+		if err := StitchTiles(tilePaths, int(t.TileGrid.Columns), filepath.Join(dir, vm.AssemblyFramesDir, filename)); err != nil {
+			return fmt.Errorf("unable to stitch frame %v: %w", frame, err)
+		}
+	}
+	return nil
+}
+
+// calculateVideoHash returns the hex encoded sha256 of the video file
+func calculateVideoHash(videoPath string) (string, error) {
+	file, err := os.Open(videoPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func submitAssembledVideo(address, taskId, cid, hash string) error {
+	args := []string{
+		"tx", "videoRendering", "submit-assembled-video",
+		taskId, cid, hash,
+		"--yes", "--from", address,
+	}
+	return ExecuteCli(args)
+}
@@ -0,0 +1,320 @@
+package videoRendering
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	fmt "fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/janction/videoRendering/ipfs"
+	"github.com/janction/videoRendering/mocks"
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// returns a completed task of two threads whose video is assembled by alice
+func newAssemblyTask() VideoRenderingTask {
+	return VideoRenderingTask{
+		TaskId:     "1",
+		StartFrame: 1,
+		EndFrame:   4,
+		Completed:  true,
+		Reward:     &types.Coin{Denom: "jct", Amount: sdkmath.NewInt(1000)},
+		Threads: []*VideoRenderingThread{
+			{ThreadId: "10", StartFrame: 1, EndFrame: 2, Workers: []string{"alice", "bob"}, Solution: &VideoRenderingThread_Solution{ProposedBy: "alice", Dir: "dir1"}},
+			{ThreadId: "11", StartFrame: 3, EndFrame: 4, Workers: []string{"bob", "carol"}, Solution: &VideoRenderingThread_Solution{ProposedBy: "bob", Dir: "dir2"}},
+		},
+		Assembly: &VideoAssembly{Reward: &types.Coin{Denom: "jct", Amount: sdkmath.NewInt(50)}, FrameRate: DefaultFrameRate, EncoderImage: testEncoderImage},
+	}
+}
+
+// ffmpeg image the videos of the tests are encoded with
+var testEncoderImage = "jrottenberg/ffmpeg@sha256:" + strings.Repeat("ef", 32)
+
+// --- Test for NewVideoAssembly ---
+func TestNewVideoAssembly(t *testing.T) {
+	assembly, err := NewVideoAssembly(types.NewCoin("jct", sdkmath.NewInt(1000)), 5, 0, testEncoderImage)
+	require.NoError(t, err)
+	require.Equal(t, types.NewCoin("jct", sdkmath.NewInt(50)), *assembly.Reward)
+	require.Equal(t, uint32(DefaultFrameRate), assembly.FrameRate)
+	require.Equal(t, testEncoderImage, assembly.EncoderImage)
+
+	assembly, err = NewVideoAssembly(types.NewCoin("jct", sdkmath.NewInt(1000)), 0, 60, testEncoderImage)
+	require.NoError(t, err)
+	require.True(t, assembly.Reward.IsZero())
+	require.Equal(t, uint32(60), assembly.FrameRate)
+
+	_, err = NewVideoAssembly(types.NewCoin("jct", sdkmath.NewInt(1000)), 5, maxFrameRate+1, testEncoderImage)
+	require.Error(t, err)
+
+	// videos are only encoded with an image pinned by its digest
+	_, err = NewVideoAssembly(types.NewCoin("jct", sdkmath.NewInt(1000)), 5, 0, "jrottenberg/ffmpeg:6.1-ubuntu")
+	require.ErrorContains(t, err, "must be pinned")
+}
+
+// --- Test for the rewards of a task with an assembly ---
+func TestGetWinnerReward_WithAssembly(t *testing.T) {
+	task := newAssemblyTask()
+
+	// the slice of the assembler isn't shared among the threads
+	expected := types.NewCoin("jct", sdkmath.NewInt(950).QuoRaw(2).QuoRaw(2))
+	require.Equal(t, expected, task.GetWinnerReward())
+	require.Equal(t, expected, task.GetValidatorsReward())
+}
+
+// --- Test for DesignateAssembler ---
+func TestDesignateAssembler(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+	require.Equal(t, "alice", task.Assembly.Assembler)
+	require.Equal(t, int64(110), task.Assembly.Deadline)
+
+	// the assembler isn't replaced
+	task.Threads[0].Solution.ProposedBy = "bob"
+	task.DesignateAssembler(20, 100)
+	require.Equal(t, "alice", task.Assembly.Assembler)
+	require.Equal(t, int64(110), task.Assembly.Deadline)
+
+	// tasks created without an encoder image have no assembler
+	task.Assembly = nil
+	task.DesignateAssembler(10, 100)
+	require.Nil(t, task.Assembly)
+}
+
+// --- Test for ReassignAssembler ---
+func TestReassignAssembler(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+	task.Assembly.VideoCid = "cid"
+	task.Assembly.VideoHash = "hash"
+	task.Assembly.Rejections = []string{"bob"}
+	require.True(t, task.Assembly.IsRejected())
+	require.Equal(t, "1", task.AssemblyId())
+
+	// the first validator assembles the video again, and the failed assembler doesn't confirm it
+	failed, reassigned := task.ReassignAssembler(50, 100)
+	require.True(t, reassigned)
+	require.Equal(t, "alice", failed)
+	require.Equal(t, "bob", task.Assembly.Assembler)
+	require.Equal(t, int64(150), task.Assembly.Deadline)
+	require.Empty(t, task.Assembly.VideoCid)
+	require.Empty(t, task.Assembly.VideoHash)
+	require.Empty(t, task.Assembly.Rejections)
+	require.False(t, task.Assembly.IsRejected())
+	require.Equal(t, []string{"carol"}, task.AssemblyValidators())
+	require.True(t, task.IsAssemblyPending("bob"))
+	require.Equal(t, "1/1", task.AssemblyId())
+
+	// once every worker failed nobody assembles the video
+	_, reassigned = task.ReassignAssembler(200, 100)
+	require.True(t, reassigned)
+	require.Equal(t, "carol", task.Assembly.Assembler)
+	failed, reassigned = task.ReassignAssembler(300, 100)
+	require.False(t, reassigned)
+	require.Equal(t, "carol", failed)
+	require.Empty(t, task.Assembly.Assembler)
+	require.Equal(t, []string{"alice", "bob", "carol"}, task.Assembly.FailedAssemblers)
+	require.False(t, task.IsAssemblyPending("carol"))
+	require.False(t, task.IsAssemblyExpired(1000))
+}
+
+// --- Test for IsAssemblyExpired ---
+func TestIsAssemblyExpired(t *testing.T) {
+	task := newAssemblyTask()
+	require.False(t, task.IsAssemblyExpired(1000))
+
+	task.DesignateAssembler(10, 100)
+	require.False(t, task.IsAssemblyExpired(110))
+	require.True(t, task.IsAssemblyExpired(111))
+
+	// verified videos don't expire
+	task.Assembly.Verified = true
+	require.False(t, task.IsAssemblyExpired(111))
+}
+
+// --- Test for IsAssemblyPending ---
+func TestIsAssemblyPending(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+
+	require.Equal(t, []string{"bob", "carol"}, task.AssemblyValidators())
+
+	// only the assembler has work until the video is submitted
+	require.True(t, task.IsAssemblyPending("alice"))
+	require.False(t, task.IsAssemblyPending("bob"))
+
+	task.Assembly.VideoCid = "cid"
+	require.False(t, task.IsAssemblyPending("alice"))
+	require.True(t, task.IsAssemblyPending("bob"))
+	require.False(t, task.IsAssemblyPending("dave"))
+
+	task.Assembly.Rejections = []string{"bob"}
+	require.False(t, task.IsAssemblyPending("bob"))
+	require.True(t, task.IsAssemblyPending("carol"))
+
+	task.Assembly.Verified = true
+	require.False(t, task.IsAssemblyPending("carol"))
+}
+
+// patches the download of every thread to write its frames, and the encode to write the video
+func patchAssembly(t *testing.T) []*monkey.PatchGuard {
+	getPatch := monkey.Patch(ipfs.IPFSGet, func(cid string, path string) error {
+		require.NoError(t, os.MkdirAll(path, 0755))
+		frames := map[string][]int{"dir1": {1, 2}, "dir2": {3, 4}}[cid]
+		for _, frame := range frames {
+			require.NoError(t, os.WriteFile(filepath.Join(path, vm.FormatFrameFilename(frame, "png")), []byte{byte(frame)}, 0644))
+		}
+		return nil
+	})
+	encodePatch := monkey.Patch((*vm.VM).EncodeVideo, func(v *vm.VM, ctx context.Context, image string, dir string, startFrame int64, frameRate uint32, extension string) (string, error) {
+		require.Equal(t, testEncoderImage, image)
+		require.Equal(t, int64(1), startFrame)
+		require.Equal(t, uint32(DefaultFrameRate), frameRate)
+		for frame := 1; frame <= 4; frame++ {
+			require.FileExists(t, filepath.Join(dir, vm.AssemblyFramesDir, vm.FormatFrameFilename(frame, extension)))
+		}
+		videoPath := filepath.Join(dir, vm.AssembledVideoFilename)
+		return videoPath, os.WriteFile(videoPath, []byte("video"), 0644)
+	})
+	return []*monkey.PatchGuard{getPatch, encodePatch}
+}
+
+// --- Test for AssembleVideo ---
+func TestAssembleVideo_Assembler(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateAssembly", "1", true).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	for _, patch := range patchAssembly(t) {
+		defer patch.Unpatch()
+	}
+	useStubStore(t, stubStore{add: func(path string) (string, error) {
+		return "videoCid", nil
+	}})
+	var submitted []string
+	cliPatch := monkey.Patch(ExecuteCli, func(args []string) error {
+		// args live on the stack of the caller
+		submitted = append([]string(nil), args...)
+		return nil
+	})
+	defer cliPatch.Unpatch()
+
+	err := task.AssembleVideo(context.Background(), "alice", t.TempDir(), vm.DefaultVM(), mockDB)
+
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("video"))
+	require.Equal(t, []string{"tx", "videoRendering", "submit-assembled-video", "1", "videoCid", hex.EncodeToString(hash[:]), "--yes", "--from", "alice"}, submitted)
+	mockDB.AssertExpectations(t)
+}
+
+func TestAssembleVideo_ValidatorCalculatesCID(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+	task.Assembly.VideoCid = "videoCid"
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateAssembly", "1", true).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	for _, patch := range patchAssembly(t) {
+		defer patch.Unpatch()
+	}
+	// validators don't upload their encode
+	useStubStore(t, stubStore{
+		add: func(path string) (string, error) {
+			return "", fmt.Errorf("validators don't upload the video")
+		},
+		hash: func(path string) (string, error) {
+			return "videoCid", nil
+		},
+	})
+	cliPatch := monkey.Patch(ExecuteCli, func(args []string) error {
+		require.Equal(t, "videoCid", args[4])
+		require.Equal(t, "bob", args[len(args)-1])
+		return nil
+	})
+	defer cliPatch.Unpatch()
+
+	err := task.AssembleVideo(context.Background(), "bob", t.TempDir(), vm.DefaultVM(), mockDB)
+
+	require.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
+
+func TestAssembleVideo_MissingFrame(t *testing.T) {
+	task := newAssemblyTask()
+	task.DesignateAssembler(10, 100)
+	// the second thread claims a frame its directory doesn't have
+	task.Threads[1].EndFrame = 5
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateAssembly", "1", true).Return(nil).Once()
+	mockDB.On("UpdateAssembly", "1", false).Return(nil).Once()
+	mockDB.On("AddLogEntry", "1", mock.Anything, mock.Anything, int64(2)).Return(nil).Once()
+
+	for _, patch := range patchAssembly(t) {
+		defer patch.Unpatch()
+	}
+
+	err := task.AssembleVideo(context.Background(), "alice", t.TempDir(), vm.DefaultVM(), mockDB)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "frame_000005.png")
+	mockDB.AssertExpectations(t)
+}
+
+func TestAssembleVideo_StitchesTiles(t *testing.T) {
+	task := VideoRenderingTask{
+		TaskId:     "1",
+		StartFrame: 1,
+		EndFrame:   1,
+		Completed:  true,
+		TileGrid:   &TileGrid{Columns: 2, Rows: 1},
+		Threads: []*VideoRenderingThread{
+			{ThreadId: "10", StartFrame: 1, EndFrame: 1, Tile: 0, Workers: []string{"alice"}, Solution: &VideoRenderingThread_Solution{ProposedBy: "alice", Dir: "tile0"}},
+			{ThreadId: "11", StartFrame: 1, EndFrame: 1, Tile: 1, Workers: []string{"alice"}, Solution: &VideoRenderingThread_Solution{ProposedBy: "alice", Dir: "tile1"}},
+		},
+		Assembly: &VideoAssembly{FrameRate: DefaultFrameRate},
+	}
+	task.DesignateAssembler(10, 100)
+	mockDB := new(mocks.DB)
+	mockDB.On("UpdateAssembly", "1", true).Return(nil).Once()
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	getPatch := monkey.Patch(ipfs.IPFSGet, func(cid string, path string) error {
+		require.NoError(t, os.MkdirAll(path, 0755))
+		writeTile(t, filepath.Join(path, vm.FormatFrameFilename(1, "png")), 3, 2, map[string]uint8{"tile0": 10, "tile1": 20}[cid])
+		return nil
+	})
+	defer getPatch.Unpatch()
+	encodePatch := monkey.Patch((*vm.VM).EncodeVideo, func(v *vm.VM, ctx context.Context, image string, dir string, startFrame int64, frameRate uint32, extension string) (string, error) {
+		// the encoder gets the stitched frame
+		frame, err := decodeFrame(filepath.Join(dir, vm.AssemblyFramesDir, vm.FormatFrameFilename(1, extension)), vm.Renderer{})
+		require.NoError(t, err)
+		require.Equal(t, 6, frame.Bounds().Dx())
+		require.Equal(t, 2, frame.Bounds().Dy())
+		videoPath := filepath.Join(dir, vm.AssembledVideoFilename)
+		return videoPath, os.WriteFile(videoPath, []byte("video"), 0644)
+	})
+	defer encodePatch.Unpatch()
+	useStubStore(t, stubStore{add: func(path string) (string, error) {
+		return "videoCid", nil
+	}})
+	cliPatch := monkey.Patch(ExecuteCli, func(args []string) error {
+		return nil
+	})
+	defer cliPatch.Unpatch()
+
+	err := task.AssembleVideo(context.Background(), "alice", t.TempDir(), vm.DefaultVM(), mockDB)
+
+	require.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
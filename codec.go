@@ -15,7 +15,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgProposeSolution{},
 		&MsgSubmitValidation{},
 		&MsgRevealSolution{},
+		&MsgRevealValidation{},
 		&MsgSubmitSolution{},
+		&MsgRegisterVerifyingKey{},
+		&MsgSubmitAssembledVideo{},
+		&MsgSubmitPreview{},
+		&MsgRegisterRendererImage{},
+		&MsgReportTaskFailure{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,61 @@
+package videoRendering
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/janction/videoRendering/db"
+	"github.com/janction/videoRendering/zkp"
+)
+
+// size in bytes of the salt used in frame commitments
+const commitmentSaltSize = 32
+
+// FrameCommitment returns the hex encoded MiMC commitment of the hash and salt a worker commits to before revealing a frame hash.
+// The address binds the commitment to its sender, so another worker can't submit it as its own.
+// Every field is hashed to a fixed width element, so a different split of the same bytes is a different commitment,
+// and the solution proof recomputes it inside the circuit.
+func FrameCommitment(hash, salt, address string) string {
+	return zkp.FrameCommitment(hash, salt, address)
+}
+
+// GenerateCommitmentSalt returns a new random hex encoded salt
+func GenerateCommitmentSalt() (string, error) {
+	salt := make([]byte, commitmentSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt), nil
+}
+
+// IsValidCommitmentSalt returns true if the salt is a hex encoded value of the expected size
+func IsValidCommitmentSalt(salt string) bool {
+	decoded, err := hex.DecodeString(salt)
+	return err == nil && len(decoded) == commitmentSaltSize
+}
+
+// IsValidCommitment returns true if the commitment is a hex encoded field element
+func IsValidCommitment(commitment string) bool {
+	decoded, err := hex.DecodeString(commitment)
+	return err == nil && len(decoded) == zkp.ElementSize
+}
+
+// commitmentSalt returns the salt this node uses in the commitments of the thread, generating it the first time
+func commitmentSalt(threadId string, db db.Database) (string, error) {
+	commitment, err := db.ReadCommitment(threadId)
+	if err != nil {
+		return "", err
+	}
+	if commitment.Salt != "" {
+		return commitment.Salt, nil
+	}
+
+	salt, err := GenerateCommitmentSalt()
+	if err != nil {
+		return "", err
+	}
+	if err := db.UpdateCommitment(threadId, salt, false); err != nil {
+		return "", err
+	}
+	return salt, nil
+}
@@ -0,0 +1,43 @@
+package videoRendering
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCommitmentSalt = "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff"
+
+// --- Test for FrameCommitment ---
+func TestFrameCommitment(t *testing.T) {
+	commitment := FrameCommitment("hash1", testCommitmentSalt, "alice")
+
+	require.True(t, IsValidCommitment(commitment))
+	require.Equal(t, commitment, FrameCommitment("hash1", testCommitmentSalt, "alice"))
+
+	// any change of the hash, salt or sender changes the commitment
+	require.NotEqual(t, commitment, FrameCommitment("hash2", testCommitmentSalt, "alice"))
+	require.NotEqual(t, commitment, FrameCommitment("hash1", "ff"+testCommitmentSalt[2:], "alice"))
+	require.NotEqual(t, commitment, FrameCommitment("hash1", testCommitmentSalt, "bob"))
+
+	// moving bytes from a field to the next one changes the commitment
+	require.NotEqual(t, FrameCommitment("hash1", "ab", "alice"), FrameCommitment("hash1a", "b", "alice"))
+	require.NotEqual(t, FrameCommitment("hash1", "ab", "alice"), FrameCommitment("hash1", "aba", "lice"))
+}
+
+func TestGenerateCommitmentSalt(t *testing.T) {
+	salt1, err := GenerateCommitmentSalt()
+	require.NoError(t, err)
+	salt2, err := GenerateCommitmentSalt()
+	require.NoError(t, err)
+
+	require.True(t, IsValidCommitmentSalt(salt1))
+	require.NotEqual(t, salt1, salt2)
+}
+
+func TestIsValidCommitmentSalt(t *testing.T) {
+	require.True(t, IsValidCommitmentSalt(testCommitmentSalt))
+	require.False(t, IsValidCommitmentSalt(""))
+	require.False(t, IsValidCommitmentSalt("0011"))
+	require.False(t, IsValidCommitmentSalt("zz"+testCommitmentSalt[2:]))
+}
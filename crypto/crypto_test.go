@@ -1,9 +1,15 @@
 package videoRenderingCrypto_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/janction/videoRendering"
 	videoRenderingCrypto "github.com/janction/videoRendering/crypto"
 )
 
@@ -24,7 +30,7 @@ func TestPublicKeyMatch(t *testing.T) {
 	}
 }
 func TestWorkerSignAndValidation(t *testing.T) {
-	message, err := videoRenderingCrypto.GenerateSignableMessage("cid", "address")
+	message, err := videoRenderingCrypto.GenerateSignableMessage(&videoRendering.FrameSignDoc{Hash: "cid", WorkerAddress: "address"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -62,7 +68,7 @@ func TestSerializationPublicKey(t *testing.T) {
 }
 
 func TestSerializationSignature(t *testing.T) {
-	message, err := videoRenderingCrypto.GenerateSignableMessage("QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", "janction1lxwfqmcfcwunzchskvc3vrztthkwkgst6zd9y7")
+	message, err := videoRenderingCrypto.GenerateSignableMessage(&videoRendering.FrameSignDoc{Hash: "QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", WorkerAddress: "janction1lxwfqmcfcwunzchskvc3vrztthkwkgst6zd9y7"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -90,23 +96,42 @@ func TestSerializationSignature(t *testing.T) {
 }
 
 func TestVerifySignature(t *testing.T) {
-	message, _ := videoRenderingCrypto.GenerateSignableMessage("6d5be21b98ce5e647b5bb031472f4bdc3c70606508dcf0db10f968c699c330a9", "janction1ttu0v9l4mxut8cu97065htdexavn8dswq395uv")
-	signature := "Id5hOW7JqbXV8T0YKWk8vhNzQGRmHWSWGNbdUL3Khq9n5ccDMykgu3a1t5VOmPjWuegOLrl5/Huj7O+UTdLN6w=="
-	publicKey := "AgIg7GgQWR8l4ea7LvUgNuHOWlCIN2fjKUO7ERB/1Sed"
+	doc := &videoRendering.FrameSignDoc{
+		Version:       videoRendering.FrameSignDocVersion,
+		Domain:        videoRendering.FrameSignDocDomain,
+		ChainId:       "janction",
+		TaskId:        "1",
+		ThreadId:      "10",
+		FrameNumber:   1,
+		Hash:          "6d5be21b98ce5e647b5bb031472f4bdc3c70606508dcf0db10f968c699c330a9",
+		HashAlgorithm: videoRendering.FrameHashAlgorithm,
+		WorkerAddress: "janction1ttu0v9l4mxut8cu97065htdexavn8dswq395uv",
+	}
+	message, _ := videoRenderingCrypto.GenerateSignableMessage(doc)
+	privKey := secp256k1.GenPrivKey()
+	signature, _ := privKey.Sign(message)
+	publicKey := videoRenderingCrypto.EncodePublicKeyForCLI(privKey.PubKey())
 
 	pk, _ := videoRenderingCrypto.DecodePublicKeyFromCLI(publicKey)
 
-	sig, _ := videoRenderingCrypto.DecodeSignatureFromCLI(signature)
+	sig, _ := videoRenderingCrypto.DecodeSignatureFromCLI(videoRenderingCrypto.EncodeSignatureForCLI(signature))
 	valid := pk.VerifySignature(message, sig)
 	if !valid {
 		t.Error("Signature Not valid")
 	} else {
 		t.Log("Signature is valid")
 	}
+
+	// the same signature is not valid for another frame
+	doc.FrameNumber = 2
+	message, _ = videoRenderingCrypto.GenerateSignableMessage(doc)
+	if pk.VerifySignature(message, sig) {
+		t.Error("Signature is valid for another frame")
+	}
 }
 
 func TestPublicKey(t *testing.T) {
-	message, err := videoRenderingCrypto.GenerateSignableMessage("QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", "janction1rkzs8h4w5dj07fhpcc2x607nj5905vd98qyl2u")
+	message, err := videoRenderingCrypto.GenerateSignableMessage(&videoRendering.FrameSignDoc{Hash: "QmRe3MVV1NeF84sgiBCeKBhwDGFVcyLPzcky4fN2cKvTzs", WorkerAddress: "janction1rkzs8h4w5dj07fhpcc2x607nj5905vd98qyl2u"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -137,3 +162,51 @@ func TestPublicKey(t *testing.T) {
 		t.Error("Not valid")
 	}
 }
+
+func TestPublicKeyTypesForCLI(t *testing.T) {
+	r1, err := secp256r1.GenPrivKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKeys := []types.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), r1}
+	message := []byte("Validate file possession")
+
+	for _, privKey := range privKeys {
+		signature, err := privKey.Sign(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded := videoRenderingCrypto.EncodePublicKeyForCLI(privKey.PubKey())
+		if !strings.HasPrefix(encoded, privKey.Type()+":") {
+			t.Errorf("public key %s is not prefixed with its type", encoded)
+		}
+
+		pubKey, err := videoRenderingCrypto.DecodePublicKeyFromCLI(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !pubKey.Equals(privKey.PubKey()) {
+			t.Errorf("decoded %s key is not the same", privKey.Type())
+		}
+		if !pubKey.VerifySignature(message, signature) {
+			t.Errorf("%s signature is not valid", privKey.Type())
+		}
+	}
+}
+
+func TestLegacyPublicKeyForCLI(t *testing.T) {
+	// keys encoded before type prefixes were added are secp256k1
+	pubKey, err := videoRenderingCrypto.DecodePublicKeyFromCLI("AgIg7GgQWR8l4ea7LvUgNuHOWlCIN2fjKUO7ERB/1Sed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pubKey.Type() != "secp256k1" {
+		t.Errorf("legacy public key decoded as %s", pubKey.Type())
+	}
+
+	if _, err := videoRenderingCrypto.DecodePublicKeyFromCLI("sr25519:AgIg7GgQWR8l4ea7LvUgNuHOWlCIN2fjKUO7ERB/1Sed"); err == nil {
+		t.Error("unsupported key type decoded")
+	}
+}
@@ -3,15 +3,19 @@ package videoRenderingCrypto
 import (
 	"crypto/sha256"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/janction/videoRendering/videoRenderingLogger"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 // Loads the janctiond Keyring
@@ -105,17 +109,10 @@ func ExtractPublicKey(rootDir, alias string, codec codec.Codec) (types.PubKey, e
 	return pubKey, nil
 }
 
-// Generate the message to sign
-type SignableMessage struct {
-	Hash          string `json:"hash"`
-	WorkerAddress string `json:"worker_address"`
-}
-
-func GenerateSignableMessage(hash, workerAddr string) ([]byte, error) {
-	msg := SignableMessage{WorkerAddress: workerAddr, Hash: hash}
-
+// Generate the message to sign from a canonical protobuf document
+func GenerateSignableMessage(doc proto.Message) ([]byte, error) {
 	// Serialize the message using Protobuf
-	msgBytes, err := json.Marshal(msg)
+	msgBytes, err := proto.Marshal(doc)
 	if err != nil {
 		return nil, err
 	}
@@ -135,22 +132,49 @@ func DecodeSignatureFromCLI(encodedSig string) ([]byte, error) {
 	return base64.StdEncoding.DecodeString(encodedSig)
 }
 
+// Encodes the public key for CLI usage as <key type>:<base64 key bytes>
 func EncodePublicKeyForCLI(publicKey types.PubKey) string {
-	return base64.StdEncoding.EncodeToString(publicKey.Bytes())
+	return publicKey.Type() + ":" + base64.StdEncoding.EncodeToString(publicKey.Bytes())
 }
 
+// Decodes a public key encoded with EncodePublicKeyForCLI.
+// Keys without a type prefix are secp256k1 keys, as encoded by older versions.
 func DecodePublicKeyFromCLI(encodedPubKey string) (types.PubKey, error) {
+	keyType := "secp256k1"
+	if parts := strings.SplitN(encodedPubKey, ":", 2); len(parts) == 2 {
+		keyType, encodedPubKey = parts[0], parts[1]
+	}
+
 	decoded, err := base64.StdEncoding.DecodeString(encodedPubKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode base64 public key: %w", err)
 	}
-	return fromBytes(decoded)
+	return fromBytes(keyType, decoded)
 }
 
-// FromBytes converts a byte slice back to a types.PubKey
-func fromBytes(pubKeyBytes []byte) (types.PubKey, error) {
-	// Create the pubKey from the byte slice (secp256k1 in this case)
-	var _ types.PubKey = (*secp256k1.PubKey)(nil)
-	pubKey := &secp256k1.PubKey{Key: pubKeyBytes}
-	return pubKey, nil
+// FromBytes converts a byte slice back to a types.PubKey of the given type
+func fromBytes(keyType string, pubKeyBytes []byte) (types.PubKey, error) {
+	switch keyType {
+	case "secp256k1":
+		if len(pubKeyBytes) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("invalid secp256k1 public key size %v", len(pubKeyBytes))
+		}
+		return &secp256k1.PubKey{Key: pubKeyBytes}, nil
+	case "ed25519":
+		if len(pubKeyBytes) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid ed25519 public key size %v", len(pubKeyBytes))
+		}
+		return &ed25519.PubKey{Key: pubKeyBytes}, nil
+	case "secp256r1":
+		// secp256r1 keys can only be built from their protobuf encoding
+		pubKey := &secp256r1.PubKey{}
+		bz := protowire.AppendTag(nil, 1, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, pubKeyBytes)
+		if err := pubKey.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf("invalid secp256r1 public key: %w", err)
+		}
+		return pubKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %s", keyType)
+	}
 }
@@ -49,6 +49,35 @@ type IPFS struct {
 	Added   bool
 }
 
+// Commitment stores the salt this node used to commit to the frames of a thread
+type Commitment struct {
+	ThreadId string
+	Salt     string
+	Revealed bool
+}
+
+// Assembly stores if this node started assembling, or confirming, the video of a task. Reassigned assemblies are
+// stored by the id of their attempt
+type Assembly struct {
+	TaskId  string
+	Started bool
+}
+
+// Preview stores if this node started the preview pass of a task
+type Preview struct {
+	TaskId  string
+	Started bool
+}
+
+// RendererImage stores the image this node renders a thread with, selected once when the thread starts
+type RendererImage struct {
+	ThreadId       string
+	Name           string
+	BlenderVersion string
+	Architecture   string
+	Digest         string
+}
+
 // DB encapsulates the database connection.
 type DB struct {
 	conn *sql.DB
@@ -58,6 +87,15 @@ type Database interface {
 	UpdateTask(taskId, threadId string, completed bool) error
 	UpdateThread(id string, downloadStarted, downloadCompleted, workStarted, workCompleted, solProposed, verificationStarted, solutionRevealed bool, submitionStarted bool) error
 	AddLogEntry(threadId, log string, timestamp, severity int64) error
+	AddRenderDuration(threadId string, threadNumber, durationInSeconds int) error
+	GetAverageRenderTime(threadId string) (int, error)
+	ReadCommitment(threadId string) (*Commitment, error)
+	UpdateCommitment(threadId, salt string, revealed bool) error
+	UpdateAssembly(taskId string, started bool) error
+	AddRenderedFrame(threadId string, frameNumber int64) error
+	ReadRenderedFrames(threadId string) (map[int64]bool, error)
+	AddReportedFrame(threadId string, frameNumber int64) error
+	ReadReportedFrames(threadId string) (map[int64]bool, error)
 }
 
 // Init initializes the SQLite database and creates the threads table.
@@ -109,6 +147,40 @@ func Init(databasePath string) (*DB, error) {
 		frame_number NUMBER,
 		render_duration NUMBER
 	);
+	CREATE TABLE IF NOT EXISTS commitments (
+		thread_id TEXT PRIMARY KEY,
+		salt TEXT,
+		revealed BOOLEAN
+	);
+	CREATE TABLE IF NOT EXISTS assemblies (
+		task_id TEXT PRIMARY KEY,
+		started BOOLEAN
+	);
+
+	CREATE TABLE IF NOT EXISTS rendered_frames (
+		thread_id TEXT,
+		frame_number INTEGER,
+		PRIMARY KEY (thread_id, frame_number)
+	);
+
+	CREATE TABLE IF NOT EXISTS reported_frames (
+		thread_id TEXT,
+		frame_number INTEGER,
+		PRIMARY KEY (thread_id, frame_number)
+	);
+
+	CREATE TABLE IF NOT EXISTS previews (
+		task_id TEXT PRIMARY KEY,
+		started BOOLEAN
+	);
+
+	CREATE TABLE IF NOT EXISTS renderer_images (
+		thread_id TEXT PRIMARY KEY,
+		name TEXT,
+		blender_version TEXT,
+		architecture TEXT,
+		digest TEXT
+	);
     `
 
 	if _, err := db.Exec(createTables); err != nil {
@@ -201,6 +273,17 @@ func (db *DB) UpdateThread(id string, downloadStarted, downloadCompleted, workSt
 	return nil
 }
 
+// ResetInterruptedWork marks the downloads and renders that were running when the node stopped as stopped, since they don't
+// survive it, so they're started again. Renders resume from the frames recorded as rendered
+func (db *DB) ResetInterruptedWork() error {
+	updateQuery := `UPDATE threads SET download_started = download_completed, work_started = false WHERE work_started = true AND work_completed = false`
+	_, err := db.conn.Exec(updateQuery)
+	if err != nil {
+		return fmt.Errorf("failed to reset interrupted work: %w", err)
+	}
+	return nil
+}
+
 // Deletethread deletes a thread by ID.
 func (db *DB) DeleteThread(id string) error {
 	deleteQuery := `DELETE FROM threads WHERE id = ?`
@@ -313,6 +396,88 @@ func (db *DB) AddRenderDuration(threadId string, threadNumber, durationInSeconds
 	return nil
 }
 
+// AddRenderedFrame records that a frame of the thread was rendered and its file verified
+func (db *DB) AddRenderedFrame(threadId string, frameNumber int64) error {
+	insertQuery := `INSERT OR IGNORE INTO rendered_frames (thread_id, frame_number) VALUES (?,?)`
+	_, err := db.conn.Exec(insertQuery, threadId, frameNumber)
+	if err != nil {
+		return fmt.Errorf("failed to insert rendered frame: %w", err)
+	}
+	return nil
+}
+
+// ReadRenderedFrames returns the frames of the thread recorded as rendered
+func (db *DB) ReadRenderedFrames(threadId string) (map[int64]bool, error) {
+	query := `SELECT frame_number FROM rendered_frames WHERE thread_id = ?`
+	rows, err := db.conn.Query(query, threadId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rendered frames: %w", err)
+	}
+	defer rows.Close()
+
+	frames := make(map[int64]bool)
+	for rows.Next() {
+		var frame int64
+		if err := rows.Scan(&frame); err != nil {
+			return nil, fmt.Errorf("failed to read rendered frames: %w", err)
+		}
+		frames[frame] = true
+	}
+	return frames, rows.Err()
+}
+
+// AddReportedFrame records that this node reported a frame of the thread as unrenderable, so it isn't rendered nor
+// reported again while the report is on its way to the chain
+func (db *DB) AddReportedFrame(threadId string, frameNumber int64) error {
+	insertQuery := `INSERT OR IGNORE INTO reported_frames (thread_id, frame_number) VALUES (?,?)`
+	_, err := db.conn.Exec(insertQuery, threadId, frameNumber)
+	if err != nil {
+		return fmt.Errorf("failed to insert reported frame: %w", err)
+	}
+	return nil
+}
+
+// ReadReportedFrames returns the frames of the thread this node reported as unrenderable
+func (db *DB) ReadReportedFrames(threadId string) (map[int64]bool, error) {
+	query := `SELECT frame_number FROM reported_frames WHERE thread_id = ?`
+	rows, err := db.conn.Query(query, threadId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reported frames: %w", err)
+	}
+	defer rows.Close()
+
+	frames := make(map[int64]bool)
+	for rows.Next() {
+		var frame int64
+		if err := rows.Scan(&frame); err != nil {
+			return nil, fmt.Errorf("failed to read reported frames: %w", err)
+		}
+		frames[frame] = true
+	}
+	return frames, rows.Err()
+}
+
+// ReadRenderDurations returns the last recorded render seconds of every frame rendered for the thread
+func (db *DB) ReadRenderDurations(threadId string) (map[int64]int, error) {
+	query := `SELECT frame_number, render_duration FROM render_times WHERE thread_id = ? ORDER BY rowid`
+	rows, err := db.conn.Query(query, threadId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read render durations: %w", err)
+	}
+	defer rows.Close()
+
+	durations := make(map[int64]int)
+	for rows.Next() {
+		var frame int64
+		var duration int
+		if err := rows.Scan(&frame, &duration); err != nil {
+			return nil, fmt.Errorf("failed to read render durations: %w", err)
+		}
+		durations[frame] = duration
+	}
+	return durations, rows.Err()
+}
+
 // inserts a new log entry
 func (db *DB) GetAverageRenderTime(threadId string) (int, error) {
 	query := `SELECT CAST(AVG(render_duration) AS INT)  FROM render_times WHERE thread_id = ?`
@@ -326,3 +491,107 @@ func (db *DB) GetAverageRenderTime(threadId string) (int, error) {
 
 	return avg, nil
 }
+
+// ReadCommitment retrieves the commitment of a thread. An empty commitment is returned if there is none yet
+func (db *DB) ReadCommitment(threadId string) (*Commitment, error) {
+	query := `SELECT thread_id, salt, revealed FROM commitments WHERE thread_id = ?`
+	row := db.conn.QueryRow(query, threadId)
+
+	var commitment Commitment
+	if err := row.Scan(&commitment.ThreadId, &commitment.Salt, &commitment.Revealed); err != nil {
+		if err == sql.ErrNoRows {
+			return &Commitment{ThreadId: threadId}, nil
+		}
+		return nil, fmt.Errorf("failed to read commitment: %w", err)
+	}
+
+	return &commitment, nil
+}
+
+// UpdateCommitment inserts or updates the commitment of a thread
+func (db *DB) UpdateCommitment(threadId, salt string, revealed bool) error {
+	upsertQuery := `INSERT INTO commitments (thread_id, salt, revealed) VALUES (?,?,?) ON CONFLICT(thread_id) DO UPDATE SET salt = excluded.salt, revealed = excluded.revealed`
+	_, err := db.conn.Exec(upsertQuery, threadId, salt, revealed)
+	if err != nil {
+		return fmt.Errorf("failed to update commitment: %w", err)
+	}
+	return nil
+}
+
+// ReadAssembly retrieves the assembly of a task. An assembly that isn't started is returned if there is none yet
+func (db *DB) ReadAssembly(taskId string) (*Assembly, error) {
+	query := `SELECT task_id, started FROM assemblies WHERE task_id = ?`
+	row := db.conn.QueryRow(query, taskId)
+
+	var assembly Assembly
+	if err := row.Scan(&assembly.TaskId, &assembly.Started); err != nil {
+		if err == sql.ErrNoRows {
+			return &Assembly{TaskId: taskId}, nil
+		}
+		return nil, fmt.Errorf("failed to read assembly: %w", err)
+	}
+
+	return &assembly, nil
+}
+
+// UpdateAssembly inserts or updates the assembly of a task
+func (db *DB) UpdateAssembly(taskId string, started bool) error {
+	upsertQuery := `INSERT INTO assemblies (task_id, started) VALUES (?,?) ON CONFLICT(task_id) DO UPDATE SET started = excluded.started`
+	_, err := db.conn.Exec(upsertQuery, taskId, started)
+	if err != nil {
+		return fmt.Errorf("failed to update assembly: %w", err)
+	}
+	return nil
+}
+
+// ReadPreview retrieves the preview pass of a task. A preview that isn't started is returned if there is none yet
+func (db *DB) ReadPreview(taskId string) (*Preview, error) {
+	query := `SELECT task_id, started FROM previews WHERE task_id = ?`
+	row := db.conn.QueryRow(query, taskId)
+
+	var preview Preview
+	if err := row.Scan(&preview.TaskId, &preview.Started); err != nil {
+		if err == sql.ErrNoRows {
+			return &Preview{TaskId: taskId}, nil
+		}
+		return nil, fmt.Errorf("failed to read preview: %w", err)
+	}
+
+	return &preview, nil
+}
+
+// UpdatePreview inserts or updates the preview pass of a task
+func (db *DB) UpdatePreview(taskId string, started bool) error {
+	upsertQuery := `INSERT INTO previews (task_id, started) VALUES (?,?) ON CONFLICT(task_id) DO UPDATE SET started = excluded.started`
+	_, err := db.conn.Exec(upsertQuery, taskId, started)
+	if err != nil {
+		return fmt.Errorf("failed to update preview: %w", err)
+	}
+	return nil
+}
+
+// ReadRendererImage retrieves the image a thread is rendered with. An image without digest is returned if there is none yet
+func (db *DB) ReadRendererImage(threadId string) (*RendererImage, error) {
+	query := `SELECT thread_id, name, blender_version, architecture, digest FROM renderer_images WHERE thread_id = ?`
+	row := db.conn.QueryRow(query, threadId)
+
+	var image RendererImage
+	if err := row.Scan(&image.ThreadId, &image.Name, &image.BlenderVersion, &image.Architecture, &image.Digest); err != nil {
+		if err == sql.ErrNoRows {
+			return &RendererImage{ThreadId: threadId}, nil
+		}
+		return nil, fmt.Errorf("failed to read renderer image: %w", err)
+	}
+
+	return &image, nil
+}
+
+// UpdateRendererImage inserts or updates the image a thread is rendered with
+func (db *DB) UpdateRendererImage(image RendererImage) error {
+	upsertQuery := `INSERT INTO renderer_images (thread_id, name, blender_version, architecture, digest) VALUES (?,?,?,?,?) ON CONFLICT(thread_id) DO UPDATE SET name = excluded.name, blender_version = excluded.blender_version, architecture = excluded.architecture, digest = excluded.digest`
+	_, err := db.conn.Exec(upsertQuery, image.ThreadId, image.Name, image.BlenderVersion, image.Architecture, image.Digest)
+	if err != nil {
+		return fmt.Errorf("failed to update renderer image: %w", err)
+	}
+	return nil
+}
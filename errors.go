@@ -10,10 +10,26 @@ var (
 	ErrWorkerNotAvailable      = errors.Register(ModuleName, 11, "worker cannot subscribe to task")
 	ErrWorkerTaskNotAvailable  = errors.Register(ModuleName, 12, "task is already completed")
 	ErrWorkerIncorrectStake  = errors.Register(ModuleName, 13, "staked coin is incorrect")
+	ErrInvalidWorkerCapabilities = errors.Register(ModuleName, 14, "invalid worker capabilities")
 
 	ErrInvalidVideoRenderingTask = errors.Register(ModuleName, 20, "invalid video rendering task")
 
 	ErrInvalidSolution = errors.Register(ModuleName, 30, "proposed solution is invalid")
 
 	ErrInvalidVerification = errors.Register(ModuleName, 40, "verification to solution is invalid")
+
+	ErrPublicKeyMismatch = errors.Register(ModuleName, 50, "public key doesn't belong to the sender")
+
+	ErrInvalidThreadPhase = errors.Register(ModuleName, 60, "thread is not in the required phase")
+
+	ErrInvalidAuthority    = errors.Register(ModuleName, 70, "invalid authority")
+	ErrInvalidVerifyingKey = errors.Register(ModuleName, 71, "invalid verifying key")
+
+	ErrInvalidAssembly = errors.Register(ModuleName, 80, "assembled video is invalid")
+
+	ErrInvalidPreview = errors.Register(ModuleName, 90, "preview pass is invalid")
+
+	ErrInvalidRendererImage = errors.Register(ModuleName, 100, "invalid renderer image")
+
+	ErrInvalidFailureReport = errors.Register(ModuleName, 110, "invalid task failure report")
 )
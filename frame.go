@@ -1,5 +1,85 @@
 package videoRendering
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/janction/videoRendering/vm"
+)
+
+const (
+	// current version of the frame sign doc
+	FrameSignDocVersion = 1
+	// domain separator of the frame signatures of this module
+	FrameSignDocDomain = "janction/videoRendering/frame"
+	// algorithm of the first version of the pixel hashes
+	FrameHashAlgorithm = "sha256-rgba8-pixels"
+	// appended to the pixel hash algorithm when frame digests are compared perceptually
+	FramePerceptualAlgorithmSuffix = "+luma-blockmean8-tiles4"
+	// separates the pixel hash from the fingerprint in a frame digest
+	frameDigestSeparator = "."
+)
+
+// FrameDigest returns the value workers commit to and sign when frames are compared perceptually: the pixel hash and its fingerprint
+func FrameDigest(hash string, fingerprint FrameFingerprint) string {
+	return hash + frameDigestSeparator + fingerprint.String()
+}
+
+// ParseFrameDigest splits a frame digest in its pixel hash and fingerprint
+func ParseFrameDigest(digest string) (string, FrameFingerprint, error) {
+	hash, encoded, found := strings.Cut(digest, frameDigestSeparator)
+	if !found {
+		return "", FrameFingerprint{}, fmt.Errorf("frame digest %s doesn't have a fingerprint", digest)
+	}
+	fingerprint, err := ParseFrameFingerprint(encoded)
+	return hash, fingerprint, err
+}
+
+// Validate returns an error if the tolerance of a perceptual comparison is out of range
+func (c *FrameComparison) Validate() error {
+	if c == nil || c.Mode == FrameComparison_EXACT {
+		return nil
+	}
+	if c.MaxHashDistance > 64 {
+		return fmt.Errorf("max hash distance must be at most 64, got %v", c.MaxHashDistance)
+	}
+	if c.MinTileSimilarity > 1000 {
+		return fmt.Errorf("min tile similarity is per mille, got %v", c.MinTileSimilarity)
+	}
+	return nil
+}
+
+// IsPerceptual returns true if frames are compared with their fingerprints
+func (c *FrameComparison) IsPerceptual() bool {
+	return c != nil && c.Mode == FrameComparison_PERCEPTUAL
+}
+
+// Matches returns true if the frame digest of a validator is close enough to the one of the solution.
+// Identical pixel hashes always match.
+func (c *FrameComparison) Matches(solution, validation string) bool {
+	if solution == validation {
+		return true
+	}
+	if !c.IsPerceptual() {
+		return false
+	}
+
+	solutionHash, solutionFingerprint, err := ParseFrameDigest(solution)
+	if err != nil {
+		return false
+	}
+	validationHash, validationFingerprint, err := ParseFrameDigest(validation)
+	if err != nil {
+		return false
+	}
+	if solutionHash == validationHash {
+		return true
+	}
+
+	return solutionFingerprint.HashDistance(validationFingerprint) <= int(c.MaxHashDistance) &&
+		solutionFingerprint.TileSimilarity(validationFingerprint) >= int(c.MinTileSimilarity)
+}
+
 // finds an specific Frame from the Frames slice
 func GetFrame(frames []*VideoRenderingThread_Frame, filename string) *VideoRenderingThread_Frame {
 	for _, frame := range frames {
@@ -9,3 +89,68 @@ func GetFrame(frames []*VideoRenderingThread_Frame, filename string) *VideoRende
 	}
 	return nil
 }
+
+// PixelHashVersion returns the version of the pixel hashes of the thread: the one of its solution,
+// or the current one while there is no solution yet
+func (t VideoRenderingThread) PixelHashVersion() string {
+	if t.Solution == nil {
+		return CurrentPixelHashVersion
+	}
+	if t.Solution.HashVersion == "" {
+		// solutions proposed before hashes were versioned
+		return PixelHashV1
+	}
+	return t.Solution.HashVersion
+}
+
+// NewFrameSignDoc generates the document the worker signs for a frame of the thread
+func (t VideoRenderingThread) NewFrameSignDoc(chainId, filename, hash, workerAddress string) (*FrameSignDoc, error) {
+	frameNumber, err := vm.ParseFrameFilename(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, ok := PixelHashAlgorithm(t.PixelHashVersion())
+	if !ok {
+		return nil, fmt.Errorf("unknown pixel hash version %s", t.PixelHashVersion())
+	}
+	if t.Comparison.IsPerceptual() {
+		algorithm += FramePerceptualAlgorithmSuffix
+	}
+
+	// frames are signed with the settings of the solution, so validations rendered under other settings aren't valid
+	var renderSettings string
+	if t.Solution != nil {
+		renderSettings = t.Solution.RenderSettings
+	}
+
+	return &FrameSignDoc{
+		Version:        FrameSignDocVersion,
+		Domain:         FrameSignDocDomain,
+		ChainId:        chainId,
+		TaskId:         t.TaskId,
+		ThreadId:       t.ThreadId,
+		FrameNumber:    frameNumber,
+		Hash:           hash,
+		HashAlgorithm:  algorithm,
+		WorkerAddress:  workerAddress,
+		RenderSettings: renderSettings,
+	}, nil
+}
+
+// directoryHashes computes what the worker commits to for every frame at dirPath: the pixel hashes of the version, or the
+// frame digests when the thread compares frames perceptually
+func (t VideoRenderingThread) directoryHashes(dirPath string, version string, renderer vm.Renderer) (map[string]string, error) {
+	if t.Comparison.IsPerceptual() {
+		return GenerateDirectoryFrameDigests(dirPath, version, t.FrameExtension(), renderer)
+	}
+	return GenerateDirectoryFileHashes(dirPath, version, t.FrameExtension(), renderer)
+}
+
+// sampledFrameHashes computes what the worker commits to for the sampled frames at dirPath, like directoryHashes
+func (t VideoRenderingThread) sampledFrameHashes(dirPath string, renderer vm.Renderer) (map[string]string, error) {
+	if t.Comparison.IsPerceptual() {
+		return GenerateFrameDigests(dirPath, t.SampledFrames, t.PixelHashVersion(), t.FrameExtension(), renderer)
+	}
+	return GenerateFrameHashes(dirPath, t.SampledFrames, t.PixelHashVersion(), t.FrameExtension(), renderer)
+}
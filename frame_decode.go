@@ -0,0 +1,118 @@
+package videoRendering
+
+import (
+	"context"
+	"encoding/binary"
+	fmt "fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/janction/videoRendering/vm"
+)
+
+// frame extensions the image package decodes. Frames of the other output formats are decoded by blender into canonical pixels
+var decodableFrameExtensions = []string{"png", "jpg"}
+
+// size of a canonical pixel: RGBA float32
+const canonicalPixelSize = 16
+
+// canonicalFrame holds the pixels of a frame decoded by blender, as rows of RGBA big endian float32.
+// The floats are kept as they are stored, so hashes cover the full precision of high dynamic range formats.
+type canonicalFrame struct {
+	Pix    []byte
+	Width  int
+	Height int
+}
+
+func (f *canonicalFrame) ColorModel() color.Model {
+	return color.RGBA64Model
+}
+
+func (f *canonicalFrame) Bounds() image.Rectangle {
+	return image.Rect(0, 0, f.Width, f.Height)
+}
+
+// At clamps the pixel to 16 bits, which is only used by the perceptual fingerprint
+func (f *canonicalFrame) At(x, y int) color.Color {
+	if !(image.Point{x, y}.In(f.Bounds())) {
+		return color.RGBA64{}
+	}
+	i := (y*f.Width + x) * canonicalPixelSize
+	var channels [4]uint16
+	for c := range channels {
+		v := math.Float32frombits(binary.BigEndian.Uint32(f.Pix[i+4*c:]))
+		switch {
+		case v >= 1:
+			channels[c] = 0xffff
+		case v > 0:
+			channels[c] = uint16(v*0xffff + 0.5)
+		}
+	}
+	return color.RGBA64{R: channels[0], G: channels[1], B: channels[2], A: channels[3]}
+}
+
+// decodeFrame decodes a frame of any output format. Formats the image package can't read are decoded by blender in the
+// renderer image the frame was rendered with
+func decodeFrame(filePath string, renderer vm.Renderer) (image.Image, error) {
+	extension := strings.TrimPrefix(filepath.Ext(filePath), ".")
+	switch {
+	case slices.Contains(decodableFrameExtensions, extension):
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		img, _, err := image.Decode(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode image: %w", err)
+		}
+		return img, nil
+	case vm.IsFrameExtension(extension):
+		if _, err := os.Stat(filePath); err != nil {
+			return nil, err
+		}
+		return decodeCanonicalFrame(filePath, renderer)
+	default:
+		return nil, fmt.Errorf("failed to decode image: unsupported frame format %s", filePath)
+	}
+}
+
+// decodeCanonicalFrame decodes a frame with blender into canonical pixels
+func decodeCanonicalFrame(filePath string, renderer vm.Renderer) (*canonicalFrame, error) {
+	dir, err := os.MkdirTemp("", "janction-canonical-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	canonicalPath := filepath.Join(dir, filepath.Base(filePath)+".canonical")
+	if err := renderer.DecodeCanonicalFrame(context.Background(), renderer.Image, filePath, canonicalPath); err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	data, err := os.ReadFile(canonicalPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseCanonicalFrame(data)
+}
+
+// parseCanonicalFrame reads the width and height as big endian uint32 followed by the pixels
+func parseCanonicalFrame(data []byte) (*canonicalFrame, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("canonical frame is too short")
+	}
+	width := binary.BigEndian.Uint32(data)
+	height := binary.BigEndian.Uint32(data[4:])
+	if width == 0 || height == 0 || uint64(len(data)-8) != uint64(width)*uint64(height)*canonicalPixelSize {
+		return nil, fmt.Errorf("canonical frame of %vx%v has %v bytes of pixels", width, height, len(data)-8)
+	}
+	return &canonicalFrame{Pix: data[8:], Width: int(width), Height: int(height)}, nil
+}
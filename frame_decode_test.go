@@ -0,0 +1,90 @@
+package videoRendering
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// encodes a canonical frame of the given RGBA float pixels
+func canonicalFrameBytes(width, height uint32, pixels ...float32) []byte {
+	data := binary.BigEndian.AppendUint32(nil, width)
+	data = binary.BigEndian.AppendUint32(data, height)
+	for _, p := range pixels {
+		data = binary.BigEndian.AppendUint32(data, math.Float32bits(p))
+	}
+	return data
+}
+
+// --- Test for parseCanonicalFrame ---
+func TestParseCanonicalFrame(t *testing.T) {
+	frame, err := parseCanonicalFrame(canonicalFrameBytes(2, 1, 0.5, 0.25, 2, 1, -1, 0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, 2, frame.Bounds().Dx())
+	require.Equal(t, 1, frame.Bounds().Dy())
+
+	// values are clamped to 16 bits for the fingerprint
+	r, g, b, a := frame.At(0, 0).RGBA()
+	require.Equal(t, []uint32{0x8000, 0x4000, 0xffff, 0xffff}, []uint32{r, g, b, a})
+	r, _, _, _ = frame.At(1, 0).RGBA()
+	require.Equal(t, uint32(0), r)
+
+	_, err = parseCanonicalFrame(canonicalFrameBytes(2, 1, 0.5))
+	require.Error(t, err)
+	_, err = parseCanonicalFrame(canonicalFrameBytes(0, 0))
+	require.Error(t, err)
+	_, err = parseCanonicalFrame([]byte{1})
+	require.Error(t, err)
+}
+
+// --- Test for CalculateFrameHash of formats decoded by blender ---
+func TestCalculateFrameHash_CanonicalFrame(t *testing.T) {
+	dir := t.TempDir()
+	framePath := filepath.Join(dir, vm.FormatFrameFilename(1, "exr"))
+	require.NoError(t, os.WriteFile(framePath, []byte("exr"), 0644))
+
+	pixels := []float32{0.5, 0.25, 1, 1}
+	decodes := 0
+	patch := monkey.Patch((*vm.VM).DecodeCanonicalFrame, func(v *vm.VM, ctx context.Context, renderer vm.RendererImage, framePath string, canonicalPath string) error {
+		decodes++
+		return os.WriteFile(canonicalPath, canonicalFrameBytes(1, 1, pixels...), 0644)
+	})
+	defer patch.Unpatch()
+
+	hash, err := CalculateFileHash(framePath, vm.Renderer{})
+	require.NoError(t, err)
+	version, _, err := ParseFrameHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, PixelHashV2, version)
+
+	// high dynamic range values that clamp to the same color still hash differently
+	pixels = []float32{0.5, 0.25, 4, 1}
+	hdrHash, err := CalculateFileHash(framePath, vm.Renderer{})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, hdrHash)
+
+	// the digest of a perceptual comparison decodes the frame once for the hash and the fingerprint
+	decodes = 0
+	digest, err := CalculateFrameDigest(framePath, CurrentPixelHashVersion, vm.Renderer{})
+	require.NoError(t, err)
+	require.Equal(t, 1, decodes)
+	digestHash, _, err := ParseFrameDigest(digest)
+	require.NoError(t, err)
+	require.Equal(t, hdrHash, digestHash)
+}
+
+func TestCalculateFrameHash_UnsupportedFormat(t *testing.T) {
+	framePath := filepath.Join(t.TempDir(), "frame_000001.gif")
+	require.NoError(t, os.WriteFile(framePath, []byte("gif"), 0644))
+
+	_, err := CalculateFileHash(framePath, vm.Renderer{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported frame format")
+}
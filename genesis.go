@@ -1,6 +1,13 @@
 package videoRendering
 
-// NewGenesisState creates a new genesis state with default values.
+import (
+	"fmt"
+
+	"github.com/janction/videoRendering/zkp"
+)
+
+// NewGenesisState creates a new genesis state with default values. No renderer image is approved, so workers render
+// with the baseline images until governance approves one
 func NewGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:                 DefaultParams(),
@@ -16,5 +23,31 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	versions := make(map[string]bool)
+	for _, key := range gs.VerifyingKeys {
+		if key.CircuitVersion == "" {
+			return fmt.Errorf("verifying key without circuit version")
+		}
+		if versions[key.CircuitVersion] {
+			return fmt.Errorf("duplicate verifying key for circuit version %s", key.CircuitVersion)
+		}
+		versions[key.CircuitVersion] = true
+
+		if err := zkp.ValidateVerifyingKey(key.VerifyingKey); err != nil {
+			return fmt.Errorf("circuit version %s: %w", key.CircuitVersion, err)
+		}
+	}
+
+	digests := make(map[string]bool)
+	for _, image := range gs.RendererImages {
+		if err := image.Validate(); err != nil {
+			return err
+		}
+		if digests[image.Digest] {
+			return fmt.Errorf("duplicate renderer image %s", image.Digest)
+		}
+		digests[image.Digest] = true
+	}
+
 	return nil
 }
@@ -1,19 +1,11 @@
 package ipfs
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"strings"
-	"time"
 
-	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/janction/videoRendering/videoRenderingLogger"
 )
 
@@ -25,11 +17,8 @@ func IPFSGet(cid string, path string) error {
 		return err
 	}
 
-	// Connect to the local IPFS node (ensure IPFS is running on localhost:5001)
-	sh := shell.NewShell("127.0.0.1:5001")
-
 	// Download the file from IPFS using the CID
-	err = sh.Get(cid, path)
+	err = store.Get(cid, path)
 	if err != nil {
 		videoRenderingLogger.Logger.Error("Error Downloading IPFS %s: %s", cid, err.Error())
 		return err
@@ -39,7 +28,7 @@ func IPFSGet(cid string, path string) error {
 	return nil
 }
 
-// CalculateCIDs recursively computes the CIDs of a directory and its contents using `ipfs add --only-hash --recursive`
+// CalculateCIDs recursively computes the CIDs of the files of a directory, without adding them
 func CalculateCIDs(dirPath string) (map[string]string, error) {
 	cidMap := make(map[string]string)
 
@@ -57,21 +46,13 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 			return nil
 		}
 
-		// Execute the IPFS add command with -Q and --only-hash to get the CID
-		cmd := exec.Command("ipfs", "add", "-Q", "--only-hash", path)
-		var out bytes.Buffer
-		cmd.Stdout = &out
-		cmd.Stderr = &out
-
-		if err := cmd.Run(); err != nil {
-			fail := fmt.Errorf("failed to calculate CID for %s: %s, %w", path, out.String(), err)
-			videoRenderingLogger.Logger.Error(fail.Error())
-			return fail
+		cid, err := store.Hash(path)
+		if err != nil {
+			return err
 		}
 
 		// Extract only the file name and add the result to the map
 		fileName := filepath.Base(path)
-		cid := strings.TrimSpace(out.String())
 		cidMap[fileName] = cid
 		return nil
 	})
@@ -84,9 +65,6 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 }
 
 func UploadSolution(ctx context.Context, rootPath, threadId string) (string, error) {
-	// Connect to the IPFS daemon
-	sh := shell.NewShell("localhost:5001") // Replace with your IPFS API address
-
 	// Construct the path to the thread's output files
 	threadOutputPath := filepath.Join(rootPath, "renders", threadId, "output")
 
@@ -103,7 +81,7 @@ func UploadSolution(ctx context.Context, rootPath, threadId string) (string, err
 		return "", fail
 	}
 
-	cid, err := sh.AddDir(threadOutputPath)
+	cid, err := store.AddDir(threadOutputPath)
 	if err != nil {
 		fail := fmt.Errorf("failed to upload files for threadId %s: %w", threadId, err)
 		videoRenderingLogger.Logger.Error(fail.Error())
@@ -113,52 +91,24 @@ func UploadSolution(ctx context.Context, rootPath, threadId string) (string, err
 	return cid, nil
 }
 
-// CheckIPFSStatus pings the IPFS daemon to check if it's running
-func CheckIPFSStatus() error {
-	client := http.Client{
-		Timeout: 2 * time.Second, // Set timeout to avoid long waits
-	}
-
-	req, err := http.NewRequest("POST", "http://localhost:5001/api/v0/id", nil) // Use POST
-	if err != nil {
-		fail := fmt.Errorf("failed to create request: %v", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return fail
-	}
-
-	resp, err := client.Do(req)
-	if err != nil {
-		fail := fmt.Errorf("IPFS node unreachable: %v", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return fail
-	}
-	defer resp.Body.Close()
+// CalculateCID computes the CID of a single file, without adding it
+func CalculateCID(path string) (string, error) {
+	return store.Hash(path)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		fail := fmt.Errorf("IPFS node returned non-200 status: %d", resp.StatusCode)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return fail
-	}
+// UploadFile adds a single file to IPFS and returns its CID
+func UploadFile(path string) (string, error) {
+	return store.Add(path)
+}
 
-	videoRenderingLogger.Logger.Info("✅ IPFS node is running")
-	return nil
+// CheckIPFSStatus pings the IPFS daemon to check if it's running
+func CheckIPFSStatus() error {
+	return store.Status()
 }
 
 // StartIPFS attempts to start the IPFS daemon
 func StartIPFS() error {
-	cmd := exec.Command("ipfs", "daemon")
-	cmd.Stdout = nil // You can redirect this if needed
-	cmd.Stderr = nil // You can log errors if needed
-
-	err := cmd.Start() // Start IPFS as a background process
-	if err != nil {
-		fail := fmt.Errorf("failed to start IPFS daemon: %v", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return fail
-	}
-
-	videoRenderingLogger.Logger.Info("IPFS daemon started successfully")
-	return nil
+	return store.Start()
 }
 
 // EnsureIPFSRunning checks and starts IPFS if needed
@@ -175,85 +125,24 @@ func EnsureIPFSRunning() {
 	}
 }
 
-// ListDirectory runs `ipfs ls {cid}` and returns a map[filename]CID with a 4s timeout.
+// ListDirectory returns a map[filename]CID of the files of the directory with the cid
 func ListDirectory(cid string) (map[string]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, "ipfs", "ls", cid) // Use context for timeout
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	err := cmd.Run()
-	if ctx.Err() == context.DeadlineExceeded {
-		fail := fmt.Errorf("timeout: ipfs ls command took too long")
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return nil, fail
-	}
-	if err != nil {
-		fail := fmt.Errorf("failed to execute ipfs ls: %v", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return nil, fail
-	}
-
-	result := make(map[string]string)
-	scanner := bufio.NewScanner(&out)
-
-	for scanner.Scan() {
-		fields := strings.Fields(scanner.Text())
-		if len(fields) < 3 {
-			continue // Ensure it has CID, size, and filename
-		}
-		cid := fields[0]
-		filename := fields[2]
-		result[filename] = cid
-	}
-
-	if err := scanner.Err(); err != nil {
-		fail := fmt.Errorf("error reading command output: %v", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return nil, fail
-	}
-
-	return result, nil
+	return store.List(cid)
 }
 
 // Function to connect to IPFS nodes
 func ConnectToIPFSNode(ip, peerId string) {
 	seed, _ := GenerateSwarmConnectURL(ip, peerId)
-	cmd := exec.Command("ipfs", "swarm", "connect", seed)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		videoRenderingLogger.Logger.Error("Failed to connect to %s: %v\nOutput: %s", seed, err, output)
+	if err := store.Connect(seed); err != nil {
+		videoRenderingLogger.Logger.Error("Failed to connect to %s: %v", seed, err)
 	} else {
 		videoRenderingLogger.Logger.Info("Connected to IPFS node: %s\n", seed)
 	}
 }
 
-// IPFSIDResponse represents the structure of the `ipfs id` JSON response.
-type IPFSIDResponse struct {
-	ID string `json:"ID"`
-}
-
-// GetIPFSPeerID runs `ipfs id` and extracts the Peer ID.
+// GetIPFSPeerID returns the Peer ID of the IPFS node.
 func GetIPFSPeerID() (string, error) {
-	cmd := exec.Command("ipfs", "id")
-	output, err := cmd.Output()
-	if err != nil {
-		fail := fmt.Errorf("failed to run ipfs id: %w", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return "", fail
-	}
-
-	var response IPFSIDResponse
-	if err := json.Unmarshal(output, &response); err != nil {
-		fail := fmt.Errorf("failed to parse ipfs id output: %w", err)
-		videoRenderingLogger.Logger.Error(fail.Error())
-		return "", fail
-	}
-
-	return response.ID, nil
+	return store.PeerID()
 }
 
 // GenerateSwarmConnectURL creates the full IPFS swarm connect URL.
@@ -272,3 +161,14 @@ func IsDownloadStarted(dir string) bool {
 
 	return len(entries) > 0 // true if there's at least one file or subdir
 }
+
+// IsDownloaded returns true if the file of the cid was already downloaded into dir, and its content still matches the cid,
+// so a restarted thread doesn't download it again
+func IsDownloaded(cid string, dir string) bool {
+	path := filepath.Join(dir, cid)
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	local, err := CalculateCID(path)
+	return err == nil && local == cid
+}
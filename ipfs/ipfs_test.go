@@ -14,6 +14,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -270,7 +271,8 @@ func TestCheckIPFSStatus_BadRequest(t *testing.T) {
 }
 
 func TestCheckIPFSStatus_RequestCreationFails(t *testing.T) {
-	patch := monkey.Patch(http.NewRequest, func(method, url string, body io.Reader) (*http.Request, error) {
+	// http.NewRequest is inlined, the request is created by NewRequestWithContext
+	patch := monkey.Patch(http.NewRequestWithContext, func(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
 		return nil, fmt.Errorf("mock NewRequest error")
 	})
 	defer patch.Unpatch()
@@ -321,21 +323,35 @@ func TestStartIPFS(t *testing.T) {
 	})
 }
 
+// statusStore is an in memory store whose daemon status and start are the functions that are set
+type statusStore struct {
+	*MemoryStore
+	status func() error
+	start  func() error
+}
+
+func (s statusStore) Status() error {
+	return s.status()
+}
+
+func (s statusStore) Start() error {
+	return s.start()
+}
+
+func useStatusStore(t *testing.T, status func() error, start func() error) {
+	previous := SetStore(statusStore{MemoryStore: NewMemoryStore(), status: status, start: start})
+	t.Cleanup(func() { SetStore(previous) })
+}
+
 func TestEnsureIPFSRunning(t *testing.T) {
 	// Test case: IPFS is already running
 	t.Run("IPFS is already running", func(t *testing.T) {
-		// Mock CheckIPFSStatus to simulate a running IPFS daemon by returning nil
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
+		useStatusStore(t, func() error {
 			return nil
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to detect if it's called when it shouldn't be
-		patchStart := monkey.Patch(StartIPFS, func() error {
+		}, func() error {
 			t.Error("StartIPFS should not be called when IPFS is already running")
 			return nil
 		})
-		defer patchStart.Unpatch()
 
 		EnsureIPFSRunning()
 	})
@@ -345,19 +361,14 @@ func TestEnsureIPFSRunning(t *testing.T) {
 		checkCalls := 0
 		startCalls := 0
 
-		// Mock CheckIPFSStatus to simulate a stopped IPFS daemon by returning an error
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
+		// the daemon is stopped, and starts successfully
+		useStatusStore(t, func() error {
 			checkCalls++
 			return fmt.Errorf("IPFS is not running")
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to simulate successful start by returning nil
-		patchStart := monkey.Patch(StartIPFS, func() error {
+		}, func() error {
 			startCalls++
 			return nil
 		})
-		defer patchStart.Unpatch()
 
 		EnsureIPFSRunning()
 
@@ -376,19 +387,14 @@ func TestEnsureIPFSRunning(t *testing.T) {
 		checkCalls := 0
 		startCalls := 0
 
-		// Mock CheckIPFSStatus to simulate a stopped IPFS daemon by returning an error
-		patchCheck := monkey.Patch(CheckIPFSStatus, func() error {
+		// the daemon is stopped, and fails to start
+		useStatusStore(t, func() error {
 			checkCalls++
 			return fmt.Errorf("IPFS is not running")
-		})
-		defer patchCheck.Unpatch()
-
-		// Mock StartIPFS to simulate a failure in starting by returning an error
-		patchStart := monkey.Patch(StartIPFS, func() error {
+		}, func() error {
 			startCalls++
 			return fmt.Errorf("Failed to start IPFS")
 		})
-		defer patchStart.Unpatch()
 
 		EnsureIPFSRunning()
 
@@ -483,23 +489,12 @@ func TestListDirectory_Timeout(t *testing.T) {
 }
 
 func TestListDirectory_ScannerErrMethod(t *testing.T) {
-	// Patch exec.CommandContext to return a command with empty output
+	// Patch exec.CommandContext to return a command with a line longer than the scanner reads
 	patchCmd := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, args ...string) *exec.Cmd {
-		// out buffer will remain empty
-		return fakeExecCommand("")
+		return fakeExecCommand(strings.Repeat("a", bufio.MaxScanTokenSize+1))
 	})
 	defer patchCmd.Unpatch()
 
-	// Patch bufio.Scanner.Err to simulate a scan error after iteration
-	patchErr := monkey.PatchInstanceMethod(
-		reflect.TypeOf(&bufio.Scanner{}),
-		"Err",
-		func(s *bufio.Scanner) error {
-			return fmt.Errorf("simulated scanner failure")
-		},
-	)
-	defer patchErr.Unpatch()
-
 	// Call under test
 	result, err := ListDirectory("anyCID")
 
@@ -671,3 +666,67 @@ func TestIsDownloadStarted(t *testing.T) {
 		os.RemoveAll(tt.dir)
 	}
 }
+
+func useMemoryStore(t *testing.T) *MemoryStore {
+	memory := NewMemoryStore()
+	previous := SetStore(memory)
+	t.Cleanup(func() { SetStore(previous) })
+	return memory
+}
+
+func TestMemoryStore_Files(t *testing.T) {
+	useMemoryStore(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scene.blend")
+	require.NoError(t, os.WriteFile(path, []byte("scene"), 0644))
+
+	// the cid of an added file is its hash
+	cid, err := UploadFile(path)
+	require.NoError(t, err)
+	hash, err := CalculateCID(path)
+	require.NoError(t, err)
+	require.Equal(t, cid, hash)
+
+	// files are downloaded inside the directory, named by their cid
+	download := filepath.Join(t.TempDir(), "renders", "1")
+	require.NoError(t, IPFSGet(cid, download))
+	data, err := os.ReadFile(filepath.Join(download, cid))
+	require.NoError(t, err)
+	require.Equal(t, "scene", string(data))
+	require.True(t, IsDownloaded(cid, download))
+
+	require.Error(t, IPFSGet("bafkmissing", download))
+}
+
+func TestMemoryStore_Solution(t *testing.T) {
+	useMemoryStore(t)
+	root := t.TempDir()
+	output := filepath.Join(root, "renders", "10", "output")
+	require.NoError(t, os.MkdirAll(output, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(output, "frame_000001.png"), []byte("frame 1"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(output, "frame_000002.png"), []byte("frame 2"), 0644))
+
+	// the listing of the solution has the cids of its frames
+	dir, err := UploadSolution(context.Background(), root, "10")
+	require.NoError(t, err)
+	files, err := ListDirectory(dir)
+	require.NoError(t, err)
+	cids, err := CalculateCIDs(output)
+	require.NoError(t, err)
+	require.Equal(t, cids, files)
+
+	// the same frames are the same directory
+	again, err := UploadSolution(context.Background(), root, "10")
+	require.NoError(t, err)
+	require.Equal(t, dir, again)
+
+	// directories are downloaded at the directory
+	download := t.TempDir()
+	require.NoError(t, IPFSGet(dir, download))
+	data, err := os.ReadFile(filepath.Join(download, "frame_000002.png"))
+	require.NoError(t, err)
+	require.Equal(t, "frame 2", string(data))
+
+	_, err = ListDirectory("bafkmissing")
+	require.Error(t, err)
+}
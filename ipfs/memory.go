@@ -0,0 +1,169 @@
+package ipfs
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+)
+
+// sha2-256 multihash code
+const sha256Multihash = 0x12
+
+// MemoryStore keeps the added files in memory, so nodes of an in-process devnet share files without an IPFS daemon.
+// Files have CIDv1 raw leaves, so Hash matches the CIDs of the files of added directories
+type MemoryStore struct {
+	mu    sync.Mutex
+	files map[string][]byte
+	dirs  map[string]map[string]string
+}
+
+// NewMemoryStore returns an empty in memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{files: make(map[string][]byte), dirs: make(map[string]map[string]string)}
+}
+
+// fileCID returns the CID of the content of a file
+func fileCID(data []byte) (string, error) {
+	c, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: sha256Multihash, MhLength: -1}.Sum(data)
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+// dirCID returns the CID of a directory, derived from the names and CIDs of its entries
+func dirCID(entries map[string]string) (string, error) {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s %s\n", name, entries[name])
+	}
+	c, err := cid.Prefix{Version: 1, Codec: cid.DagProtobuf, MhType: sha256Multihash, MhLength: -1}.Sum(h.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+func (s *MemoryStore) Get(cid string, dir string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// like ipfs get, files are written inside the directory and directories are written at it
+	if _, ok := s.files[cid]; ok {
+		return s.get(cid, filepath.Join(dir, cid))
+	}
+	return s.get(cid, dir)
+}
+
+// get writes the content of the cid at path
+func (s *MemoryStore) get(cid string, path string) error {
+	if data, ok := s.files[cid]; ok {
+		return os.WriteFile(path, data, 0644)
+	}
+	entries, ok := s.dirs[cid]
+	if !ok {
+		return fmt.Errorf("cid %s not found", cid)
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+	for name, entry := range entries {
+		if err := s.get(entry, filepath.Join(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Add(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	cid, err := fileCID(data)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	s.files[cid] = data
+	s.mu.Unlock()
+	return cid, nil
+}
+
+func (s *MemoryStore) AddDir(path string) (string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	listing := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		add := s.Add
+		if entry.IsDir() {
+			add = s.AddDir
+		}
+		cid, err := add(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return "", err
+		}
+		listing[entry.Name()] = cid
+	}
+
+	cid, err := dirCID(listing)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	s.dirs[cid] = listing
+	s.mu.Unlock()
+	return cid, nil
+}
+
+func (s *MemoryStore) Hash(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate CID for %s: %w", path, err)
+	}
+	return fileCID(data)
+}
+
+func (s *MemoryStore) List(cid string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries, ok := s.dirs[cid]
+	if !ok {
+		return nil, fmt.Errorf("directory %s not found", cid)
+	}
+	result := make(map[string]string, len(entries))
+	for name, entry := range entries {
+		result[name] = entry
+	}
+	return result, nil
+}
+
+func (s *MemoryStore) Status() error {
+	return nil
+}
+
+func (s *MemoryStore) Start() error {
+	return nil
+}
+
+func (s *MemoryStore) PeerID() (string, error) {
+	return "memory", nil
+}
+
+func (s *MemoryStore) Connect(address string) error {
+	return nil
+}
@@ -0,0 +1,226 @@
+package ipfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// Store is where the files of tasks and solutions are added and downloaded from
+type Store interface {
+	// Get downloads the content of the cid into the existing dir. Files are written at dir/cid, and the files of
+	// directories are written at dir
+	Get(cid string, dir string) error
+	// Add adds a single file and returns its CID
+	Add(path string) (string, error)
+	// AddDir adds a directory and its files and returns the CID of the directory
+	AddDir(path string) (string, error)
+	// Hash computes the CID of a single file, without adding it
+	Hash(path string) (string, error)
+	// List returns a map[filename]CID of the files of the directory with the cid
+	List(cid string) (map[string]string, error)
+	// Status returns an error if the store can't be used
+	Status() error
+	// Start starts the store
+	Start() error
+	// PeerID returns the id other nodes connect to
+	PeerID() (string, error)
+	// Connect connects to the node at the address
+	Connect(address string) error
+}
+
+// store is the Store used by the package functions
+var store Store = daemonStore{}
+
+// SetStore replaces the store used by the package functions and returns the previous one
+func SetStore(s Store) Store {
+	previous := store
+	store = s
+	return previous
+}
+
+// daemonStore uses the local IPFS daemon, through its API and its cli
+type daemonStore struct{}
+
+func (daemonStore) Get(cid string, dir string) error {
+	// Connect to the local IPFS node (ensure IPFS is running on localhost:5001)
+	sh := shell.NewShell("127.0.0.1:5001")
+	return sh.Get(cid, dir)
+}
+
+func (daemonStore) Add(path string) (string, error) {
+	sh := shell.NewShell("localhost:5001")
+
+	file, err := os.Open(path)
+	if err != nil {
+		fail := fmt.Errorf("failed to open %s: %w", path, err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	defer file.Close()
+
+	cid, err := sh.Add(file)
+	if err != nil {
+		fail := fmt.Errorf("failed to upload %s: %w", path, err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	return cid, nil
+}
+
+func (daemonStore) AddDir(path string) (string, error) {
+	sh := shell.NewShell("localhost:5001")
+	return sh.AddDir(path)
+}
+
+// Hash uses `ipfs add --only-hash`
+func (daemonStore) Hash(path string) (string, error) {
+	cmd := exec.Command("ipfs", "add", "-Q", "--only-hash", path)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		fail := fmt.Errorf("failed to calculate CID for %s: %s, %w", path, out.String(), err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// List runs `ipfs ls {cid}` with a 4s timeout.
+func (daemonStore) List(cid string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "ipfs", "ls", cid) // Use context for timeout
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		fail := fmt.Errorf("timeout: ipfs ls command took too long")
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return nil, fail
+	}
+	if err != nil {
+		fail := fmt.Errorf("failed to execute ipfs ls: %v", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return nil, fail
+	}
+
+	result := make(map[string]string)
+	scanner := bufio.NewScanner(&out)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue // Ensure it has CID, size, and filename
+		}
+		cid := fields[0]
+		filename := fields[2]
+		result[filename] = cid
+	}
+
+	if err := scanner.Err(); err != nil {
+		fail := fmt.Errorf("error reading command output: %v", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return nil, fail
+	}
+
+	return result, nil
+}
+
+// Status pings the API of the daemon
+func (daemonStore) Status() error {
+	client := http.Client{
+		Timeout: 2 * time.Second, // Set timeout to avoid long waits
+	}
+
+	req, err := http.NewRequest("POST", "http://localhost:5001/api/v0/id", nil) // Use POST
+	if err != nil {
+		fail := fmt.Errorf("failed to create request: %v", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return fail
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		fail := fmt.Errorf("IPFS node unreachable: %v", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return fail
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		fail := fmt.Errorf("IPFS node returned non-200 status: %d", resp.StatusCode)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return fail
+	}
+
+	videoRenderingLogger.Logger.Info("✅ IPFS node is running")
+	return nil
+}
+
+// Start runs `ipfs daemon` as a background process
+func (daemonStore) Start() error {
+	cmd := exec.Command("ipfs", "daemon")
+	cmd.Stdout = nil // You can redirect this if needed
+	cmd.Stderr = nil // You can log errors if needed
+
+	err := cmd.Start() // Start IPFS as a background process
+	if err != nil {
+		fail := fmt.Errorf("failed to start IPFS daemon: %v", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return fail
+	}
+
+	videoRenderingLogger.Logger.Info("IPFS daemon started successfully")
+	return nil
+}
+
+// IPFSIDResponse represents the structure of the `ipfs id` JSON response.
+type IPFSIDResponse struct {
+	ID string `json:"ID"`
+}
+
+// PeerID runs `ipfs id` and extracts the Peer ID.
+func (daemonStore) PeerID() (string, error) {
+	cmd := exec.Command("ipfs", "id")
+	output, err := cmd.Output()
+	if err != nil {
+		fail := fmt.Errorf("failed to run ipfs id: %w", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+
+	var response IPFSIDResponse
+	if err := json.Unmarshal(output, &response); err != nil {
+		fail := fmt.Errorf("failed to parse ipfs id output: %w", err)
+		videoRenderingLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+
+	return response.ID, nil
+}
+
+// Connect runs `ipfs swarm connect`
+func (daemonStore) Connect(address string) error {
+	cmd := exec.Command("ipfs", "swarm", "connect", address)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w, output: %s", err, output)
+	}
+	return nil
+}
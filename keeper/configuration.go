@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/BurntSushi/toml"
+	"github.com/janction/videoRendering/vm"
 )
 
 type VideoConfiguration struct {
@@ -16,13 +17,19 @@ type VideoConfiguration struct {
 	WorkerKeyLocation string `toml:"worker_key_location"`
 	MinReward         int64  `toml:"min_reward"`
 	GPUAmount         int64  `toml:"gpu_amount"`
-	ConfigPath        string
-	RootPath          string
+	// blender versions the worker renders. Empty renders every version with an approved image for its architecture
+	BlenderVersions []string `toml:"blender_versions"`
+	// container runtime the worker renders and encodes with
+	Runtime vm.RuntimeConfiguration `toml:"runtime"`
+	// timeout and retries of every frame the worker renders
+	Render     vm.RenderPolicy `toml:"render"`
+	ConfigPath string
+	RootPath   string
 }
 
 func GetVideoRenderingConfiguration(rootPath string) (*VideoConfiguration, error) {
 	var configPath string = rootPath + "/config/videoRendering.toml"
-	conf := VideoConfiguration{Enabled: false, RootPath: rootPath, ConfigPath: configPath}
+	conf := VideoConfiguration{Enabled: false, RootPath: rootPath, ConfigPath: configPath, Runtime: vm.DefaultRuntimeConfiguration(), Render: vm.DefaultRenderPolicy()}
 
 	// we make sure the root path exists. It might yet not be initialized
 	_, err := os.Stat(rootPath)
@@ -52,6 +59,15 @@ func GetVideoRenderingConfiguration(rootPath string) (*VideoConfiguration, error
 	return &conf, nil
 }
 
+// NewVM returns the vm the worker renders with, or an error if its runtime or render policy are invalid.
+// Nodes that don't work never render, so they get the default vm
+func (c VideoConfiguration) NewVM() (*vm.VM, error) {
+	if !c.Enabled {
+		return vm.DefaultVM(), nil
+	}
+	return vm.NewVM(c.Runtime, c.Render)
+}
+
 func (c *VideoConfiguration) SaveConf() error {
 	// we make sure the root path exists. It might not be initialized
 	_, err := os.Stat(c.ConfigPath)
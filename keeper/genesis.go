@@ -16,6 +16,18 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *videoRendering.GenesisSt
 		return err
 	}
 
+	for _, key := range data.VerifyingKeys {
+		if err := k.VerifyingKeys.Set(ctx, key.CircuitVersion, key.VerifyingKey); err != nil {
+			return err
+		}
+	}
+
+	for _, image := range data.RendererImages {
+		if err := k.RendererImages.Set(ctx, image.Digest, image); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -26,9 +38,24 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*videoRendering.GenesisStat
 		return nil, err
 	}
 
+	var verifyingKeys []videoRendering.CircuitVerifyingKey
+	if err := k.VerifyingKeys.Walk(ctx, nil, func(version string, key []byte) (bool, error) {
+		verifyingKeys = append(verifyingKeys, videoRendering.CircuitVerifyingKey{CircuitVersion: version, VerifyingKey: key})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	rendererImages, err := k.GetRendererImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &videoRendering.GenesisState{
 		Params:                 params,
 		VideoRenderingTaskList: []videoRendering.IndexedVideoRenderingTask{},
 		VideoRenderingTaskInfo: videoRendering.VideoRenderingTaskInfo{NextId: 1},
+		VerifyingKeys:          verifyingKeys,
+		RendererImages:         rendererImages,
 	}, nil
 }
@@ -1,15 +1,22 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/janction/videoRendering"
 	"github.com/janction/videoRendering/db"
+	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
 )
 
 type Keeper struct {
@@ -27,12 +34,16 @@ type Keeper struct {
 	VideoRenderingTaskInfo collections.Item[videoRendering.VideoRenderingTaskInfo]
 	VideoRenderingTasks    collections.Map[string, videoRendering.VideoRenderingTask]
 	Workers                collections.Map[string, videoRendering.Worker]
+	VerifyingKeys          collections.Map[string, []byte]
+	RendererImages         collections.Map[string, videoRendering.RendererImage]
 	Configuration          VideoConfiguration
 	DB                     db.DB
+	// vm the worker renders, encodes and decodes frames with
+	VM *vm.VM
 }
 
-// NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, path string, bankKeeper bankkeeper.BaseKeeper) Keeper {
+// NewKeeper creates a new Keeper instance. The vm is built from the validated configuration of the worker
+func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, path string, bankKeeper bankkeeper.BaseKeeper, machine *vm.VM) Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
@@ -54,9 +65,12 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 		VideoRenderingTaskInfo: collections.NewItem(sb, videoRendering.TaskInfoKey, "taskInfo", codec.CollValue[videoRendering.VideoRenderingTaskInfo](cdc)),
 		VideoRenderingTasks:    collections.NewMap(sb, videoRendering.VideoRenderingTaskKey, "videoRenderingTasks", collections.StringKey, codec.CollValue[videoRendering.VideoRenderingTask](cdc)),
 		Workers:                collections.NewMap(sb, videoRendering.WorkerKey, "workers", collections.StringKey, codec.CollValue[videoRendering.Worker](cdc)),
+		VerifyingKeys:          collections.NewMap(sb, videoRendering.VerifyingKeysKey, "verifyingKeys", collections.StringKey, collections.BytesValue),
+		RendererImages:         collections.NewMap(sb, videoRendering.RendererImagesKey, "rendererImages", collections.StringKey, codec.CollValue[videoRendering.RendererImage](cdc)),
 		Configuration:          *config,
 		DB:                     *db,
 		BankKeeper:             bankKeeper,
+		VM:                     machine,
 	}
 
 	schema, err := sb.Build()
@@ -73,3 +87,121 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// CanRenderBlenderVersion returns true if this node has an approved image for the blender version, and its configuration serves it.
+// Tasks without version are rendered with the latest approved image
+func (k Keeper) CanRenderBlenderVersion(ctx context.Context, blenderVersion string) bool {
+	images, err := k.GetRendererImages(ctx)
+	if err != nil {
+		return false
+	}
+	if _, ok := videoRendering.SelectRendererImage(images, vm.Architecture(), blenderVersion); !ok {
+		return false
+	}
+	return blenderVersion == "" || videoRendering.ServesBlenderVersion(k.Configuration.BlenderVersions, blenderVersion)
+}
+
+// previewWorkers returns the addresses of the enabled, idle workers able to render the blender version, in address order
+func (k Keeper) previewWorkers(ctx context.Context, blenderVersion string) ([]string, error) {
+	images, err := k.GetRendererImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	err = k.Workers.Walk(ctx, nil, func(address string, worker videoRendering.Worker) (bool, error) {
+		if worker.Enabled && worker.CurrentTaskId == "" && worker.CanRender(images, blenderVersion) {
+			res = append(res, address)
+		}
+		return false, nil
+	})
+	return res, err
+}
+
+// ReassignAssembly designates another worker of the task to assemble its video. Penalized assemblers lose a slice of
+// their stake, which is paid to the requester. If no worker is left the reward of the assembly is refunded
+func (k Keeper) ReassignAssembly(ctx context.Context, task *videoRendering.VideoRenderingTask, penalize bool) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	requester, _ := types.AccAddressFromBech32(task.Requester)
+
+	// the penalty of the assembler and the refund of the reward are paid before any bookkeeping changes,
+	// so a reassignment the module can't pay for leaves the task and the stake as they were, and is retried
+	var owed types.Coins
+	worker, err := k.Workers.Get(ctx, task.Assembly.Assembler)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	var penalty types.Coin
+	penalized := false
+	if penalize && err == nil && worker.Reputation != nil && worker.Reputation.Staked != nil {
+		penalty = types.NewCoin(worker.Reputation.Staked.Denom, worker.Reputation.Staked.Amount.MulRaw(int64(params.AssemblyPenaltyPercentage)).QuoRaw(100))
+		penalized = penalty.IsPositive()
+	}
+	if penalized {
+		owed = owed.Add(penalty)
+	}
+	reassigned := len(task.AssemblyValidators()) > 0
+	refund := !reassigned && task.Assembly.Reward != nil && task.Assembly.Reward.IsPositive()
+	if refund {
+		owed = owed.Add(*task.Assembly.Reward)
+	}
+
+	if !owed.IsZero() {
+		balance := k.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(videoRendering.ModuleName))
+		if !balance.IsAllGTE(owed) {
+			return fmt.Errorf("module balance %s can't pay %s for the reassignment of the assembly of task %s", balance, owed, task.TaskId)
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoRendering.ModuleName, requester, owed); err != nil {
+			return err
+		}
+	}
+
+	if penalized {
+		videoRenderingLogger.Logger.Info("assembler %s of task %s penalized with %s", worker.Address, task.TaskId, penalty)
+		staked := worker.Reputation.Staked.Sub(penalty)
+		worker.Reputation.Staked = &staked
+		if err := k.Workers.Set(ctx, worker.Address, worker); err != nil {
+			return err
+		}
+	}
+
+	task.ReassignAssembler(types.UnwrapSDKContext(ctx).BlockHeight(), params.AssemblyPhaseBlocks)
+	if !reassigned {
+		videoRenderingLogger.Logger.Info("no worker left to assemble the video of task %s, refunding its reward", task.TaskId)
+	}
+	if refund {
+		refunded := types.NewCoin(task.Assembly.Reward.Denom, math.ZeroInt())
+		task.Assembly.Reward = &refunded
+	}
+	return nil
+}
+
+// DropSolution drops the solution its proposer didn't reveal in time, releasing the proposer from the task
+func (k Keeper) DropSolution(ctx context.Context, thread *videoRendering.VideoRenderingThread) error {
+	proposer := thread.DropSolution()
+	videoRenderingLogger.Logger.Info("solution of thread %s proposed by %s dropped", thread.ThreadId, proposer)
+
+	worker, err := k.Workers.Get(ctx, proposer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if worker.CurrentTaskId == thread.TaskId {
+		worker.CurrentTaskId = ""
+		worker.CurrentThreadIndex = 0
+	}
+	return k.Workers.Set(ctx, proposer, worker)
+}
+
+// GetRendererImages returns the approved renderer images, ordered by digest
+func (k Keeper) GetRendererImages(ctx context.Context) ([]videoRendering.RendererImage, error) {
+	var images []videoRendering.RendererImage
+	err := k.RendererImages.Walk(ctx, nil, func(digest string, image videoRendering.RendererImage) (bool, error) {
+		images = append(images, image)
+		return false, nil
+	})
+	return images, err
+}
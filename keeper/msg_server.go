@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +18,8 @@ import (
 	videoRenderingCrypto "github.com/janction/videoRendering/crypto"
 	"github.com/janction/videoRendering/ipfs"
 	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
+	"github.com/janction/videoRendering/zkp"
 )
 
 type msgServer struct {
@@ -48,6 +53,59 @@ func (ms msgServer) CreateVideoRenderingTask(ctx context.Context, msg *videoRend
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "cid %s is invalid", msg.Cid)
 	}
 
+	// non deterministic renderers need a valid tolerance
+	if err := msg.Comparison.Validate(); err != nil {
+		videoRenderingLogger.Logger.Error("invalid frame comparison: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "invalid frame comparison: %s", err.Error())
+	}
+
+	if err := msg.RenderSettings.Validate(); err != nil {
+		videoRenderingLogger.Logger.Error("invalid render settings: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "invalid render settings: %s", err.Error())
+	}
+
+	if err := msg.TileGrid.Validate(msg.RenderSettings); err != nil {
+		videoRenderingLogger.Logger.Error("invalid tile grid: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "invalid tile grid: %s", err.Error())
+	}
+
+	if err := msg.Split.Validate(); err != nil {
+		videoRenderingLogger.Logger.Error("invalid thread split: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "invalid thread split: %s", err.Error())
+	}
+
+	// the scene can only be rendered if some architecture has an approved image for its blender version
+	if msg.BlenderVersion != "" {
+		if err := vm.ValidateBlenderVersion(msg.BlenderVersion); err != nil {
+			videoRenderingLogger.Logger.Error(err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "%s", err.Error())
+		}
+		images, err := ms.k.GetRendererImages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !videoRendering.HasRendererImage(images, msg.BlenderVersion) {
+			videoRenderingLogger.Logger.Error("no renderer image approved for blender %s", msg.BlenderVersion)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "no renderer image approved for blender %s", msg.BlenderVersion)
+		}
+	}
+
+	// a slice of the reward is kept for the worker assembling the video
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting params: %s", err.Error())
+		return nil, err
+	}
+	// videos are only assembled once an encoder image is pinned
+	var assembly *videoRendering.VideoAssembly
+	if params.EncoderImage != "" {
+		assembly, err = videoRendering.NewVideoAssembly(*msg.Reward, params.AssemblyRewardPercentage, msg.FrameRate, params.EncoderImage)
+		if err != nil {
+			videoRenderingLogger.Logger.Error("invalid video assembly: %s", err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVideoRenderingTask.Error(), "invalid video assembly: %s", err.Error())
+		}
+	}
+
 	var nextId = taskInfo.NextId
 	// we get the taskId in string
 	taskId := strconv.FormatInt(nextId, 10)
@@ -56,9 +114,23 @@ func (ms msgServer) CreateVideoRenderingTask(ctx context.Context, msg *videoRend
 	nextId++
 	ms.k.VideoRenderingTaskInfo.Set(ctx, videoRendering.VideoRenderingTaskInfo{NextId: nextId})
 
-	videoTask := videoRendering.VideoRenderingTask{TaskId: taskId, Requester: msg.Creator, Cid: msg.Cid, StartFrame: msg.StartFrame, EndFrame: msg.EndFrame, Completed: false, ThreadAmount: msg.Threads, Reward: msg.Reward}
-	threads := videoTask.GenerateThreads(taskId)
-	videoTask.Threads = threads
+	videoTask := videoRendering.VideoRenderingTask{TaskId: taskId, Requester: msg.Creator, Cid: msg.Cid, StartFrame: msg.StartFrame, EndFrame: msg.EndFrame, Completed: false, ThreadAmount: msg.Threads, Reward: msg.Reward, Comparison: msg.Comparison, RenderSettings: msg.RenderSettings, Assembly: assembly, TileGrid: msg.TileGrid, Split: msg.Split, BlenderVersion: msg.BlenderVersion}
+	if videoTask.Split.IsPreview() {
+		// threads are generated once the preview pass is submitted, or evenly after the deadline
+		sdkCtx := types.UnwrapSDKContext(ctx)
+		videoTask.Split.PreviewDeadline = sdkCtx.BlockHeight() + params.PreviewPhaseBlocks
+		previewers, err := ms.k.previewWorkers(ctx, videoTask.BlenderVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !videoTask.DesignatePreviewers(previewers) {
+			videoRenderingLogger.Logger.Info("Not enough workers to preview task %s. Splitting frames evenly", taskId)
+			videoTask.Threads = videoTask.GenerateThreads(taskId)
+		}
+	} else {
+		threads := videoTask.GenerateThreads(taskId)
+		videoTask.Threads = threads
+	}
 
 	// the module will keep the reward to be distributed later
 	// TODO Add validations for msg.Creator
@@ -87,6 +159,15 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *videoRendering.MsgAddWor
 		return &videoRendering.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
+	// declared versions are matched against the ones of the tasks
+	for _, version := range msg.BlenderVersions {
+		if err := vm.ValidateBlenderVersion(version); err != nil {
+			error := sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidWorkerCapabilities.Error(), "%s", err.Error())
+			videoRenderingLogger.Logger.Error(error.Error())
+			return &videoRendering.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+		}
+	}
+
 	// we verify the staking amount if valid and at least equeal the min value
 	params, _ := ms.k.Params.Get(ctx)
 	if msg.Stake.Denom != params.MinWorkerStaking.Denom {
@@ -114,7 +195,7 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *videoRendering.MsgAddWor
 
 	// worker is not previously registered, so we move on
 	reputation := videoRendering.Worker_Reputation{Points: 0, Staked: &msg.Stake, Validations: 0, Solutions: 0, Winnings: types.NewCoin(params.MinWorkerStaking.Denom, math.NewInt(0))}
-	worker := videoRendering.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId}
+	worker := videoRendering.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId, Architecture: msg.Architecture, BlenderVersions: msg.BlenderVersions}
 
 	err = ms.k.Workers.Set(ctx, msg.Creator, worker)
 	if err != nil {
@@ -155,6 +236,16 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoRenderi
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrWorkerTaskNotAvailable.Error(), "task (%s) is already completed. Can't subscribe worker", msg.TaskId)
 	}
 
+	// workers must be able to render the blender version of the scene
+	images, err := ms.k.GetRendererImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !worker.CanRender(images, task.BlenderVersion) {
+		videoRenderingLogger.Logger.Error("worker %s can't render blender %s of task %s", msg.Address, task.BlenderVersion, msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrWorkerNotAvailable.Error(), "worker (%s) can't render blender %s", msg.Address, task.BlenderVersion)
+	}
+
 	// we get the params to get the MaxWorkersPerThread value
 	params, _ := ms.k.Params.Get(ctx)
 	for i, v := range task.Threads {
@@ -166,6 +257,10 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoRenderi
 					return nil, nil
 				}
 
+				// render orders are fixed for the thread once the first worker subscribes
+				if v.RenderSlots == 0 {
+					v.RenderSlots = params.MaxWorkersPerThread
+				}
 				v.Workers = append(v.Workers, msg.Address)
 
 				worker.CurrentTaskId = task.TaskId
@@ -185,7 +280,7 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoRenderi
 }
 
 func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoRendering.MsgProposeSolution) (*videoRendering.MsgProposeSolutionResponse, error) {
-	videoRenderingLogger.Logger.Info("ProposeSolution - creator: %s, taskId: %s, threadId: %s, publicKey: %s, signatures: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Signatures)
+	videoRenderingLogger.Logger.Info("ProposeSolution - creator: %s, taskId: %s, threadId: %s, publicKey: %s, merkleRoot: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.MerkleRoot)
 
 	// creator of the solution must be a valid worker
 	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
@@ -211,10 +306,13 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoRendering.Msg
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "Task %s is not valid to accept solutions", msg.TaskId)
 	}
 
+	// we get the params to size the commit window of the thread
+	params, _ := ms.k.Params.Get(ctx)
+	sdkCtx := types.UnwrapSDKContext(ctx)
 	for i, v := range task.Threads {
 		// TODO threads might be better as map instead of slice
 		if v.ThreadId == msg.ThreadId {
-			if v.Solution != nil {
+			if v.Solution != nil || v.Phase != videoRendering.VideoRenderingThread_RENDERING {
 				videoRenderingLogger.Logger.Error("thread %s already has a solution", msg.ThreadId)
 				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "thread %s already has a solution", msg.ThreadId)
 			}
@@ -224,34 +322,59 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoRendering.Msg
 				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "Worker %s is not valid at thread %s", msg.Creator, msg.ThreadId)
 			}
 
-			// solution len must be equal to the frames generated
-			if len(msg.Signatures) != (int(v.EndFrame) - int(v.StartFrame) + 1) {
-				videoRenderingLogger.Logger.Error("amount of files in solution is incorrect, %v ", len(msg.Signatures))
-				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "amount of files in solution is incorrect, %v ", len(msg.Signatures))
+			// solution is the merkle root of the hashes of all frames
+			if root, err := hex.DecodeString(msg.MerkleRoot); err != nil || len(root) != zkp.ElementSize {
+				videoRenderingLogger.Logger.Error("invalid merkle root %s", msg.MerkleRoot)
+				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "invalid merkle root %s", msg.MerkleRoot)
+			}
+
+			if err := ms.validatePublicKey(msg.PublicKey, msg.Creator); err != nil {
+				return nil, err
 			}
 
-			// we have passed all validations, lets add the solution to the thread
-			var frames []*videoRendering.VideoRenderingThread_Frame
+			// validators must be able to hash their frames like the solution
+			hashVersion := msg.HashVersion
+			if hashVersion == "" {
+				hashVersion = videoRendering.PixelHashV1
+			}
+			if _, ok := videoRendering.PixelHashAlgorithm(hashVersion); !ok {
+				videoRenderingLogger.Logger.Error("unknown pixel hash version %s", msg.HashVersion)
+				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "unknown pixel hash version %s", msg.HashVersion)
+			}
 
-			for _, val := range msg.Signatures {
-				parts := strings.SplitN(val, "=", 2)
+			// validators need to know the settings the solution was rendered with
+			if fingerprint, err := hex.DecodeString(msg.RenderSettings); msg.RenderSettings != "" && (err != nil || len(fingerprint) != sha256.Size) {
+				videoRenderingLogger.Logger.Error("invalid render settings fingerprint %s", msg.RenderSettings)
+				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "invalid render settings fingerprint %s", msg.RenderSettings)
+			}
 
-				if err != nil {
-					videoRenderingLogger.Logger.Error("unable to decode signature from msg %s: %s", parts[1], err.Error())
+			// solutions are only comparable when rendered with an approved image
+			if msg.RendererDigest != "" {
+				if approved, err := ms.k.RendererImages.Has(ctx, msg.RendererDigest); err != nil {
 					return nil, err
+				} else if !approved {
+					videoRenderingLogger.Logger.Error("renderer image %s isn't approved", msg.RendererDigest)
+					return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "renderer image %s isn't approved", msg.RendererDigest)
 				}
-
-				frame := videoRendering.VideoRenderingThread_Frame{Filename: parts[0], Signature: parts[1]}
-				frames = append(frames, &frame)
 			}
 
-			_, err := videoRenderingCrypto.DecodePublicKeyFromCLI(msg.PublicKey)
-
-			if err != nil {
-				videoRenderingLogger.Logger.Error("unable to decode publicKey from msg %s: %s", msg.PublicKey, err.Error())
-				return nil, err
+			// zk proofs are optional, but when provided they must be valid
+			if msg.ZkProof != "" {
+				if err := ms.verifySolutionProof(ctx, msg); err != nil {
+					return nil, err
+				}
 			}
-			task.Threads[i].Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: msg.Creator, Frames: frames, PublicKey: msg.PublicKey}
+			task.Threads[i].Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: msg.Creator, PublicKey: msg.PublicKey, MerkleRoot: msg.MerkleRoot, HashVersion: hashVersion, RenderSettings: msg.RenderSettings, RendererDigest: msg.RendererDigest}
+			// the frames validators will verify are only known once the solution is proposed
+			task.Threads[i].SampledFrames = v.SampleFrames(sdkCtx.HeaderHash())
+			if msg.AverageRenderSeconds < 0 {
+				videoRenderingLogger.Logger.Error("invalid average render seconds %v", msg.AverageRenderSeconds)
+				return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "invalid average render seconds %v", msg.AverageRenderSeconds)
+			}
+			task.Threads[i].AverageRenderSeconds = msg.AverageRenderSeconds
+			// validators can now commit to their hashes until the deadline, with time to render every frame of the thread
+			commitBlocks := params.CommitBlocks(v.EndFrame-v.StartFrame+1, msg.AverageRenderSeconds)
+			task.Threads[i].StartCommitPhase(sdkCtx.BlockHeight(), commitBlocks)
 			err = ms.k.VideoRenderingTasks.Set(ctx, msg.TaskId, task)
 
 			if err != nil {
@@ -266,7 +389,7 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoRendering.Msg
 
 // TODO Implement
 func (ms msgServer) RevealSolution(ctx context.Context, msg *videoRendering.MsgRevealSolution) (*videoRendering.MsgRevealSolutionResponse, error) {
-	videoRenderingLogger.Logger.Info("RevealSolution - creator: %s, taskId: %s, threadId: %s, frames: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Frames)
+	videoRenderingLogger.Logger.Info("RevealSolution - creator: %s, taskId: %s, threadId: %s, salt: %s, frames: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Salt, msg.Frames)
 
 	// Solution must be from a worker on the thread
 	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
@@ -295,11 +418,21 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoRendering.MsgR
 
 	thread := task.Threads[worker.CurrentThreadIndex]
 
+	if thread.Phase != videoRendering.VideoRenderingThread_REVEAL {
+		videoRenderingLogger.Logger.Error("thread %s is not accepting reveals", thread.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidThreadPhase.Error(), "thread %s is not accepting reveals", thread.ThreadId)
+	}
+
 	if thread.Solution.Accepted {
 		videoRenderingLogger.Logger.Error("solution has already been accepted")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "solution has already been accepted.")
 	}
 
+	if len(thread.Solution.Frames) > 0 {
+		videoRenderingLogger.Logger.Error("solution has already been revealed")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "solution has already been revealed.")
+	}
+
 	if thread.Solution.ProposedBy != msg.Creator {
 		videoRenderingLogger.Logger.Error("creator is not the winner.")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "creator is not the winner.")
@@ -316,39 +449,47 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoRendering.MsgR
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker is not working on thread")
 	}
 
-	// cids amount must be equal to the amount of frames
-	if len(msg.Frames) != len(thread.Solution.Frames) {
+	if !videoRendering.IsValidCommitmentSalt(msg.Salt) {
+		videoRenderingLogger.Logger.Error("invalid salt %s", msg.Salt)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid salt %s", msg.Salt)
+	}
+
+	// only the sampled frames are revealed
+	if len(msg.Frames) != len(thread.SampledFrames) {
 		videoRenderingLogger.Logger.Error("invalid amount of frames for the solution")
-		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid amount of cids for the solution")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid amount of frames for the solution")
 	}
 
-	solution := videoRendering.FromCliToFrames(msg.Frames)
-	for _, frame := range solution {
-		idx := slices.IndexFunc(thread.Solution.Frames, func(f *videoRendering.VideoRenderingThread_Frame) bool { return f.Filename == frame.Filename })
-		if idx < 0 {
-			videoRenderingLogger.Logger.Error("Unable to find frame with filename %s in solution", frame.String())
-			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "frame %s not found in solution", frame)
+	solution, proofs := videoRendering.FromCliToFrames(msg.Frames)
+	var frames []*videoRendering.VideoRenderingThread_Frame
+	for _, sampled := range thread.SampledFrames {
+		filename := vm.FormatFrameFilename(int(sampled), thread.FrameExtension())
+		frame, ok := solution[filename]
+		if !ok || frame.Cid == "" || frame.Hash == "" {
+			videoRenderingLogger.Logger.Error("Frame %s doesn't have a CID or Hash revelaed", filename)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "Frame %s doesn't have a CID or Hash revelaed", filename)
 		}
-		// we reveal the solution
-		thread.Solution.Frames[idx].Cid = frame.Cid
-		thread.Solution.Frames[idx].Hash = frame.Hash
-	}
 
-	// We verify all frames in the solution have a CID revealed
-	for _, frame := range thread.Solution.Frames {
-		if frame.Cid == "" || frame.Hash == "" {
-			videoRenderingLogger.Logger.Error("Frame %s doesn't have a CID or Hash revelaed", frame.Filename)
-			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "Frame %s doesn't have a CID or Hash revelaed", frame.Filename)
+		// the revealed hash must be the one committed when the solution was proposed
+		commitment := videoRendering.FrameCommitment(frame.Hash, msg.Salt, msg.Creator)
+		if !thread.VerifyFrameProof(filename, commitment, proofs[filename]) {
+			videoRenderingLogger.Logger.Error("invalid merkle proof for frame %s", filename)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid merkle proof for frame %s", filename)
 		}
+
+		frames = append(frames, &videoRendering.VideoRenderingThread_Frame{Filename: filename, Cid: frame.Cid, Hash: frame.Hash})
 	}
 
+	// we reveal the solution
+	thread.Solution.Frames = frames
+
 	task.Threads[worker.CurrentThreadIndex] = thread
 	ms.k.VideoRenderingTasks.Set(ctx, msg.TaskId, task)
 	return nil, nil
 }
 
 func (ms msgServer) SubmitValidation(ctx context.Context, msg *videoRendering.MsgSubmitValidation) (*videoRendering.MsgSubmitValidationResponse, error) {
-	videoRenderingLogger.Logger.Info("SubmitValidation - creator: %s, taskId: %s, threadId: %s, publicKey: %s, Signatures: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Signatures)
+	videoRenderingLogger.Logger.Info("SubmitValidation - creator: %s, taskId: %s, threadId: %s, publicKey: %s, Commitments: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.PublicKey, msg.Commitments)
 
 	// validation must be from a worker on the thread
 	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
@@ -392,25 +533,150 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *videoRendering.Ms
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker is not working on thread")
 	}
 
+	// validations are only commitments, accepted until the commit deadline
+	if !thread.IsCommitOpen(types.UnwrapSDKContext(ctx).BlockHeight()) {
+		videoRenderingLogger.Logger.Error("thread %s is not accepting commitments", thread.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidThreadPhase.Error(), "thread %s is not accepting commitments", thread.ThreadId)
+	}
+
+	if thread.GetValidation(msg.Creator) != nil {
+		videoRenderingLogger.Logger.Error("worker %s already committed a validation", msg.Creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker %s already committed a validation", msg.Creator)
+	}
+
+	// signatures must be made with the key of the validator account
+	if err := ms.validatePublicKey(msg.PublicKey, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// validators can only commit to the frames sampled when the solution was proposed
+	if len(msg.Commitments) != len(thread.SampledFrames) {
+		videoRenderingLogger.Logger.Error("amount of frames in validation is incorrect, %v", len(msg.Commitments))
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "amount of frames in validation is incorrect, %v", len(msg.Commitments))
+	}
+
 	var frames []*videoRendering.VideoRenderingThread_Frame
-	for _, signatures := range msg.Signatures {
-		parts := strings.SplitN(signatures, "=", 2)
+	for _, commitment := range msg.Commitments {
+		parts := strings.SplitN(commitment, "=", 2)
+		if len(parts) != 2 || !videoRendering.IsValidCommitment(parts[1]) {
+			videoRenderingLogger.Logger.Error("invalid commitment %s", commitment)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid commitment %s", commitment)
+		}
+
+		if !thread.IsSampledFrame(parts[0]) || videoRendering.GetFrame(frames, parts[0]) != nil {
+			videoRenderingLogger.Logger.Error("frame %s is not sampled for validation", parts[0])
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "frame %s is not sampled for validation", parts[0])
+		}
 
-		frame := videoRendering.VideoRenderingThread_Frame{Filename: parts[0], Signature: parts[1]}
+		frame := videoRendering.VideoRenderingThread_Frame{Filename: parts[0], Commitment: parts[1]}
 		frames = append(frames, &frame)
 	}
 
-	validation := videoRendering.VideoRenderingThread_Validation{Validator: msg.Creator, IsReverse: thread.IsReverse(worker.Address), Frames: frames, PublicKey: msg.PublicKey}
+	validation := videoRendering.VideoRenderingThread_Validation{Validator: msg.Creator, RenderSlot: int64(thread.RenderSlot(worker.Address)), Frames: frames, PublicKey: msg.PublicKey}
 	task.Threads[worker.CurrentThreadIndex].Validations = append(thread.Validations, &validation)
 	ms.k.VideoRenderingTasks.Set(ctx, msg.TaskId, task)
 
+	return &videoRendering.MsgSubmitValidationResponse{}, nil
+}
+
+func (ms msgServer) RevealValidation(ctx context.Context, msg *videoRendering.MsgRevealValidation) (*videoRendering.MsgRevealValidationResponse, error) {
+	videoRenderingLogger.Logger.Info("RevealValidation - creator: %s, taskId: %s, threadId: %s, salt: %s, frames: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Salt, msg.Frames)
+
+	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, err
+	}
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+
+	if worker.CurrentTaskId != msg.TaskId {
+		videoRenderingLogger.Logger.Error("worker is not working on task")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker is not working on task")
+	}
+
+	if task.Completed {
+		videoRenderingLogger.Logger.Error("task is already completed. No more validations accepted")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
+	}
+
+	thread := task.Threads[worker.CurrentThreadIndex]
+	if thread.ThreadId != msg.ThreadId {
+		videoRenderingLogger.Logger.Error("worker is not working on thread")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker is not working on thread")
+	}
+
+	if thread.Phase != videoRendering.VideoRenderingThread_REVEAL {
+		videoRenderingLogger.Logger.Error("thread %s is not accepting reveals", thread.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidThreadPhase.Error(), "thread %s is not accepting reveals", thread.ThreadId)
+	}
+
+	validation := thread.GetValidation(msg.Creator)
+	if validation == nil {
+		videoRenderingLogger.Logger.Error("worker %s didn't commit a validation", msg.Creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "worker %s didn't commit a validation", msg.Creator)
+	}
+
+	if validation.IsRevealed() {
+		videoRenderingLogger.Logger.Error("validation of worker %s is already revealed", msg.Creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "validation of worker %s is already revealed", msg.Creator)
+	}
+
+	if !videoRendering.IsValidCommitmentSalt(msg.Salt) {
+		videoRenderingLogger.Logger.Error("invalid salt %s", msg.Salt)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid salt %s", msg.Salt)
+	}
+
+	if len(msg.Frames) != len(validation.Frames) {
+		videoRenderingLogger.Logger.Error("amount of frames in reveal is incorrect, %v", len(msg.Frames))
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "amount of frames in reveal is incorrect, %v", len(msg.Frames))
+	}
+
+	revealed := make(map[string][]string)
+	for _, entry := range msg.Frames {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			videoRenderingLogger.Logger.Error("invalid revealed frame %s", entry)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid revealed frame %s", entry)
+		}
+		hashAndSignature := strings.Split(parts[1], ":")
+		if len(hashAndSignature) != 2 {
+			videoRenderingLogger.Logger.Error("invalid revealed frame %s", entry)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "invalid revealed frame %s", entry)
+		}
+		revealed[parts[0]] = hashAndSignature
+	}
+
+	for _, frame := range validation.Frames {
+		hashAndSignature, ok := revealed[frame.Filename]
+		if !ok {
+			videoRenderingLogger.Logger.Error("frame %s is not revealed", frame.Filename)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "frame %s is not revealed", frame.Filename)
+		}
+
+		// the revealed hash must be the one committed before the deadline
+		if videoRendering.FrameCommitment(hashAndSignature[0], msg.Salt, msg.Creator) != frame.Commitment {
+			videoRenderingLogger.Logger.Error("revealed hash of frame %s doesn't match its commitment", frame.Filename)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerification.Error(), "revealed hash of frame %s doesn't match its commitment", frame.Filename)
+		}
+
+		frame.Hash = hashAndSignature[0]
+		frame.Signature = hashAndSignature[1]
+	}
+
+	ms.k.VideoRenderingTasks.Set(ctx, msg.TaskId, task)
+
 	// we release the worker since there is nothing else for him to do on this thread
 	if worker.Address != thread.Solution.ProposedBy {
 		worker.ReleaseValidator()
 		ms.k.Workers.Set(ctx, msg.Creator, worker)
 	}
 
-	return &videoRendering.MsgSubmitValidationResponse{}, nil
+	return &videoRendering.MsgRevealValidationResponse{}, nil
 }
 
 func (ms msgServer) SubmitSolution(ctx context.Context, msg *videoRendering.MsgSubmitSolution) (*videoRendering.MsgSubmitSolutionResponse, error) {
@@ -421,15 +687,28 @@ func (ms msgServer) SubmitSolution(ctx context.Context, msg *videoRendering.MsgS
 		videoRenderingLogger.Logger.Error("Getting Task: %s", err.Error())
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "provided task doesn't exists")
 	}
+	// a failed task already refunded its reward
+	if task.Failed || task.Completed {
+		error := sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "task %s is already completed", msg.TaskId)
+		videoRenderingLogger.Logger.Error(error.Error())
+		return nil, error
+	}
 	for i, thread := range task.Threads {
 		if thread.ThreadId == msg.ThreadId {
 
-			if thread.Solution.ProposedBy != msg.Creator {
+			if thread.Solution == nil || thread.Solution.ProposedBy != msg.Creator {
 				error := sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "only the provider of the solution can upload it")
 				videoRenderingLogger.Logger.Error(error.Error())
 				return nil, error
 			}
 
+			// the winner is only paid once, for a solution validators accepted
+			if !thread.Solution.Accepted || thread.Completed {
+				error := sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "solution of thread %s isn't accepted or was already submitted", msg.ThreadId)
+				videoRenderingLogger.Logger.Error(error.Error())
+				return nil, error
+			}
+
 			// we make sure ipfs is running
 			ipfs.EnsureIPFSRunning()
 
@@ -474,3 +753,346 @@ func (ms msgServer) SubmitSolution(ctx context.Context, msg *videoRendering.MsgS
 	}
 	return &videoRendering.MsgSubmitSolutionResponse{}, nil
 }
+
+// validatePublicKey verifies the public key submitted from the CLI derives to the sender address
+func (ms msgServer) validatePublicKey(encodedPubKey, creator string) error {
+	pubKey, err := videoRenderingCrypto.DecodePublicKeyFromCLI(encodedPubKey)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to decode publicKey from msg %s: %s", encodedPubKey, err.Error())
+		return err
+	}
+
+	creatorAddress, err := ms.k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("invalid creator address %s: %s", creator, err.Error())
+		return err
+	}
+
+	if !bytes.Equal(pubKey.Address(), creatorAddress) {
+		videoRenderingLogger.Logger.Error("public key %s doesn't belong to %s", encodedPubKey, creator)
+		return sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrPublicKeyMismatch.Error(), "public key %s doesn't belong to %s", encodedPubKey, creator)
+	}
+	return nil
+}
+
+// verifySolutionProof verifies the zk proof of knowledge of the hashes and salt committed in the merkle root of the solution,
+// bound to the creator, using the verifying key registered on chain for the circuit version of the proof
+func (ms msgServer) verifySolutionProof(ctx context.Context, msg *videoRendering.MsgProposeSolution) error {
+	verifyingKey, err := ms.k.VerifyingKeys.Get(ctx, msg.ZkCircuitVersion)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("there is no verifying key registered for circuit version %s", msg.ZkCircuitVersion)
+		return sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "there is no verifying key registered for circuit version %s", msg.ZkCircuitVersion)
+	}
+
+	if err := zkp.VerifyFrameProof(msg.ZkProof, verifyingKey, msg.MerkleRoot, msg.Creator); err != nil {
+		videoRenderingLogger.Logger.Error("invalid zk proof of solution: %s", err.Error())
+		return sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidSolution.Error(), "invalid zk proof of solution: %s", err.Error())
+	}
+	return nil
+}
+
+// RegisterVerifyingKey stores the verifying key of a frame proof circuit version, so every node verifies proofs with the same key
+func (ms msgServer) RegisterVerifyingKey(ctx context.Context, msg *videoRendering.MsgRegisterVerifyingKey) (*videoRendering.MsgRegisterVerifyingKeyResponse, error) {
+	videoRenderingLogger.Logger.Info("RegisterVerifyingKey - authority: %s, circuitVersion: %s", msg.Authority, msg.CircuitVersion)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		videoRenderingLogger.Logger.Error("invalid authority %s, expected %s", msg.Authority, ms.k.GetAuthority())
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(videoRendering.ErrInvalidAuthority.Error(), "expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+
+	if msg.CircuitVersion == "" {
+		videoRenderingLogger.Logger.Error("verifying key without circuit version")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerifyingKey.Error(), "circuit version is required")
+	}
+
+	if err := zkp.ValidateVerifyingKey(msg.VerifyingKey); err != nil {
+		videoRenderingLogger.Logger.Error("invalid verifying key for circuit version %s: %s", msg.CircuitVersion, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerifyingKey.Error(), "%s", err.Error())
+	}
+
+	// registered keys are immutable, so proofs already submitted for the version remain verifiable
+	if exists, err := ms.k.VerifyingKeys.Has(ctx, msg.CircuitVersion); err != nil {
+		return nil, err
+	} else if exists {
+		videoRenderingLogger.Logger.Error("circuit version %s already has a verifying key", msg.CircuitVersion)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidVerifyingKey.Error(), "circuit version %s already has a verifying key", msg.CircuitVersion)
+	}
+
+	if err := ms.k.VerifyingKeys.Set(ctx, msg.CircuitVersion, msg.VerifyingKey); err != nil {
+		videoRenderingLogger.Logger.Error("unable to register verifying key %s", err.Error())
+		return nil, err
+	}
+
+	return &videoRendering.MsgRegisterVerifyingKeyResponse{}, nil
+}
+
+// RegisterRendererImage approves the renderer image workers of its architecture render with, or revokes it.
+// Workers only agree on frame hashes when they render with the same blender build, so images are pinned by digest
+func (ms msgServer) RegisterRendererImage(ctx context.Context, msg *videoRendering.MsgRegisterRendererImage) (*videoRendering.MsgRegisterRendererImageResponse, error) {
+	videoRenderingLogger.Logger.Info("RegisterRendererImage - authority: %s, image: %s, revoke: %v", msg.Authority, msg.Image.VMImage().Reference(), msg.Revoke)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		videoRenderingLogger.Logger.Error("invalid authority %s, expected %s", msg.Authority, ms.k.GetAuthority())
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(videoRendering.ErrInvalidAuthority.Error(), "expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+
+	if msg.Revoke {
+		if exists, err := ms.k.RendererImages.Has(ctx, msg.Image.Digest); err != nil {
+			return nil, err
+		} else if !exists {
+			videoRenderingLogger.Logger.Error("renderer image %s isn't approved", msg.Image.Digest)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidRendererImage.Error(), "renderer image %s isn't approved", msg.Image.Digest)
+		}
+		if err := ms.k.RendererImages.Remove(ctx, msg.Image.Digest); err != nil {
+			videoRenderingLogger.Logger.Error("unable to revoke renderer image %s", err.Error())
+			return nil, err
+		}
+		return &videoRendering.MsgRegisterRendererImageResponse{}, nil
+	}
+
+	if err := msg.Image.Validate(); err != nil {
+		videoRenderingLogger.Logger.Error("invalid renderer image: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidRendererImage.Error(), "%s", err.Error())
+	}
+
+	// an approved image can only be revoked, so solutions rendered with it keep the same blender version
+	if exists, err := ms.k.RendererImages.Has(ctx, msg.Image.Digest); err != nil {
+		return nil, err
+	} else if exists {
+		videoRenderingLogger.Logger.Error("renderer image %s is already approved", msg.Image.Digest)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidRendererImage.Error(), "renderer image %s is already approved", msg.Image.Digest)
+	}
+
+	if err := ms.k.RendererImages.Set(ctx, msg.Image.Digest, msg.Image); err != nil {
+		videoRenderingLogger.Logger.Error("unable to register renderer image %s", err.Error())
+		return nil, err
+	}
+
+	return &videoRendering.MsgRegisterRendererImageResponse{}, nil
+}
+
+// SubmitAssembledVideo records the video the assembler encoded from the frames of a completed task.
+// Other workers of the task confirm it with the CID and hash of their own encode, and the assembler is paid once it's confirmed
+func (ms msgServer) SubmitAssembledVideo(ctx context.Context, msg *videoRendering.MsgSubmitAssembledVideo) (*videoRendering.MsgSubmitAssembledVideoResponse, error) {
+	videoRenderingLogger.Logger.Info("SubmitAssembledVideo - creator: %s, taskId: %s, videoCid: %s, videoHash: %s", msg.Creator, msg.TaskId, msg.VideoCid, msg.VideoHash)
+
+	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "provided task doesn't exists")
+	}
+
+	assembly := task.Assembly
+	if !task.Completed || assembly == nil || assembly.Assembler == "" {
+		videoRenderingLogger.Logger.Error("task %s isn't waiting for its video", msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "task %s isn't waiting for its video", msg.TaskId)
+	}
+	if assembly.Verified {
+		videoRenderingLogger.Logger.Error("video of task %s is already verified", msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "video of task %s is already verified", msg.TaskId)
+	}
+
+	if _, err := cid.Decode(msg.VideoCid); err != nil {
+		videoRenderingLogger.Logger.Error("provided video cid is invalid: (%s)", msg.VideoCid)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "video cid %s is invalid", msg.VideoCid)
+	}
+	if decoded, err := hex.DecodeString(msg.VideoHash); err != nil || len(decoded) != sha256.Size {
+		videoRenderingLogger.Logger.Error("provided video hash is invalid: (%s)", msg.VideoHash)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "video hash %s is invalid", msg.VideoHash)
+	}
+
+	validators := task.AssemblyValidators()
+	if assembly.VideoCid == "" {
+		if msg.Creator != assembly.Assembler {
+			videoRenderingLogger.Logger.Error("only the assembler %s can submit the video of task %s", assembly.Assembler, msg.TaskId)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "only the assembler %s can submit the video", assembly.Assembler)
+		}
+		assembly.VideoCid = msg.VideoCid
+		assembly.VideoHash = msg.VideoHash
+	} else {
+		// other workers re-encode the frames, the video is confirmed if they get the same file
+		if !slices.Contains(validators, msg.Creator) || assembly.IsChecked(msg.Creator) {
+			videoRenderingLogger.Logger.Error("worker %s can't confirm the video of task %s", msg.Creator, msg.TaskId)
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidAssembly.Error(), "worker %s can't confirm the video", msg.Creator)
+		}
+		if msg.VideoCid == assembly.VideoCid && msg.VideoHash == assembly.VideoHash {
+			assembly.Confirmations = append(assembly.Confirmations, msg.Creator)
+		} else {
+			videoRenderingLogger.Logger.Info("video %s of task %s rejected by %s", assembly.VideoCid, msg.TaskId, msg.Creator)
+			assembly.Rejections = append(assembly.Rejections, msg.Creator)
+		}
+	}
+
+	// a rejected video is assembled again by another worker, and its assembler loses a slice of its stake.
+	// Videos no other worker confirms are reassigned once their deadline passes
+	if !assembly.IsConfirmed() && assembly.IsRejected() {
+		videoRenderingLogger.Logger.Info("video %s of task %s rejected, reassigning its assembly", assembly.VideoCid, msg.TaskId)
+		if err := ms.k.ReassignAssembly(ctx, &task, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if assembly.IsConfirmed() {
+		assembly.Verified = true
+		addr, _ := types.AccAddressFromBech32(assembly.Assembler)
+		if assembly.Reward != nil && assembly.Reward.IsPositive() {
+			if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoRendering.ModuleName, addr, types.NewCoins(*assembly.Reward)); err != nil {
+				videoRenderingLogger.Logger.Error("unable to pay the assembler %s: %s", assembly.Assembler, err.Error())
+				return nil, err
+			}
+			worker, _ := ms.k.Workers.Get(ctx, assembly.Assembler)
+			if worker.Reputation != nil {
+				worker.Reputation.Winnings = worker.Reputation.Winnings.Add(*assembly.Reward)
+				ms.k.Workers.Set(ctx, worker.Address, worker)
+			}
+		}
+	}
+
+	if err := ms.k.VideoRenderingTasks.Set(ctx, msg.TaskId, task); err != nil {
+		return nil, err
+	}
+	return &videoRendering.MsgSubmitAssembledVideoResponse{}, nil
+}
+
+func (ms msgServer) SubmitPreview(ctx context.Context, msg *videoRendering.MsgSubmitPreview) (*videoRendering.MsgSubmitPreviewResponse, error) {
+	videoRenderingLogger.Logger.Info("SubmitPreview - creator: %s, taskId: %s, costs: %v", msg.Creator, msg.TaskId, msg.Costs)
+
+	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidPreview.Error(), "provided task doesn't exists")
+	}
+
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	if !task.IsPreviewPending() || sdkCtx.BlockHeight() > task.Split.PreviewDeadline {
+		videoRenderingLogger.Logger.Error("task %s isn't waiting for its preview", msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidPreview.Error(), "task %s isn't waiting for its preview", msg.TaskId)
+	}
+
+	// only the designated workers render previews, once each
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil || !worker.Enabled || !task.IsPreviewer(msg.Creator) {
+		videoRenderingLogger.Logger.Error("worker %s can't submit previews of task %s", msg.Creator, msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidPreview.Error(), "worker %s can't submit previews of task %s", msg.Creator, msg.TaskId)
+	}
+
+	costs, err := task.ParseFrameCosts(msg.Costs)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("invalid preview costs: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidPreview.Error(), "invalid preview costs: %s", err.Error())
+	}
+
+	// threads are generated once enough previewers agree on the costs
+	if task.Split.AddPreviewSubmission(msg.Creator, costs) {
+		task.Threads = task.GenerateThreads(task.TaskId)
+	}
+
+	if err := ms.k.VideoRenderingTasks.Set(ctx, task.TaskId, task); err != nil {
+		return nil, err
+	}
+	return &videoRendering.MsgSubmitPreviewResponse{}, nil
+}
+
+// ReportTaskFailure records a frame of the thread the worker couldn't render within the attempts of its render policy.
+// Once enough workers report the same reason, the task fails and the requester is refunded minus the fee of the reporters
+func (ms msgServer) ReportTaskFailure(ctx context.Context, msg *videoRendering.MsgReportTaskFailure) (*videoRendering.MsgReportTaskFailureResponse, error) {
+	videoRenderingLogger.Logger.Info("ReportTaskFailure - creator: %s, taskId: %s, threadId: %s, frame: %v, reason: %s, attempts: %v, logCid: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Frame, msg.Reason, msg.Attempts, msg.LogCid)
+
+	task, err := ms.k.VideoRenderingTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "provided task doesn't exists")
+	}
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil || !worker.Enabled {
+		videoRenderingLogger.Logger.Error("worker %s can't report failures", msg.Creator)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "worker %s can't report failures", msg.Creator)
+	}
+
+	if task.Completed {
+		videoRenderingLogger.Logger.Error("task %s is already completed", msg.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "task %s is already completed", msg.TaskId)
+	}
+
+	// reports must be independent from the requester, which is refunded when the task fails
+	if msg.Creator == task.Requester {
+		videoRenderingLogger.Logger.Error("requester can't report failures of its own task")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "requester can't report failures of its own task")
+	}
+
+	// only workers rendering the thread report its frames
+	if worker.CurrentTaskId != msg.TaskId || int(worker.CurrentThreadIndex) >= len(task.Threads) || task.Threads[worker.CurrentThreadIndex].ThreadId != msg.ThreadId {
+		videoRenderingLogger.Logger.Error("worker is not working on thread")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "worker is not working on thread")
+	}
+	thread := task.Threads[worker.CurrentThreadIndex]
+	if !slices.Contains(thread.Workers, msg.Creator) || thread.Completed {
+		videoRenderingLogger.Logger.Error("worker is not working on thread")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "worker is not working on thread")
+	}
+
+	if msg.Frame < thread.StartFrame || msg.Frame > thread.EndFrame {
+		videoRenderingLogger.Logger.Error("frame %v isn't rendered by thread %s", msg.Frame, msg.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "frame %v isn't rendered by thread %s", msg.Frame, msg.ThreadId)
+	}
+	if _, ok := videoRendering.VideoRenderingThread_UnrenderableFrame_Reason_name[int32(msg.Reason)]; !ok || msg.Attempts == 0 {
+		videoRenderingLogger.Logger.Error("invalid report of frame %v: reason %v after %v attempts", msg.Frame, msg.Reason, msg.Attempts)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "invalid report of frame %v: reason %v after %v attempts", msg.Frame, msg.Reason, msg.Attempts)
+	}
+	if _, err := cid.Decode(msg.LogCid); err != nil {
+		videoRenderingLogger.Logger.Error("invalid render log cid %s", msg.LogCid)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoRendering.ErrInvalidFailureReport.Error(), "invalid render log cid %s", msg.LogCid)
+	}
+
+	thread.AddUnrenderableFrame(videoRendering.VideoRenderingThread_UnrenderableFrame{Worker: msg.Creator, Frame: msg.Frame, Reason: msg.Reason, Attempts: msg.Attempts, LogCid: msg.LogCid})
+
+	params, _ := ms.k.Params.Get(ctx)
+	if frame, reason, reporters, failed := thread.ReportedFailure(max(int(params.FailureReports), 1)); failed {
+		if err := ms.failTask(ctx, &task, frame, reason, reporters, params.FailureFeePercentage); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.k.VideoRenderingTasks.Set(ctx, task.TaskId, task); err != nil {
+		return nil, err
+	}
+	return &videoRendering.MsgReportTaskFailureResponse{}, nil
+}
+
+// failTask completes the task as failed, refunds the reward that wasn't paid to the requester minus the fee paid to
+// every reporter of the failure, and releases the workers of the task
+func (ms msgServer) failTask(ctx context.Context, task *videoRendering.VideoRenderingTask, frame int64, reason videoRendering.VideoRenderingThread_UnrenderableFrame_Reason, reporters []string, feePercentage uint32) error {
+	videoRenderingLogger.Logger.Info("task %s failed with %s of frame %v, reported by %v", task.TaskId, reason, frame, reporters)
+
+	refund, fee := task.FailureRefund(len(reporters), feePercentage)
+	if fee.IsPositive() {
+		for _, reporter := range reporters {
+			addr, _ := types.AccAddressFromBech32(reporter)
+			if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoRendering.ModuleName, addr, types.NewCoins(fee)); err != nil {
+				return err
+			}
+		}
+	}
+	if refund.IsPositive() {
+		addr, _ := types.AccAddressFromBech32(task.Requester)
+		if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoRendering.ModuleName, addr, types.NewCoins(refund)); err != nil {
+			return err
+		}
+	}
+
+	// workers still on the task are released, no more work is accepted for it
+	for _, thread := range task.Threads {
+		for _, address := range thread.Workers {
+			worker, err := ms.k.Workers.Get(ctx, address)
+			if err == nil && worker.CurrentTaskId == task.TaskId {
+				worker.CurrentTaskId = ""
+				worker.CurrentThreadIndex = 0
+				ms.k.Workers.Set(ctx, worker.Address, worker)
+			}
+		}
+	}
+	task.Failed = true
+	task.Completed = true
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"google.golang.org/grpc/codes"
@@ -61,9 +62,9 @@ func (qs queryServer) GetPendingVideoRenderingTasks(ctx context.Context, req *vi
 
 	var result []*videoRendering.VideoRenderingTask
 	for i := 0; i < int(nextId); i++ {
-		task, err := qs.k.VideoRenderingTasks.Get(ctx, string(i))
+		task, err := qs.k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
 		if err != nil {
-			log.Fatalf("unable to retrieve task with id %v. Error: %v", string(i), err.Error())
+			log.Fatalf("unable to retrieve task with id %v. Error: %v", strconv.Itoa(i), err.Error())
 			continue
 		}
 
@@ -82,3 +83,15 @@ func (qs queryServer) GetWorker(ctx context.Context, req *videoRendering.QueryGe
 
 	return &videoRendering.QueryGetWorkerResponse{Worker: &worker}, nil
 }
+
+func (qs queryServer) GetVerifyingKey(ctx context.Context, req *videoRendering.QueryGetVerifyingKeyRequest) (*videoRendering.QueryGetVerifyingKeyResponse, error) {
+	verifyingKey, err := qs.k.VerifyingKeys.Get(ctx, req.CircuitVersion)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no verifying key registered for circuit version %s", req.CircuitVersion)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &videoRendering.QueryGetVerifyingKeyResponse{VerifyingKey: verifyingKey}, nil
+}
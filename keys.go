@@ -10,4 +10,6 @@ var (
 	WorkerKey                     = collections.NewPrefix("Worker")
 	TaskInfoKey                   = collections.NewPrefix(0)
 	PendingVideoRenderingTasksKey = collections.NewPrefix(1)
+	VerifyingKeysKey              = collections.NewPrefix(2)
+	RendererImagesKey             = collections.NewPrefix(3)
 )
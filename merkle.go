@@ -0,0 +1,135 @@
+package videoRendering
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/janction/videoRendering/vm"
+	"github.com/janction/videoRendering/zkp"
+)
+
+// FrameMerkleTree commits to the frame commitments of a thread, ordered by frame number.
+// Leaves are padded with zeros up to the next power of two, and the root binds the amount of frames to the top of the tree,
+// so the depth of every proof is fixed by the frames of the thread. Nodes are MiMC hashes, so the solution proof recomputes them.
+type FrameMerkleTree struct {
+	frames int
+	levels [][][]byte
+}
+
+// FrameMerkleLeaf returns the leaf of a frame. The frame number binds the commitment to its position in the thread.
+func FrameMerkleLeaf(frame int64, commitment string) ([]byte, error) {
+	return zkp.MerkleLeaf(frame, commitment)
+}
+
+// NewFrameMerkleTree builds the tree from the ordered leaves
+func NewFrameMerkleTree(leaves [][]byte) (*FrameMerkleTree, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("unable to build a merkle tree without leaves")
+	}
+
+	padded := append([][]byte{}, leaves...)
+	for len(padded) < 1<<zkp.MerkleDepthOf(len(leaves)) {
+		padded = append(padded, make([]byte, zkp.ElementSize))
+	}
+
+	levels := [][][]byte{padded}
+	for level := padded; len(level) > 1; {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, zkp.MerkleNode(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &FrameMerkleTree{frames: len(leaves), levels: levels}, nil
+}
+
+// Root returns the hex encoded root of the tree
+func (m *FrameMerkleTree) Root() string {
+	return hex.EncodeToString(zkp.MerkleRoot(m.frames, m.levels[len(m.levels)-1][0]))
+}
+
+// Proof returns the hex encoded siblings needed to go from the leaf at index to the root
+func (m *FrameMerkleTree) Proof(index int) ([]string, error) {
+	if index < 0 || index >= m.frames {
+		return nil, fmt.Errorf("leaf %v doesn't exists in the merkle tree", index)
+	}
+
+	var proof []string
+	for _, level := range m.levels[:len(m.levels)-1] {
+		proof = append(proof, hex.EncodeToString(level[index^1]))
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyFrameMerkleProof verifies the leaf at index is part of a tree of total leaves with the given root
+func VerifyFrameMerkleProof(root string, leaf []byte, index, total int, proof []string) bool {
+	if index < 0 || index >= total || len(proof) != zkp.MerkleDepthOf(total) {
+		return false
+	}
+
+	node := leaf
+	for _, encoded := range proof {
+		sibling, err := hex.DecodeString(encoded)
+		if err != nil {
+			return false
+		}
+
+		if index%2 == 0 {
+			node = zkp.MerkleNode(node, sibling)
+		} else {
+			node = zkp.MerkleNode(sibling, node)
+		}
+		index /= 2
+	}
+
+	expected, err := hex.DecodeString(root)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(zkp.MerkleRoot(total, node), expected)
+}
+
+// NewFrameMerkleTree builds the merkle tree of the thread from the hashes of every frame, keyed by filename.
+// Leaves are the salted commitments of the hashes, so the root doesn't leak them.
+func (t VideoRenderingThread) NewFrameMerkleTree(hashes map[string]string, salt, address string) (*FrameMerkleTree, error) {
+	var leaves [][]byte
+	for frame := t.StartFrame; frame <= t.EndFrame; frame++ {
+		filename := vm.FormatFrameFilename(int(frame), t.FrameExtension())
+		hash, ok := hashes[filename]
+		if !ok {
+			return nil, fmt.Errorf("frame %s is missing to build the merkle tree", filename)
+		}
+		leaf, err := FrameMerkleLeaf(frame, FrameCommitment(hash, salt, address))
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+	return NewFrameMerkleTree(leaves)
+}
+
+// VerifyFrameProof verifies the frame commitment is part of the merkle root of the proposed solution
+func (t VideoRenderingThread) VerifyFrameProof(filename, commitment string, proof []string) bool {
+	if t.Solution == nil {
+		return false
+	}
+
+	frameNumber, err := vm.ParseFrameFilename(filename)
+	if err != nil {
+		return false
+	}
+
+	leaf, err := FrameMerkleLeaf(frameNumber, commitment)
+	if err != nil {
+		return false
+	}
+
+	index := int(frameNumber - t.StartFrame)
+	total := int(t.EndFrame - t.StartFrame + 1)
+	return VerifyFrameMerkleProof(t.Solution.MerkleRoot, leaf, index, total, proof)
+}
@@ -0,0 +1,104 @@
+package videoRendering
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for FrameMerkleTree ---
+func TestFrameMerkleTree_ProofsVerify(t *testing.T) {
+	// every tree size, including the ones padded up to a power of two
+	for total := 1; total <= 9; total++ {
+		var leaves [][]byte
+		for i := 0; i < total; i++ {
+			leaf, err := FrameMerkleLeaf(int64(i), FrameCommitment(fmt.Sprintf("hash%v", i), testCommitmentSalt, "alice"))
+			require.NoError(t, err)
+			leaves = append(leaves, leaf)
+		}
+
+		tree, err := NewFrameMerkleTree(leaves)
+		require.NoError(t, err)
+
+		for i := 0; i < total; i++ {
+			proof, err := tree.Proof(i)
+			require.NoError(t, err)
+			require.True(t, VerifyFrameMerkleProof(tree.Root(), leaves[i], i, total, proof), "leaf %v of %v", i, total)
+
+			// the leaf can't be presented at another position, nor in a tree of other size
+			if total > 1 {
+				require.False(t, VerifyFrameMerkleProof(tree.Root(), leaves[i], (i+1)%total, total, proof), "leaf %v of %v", i, total)
+			}
+			require.False(t, VerifyFrameMerkleProof(tree.Root(), leaves[i], i, total+1, proof), "leaf %v of %v", i, total)
+		}
+	}
+}
+
+func TestFrameMerkleTree_Empty(t *testing.T) {
+	_, err := NewFrameMerkleTree(nil)
+
+	require.Error(t, err)
+}
+
+func TestFrameMerkleTree_ProofOutOfRange(t *testing.T) {
+	leaf, err := FrameMerkleLeaf(0, FrameCommitment("hash0", testCommitmentSalt, "alice"))
+	require.NoError(t, err)
+	tree, err := NewFrameMerkleTree([][]byte{leaf})
+	require.NoError(t, err)
+
+	_, err = tree.Proof(1)
+
+	require.Error(t, err)
+}
+
+func TestVerifyFrameProof(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", StartFrame: 10, EndFrame: 14}
+	hashes := map[string]string{}
+	for frame := thread.StartFrame; frame <= thread.EndFrame; frame++ {
+		hashes[vm.FormatFrameFilename(int(frame), "png")] = fmt.Sprintf("hash%v", frame)
+	}
+
+	tree, err := thread.NewFrameMerkleTree(hashes, testCommitmentSalt, "alice")
+	require.NoError(t, err)
+	thread.Solution = &VideoRenderingThread_Solution{MerkleRoot: tree.Root()}
+
+	proof, err := tree.Proof(2)
+	require.NoError(t, err)
+	commitment := FrameCommitment("hash12", testCommitmentSalt, "alice")
+
+	t.Run("committed hash is valid", func(t *testing.T) {
+		require.True(t, thread.VerifyFrameProof("frame_000012.png", commitment, proof))
+	})
+
+	t.Run("different hash is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000012.png", FrameCommitment("hash13", testCommitmentSalt, "alice"), proof))
+	})
+
+	t.Run("different salt is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000012.png", FrameCommitment("hash12", "ff"+testCommitmentSalt[2:], "alice"), proof))
+	})
+
+	t.Run("different sender is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000012.png", FrameCommitment("hash12", testCommitmentSalt, "bob"), proof))
+	})
+
+	t.Run("different frame is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000013.png", commitment, proof))
+	})
+
+	t.Run("truncated proof is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000012.png", commitment, proof[1:]))
+	})
+
+	t.Run("invalid commitment is not valid", func(t *testing.T) {
+		require.False(t, thread.VerifyFrameProof("frame_000012.png", "not hex", proof))
+	})
+
+	t.Run("missing frame can't build the tree", func(t *testing.T) {
+		delete(hashes, "frame_000011.png")
+		_, err := thread.NewFrameMerkleTree(hashes, testCommitmentSalt, "alice")
+		require.Error(t, err)
+	})
+}
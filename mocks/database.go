@@ -1,6 +1,9 @@
 package mocks
 
-import "github.com/stretchr/testify/mock"
+import (
+	"github.com/janction/videoRendering/db"
+	"github.com/stretchr/testify/mock"
+)
 
 type DB struct {
 	mock.Mock
@@ -20,3 +23,48 @@ func (m *DB) AddLogEntry(threadId, log string, timestamp, severity int64) error
 	args := m.Called(threadId, log, timestamp, severity)
 	return args.Error(0)
 }
+
+func (m *DB) AddRenderDuration(threadId string, threadNumber, durationInSeconds int) error {
+	args := m.Called(threadId, threadNumber, durationInSeconds)
+	return args.Error(0)
+}
+
+func (m *DB) GetAverageRenderTime(threadId string) (int, error) {
+	args := m.Called(threadId)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *DB) ReadCommitment(threadId string) (*db.Commitment, error) {
+	args := m.Called(threadId)
+	return args.Get(0).(*db.Commitment), args.Error(1)
+}
+
+func (m *DB) UpdateCommitment(threadId, salt string, revealed bool) error {
+	args := m.Called(threadId, salt, revealed)
+	return args.Error(0)
+}
+
+func (m *DB) UpdateAssembly(taskId string, started bool) error {
+	args := m.Called(taskId, started)
+	return args.Error(0)
+}
+
+func (m *DB) AddRenderedFrame(threadId string, frameNumber int64) error {
+	args := m.Called(threadId, frameNumber)
+	return args.Error(0)
+}
+
+func (m *DB) ReadRenderedFrames(threadId string) (map[int64]bool, error) {
+	args := m.Called(threadId)
+	return args.Get(0).(map[int64]bool), args.Error(1)
+}
+
+func (m *DB) AddReportedFrame(threadId string, frameNumber int64) error {
+	args := m.Called(threadId, frameNumber)
+	return args.Error(0)
+}
+
+func (m *DB) ReadReportedFrames(threadId string) (map[int64]bool, error) {
+	args := m.Called(threadId)
+	return args.Get(0).(map[int64]bool), args.Error(1)
+}
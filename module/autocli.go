@@ -9,7 +9,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: videoRenderingv1.Query_ServiceDesc.ServiceName,
+			Service:              videoRenderingv1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // adds these commands to the ones of GetQueryCmd
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "GetVideoRenderingTask",
@@ -24,6 +25,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "get-pending-video-rendering-tasks",
 					Short:     "Gets the pending video rendering tasks",
 				},
+				{
+					RpcMethod: "GetVerifyingKey",
+					Use:       "get-verifying-key [circuit_version]",
+					Short:     "Gets the verifying key registered for the circuit version",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "circuit_version"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -69,7 +78,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "ProposeSolution",
-					Use:       "propose-solution [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
+					Use:       "propose-solution [taskId] [threadId] [publicKey] [merkleRoot] --from [workerAddress]",
 					Short:     "Proposes a solution to a thread.",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
@@ -77,7 +86,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
 						{ProtoField: "public_key"},
-						{ProtoField: "signatures", Varargs: true},
+						{ProtoField: "merkle_root"},
 					},
 				},
 				{
@@ -95,29 +104,89 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "SubmitValidation",
-					Use:       "submit-validation [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
-					Short:     "Submit a validation to a proposed solution",
+					Use:       "submit-validation [taskId] [threadId] [publicKey] [commitments] --from [workerAddress]",
+					Short:     "Commits a validation to a proposed solution",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
 						{ProtoField: "public_key"},
-						{ProtoField: "signatures", Varargs: true},
+						{ProtoField: "commitments", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RevealValidation",
+					Use:       "reveal-validation [taskId] [threadId] [salt] [frames] --from [workerAddress]",
+					Short:     "Reveals the hashes committed in a validation",
+					Long:      "", // TODO Add long
+					Example:   "", // TODO add exampe
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+						{ProtoField: "salt"},
+						{ProtoField: "frames", Varargs: true},
 					},
 				},
 				{
 					RpcMethod: "RevealSolution",
-					Use:       "reveal-solution [taskId] [threadId] [solution] --from [workerAddress]",
+					Use:       "reveal-solution [taskId] [threadId] [salt] [sampled frames] --from [workerAddress]",
 					Short:     "Reveals the CiDs of the solution",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "taskId"},
 						{ProtoField: "threadId"},
+						{ProtoField: "salt"},
 						{ProtoField: "frames", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "RegisterVerifyingKey",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterRendererImage",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SubmitAssembledVideo",
+					Use:       "submit-assembled-video [taskId] [videoCid] [videoHash] --from [workerAddress]",
+					Short:     "Submits the video assembled from the frames of a completed task, or confirms it",
+					Long:      "", // TODO Add long
+					Example:   "", // TODO add exampe
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "task_id"},
+						{ProtoField: "video_cid"},
+						{ProtoField: "video_hash"},
+					},
+				},
+				{
+					RpcMethod: "SubmitPreview",
+					Use:       "submit-preview [taskId] [frame=seconds]... --from [workerAddress]",
+					Short:     "Submits the render times of the preview pass of a task, splitting its threads",
+					Long:      "", // TODO Add long
+					Example:   "", // TODO add exampe
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "task_id"},
+						{ProtoField: "costs", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "ReportTaskFailure",
+					Use:       "report-task-failure [taskId] [threadId] [frame] [timeout|crash|missing-output] [attempts] [logCid] --from [workerAddress]",
+					Short:     "Reports a frame of a thread the worker couldn't render, failing the task once enough workers report it",
+					Long:      "", // TODO Add long
+					Example:   "", // TODO add exampe
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "task_id"},
+						{ProtoField: "thread_id"},
+						{ProtoField: "frame"},
+						{ProtoField: "reason"},
+						{ProtoField: "attempts"},
+						{ProtoField: "log_cid"},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,104 @@
+package module
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/janction/videoRendering"
+	"github.com/janction/videoRendering/zkp"
+)
+
+// GetQueryCmd returns the query commands of the module that autocli can't generate. Autocli adds the rest of them.
+func (am AppModule) GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        videoRendering.ModuleName,
+		Short:                      "Querying commands for the videoRendering module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(provingKeyCmd())
+	return cmd
+}
+
+// provingKeyCmd groups the commands that share the proving key of the current circuit version between nodes
+func provingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proving-key",
+		Short: "Exports and imports the proving key of the current circuit version",
+		RunE:  client.ValidateCmd,
+	}
+	cmd.AddCommand(exportProvingKeyCmd(), importProvingKeyCmd())
+	return cmd
+}
+
+func exportProvingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Exports the proving key of the current circuit version of the node to a file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			version, err := zkp.CircuitVersion()
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := zkp.ExportProvingKey(clientCtx.HomeDir, version, file); err != nil {
+				os.Remove(args[0])
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("exported proving key of circuit version %s to %s\n", version, args[0]))
+		},
+	}
+	return cmd
+}
+
+func importProvingKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports the proving key of the current circuit version from a file",
+		Long:  "Imports the proving key of the current circuit version from a file. The key is only imported if it matches the verifying key registered on chain for the version.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := zkp.CircuitVersion()
+			if err != nil {
+				return err
+			}
+
+			res, err := videoRendering.NewQueryClient(clientCtx).GetVerifyingKey(cmd.Context(), &videoRendering.QueryGetVerifyingKeyRequest{CircuitVersion: version})
+			if err != nil {
+				return fmt.Errorf("failed to get the verifying key of circuit version %s: %w", version, err)
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := zkp.ImportProvingKey(clientCtx.HomeDir, version, res.VerifyingKey, file); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("imported proving key of circuit version %s\n", version))
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
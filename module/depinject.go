@@ -1,6 +1,8 @@
 package module
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -47,14 +49,25 @@ type ModuleOutputs struct {
 	Keeper keeper.Keeper
 }
 
-func ProvideModule(in ModuleInputs) ModuleOutputs {
+func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 	// default to governance as authority if not provided
 	authority := authtypes.NewModuleAddress("gov")
 	if in.Config.Authority != "" {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
-	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, authority.String(), in.Config.GetPath(), in.BankKeeper)
+
+	// the worker configuration is validated before the app starts, so a node can't start with a runtime it can't render with
+	config, err := keeper.GetVideoRenderingConfiguration(in.Config.GetPath())
+	if err != nil {
+		return ModuleOutputs{}, err
+	}
+	machine, err := config.NewVM()
+	if err != nil {
+		return ModuleOutputs{}, fmt.Errorf("invalid configuration at %s: %w", config.ConfigPath, err)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, authority.String(), in.Config.GetPath(), in.BankKeeper, machine)
 	m := NewAppModule(in.Cdc, k)
 
-	return ModuleOutputs{Module: m, Keeper: k}
+	return ModuleOutputs{Module: m, Keeper: k}, nil
 }
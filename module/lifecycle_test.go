@@ -0,0 +1,924 @@
+package module
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/stretchr/testify/require"
+
+	"bou.ke/monkey"
+	"github.com/janction/videoRendering"
+	videoRenderingCrypto "github.com/janction/videoRendering/crypto"
+	"github.com/janction/videoRendering/ipfs"
+	"github.com/janction/videoRendering/keeper"
+	"github.com/janction/videoRendering/vm"
+	"github.com/janction/videoRendering/zkp"
+)
+
+// devnetNode is a worker node of the in-process devnet
+type devnetNode struct {
+	address   string
+	publicKey string
+	module    AppModule
+	keeper    keeper.Keeper
+}
+
+// devnet runs the module of several worker nodes over the same chain state. Transactions of the nodes are delivered
+// at the beginning of the next block, like a chain would include them
+type devnet struct {
+	t         *testing.T
+	ctx       sdk.Context
+	server    videoRendering.MsgServer
+	bank      bankkeeper.BaseKeeper
+	nodes     []devnetNode
+	mu        sync.Mutex
+	mempool   []func(ctx context.Context) error
+	delivered []string
+	// vm every node renders with
+	machine *vm.VM
+}
+
+func newDevnet(t *testing.T, workers int) *devnet {
+	// the whole pipeline runs without containers nor an IPFS daemon
+	previousStore := ipfs.SetStore(ipfs.NewMemoryStore())
+	// failed renders are retried right away, enough times for flaky renders to succeed
+	net := &devnet{t: t, machine: &vm.VM{Runtime: vm.NewSimulatedRuntime(0, 0.3, 1), Sandbox: vm.DefaultSandbox(), Policy: vm.RenderPolicy{FrameTimeout: 60, MaxAttempts: 10}}}
+	previousRunner := videoRendering.SetCliRunner(net.broadcast)
+	t.Cleanup(func() {
+		ipfs.SetStore(previousStore)
+		videoRendering.SetCliRunner(previousRunner)
+	})
+
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, "bank", videoRendering.ModuleName)
+	net.ctx = testutil.DefaultContextWithKeys(keys, nil, nil).WithChainID("devnet").WithBlockHeight(1)
+
+	encoding := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	videoRendering.RegisterInterfaces(encoding.InterfaceRegistry)
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	accounts := authkeeper.NewAccountKeeper(encoding.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]), authtypes.ProtoBaseAccount,
+		map[string][]string{"mint": {authtypes.Minter}, videoRendering.ModuleName: nil}, addressCodec, sdk.GetConfig().GetBech32AccountAddrPrefix(), authority)
+	net.bank = bankkeeper.NewBaseKeeper(encoding.Codec, runtime.NewKVStoreService(keys["bank"]), accounts, map[string]bool{}, authority, log.NewNopLogger())
+	storeService := runtime.NewKVStoreService(keys[videoRendering.ModuleName])
+
+	genesis := videoRendering.NewGenesisState()
+	// blocks are short, so validators get enough of them to render the sampled frames
+	genesis.Params.CommitPhaseBlocks = 20
+	genesis.Params.EncoderImage = devnetEncoderImage
+	genesis.RendererImages = []videoRendering.RendererImage{{Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: vm.Architecture(), Digest: devnetRendererDigest}}
+
+	for i := 0; i < workers; i++ {
+		name := fmt.Sprintf("worker%d", i)
+		// render goroutines of the nodes may still write when the test ends, so their files are removed best effort
+		rootPath, err := os.MkdirTemp("", name)
+		require.NoError(t, err)
+		t.Cleanup(func() { os.RemoveAll(rootPath) })
+		ring, err := keyring.New("janction", keyring.BackendTest, rootPath, nil, encoding.Codec)
+		require.NoError(t, err)
+		record, _, err := ring.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		address, err := record.GetAddress()
+		require.NoError(t, err)
+		publicKey, err := record.GetPubKey()
+		require.NoError(t, err)
+
+		k := keeper.NewKeeper(encoding.Codec, addressCodec, storeService, authority, rootPath, net.bank, net.machine)
+		k.Configuration = keeper.VideoConfiguration{Enabled: true, WorkerName: name, WorkerAddress: address.String(), RootPath: rootPath}
+		net.nodes = append(net.nodes, devnetNode{address: address.String(), publicKey: videoRenderingCrypto.EncodePublicKeyForCLI(publicKey), module: NewAppModule(encoding.Codec, k), keeper: k})
+	}
+	require.NoError(t, net.nodes[0].keeper.InitGenesis(net.ctx, genesis))
+	net.server = keeper.NewMsgServerImpl(net.nodes[0].keeper)
+
+	// workers are registered with their stake, so nodes don't register them with the cli
+	for _, node := range net.nodes {
+		net.fund(node.address, *genesis.Params.MinWorkerStaking)
+		_, err := net.server.AddWorker(net.ctx, &videoRendering.MsgAddWorker{Creator: node.address, Stake: *genesis.Params.MinWorkerStaking, Architecture: vm.Architecture()})
+		require.NoError(t, err)
+		require.NoError(t, node.keeper.DB.Addworker(node.address))
+	}
+	return net
+}
+
+// fund mints the coins to the address
+func (net *devnet) fund(address string, coin sdk.Coin) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(net.t, err)
+	require.NoError(net.t, net.bank.MintCoins(net.ctx, "mint", sdk.NewCoins(coin)))
+	require.NoError(net.t, net.bank.SendCoinsFromModuleToAccount(net.ctx, "mint", addr, sdk.NewCoins(coin)))
+}
+
+// broadcast adds the transaction of the janctiond arguments to the mempool
+func (net *devnet) broadcast(args []string) error {
+	if len(args) < 3 || args[0] != "tx" || args[1] != videoRendering.ModuleName {
+		return fmt.Errorf("unsupported command %v", args)
+	}
+	// positional arguments go before the flags
+	var positional []string
+	flags := make(map[string]string)
+	for i := 3; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "--") {
+			positional = append(positional, args[i])
+			continue
+		}
+		if args[i] != "--yes" && i+1 < len(args) {
+			flags[args[i]] = args[i+1]
+			i++
+		}
+	}
+	arg := func(i int) string {
+		if i < len(positional) {
+			return positional[i]
+		}
+		return ""
+	}
+	rest := func(i int) []string {
+		if i < len(positional) {
+			return positional[i:]
+		}
+		return nil
+	}
+	from := flags["--from"]
+
+	var deliver func(ctx context.Context) error
+	switch args[2] {
+	case "subscribe-worker-to-task":
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.SubscribeWorkerToTask(ctx, &videoRendering.MsgSubscribeWorkerToTask{Address: arg(0), TaskId: arg(1), ThreadId: arg(2)})
+			return err
+		}
+	case "propose-solution":
+		var seconds int64
+		if flag, ok := flags["--average-render-seconds"]; ok {
+			var err error
+			if seconds, err = strconv.ParseInt(flag, 10, 64); err != nil {
+				return err
+			}
+		}
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.ProposeSolution(ctx, &videoRendering.MsgProposeSolution{Creator: from, TaskId: arg(0), ThreadId: arg(1), PublicKey: arg(2), MerkleRoot: arg(3),
+				HashVersion: flags["--hash-version"], RenderSettings: flags["--render-settings"], RendererDigest: flags["--renderer-digest"], ZkProof: flags["--zk-proof"], ZkCircuitVersion: flags["--zk-circuit-version"],
+				AverageRenderSeconds: seconds})
+			return err
+		}
+	case "submit-validation":
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.SubmitValidation(ctx, &videoRendering.MsgSubmitValidation{Creator: from, TaskId: arg(0), ThreadId: arg(1), PublicKey: arg(2), Commitments: rest(3)})
+			return err
+		}
+	case "reveal-validation":
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.RevealValidation(ctx, &videoRendering.MsgRevealValidation{Creator: from, TaskId: arg(0), ThreadId: arg(1), Salt: arg(2), Frames: rest(3)})
+			return err
+		}
+	case "reveal-solution":
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.RevealSolution(ctx, &videoRendering.MsgRevealSolution{Creator: from, TaskId: arg(0), ThreadId: arg(1), Salt: arg(2), Frames: rest(3)})
+			return err
+		}
+	case "submit-solution":
+		seconds, err := strconv.ParseInt(arg(3), 10, 64)
+		if err != nil {
+			return err
+		}
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.SubmitSolution(ctx, &videoRendering.MsgSubmitSolution{Creator: from, TaskId: arg(0), ThreadId: arg(1), Dir: arg(2), AverageRenderSeconds: seconds})
+			return err
+		}
+	case "submit-assembled-video":
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.SubmitAssembledVideo(ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: from, TaskId: arg(0), VideoCid: arg(1), VideoHash: arg(2)})
+			return err
+		}
+	case "report-task-failure":
+		frame, err := strconv.ParseInt(arg(2), 10, 64)
+		if err != nil {
+			return err
+		}
+		attempts, err := strconv.ParseUint(arg(4), 10, 32)
+		if err != nil {
+			return err
+		}
+		reason := videoRendering.VideoRenderingThread_UnrenderableFrame_Reason_value[strings.ToUpper(strings.ReplaceAll(arg(3), "-", "_"))]
+		deliver = func(ctx context.Context) error {
+			_, err := net.server.ReportTaskFailure(ctx, &videoRendering.MsgReportTaskFailure{Creator: from, TaskId: arg(0), ThreadId: arg(1), Frame: frame,
+				Reason: videoRendering.VideoRenderingThread_UnrenderableFrame_Reason(reason), Attempts: uint32(attempts), LogCid: arg(5)})
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported command %s", args[2])
+	}
+
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	net.mempool = append(net.mempool, deliver)
+	net.delivered = append(net.delivered, args[2])
+	return nil
+}
+
+// deliver delivers the transactions of the mempool
+func (net *devnet) deliver() {
+	net.mu.Lock()
+	mempool := net.mempool
+	net.mempool = nil
+	net.mu.Unlock()
+
+	// like a chain, failed transactions don't stop the block
+	for _, deliver := range mempool {
+		if err := deliver(net.ctx); err != nil {
+			net.t.Logf("transaction failed: %s", err.Error())
+		}
+	}
+}
+
+// commit runs the next block at every node
+func (net *devnet) commit() {
+	height := net.ctx.BlockHeight() + 1
+	var bytes [8]byte
+	binary.BigEndian.PutUint64(bytes[:], uint64(height))
+	hash := sha256.Sum256(bytes[:])
+	net.ctx = net.ctx.WithBlockHeight(height).WithHeaderHash(hash[:])
+
+	for _, node := range net.nodes {
+		require.NoError(net.t, node.module.BeginBlock(net.ctx))
+	}
+	for _, node := range net.nodes {
+		require.NoError(net.t, node.module.EndBlock(net.ctx))
+	}
+}
+
+// transactions returns the commands broadcasted by the nodes
+func (net *devnet) transactions() []string {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	return append([]string{}, net.delivered...)
+}
+
+func TestTaskLifecycle_SimulatedDevnet(t *testing.T) {
+	// 1. Setup: three workers, and a requester with the scene of the task in the store
+	net := newDevnet(t, 3)
+	scene := filepath.Join(t.TempDir(), "scene.blend")
+	require.NoError(t, os.WriteFile(scene, []byte("simulated scene"), 0644))
+	cid, err := ipfs.UploadFile(scene)
+	require.NoError(t, err)
+
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+
+	// 2. The task is created
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: cid, StartFrame: 1, EndFrame: 5, Threads: 1, Reward: &reward, BlenderVersion: "4.2"})
+	require.NoError(t, err)
+	// scenes of versions without an approved image can't be rendered
+	_, err = net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: cid, StartFrame: 1, EndFrame: 5, Threads: 1, Reward: &reward, BlenderVersion: "3.6"})
+	require.ErrorContains(t, err, videoRendering.ErrInvalidVideoRenderingTask.Error())
+
+	// 3. Blocks run until the solution is submitted
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	// the workers of the thread keep the image they started with
+	pinned := func(thread *videoRendering.VideoRenderingThread) bool {
+		count := 0
+		for _, node := range net.nodes {
+			image, err := node.keeper.DB.ReadRendererImage(thread.ThreadId)
+			if err == nil && slices.Contains(thread.Workers, node.address) && image.Digest != "" {
+				count++
+			}
+		}
+		return count == 2
+	}
+	upgraded := false
+	deadline := time.Now().Add(60 * time.Second)
+	for net.deliver(); !read().Threads[0].Completed; net.deliver() {
+		require.True(t, time.Now().Before(deadline), "thread wasn't completed. Transactions: %v", net.transactions())
+		// governance approves a newer blender build while the thread renders
+		if !upgraded && pinned(read().Threads[0]) {
+			upgrade := videoRendering.RendererImage{Name: "blendergrid/blender", BlenderVersion: "4.2.1", Architecture: vm.Architecture(), Digest: "sha256:" + strings.Repeat("1e", 32)}
+			_, err := net.server.RegisterRendererImage(net.ctx, &videoRendering.MsgRegisterRendererImage{Authority: net.nodes[0].keeper.GetAuthority(), Image: upgrade})
+			require.NoError(t, err)
+			upgraded = true
+		}
+		net.commit()
+		time.Sleep(20 * time.Millisecond)
+	}
+	require.True(t, upgraded)
+
+	// 4. The solution went through every phase, and was accepted with the validations of both workers of the thread
+	task := read()
+	thread := task.Threads[0]
+	transactions := net.transactions()
+	for _, command := range []string{"subscribe-worker-to-task", "propose-solution", "submit-validation", "reveal-validation", "reveal-solution", "submit-solution"} {
+		require.Contains(t, transactions, command)
+	}
+	require.Len(t, thread.Workers, 2)
+	require.True(t, thread.Solution.Accepted)
+	require.Len(t, thread.Solution.Frames, len(thread.SampledFrames))
+	require.Equal(t, 2, thread.RevealedValidations())
+	// rendered with the approved image of the blender version of the task when the thread started
+	require.Equal(t, "4.2", thread.BlenderVersion)
+	require.Equal(t, devnetRendererDigest, thread.Solution.RendererDigest)
+
+	// 5. Every worker rendered the same frames, and the submitted directory has them
+	var outputs []string
+	for _, node := range net.nodes {
+		output := filepath.Join(node.keeper.Configuration.RootPath, "renders", thread.ThreadId, "output")
+		if _, err := os.Stat(output); err == nil {
+			outputs = append(outputs, output)
+		}
+	}
+	require.Len(t, outputs, 2)
+	for frame := thread.StartFrame; frame <= thread.EndFrame; frame++ {
+		filename := vm.FormatFrameFilename(int(frame), thread.FrameExtension())
+		rendered, err := os.ReadFile(filepath.Join(outputs[0], filename))
+		require.NoError(t, err)
+		validated, err := os.ReadFile(filepath.Join(outputs[1], filename))
+		require.NoError(t, err)
+		require.Equal(t, rendered, validated)
+	}
+	require.NotEmpty(t, thread.Solution.Dir)
+	require.NoError(t, thread.VerifySubmittedSolution(thread.Solution.Dir))
+
+	// 6. The winner was paid
+	winner, err := sdk.AccAddressFromBech32(thread.Solution.ProposedBy)
+	require.NoError(t, err)
+	require.Equal(t, task.GetWinnerReward(), net.bank.GetBalance(net.ctx, winner, "jct"))
+
+	// 7. Blocks run until the video the winner assembled is confirmed by the other worker of the thread
+	for net.deliver(); !read().Assembly.Verified; net.deliver() {
+		require.True(t, time.Now().Before(deadline), "video wasn't verified. Transactions: %v", net.transactions())
+		net.commit()
+		time.Sleep(20 * time.Millisecond)
+	}
+	task = read()
+	require.Equal(t, thread.Solution.ProposedBy, task.Assembly.Assembler)
+	require.Equal(t, devnetEncoderImage, task.Assembly.EncoderImage)
+	require.Len(t, task.Assembly.Confirmations, 1)
+	require.Empty(t, task.Assembly.FailedAssemblers)
+	require.Equal(t, task.GetWinnerReward().Add(*task.Assembly.Reward), net.bank.GetBalance(net.ctx, winner, "jct"))
+}
+
+func TestTaskLifecycle_RejectedVideo(t *testing.T) {
+	// 1. Setup: a completed task whose first worker assembles the video, confirmed by the other two. Nodes only run the
+	// chain, videos are submitted by the test
+	net := newDevnet(t, 3)
+	for i := range net.nodes {
+		net.nodes[i].module.keeper.Configuration.Enabled = false
+	}
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+
+	params, err := net.nodes[0].keeper.Params.Get(net.ctx)
+	require.NoError(t, err)
+	task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+	require.NoError(t, err)
+	task.Completed = true
+	task.Threads[0].Workers = []string{net.nodes[0].address, net.nodes[1].address, net.nodes[2].address}
+	task.Threads[0].Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: net.nodes[0].address}
+	task.DesignateAssembler(net.ctx.BlockHeight(), params.AssemblyPhaseBlocks)
+	require.NoError(t, net.nodes[0].keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	stake := func(address string) math.Int {
+		worker, err := net.nodes[0].keeper.Workers.Get(net.ctx, address)
+		require.NoError(t, err)
+		return worker.Reputation.Staked.Amount
+	}
+	balance := func(address string) math.Int {
+		addr, err := sdk.AccAddressFromBech32(address)
+		require.NoError(t, err)
+		return net.bank.GetBalance(net.ctx, addr, "jct").Amount
+	}
+	staked := params.MinWorkerStaking.Amount
+	videoCid := "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n"
+	video := sha256.Sum256([]byte("video"))
+	other := sha256.Sum256([]byte("other video"))
+
+	// 2. The assembler is the only one that can submit the video
+	_, err = net.server.SubmitAssembledVideo(net.ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: net.nodes[1].address, TaskId: task.TaskId, VideoCid: videoCid, VideoHash: hex.EncodeToString(video[:])})
+	require.ErrorContains(t, err, videoRendering.ErrInvalidAssembly.Error())
+	_, err = net.server.SubmitAssembledVideo(net.ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: net.nodes[0].address, TaskId: task.TaskId, VideoCid: videoCid, VideoHash: hex.EncodeToString(video[:])})
+	require.NoError(t, err)
+	require.False(t, read().Assembly.Verified)
+
+	// 3. Another worker encodes another video, so the assembler loses a slice of its stake to the requester and the
+	// assembly goes to the next worker
+	_, err = net.server.SubmitAssembledVideo(net.ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: net.nodes[1].address, TaskId: task.TaskId, VideoCid: videoCid, VideoHash: hex.EncodeToString(other[:])})
+	require.NoError(t, err)
+	task = read()
+	require.False(t, task.Assembly.Verified)
+	require.Equal(t, net.nodes[1].address, task.Assembly.Assembler)
+	require.Equal(t, []string{net.nodes[0].address}, task.Assembly.FailedAssemblers)
+	require.Empty(t, task.Assembly.VideoCid)
+	penalty := staked.MulRaw(int64(params.AssemblyPenaltyPercentage)).QuoRaw(100)
+	require.Equal(t, staked.Sub(penalty), stake(net.nodes[0].address))
+	require.Equal(t, penalty, balance(requester))
+	_, err = net.server.SubmitAssembledVideo(net.ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: net.nodes[0].address, TaskId: task.TaskId, VideoCid: videoCid, VideoHash: hex.EncodeToString(video[:])})
+	require.ErrorContains(t, err, videoRendering.ErrInvalidAssembly.Error())
+
+	// 4. The new assembler doesn't submit its video in time, so it's penalized and the last worker assembles it
+	net.ctx = net.ctx.WithBlockHeight(task.Assembly.Deadline)
+	net.commit()
+	task = read()
+	require.Equal(t, net.nodes[2].address, task.Assembly.Assembler)
+	require.Equal(t, staked.Sub(penalty), stake(net.nodes[1].address))
+	require.Equal(t, penalty.MulRaw(2), balance(requester))
+
+	// 5. A video no other worker can confirm isn't accepted, its reward is refunded once the deadline passes
+	_, err = net.server.SubmitAssembledVideo(net.ctx, &videoRendering.MsgSubmitAssembledVideo{Creator: net.nodes[2].address, TaskId: task.TaskId, VideoCid: videoCid, VideoHash: hex.EncodeToString(video[:])})
+	require.NoError(t, err)
+	require.False(t, read().Assembly.Verified)
+	net.ctx = net.ctx.WithBlockHeight(task.Assembly.Deadline)
+	net.commit()
+	task = read()
+	require.False(t, task.Assembly.Verified)
+	require.Empty(t, task.Assembly.Assembler)
+	require.True(t, task.Assembly.Reward.IsZero())
+	// submitted videos aren't penalized, the assembler couldn't get them confirmed
+	require.Equal(t, staked, stake(net.nodes[2].address))
+	require.Equal(t, penalty.MulRaw(2).AddRaw(50), balance(requester))
+}
+
+func TestTaskLifecycle_UnpaidReassignment(t *testing.T) {
+	// 1. Setup: a completed task whose only worker doesn't submit its video in time, so it's penalized and the reward
+	// of the assembly refunded. Nodes only run the chain
+	net := newDevnet(t, 1)
+	net.nodes[0].module.keeper.Configuration.Enabled = false
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+
+	params, err := net.nodes[0].keeper.Params.Get(net.ctx)
+	require.NoError(t, err)
+	task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+	require.NoError(t, err)
+	task.Completed = true
+	task.Threads[0].Workers = []string{net.nodes[0].address}
+	task.Threads[0].Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: net.nodes[0].address}
+	task.DesignateAssembler(net.ctx.BlockHeight(), params.AssemblyPhaseBlocks)
+	require.NoError(t, net.nodes[0].keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	stake := func() math.Int {
+		worker, err := net.nodes[0].keeper.Workers.Get(net.ctx, net.nodes[0].address)
+		require.NoError(t, err)
+		return worker.Reputation.Staked.Amount
+	}
+	requesterAddress, err := sdk.AccAddressFromBech32(requester)
+	require.NoError(t, err)
+	balance := func() math.Int {
+		return net.bank.GetBalance(net.ctx, requesterAddress, "jct").Amount
+	}
+	staked := params.MinWorkerStaking.Amount
+	penalty := staked.MulRaw(int64(params.AssemblyPenaltyPercentage)).QuoRaw(100)
+
+	// 2. The module holds the penalty but not the refund, so neither is paid and the stake of the assembler is kept,
+	// however many blocks pass
+	moduleAddress := authtypes.NewModuleAddress(videoRendering.ModuleName)
+	sink := authtypes.NewModuleAddress("sink")
+	missing := net.bank.GetAllBalances(net.ctx, moduleAddress).Sub(sdk.NewCoin("jct", penalty))
+	require.NoError(t, net.bank.SendCoinsFromModuleToAccount(net.ctx, videoRendering.ModuleName, sink, missing))
+	net.ctx = net.ctx.WithBlockHeight(task.Assembly.Deadline + 1)
+	net.commit()
+	net.commit()
+	task = read()
+	require.Equal(t, net.nodes[0].address, task.Assembly.Assembler)
+	require.Empty(t, task.Assembly.FailedAssemblers)
+	require.Equal(t, reward.Amount.MulRaw(int64(params.AssemblyRewardPercentage)).QuoRaw(100), task.Assembly.Reward.Amount)
+	require.Equal(t, staked, stake())
+	require.True(t, balance().IsZero())
+
+	// 3. Once the module holds the coins again, the assembler is penalized once and the reward refunded
+	require.NoError(t, net.bank.SendCoinsFromAccountToModule(net.ctx, sink, videoRendering.ModuleName, missing))
+	net.commit()
+	task = read()
+	require.Empty(t, task.Assembly.Assembler)
+	require.Equal(t, []string{net.nodes[0].address}, task.Assembly.FailedAssemblers)
+	require.True(t, task.Assembly.Reward.IsZero())
+	require.Equal(t, staked.Sub(penalty), stake())
+	require.Equal(t, penalty.AddRaw(50), balance())
+}
+
+func TestTaskLifecycle_DesignatedPreview(t *testing.T) {
+	// 1. Setup: four workers, three of them designated to preview the task. Nodes only run the chain, previews are
+	// submitted by the test
+	net := newDevnet(t, 4)
+	for i := range net.nodes {
+		net.nodes[i].module.keeper.Configuration.Enabled = false
+	}
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 11, Threads: 2, Reward: &reward,
+		Split: &videoRendering.ThreadSplit{Strategy: videoRendering.ThreadSplit_PREVIEW, PreviewStride: 5}})
+	require.NoError(t, err)
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	task := read()
+	require.Len(t, task.Split.Previewers, 3)
+	require.Empty(t, task.Threads)
+	var outsider string
+	for _, node := range net.nodes {
+		if !slices.Contains(task.Split.Previewers, node.address) {
+			outsider = node.address
+		}
+	}
+	submit := func(worker string, costs ...string) error {
+		_, err := net.server.SubmitPreview(net.ctx, &videoRendering.MsgSubmitPreview{Creator: worker, TaskId: response.TaskId, Costs: costs})
+		return err
+	}
+
+	// 2. Only designated workers submit previews, once each
+	require.ErrorContains(t, submit(outsider, "1=2", "6=2", "11=40"), videoRendering.ErrInvalidPreview.Error())
+	require.NoError(t, submit(task.Split.Previewers[0], "1=2", "6=2", "11=40"))
+	require.ErrorContains(t, submit(task.Split.Previewers[0], "1=2", "6=2", "11=40"), videoRendering.ErrInvalidPreview.Error())
+
+	// 3. Costs that don't match the first preview aren't accepted
+	require.NoError(t, submit(task.Split.Previewers[1], "1=40", "6=2", "11=2"))
+	require.Empty(t, read().Threads)
+
+	// 4. A matching preview confirms the costs, and threads are split by their average
+	require.NoError(t, submit(task.Split.Previewers[2], "1=2", "6=4", "11=60"))
+	task = read()
+	require.Equal(t, []*videoRendering.FrameCost{{Frame: 1, Seconds: 2}, {Frame: 6, Seconds: 3}, {Frame: 11, Seconds: 50}}, task.Split.Costs)
+	require.Len(t, task.Threads, 2)
+	require.Equal(t, int64(9), task.Threads[0].EndFrame)
+
+	// 5. Without enough workers to confirm a preview, frames are split evenly right away
+	single := newDevnet(t, 1)
+	single.fund(requester, reward)
+	response, err = single.server.CreateVideoRenderingTask(single.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 11, Threads: 2, Reward: &reward,
+		Split: &videoRendering.ThreadSplit{Strategy: videoRendering.ThreadSplit_PREVIEW}})
+	require.NoError(t, err)
+	task, err = single.nodes[0].keeper.VideoRenderingTasks.Get(single.ctx, response.TaskId)
+	require.NoError(t, err)
+	require.Empty(t, task.Split.Previewers)
+	require.Len(t, task.Threads, 2)
+	require.Equal(t, int64(6), task.Threads[0].EndFrame)
+}
+
+func TestTaskLifecycle_ReopenedCommitPhase(t *testing.T) {
+	// 1. Setup: a proposed solution with one validator committed. Nodes only run the chain
+	net := newDevnet(t, 3)
+	for i := range net.nodes {
+		net.nodes[i].module.keeper.Configuration.Enabled = false
+	}
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+	params, err := net.nodes[0].keeper.Params.Get(net.ctx)
+	require.NoError(t, err)
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	task := read()
+	thread := task.Threads[0]
+	thread.Workers = []string{net.nodes[0].address, net.nodes[1].address, net.nodes[2].address}
+	thread.Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: net.nodes[0].address}
+	thread.Validations = []*videoRendering.VideoRenderingThread_Validation{{Validator: net.nodes[1].address, Frames: []*videoRendering.VideoRenderingThread_Frame{{Filename: "frame_000001.png", Commitment: "commitment"}}}}
+	thread.StartCommitPhase(net.ctx.BlockHeight(), params.CommitBlocks(2, 0))
+	require.NoError(t, net.nodes[0].keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+
+	// 2. Once the commit deadline is over, validators have until the reveal deadline
+	net.ctx = net.ctx.WithBlockHeight(thread.CommitDeadline)
+	net.commit()
+	thread = read().Threads[0]
+	require.Equal(t, videoRendering.VideoRenderingThread_REVEAL, thread.Phase)
+	require.Equal(t, net.ctx.BlockHeight()+params.RevealPhaseBlocks, thread.RevealDeadline)
+
+	// 3. Nobody revealed, so the commit phase is reopened for another round without the unrevealed validation
+	net.ctx = net.ctx.WithBlockHeight(thread.RevealDeadline)
+	net.commit()
+	thread = read().Threads[0]
+	require.Equal(t, videoRendering.VideoRenderingThread_COMMIT, thread.Phase)
+	require.Equal(t, uint32(1), thread.CommitRound)
+	require.Empty(t, thread.Validations)
+	require.Equal(t, net.ctx.BlockHeight()+params.CommitPhaseBlocks, thread.CommitDeadline)
+	require.True(t, thread.IsCommitOpen(net.ctx.BlockHeight()))
+}
+
+func TestTaskLifecycle_DroppedSolution(t *testing.T) {
+	// 1. Setup: a proposed solution revealed by two validators, but not by its proposer. Nodes only run the chain
+	net := newDevnet(t, 3)
+	for i := range net.nodes {
+		net.nodes[i].module.keeper.Configuration.Enabled = false
+	}
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+	params, err := net.nodes[0].keeper.Params.Get(net.ctx)
+	require.NoError(t, err)
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	proposer := net.nodes[0].address
+	worker, err := net.nodes[0].keeper.Workers.Get(net.ctx, proposer)
+	require.NoError(t, err)
+	worker.CurrentTaskId = response.TaskId
+	require.NoError(t, net.nodes[0].keeper.Workers.Set(net.ctx, proposer, worker))
+	task := read()
+	thread := task.Threads[0]
+	thread.Workers = []string{proposer, net.nodes[1].address, net.nodes[2].address}
+	thread.Solution = &videoRendering.VideoRenderingThread_Solution{ProposedBy: proposer}
+	frames := []*videoRendering.VideoRenderingThread_Frame{{Filename: "frame_000001.png", Commitment: "commitment", Hash: "hash", Signature: "signature"}}
+	thread.Validations = []*videoRendering.VideoRenderingThread_Validation{{Validator: net.nodes[1].address, Frames: frames}, {Validator: net.nodes[2].address, Frames: frames}}
+	thread.StartCommitPhase(net.ctx.BlockHeight(), params.CommitBlocks(2, 0))
+	require.NoError(t, net.nodes[0].keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+
+	// 2. Once validators revealed, the proposer has until the solution reveal deadline
+	net.ctx = net.ctx.WithBlockHeight(thread.CommitDeadline)
+	net.commit()
+	thread = read().Threads[0]
+	require.Equal(t, videoRendering.VideoRenderingThread_REVEAL, thread.Phase)
+	require.Equal(t, net.ctx.BlockHeight()+params.RevealPhaseBlocks, thread.SolutionRevealDeadline)
+
+	// 3. The proposer didn't reveal in time, so its solution is dropped and the thread reopened for the other workers
+	net.ctx = net.ctx.WithBlockHeight(thread.SolutionRevealDeadline)
+	net.commit()
+	thread = read().Threads[0]
+	require.Equal(t, videoRendering.VideoRenderingThread_RENDERING, thread.Phase)
+	require.Nil(t, thread.Solution)
+	require.Empty(t, thread.Validations)
+	require.Equal(t, []string{net.nodes[1].address, net.nodes[2].address}, thread.Workers)
+	require.Equal(t, uint32(1), thread.ProposalRound)
+	worker, err = net.nodes[0].keeper.Workers.Get(net.ctx, proposer)
+	require.NoError(t, err)
+	require.Empty(t, worker.CurrentTaskId)
+
+	// 4. Another worker of the thread proposes a solution, but the dropped proposer can't
+	propose := func(node devnetNode) error {
+		_, err := net.server.ProposeSolution(net.ctx, &videoRendering.MsgProposeSolution{Creator: node.address, TaskId: task.TaskId, ThreadId: thread.ThreadId, PublicKey: node.publicKey, MerkleRoot: hex.EncodeToString(sha256.New().Sum(nil))})
+		return err
+	}
+	require.ErrorContains(t, propose(net.nodes[0]), videoRendering.ErrInvalidSolution.Error())
+	require.NoError(t, propose(net.nodes[1]))
+	require.Equal(t, net.nodes[1].address, read().Threads[0].Solution.ProposedBy)
+}
+
+func TestTaskLifecycle_RestartedWork(t *testing.T) {
+	// 1. Setup: a worker whose render stopped with an error after the scene was downloaded
+	net := newDevnet(t, 1)
+	node := net.nodes[0]
+	scene := filepath.Join(t.TempDir(), "scene.blend")
+	require.NoError(t, os.WriteFile(scene, []byte("simulated scene"), 0644))
+	cid, err := ipfs.UploadFile(scene)
+	require.NoError(t, err)
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: cid, StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward, BlenderVersion: "4.2"})
+	require.NoError(t, err)
+	task, err := node.keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+	require.NoError(t, err)
+	thread := task.Threads[0]
+	thread.Workers = []string{node.address}
+	require.NoError(t, node.keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+	worker, err := node.keeper.Workers.Get(net.ctx, node.address)
+	require.NoError(t, err)
+	worker.CurrentTaskId = task.TaskId
+	require.NoError(t, node.keeper.Workers.Set(net.ctx, node.address, worker))
+	require.NoError(t, node.keeper.DB.AddThread(thread.ThreadId))
+	require.NoError(t, node.keeper.DB.UpdateThread(thread.ThreadId, true, true, false, false, false, false, false, false))
+
+	// 2. Blocks restart the work until every frame is rendered
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		local, err := node.keeper.DB.ReadThread(thread.ThreadId)
+		require.NoError(t, err)
+		if local.WorkCompleted {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "work of thread %s wasn't restarted", thread.ThreadId)
+		net.commit()
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestTaskLifecycle_InterruptedWork(t *testing.T) {
+	// 1. Setup: a node that stopped while it was rendering a thread, so the database still records the render as running
+	net := newDevnet(t, 1)
+	node := net.nodes[0]
+	scene := filepath.Join(t.TempDir(), "scene.blend")
+	require.NoError(t, os.WriteFile(scene, []byte("simulated scene"), 0644))
+	cid, err := ipfs.UploadFile(scene)
+	require.NoError(t, err)
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: cid, StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward, BlenderVersion: "4.2"})
+	require.NoError(t, err)
+	task, err := node.keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+	require.NoError(t, err)
+	thread := task.Threads[0]
+	thread.Workers = []string{node.address}
+	require.NoError(t, node.keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+	worker, err := node.keeper.Workers.Get(net.ctx, node.address)
+	require.NoError(t, err)
+	worker.CurrentTaskId = task.TaskId
+	require.NoError(t, node.keeper.Workers.Set(net.ctx, node.address, worker))
+	require.NoError(t, node.keeper.DB.AddThread(thread.ThreadId))
+	require.NoError(t, node.keeper.DB.UpdateThread(thread.ThreadId, true, true, true, false, false, false, false, false))
+
+	// 2. The first block resumes the render from the database
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		local, err := node.keeper.DB.ReadThread(thread.ThreadId)
+		require.NoError(t, err)
+		if local.WorkCompleted {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "interrupted work of thread %s wasn't resumed", thread.ThreadId)
+		net.commit()
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestTaskLifecycle_ZkProof(t *testing.T) {
+	// 1. Setup: a thread rendered by a worker, with a verifying key registered. Nodes only run the chain
+	net := newDevnet(t, 1)
+	net.nodes[0].module.keeper.Configuration.Enabled = false
+	worker := net.nodes[0]
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: "QmTzQ1JRkWErjk39mryYw2WVaphAZNAREyMchXzYQ7c15n", StartFrame: 1, EndFrame: 2, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+	task, err := worker.keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+	require.NoError(t, err)
+	task.Threads[0].Workers = []string{worker.address}
+	require.NoError(t, worker.keeper.VideoRenderingTasks.Set(net.ctx, task.TaskId, task))
+	require.NoError(t, worker.keeper.VerifyingKeys.Set(net.ctx, "v1", []byte("vk")))
+	// the proof itself is verified by the zkp package, only its public inputs are checked here
+	var verified []string
+	valid := false
+	patch := monkey.Patch(zkp.VerifyFrameProof, func(proof string, verifyingKey []byte, merkleRoot string, nodeAddress string) error {
+		verified = []string{proof, string(verifyingKey), merkleRoot, nodeAddress}
+		if !valid {
+			return errors.New("proof verification failed")
+		}
+		return nil
+	})
+	defer patch.Unpatch()
+
+	merkleRoot := hex.EncodeToString(sha256.New().Sum(nil))
+	propose := func(circuitVersion string) error {
+		_, err := net.server.ProposeSolution(net.ctx, &videoRendering.MsgProposeSolution{Creator: worker.address, TaskId: task.TaskId, ThreadId: task.Threads[0].ThreadId, PublicKey: worker.publicKey, MerkleRoot: merkleRoot,
+			ZkProof: "proof", ZkCircuitVersion: circuitVersion})
+		return err
+	}
+
+	// 2. A proof of a circuit version without verifying key is rejected
+	require.ErrorContains(t, propose("v2"), videoRendering.ErrInvalidSolution.Error())
+
+	// 3. An invalid proof is rejected. Only the merkle root and the creator are public inputs
+	require.ErrorContains(t, propose("v1"), videoRendering.ErrInvalidSolution.Error())
+	require.Equal(t, []string{"proof", "vk", merkleRoot, worker.address}, verified)
+
+	// 4. A valid proof of the merkle root is accepted
+	valid = true
+	require.NoError(t, propose("v1"))
+	task, err = worker.keeper.VideoRenderingTasks.Get(net.ctx, task.TaskId)
+	require.NoError(t, err)
+	require.Equal(t, merkleRoot, task.Threads[0].Solution.MerkleRoot)
+}
+
+func TestTaskLifecycle_FailedTask(t *testing.T) {
+	// 1. Setup: two workers rendering a scene that crashes blender on every frame
+	net := newDevnet(t, 2)
+	net.machine.Runtime = vm.NewSimulatedRuntime(0, 1, 1)
+	net.machine.Policy = vm.RenderPolicy{FrameTimeout: 60, MaxAttempts: 2}
+	scene := filepath.Join(t.TempDir(), "scene.blend")
+	require.NoError(t, os.WriteFile(scene, []byte("broken scene"), 0644))
+	cid, err := ipfs.UploadFile(scene)
+	require.NoError(t, err)
+
+	requester := authtypes.NewModuleAddress("requester").String()
+	reward := sdk.NewCoin("jct", math.NewInt(1000))
+	net.fund(requester, reward)
+	response, err := net.server.CreateVideoRenderingTask(net.ctx, &videoRendering.MsgCreateVideoRenderingTask{Creator: requester, Cid: cid, StartFrame: 1, EndFrame: 3, Threads: 1, Reward: &reward})
+	require.NoError(t, err)
+
+	// 2. Blocks run until both workers reported the failure
+	read := func() videoRendering.VideoRenderingTask {
+		task, err := net.nodes[0].keeper.VideoRenderingTasks.Get(net.ctx, response.TaskId)
+		require.NoError(t, err)
+		return task
+	}
+	deadline := time.Now().Add(60 * time.Second)
+	for net.deliver(); !read().Failed; net.deliver() {
+		require.True(t, time.Now().Before(deadline), "task didn't fail. Transactions: %v", net.transactions())
+		net.commit()
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// 3. Workers start on different frames, so each reported its own and then confirmed the other's. Every report is sent
+	// once, after every attempt of the render policy, with its log
+	for i := 0; i < 10; i++ {
+		net.commit()
+		net.deliver()
+	}
+	task := read()
+	thread := task.Threads[0]
+	require.True(t, task.Completed)
+	require.Nil(t, thread.Solution)
+	// failed tasks have no video to assemble
+	require.Empty(t, task.Assembly.Assembler)
+	require.Len(t, thread.UnrenderableFrames, 3)
+	frame, _, reporters, failed := thread.ReportedFailure(2)
+	require.True(t, failed)
+	require.ElementsMatch(t, thread.Workers, reporters)
+	for _, report := range thread.UnrenderableFrames {
+		require.Contains(t, thread.Workers, report.Worker)
+		require.Equal(t, videoRendering.VideoRenderingThread_UnrenderableFrame_CRASH, report.Reason)
+		require.Equal(t, uint32(2), report.Attempts)
+		logs := t.TempDir()
+		require.NoError(t, ipfs.IPFSGet(report.LogCid, logs))
+		content, err := os.ReadFile(filepath.Join(logs, report.LogCid))
+		require.NoError(t, err)
+		require.Contains(t, string(content), "simulated render failure")
+	}
+	// workers don't render a frame again after reporting it. The last confirmation may arrive after the task failed
+	reports := 0
+	for _, command := range net.transactions() {
+		if command == "report-task-failure" {
+			reports++
+		}
+	}
+	require.GreaterOrEqual(t, reports, 3)
+	require.LessOrEqual(t, reports, 4)
+	require.Contains(t, []int64{1, 2}, frame)
+
+	// 4. The requester was refunded minus the fee of the reporters, and the workers were released
+	requesterAddress, err := sdk.AccAddressFromBech32(requester)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("jct", math.NewInt(980)), net.bank.GetBalance(net.ctx, requesterAddress, "jct"))
+	for _, node := range net.nodes {
+		address, err := sdk.AccAddressFromBech32(node.address)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoin("jct", math.NewInt(10)), net.bank.GetBalance(net.ctx, address, "jct"))
+		worker, err := node.keeper.Workers.Get(net.ctx, node.address)
+		require.NoError(t, err)
+		require.Empty(t, worker.CurrentTaskId)
+	}
+
+	// 5. The refunded reward isn't paid to solutions of the failed task
+	_, err = net.server.SubmitSolution(net.ctx, &videoRendering.MsgSubmitSolution{Creator: net.nodes[0].address, TaskId: task.TaskId, ThreadId: thread.ThreadId, Dir: cid})
+	require.ErrorContains(t, err, videoRendering.ErrInvalidSolution.Error())
+}
+
+// digest of the renderer image approved in the devnet. The simulated runtime verifies the digest images are pinned by
+var devnetRendererDigest = "sha256:" + strings.Repeat("0f", 32)
+
+// ffmpeg image the devnet encodes videos with
+var devnetEncoderImage = "jrottenberg/ffmpeg@sha256:" + strings.Repeat("f0", 32)
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strconv"
+	"sync"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/math"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/janction/videoRendering"
+	"github.com/janction/videoRendering/db"
 	"github.com/janction/videoRendering/ipfs"
 	"github.com/janction/videoRendering/keeper"
 	"github.com/janction/videoRendering/videoRenderingLogger"
@@ -37,6 +39,8 @@ const ConsensusVersion = 1
 type AppModule struct {
 	cdc    codec.Codec
 	keeper keeper.Keeper
+	// work interrupted by a restart of the node is resumed once, on the first block the node runs
+	resume *sync.Once
 }
 
 // NewAppModule creates a new AppModule object
@@ -44,6 +48,7 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 	return AppModule{
 		cdc:    cdc,
 		keeper: keeper,
+		resume: &sync.Once{},
 	}
 }
 
@@ -122,6 +127,68 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// startPendingPreview starts the preview pass of the first task waiting for this worker to preview it, unless this node already started it
+func (am AppModule) startPendingPreview(ctx context.Context, workerAddress string) {
+	k := am.keeper
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	maxId, _ := k.VideoRenderingTaskInfo.Get(ctx)
+	for i := 0; i < int(maxId.NextId); i++ {
+		task, err := k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
+		if err != nil || !task.IsPreviewPending() || !task.IsPreviewer(workerAddress) || height > task.Split.PreviewDeadline || task.Reward.Amount.LT(math.NewInt(k.Configuration.MinReward)) {
+			continue
+		}
+		if !k.CanRenderBlenderVersion(ctx, task.BlenderVersion) {
+			continue
+		}
+		preview, _ := k.DB.ReadPreview(task.TaskId)
+		if preview != nil && !preview.Started {
+			// the image is selected once, so governance changes don't alter the preview while it renders
+			image, _ := am.rendererImage(ctx, task.BlenderVersion)
+			videoRenderingLogger.Logger.Info("Rendering preview of task %s", task.TaskId)
+			go task.RenderPreview(ctx, workerAddress, k.Configuration.RootPath, vm.Renderer{VM: k.VM, Image: image}, &k.DB)
+		}
+		return
+	}
+}
+
+// rendererImage returns the approved image of our architecture for the blender version, and false if there is none
+func (am AppModule) rendererImage(ctx context.Context, blenderVersion string) (vm.RendererImage, bool) {
+	images, _ := am.keeper.GetRendererImages(ctx)
+	return videoRendering.SelectRendererImage(images, vm.Architecture(), blenderVersion)
+}
+
+// threadRendererImage returns the image this node renders the thread with. It's selected the first time and kept in the
+// local database, so governance changes don't alter the frames of a running thread. Threads joined once a solution was
+// proposed render with the image of the solution, if it's approved for our architecture
+func (am AppModule) threadRendererImage(ctx context.Context, thread videoRendering.VideoRenderingThread) (vm.RendererImage, bool) {
+	pinned, err := am.keeper.DB.ReadRendererImage(thread.ThreadId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read renderer image of thread %s: %s", thread.ThreadId, err.Error())
+		return vm.RendererImage{}, false
+	}
+	if pinned.Name != "" {
+		return vm.RendererImage{Name: pinned.Name, BlenderVersion: pinned.BlenderVersion, Architecture: pinned.Architecture, Digest: pinned.Digest}, true
+	}
+
+	images, _ := am.keeper.GetRendererImages(ctx)
+	image, approved := videoRendering.SelectRendererImage(images, vm.Architecture(), thread.BlenderVersion)
+	if thread.Solution != nil && thread.Solution.RendererDigest != "" {
+		for _, candidate := range images {
+			if candidate.Digest == thread.Solution.RendererDigest && candidate.Architecture == vm.Architecture() {
+				image, approved = candidate.VMImage(), true
+			}
+		}
+	}
+	if !approved {
+		return image, false
+	}
+	if err := am.keeper.DB.UpdateRendererImage(db.RendererImage{ThreadId: thread.ThreadId, Name: image.Name, BlenderVersion: image.BlenderVersion, Architecture: image.Architecture, Digest: image.Digest}); err != nil {
+		videoRenderingLogger.Logger.Error("unable to keep renderer image of thread %s: %s", thread.ThreadId, err.Error())
+		return image, false
+	}
+	return image, true
+}
+
 func (am AppModule) getPendingVideoRenderingTask(ctx context.Context) (bool, videoRendering.VideoRenderingTask) {
 	params, _ := am.keeper.Params.Get(ctx)
 	ti, err := am.keeper.VideoRenderingTaskInfo.Get(ctx)
@@ -136,8 +203,8 @@ func (am AppModule) getPendingVideoRenderingTask(ctx context.Context) (bool, vid
 			continue
 		}
 
-		// we only search for in progress and with the reward this node will accept
-		if !task.Completed && task.Reward.Amount.GTE(math.NewInt(am.keeper.Configuration.MinReward)) {
+		// we only search for in progress, with the reward this node will accept and a blender version it can render
+		if !task.Completed && task.Reward.Amount.GTE(math.NewInt(am.keeper.Configuration.MinReward)) && am.keeper.CanRenderBlenderVersion(ctx, task.BlenderVersion) {
 			for _, value := range task.Threads {
 				if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) {
 					return true, task
@@ -167,6 +234,13 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	}
 
 	if k.Configuration.Enabled && k.Configuration.WorkerAddress != "" {
+		// downloads and renders don't survive the node, so the ones it was running are resumed from the database
+		am.resume.Do(func() {
+			if err := k.DB.ResetInterruptedWork(); err != nil {
+				videoRenderingLogger.Logger.Error("unable to resume interrupted work: %s", err.Error())
+			}
+		})
+
 		worker, _ := k.Workers.Get(ctx, k.Configuration.WorkerAddress)
 		if worker.Enabled && worker.CurrentTaskId != "" {
 			// we have to start some work!
@@ -181,23 +255,27 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 			workPath := filepath.Join(k.Configuration.RootPath, "renders", thread.ThreadId)
 
-			if !thread.Completed && !dbThread.DownloadStarted {
+			// we render with the approved image of our architecture for the blender version of the task, selected once for the thread
+			image, approved := am.threadRendererImage(ctx, thread)
+			renderer := vm.Renderer{VM: k.VM, Image: image}
+			if !approved {
+				videoRenderingLogger.Logger.Error("no renderer image approved for blender %q on architecture %s, unable to render thread %s", thread.BlenderVersion, vm.Architecture(), thread.ThreadId)
+			} else if !thread.Completed && !dbThread.DownloadStarted {
 				videoRenderingLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
+				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, renderer, &k.DB)
+			} else if !thread.Completed && !dbThread.WorkStarted && !dbThread.WorkCompleted && am.confirmsFailure(thread, worker.Address) {
+				// other workers reported frames we didn't render yet as unrenderable, so we render them to confirm it
+				videoRenderingLogger.Logger.Info("confirming unrenderable frames of thread %s", thread.ThreadId)
+				// marked as working before the render starts, so the next block doesn't start another one
+				k.DB.UpdateThread(thread.ThreadId, true, true, true, false, false, false, false, false)
+				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, renderer, &k.DB)
+			} else if !thread.Completed && dbThread.DownloadCompleted && !dbThread.WorkStarted && !dbThread.WorkCompleted {
+				// the render stopped with an error or missing frames, so we render the frames that are left
+				videoRenderingLogger.Logger.Info("restarting work of thread %s", thread.ThreadId)
+				// marked as working before the render starts, so the next block doesn't start another one
+				k.DB.UpdateThread(thread.ThreadId, true, true, true, false, false, false, false, false)
+				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, renderer, &k.DB)
 			} else {
-				if dbThread.WorkStarted {
-					// if we are already working but the container is exited, it means there was an error, so we trigger it again
-					isExited, err := vm.IsContainerExited(thread.ThreadId)
-					if err != nil {
-						videoRenderingLogger.Logger.Error("unable to determine if container myBlender%s is running: %s", thread.ThreadId, err.Error())
-					}
-					if isExited {
-						videoRenderingLogger.Logger.Info("container myBlender%s is existed. We restarted", thread.ThreadId)
-						go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
-					}
-
-				}
-
 				// if ipfs didn't download yet, then we make sure we are still downloading a file at least
 				if !dbThread.DownloadCompleted {
 					if !ipfs.IsDownloadStarted(workPath) {
@@ -209,17 +287,39 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 				}
 			}
 
-			// we completed the work, so lets propose a solution
-			if thread.Solution == nil && dbThread.WorkCompleted && !dbThread.SolutionProposed {
+			// we completed the work, so lets propose a solution. Solutions that weren't revealed in time are dropped, and
+			// proposed again on the next proposal round
+			proposal, _ := k.DB.ReadCommitment(thread.ProposalId())
+			unproposed := !dbThread.SolutionProposed || thread.ProposalRound > 0 && (proposal == nil || proposal.Salt == "")
+			if thread.Solution == nil && dbThread.WorkCompleted && unproposed {
 				videoRenderingLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.ProposeSolution(am.cdc, k.Configuration.WorkerName, worker.Address, k.Configuration.RootPath, &k.DB)
+				if err := thread.StartProposal(&k.DB); err != nil {
+					videoRenderingLogger.Logger.Error("unable to start the proposal of thread %s: %s", thread.ThreadId, err.Error())
+				} else {
+					go thread.ProposeSolution(am.cdc, k.Configuration.WorkerName, worker.Address, k.Configuration.RootPath, renderer, &k.DB)
+				}
 			}
 
-			// someone already submited solution, lets submit our verification
-			if thread.Solution != nil && thread.Solution.ProposedBy != "" && !dbThread.VerificationStarted {
+			// someone already submited solution, lets commit to our verification. We commit once per round of the commit
+			// phase, and again while committing fails
+			commitment, _ := k.DB.ReadCommitment(thread.CommitmentId())
+			uncommitted := !dbThread.VerificationStarted || commitment == nil || commitment.Salt == ""
+			if thread.Solution != nil && thread.Solution.ProposedBy != "" && thread.IsCommitOpen(sdk.UnwrapSDKContext(ctx).BlockHeight()) && thread.GetValidation(worker.Address) == nil && uncommitted {
 				// start verification
 				videoRenderingLogger.Logger.Info("Started verification for thread %s", thread.ThreadId)
-				go thread.SubmitVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, k.Configuration.RootPath, &k.DB)
+				if err := thread.StartVerification(&k.DB); err != nil {
+					videoRenderingLogger.Logger.Error("unable to start verification of thread %s: %s", thread.ThreadId, err.Error())
+				} else {
+					go thread.SubmitVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, k.Configuration.RootPath, renderer, &k.DB)
+				}
+			}
+
+			// commit deadline is over, lets reveal our verification
+			if validation := thread.GetValidation(worker.Address); thread.Phase == videoRendering.VideoRenderingThread_REVEAL && validation != nil && !validation.IsRevealed() {
+				if commitment != nil && commitment.Salt != "" && !commitment.Revealed {
+					videoRenderingLogger.Logger.Info("Revealing verification for thread %s", thread.ThreadId)
+					go thread.RevealVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, sdk.UnwrapSDKContext(ctx).ChainID(), k.Configuration.RootPath, renderer, &k.DB)
+				}
 			}
 		}
 	}
@@ -229,10 +329,38 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	am.keeper.VideoRenderingTasks.Walk(ctx, nil, func(key string, task videoRendering.VideoRenderingTask) (bool, error) {
 		if !task.Completed {
 			for _, thread := range task.Threads {
-				if (len(thread.Validations) > 1 || len(thread.Validations) == len(thread.Workers)) && !thread.Completed && thread.Solution != nil && !thread.Solution.Accepted && len(thread.Solution.Frames) > 0 && thread.Solution.Frames[0].Hash != "" {
+				// once the commit deadline is over, threads move on to reveal their hashes
+				if thread.CloseCommitPhase(sdk.UnwrapSDKContext(ctx).BlockHeight(), params.RevealPhaseBlocks) {
+					videoRenderingLogger.Logger.Info("Commit phase of thread %s is over", thread.ThreadId)
+					k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+				}
+
+				// validators that didn't commit or reveal in time get another round
+				if thread.IsRevealExpired(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+					videoRenderingLogger.Logger.Info("Not enough validators revealed thread %s. Reopening its commit phase", thread.ThreadId)
+					thread.ReopenCommitPhase(sdk.UnwrapSDKContext(ctx).BlockHeight())
+					k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+				}
+
+				// once enough validators revealed, the proposer has until the solution reveal deadline to reveal the sampled frames
+				if thread.StartSolutionReveal(sdk.UnwrapSDKContext(ctx).BlockHeight(), params.RevealPhaseBlocks) {
+					k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+				}
+
+				// solutions that weren't revealed in time are dropped, so another worker of the thread proposes one
+				if thread.IsSolutionRevealExpired(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+					videoRenderingLogger.Logger.Info("Solution of thread %s wasn't revealed in time. Dropping it", thread.ThreadId)
+					if err := k.DropSolution(ctx, thread); err != nil {
+						videoRenderingLogger.Logger.Error("unable to drop the solution of thread %s: %s", thread.ThreadId, err.Error())
+					} else {
+						k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+					}
+				}
+
+				if thread.Phase == videoRendering.VideoRenderingThread_REVEAL && thread.HasRevealQuorum() && !thread.Completed && thread.Solution != nil && !thread.Solution.Accepted && len(thread.Solution.Frames) > 0 && thread.Solution.Frames[0].Hash != "" {
 					videoRenderingLogger.Logger.Info("Solution revealed, we verify it for thread %s ", thread.ThreadId)
 
-					thread.EvaluateVerifications()
+					thread.EvaluateVerifications(sdk.UnwrapSDKContext(ctx).ChainID())
 					accepted := thread.IsSolutionAccepted()
 					if accepted {
 						thread.Solution.Accepted = true
@@ -276,11 +404,14 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 				// the worker is not registered, so we do it with the stake
 				params, _ := am.keeper.Params.Get(ctx)
 				videoRenderingLogger.Logger.Info("Registering Worker %s", k.Configuration.WorkerAddress)
-				go worker.RegisterWorker(k.Configuration.WorkerAddress, *params.MinWorkerStaking, &k.DB)
+				go worker.RegisterWorker(k.Configuration.WorkerAddress, *params.MinWorkerStaking, k.Configuration.BlenderVersions, &k.DB)
 			}
 		}
 
 		if worker.Enabled && worker.CurrentTaskId == "" {
+			// previews are cheap and the threads of their tasks wait for them, so they go first
+			am.startPendingPreview(ctx, worker.Address)
+
 			// we find any task in progress that has enought reward
 			videoRenderingLogger.Logger.Info(" worker %v is idle ", worker.Address)
 			found, task := am.getPendingVideoRenderingTask(ctx)
@@ -311,24 +442,33 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		task, _ := k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
 		if !task.Completed {
 			for _, thread := range task.Threads {
-				if len(thread.Validations) > 0 && len(thread.Workers) > 0 {
-					// we check if we have enought validations to reveal the solution
-					if (len(thread.Validations) > 1 || len(thread.Validations) == len(thread.Workers)) && !thread.Completed && thread.Solution.ProposedBy == am.keeper.Configuration.WorkerAddress {
-						db, _ := k.DB.ReadThread(thread.ThreadId)
-						if !db.SolutionRevealed {
-							// We have reached enought validations, if we are the winning node, is time to reveal the solution
-							videoRenderingLogger.Logger.Info("Time to reveal solution!!!!!!")
-							go thread.RevealSolution(am.keeper.Configuration.RootPath, &k.DB)
-						}
+				// commit deadline is over, if we are the winning node, is time to reveal the solution
+				if thread.Phase == videoRendering.VideoRenderingThread_REVEAL && !thread.Completed && thread.Solution.ProposedBy == am.keeper.Configuration.WorkerAddress && len(thread.Solution.Frames) == 0 {
+					db, _ := k.DB.ReadThread(thread.ThreadId)
+					if !db.SolutionRevealed {
+						videoRenderingLogger.Logger.Info("Time to reveal solution!!!!!!")
+						image, _ := am.threadRendererImage(ctx, *thread)
+						go thread.RevealSolution(am.keeper.Configuration.RootPath, vm.Renderer{VM: k.VM, Image: image}, &k.DB)
 					}
 				}
 			}
 		}
 	}
 
+	// tasks whose preview wasn't submitted in time are split evenly
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 	for i := 0; i < int(maxId.NextId); i++ {
 		task, _ := k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
-		if !task.Completed {
+		if task.IsPreviewPending() && height > task.Split.PreviewDeadline {
+			videoRenderingLogger.Logger.Info("No preview submitted for task %s. Splitting frames evenly", task.TaskId)
+			task.Threads = task.GenerateThreads(task.TaskId)
+			k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+		}
+	}
+
+	for i := 0; i < int(maxId.NextId); i++ {
+		task, _ := k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
+		if !task.Completed && !task.IsPreviewPending() {
 			completed := true
 			for _, thread := range task.Threads {
 				if !thread.Completed {
@@ -338,11 +478,37 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 				}
 			}
 			if completed {
-				// all threads are over, we mark the task as completed
+				// all threads are over, we mark the task as completed and designate who assembles its video
+				params, _ := k.Params.Get(ctx)
 				task.Completed = true
+				task.DesignateAssembler(height, params.AssemblyPhaseBlocks)
 				k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
 			}
 		}
+
+		// videos not confirmed in time are assembled by another worker. Assemblers that didn't submit theirs are penalized
+		if task.IsAssemblyExpired(height) {
+			videoRenderingLogger.Logger.Info("video of task %s wasn't confirmed in time, reassigning its assembly", task.TaskId)
+			if err := k.ReassignAssembly(ctx, &task, task.Assembly.VideoCid == ""); err != nil {
+				videoRenderingLogger.Logger.Error("unable to reassign the assembly of task %s: %s", task.TaskId, err.Error())
+				continue
+			}
+			k.VideoRenderingTasks.Set(ctx, task.TaskId, task)
+		}
+	}
+
+	// videos of completed tasks are assembled by the assembler, and confirmed by the other workers of the task
+	if k.Configuration.Enabled && k.Configuration.WorkerAddress != "" {
+		for i := 0; i < int(maxId.NextId); i++ {
+			task, _ := k.VideoRenderingTasks.Get(ctx, strconv.Itoa(i))
+			if task.IsAssemblyPending(k.Configuration.WorkerAddress) {
+				assembly, _ := k.DB.ReadAssembly(task.AssemblyId())
+				if assembly != nil && !assembly.Started {
+					videoRenderingLogger.Logger.Info("Assembling video of task %s", task.TaskId)
+					go task.AssembleVideo(ctx, k.Configuration.WorkerAddress, k.Configuration.RootPath, k.VM, &k.DB)
+				}
+			}
+		}
 	}
 
 	// we now will connect to the IPFS nodes of new workers
@@ -363,6 +529,27 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	return nil
 }
 
+// confirmsFailure returns true if the worker didn't render or report a frame other workers of the thread reported unrenderable
+func (am AppModule) confirmsFailure(thread videoRendering.VideoRenderingThread, worker string) bool {
+	rendered, err := am.keeper.DB.ReadRenderedFrames(thread.ThreadId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read rendered frames of thread %s: %s", thread.ThreadId, err.Error())
+		return false
+	}
+	// reports still on their way to the chain aren't confirmed again
+	reported, err := am.keeper.DB.ReadReportedFrames(thread.ThreadId)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read reported frames of thread %s: %s", thread.ThreadId, err.Error())
+		return false
+	}
+	for _, frame := range thread.UnconfirmedFailures(worker) {
+		if !rendered[frame] && !reported[frame] {
+			return true
+		}
+	}
+	return false
+}
+
 func (am AppModule) EvaluateCompletedThread(ctx context.Context, task *videoRendering.VideoRenderingTask, index int) error {
 	//TODO  implement validations. What happens if a validation is false?
 	thread := task.Threads[index]
@@ -1,8 +1,11 @@
 package videoRendering
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/janction/videoRendering/vm"
 )
 
 // DefaultParams returns default module parameters.
@@ -12,6 +15,22 @@ func DefaultParams() Params {
 		MinWorkerStaking:    &sdk.Coin{Denom: "jct", Amount: math.NewInt(1000000)},
 		MaxWorkersPerThread: 2,
 		MinValidators:       1,
+		CommitPhaseBlocks:   10,
+		// commit windows grow with the render time of threads, within a day of 6 seconds blocks
+		MaxCommitPhaseBlocks: 14400,
+		BlockSeconds:         6,
+		// commit phases without enough reveals are reopened
+		RevealPhaseBlocks: 10,
+		// the assembler is paid a slice of the reward for encoding the video
+		AssemblyRewardPercentage: 5,
+		// tasks with a preview pass are split evenly if no preview is submitted in time
+		PreviewPhaseBlocks: 50,
+		// tasks fail once two workers can't render them for the same reason, and they're paid a slice of the refund
+		FailureReports:       2,
+		FailureFeePercentage: 2,
+		// videos aren't assembled until the genesis pins an encoder image. Assemblers failing to deliver lose a slice of their stake
+		AssemblyPhaseBlocks:       100,
+		AssemblyPenaltyPercentage: 10,
 	}
 }
 
@@ -21,9 +40,53 @@ func (p Params) Validate() error {
 
 	// We can't have more validators that the amount of workers allowed per thread
 	if p.MinValidators > p.MaxWorkersPerThread {
-		// error
+		return fmt.Errorf("min validators %v can't be more than the max workers per thread %v", p.MinValidators, p.MaxWorkersPerThread)
+	}
+
+	// failures are reported by the workers of a thread, so more reports than workers would never fail a task
+	if int64(p.FailureReports) > p.MaxWorkersPerThread {
+		return fmt.Errorf("failure reports %v can't be more than the max workers per thread %v", p.FailureReports, p.MaxWorkersPerThread)
+	}
+
+	if p.AssemblyRewardPercentage > 100 {
+		return fmt.Errorf("assembly reward percentage must be at most 100, got %v", p.AssemblyRewardPercentage)
+	}
+
+	if p.FailureFeePercentage > 100 {
+		return fmt.Errorf("failure fee percentage must be at most 100, got %v", p.FailureFeePercentage)
+	}
+
+	if p.AssemblyPenaltyPercentage > 100 {
+		return fmt.Errorf("assembly penalty percentage must be at most 100, got %v", p.AssemblyPenaltyPercentage)
+	}
+
+	if p.MaxCommitPhaseBlocks != 0 && p.MaxCommitPhaseBlocks < p.CommitPhaseBlocks {
+		return fmt.Errorf("max commit phase blocks must be at least the commit phase blocks %v, got %v", p.CommitPhaseBlocks, p.MaxCommitPhaseBlocks)
+	}
+
+	if p.BlockSeconds < 0 {
+		return fmt.Errorf("block seconds can't be negative, got %v", p.BlockSeconds)
+	}
+
+	if p.EncoderImage != "" {
+		if err := vm.ValidateEncoderImage(p.EncoderImage); err != nil {
+			return err
+		}
 	}
 
 	// if any of the values is zero thats another mistake
 	return nil
 }
+
+// CommitBlocks returns the commit window of a thread: enough blocks for its validators to render every frame at the
+// render seconds measured by the proposer, between the commit phase blocks and the max commit phase blocks
+func (p Params) CommitBlocks(frames, renderSeconds int64) int64 {
+	blocks := p.CommitPhaseBlocks
+	if p.BlockSeconds > 0 && renderSeconds > 0 {
+		blocks = max(blocks, (frames*renderSeconds+p.BlockSeconds-1)/p.BlockSeconds)
+	}
+	if p.MaxCommitPhaseBlocks > 0 {
+		blocks = min(blocks, p.MaxCommitPhaseBlocks)
+	}
+	return blocks
+}
@@ -0,0 +1,45 @@
+package videoRendering
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for Params.CommitBlocks ---
+func TestParamsCommitBlocks(t *testing.T) {
+	params := Params{CommitPhaseBlocks: 10, MaxCommitPhaseBlocks: 100, BlockSeconds: 6}
+
+	// quick threads get the minimum window
+	require.Equal(t, int64(10), params.CommitBlocks(5, 2))
+	require.Equal(t, int64(10), params.CommitBlocks(5, 0))
+	// slow threads get enough blocks to render every frame
+	require.Equal(t, int64(34), params.CommitBlocks(20, 10))
+	// up to the maximum
+	require.Equal(t, int64(100), params.CommitBlocks(200, 60))
+
+	// without a block time the window is fixed
+	params.BlockSeconds = 0
+	require.Equal(t, int64(10), params.CommitBlocks(200, 60))
+}
+
+// --- Test for Params.Validate ---
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.MaxCommitPhaseBlocks = params.CommitPhaseBlocks - 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BlockSeconds = -1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinValidators = params.MaxWorkersPerThread + 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.FailureReports = uint32(params.MaxWorkersPerThread) + 1
+	require.Error(t, params.Validate())
+}
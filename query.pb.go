@@ -390,6 +390,94 @@ func (m *QueryGetWorkerResponse) GetWorker() *Worker {
 	return nil
 }
 
+type QueryGetVerifyingKeyRequest struct {
+	CircuitVersion string `protobuf:"bytes,1,opt,name=circuit_version,json=circuitVersion,proto3" json:"circuit_version,omitempty"`
+}
+
+func (m *QueryGetVerifyingKeyRequest) Reset()         { *m = QueryGetVerifyingKeyRequest{} }
+func (m *QueryGetVerifyingKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifyingKeyRequest) ProtoMessage()    {}
+func (*QueryGetVerifyingKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6439ce36a3757d86, []int{8}
+}
+func (m *QueryGetVerifyingKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerifyingKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerifyingKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerifyingKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifyingKeyRequest.Merge(m, src)
+}
+func (m *QueryGetVerifyingKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerifyingKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifyingKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerifyingKeyRequest proto.InternalMessageInfo
+
+func (m *QueryGetVerifyingKeyRequest) GetCircuitVersion() string {
+	if m != nil {
+		return m.CircuitVersion
+	}
+	return ""
+}
+
+type QueryGetVerifyingKeyResponse struct {
+	VerifyingKey []byte `protobuf:"bytes,1,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+}
+
+func (m *QueryGetVerifyingKeyResponse) Reset()         { *m = QueryGetVerifyingKeyResponse{} }
+func (m *QueryGetVerifyingKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerifyingKeyResponse) ProtoMessage()    {}
+func (*QueryGetVerifyingKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6439ce36a3757d86, []int{9}
+}
+func (m *QueryGetVerifyingKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerifyingKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerifyingKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerifyingKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerifyingKeyResponse.Merge(m, src)
+}
+func (m *QueryGetVerifyingKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerifyingKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerifyingKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerifyingKeyResponse proto.InternalMessageInfo
+
+func (m *QueryGetVerifyingKeyResponse) GetVerifyingKey() []byte {
+	if m != nil {
+		return m.VerifyingKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetVideoRenderingTaskRequest)(nil), "janction.videoRendering.v1.QueryGetVideoRenderingTaskRequest")
 	proto.RegisterType((*QueryGetVideoRenderingTaskResponse)(nil), "janction.videoRendering.v1.QueryGetVideoRenderingTaskResponse")
@@ -399,6 +487,8 @@ func init() {
 	proto.RegisterType((*QueryGetPendingVideoRenderingTaskResponse)(nil), "janction.videoRendering.v1.QueryGetPendingVideoRenderingTaskResponse")
 	proto.RegisterType((*QueryGetWorkerRequest)(nil), "janction.videoRendering.v1.QueryGetWorkerRequest")
 	proto.RegisterType((*QueryGetWorkerResponse)(nil), "janction.videoRendering.v1.QueryGetWorkerResponse")
+	proto.RegisterType((*QueryGetVerifyingKeyRequest)(nil), "janction.videoRendering.v1.QueryGetVerifyingKeyRequest")
+	proto.RegisterType((*QueryGetVerifyingKeyResponse)(nil), "janction.videoRendering.v1.QueryGetVerifyingKeyResponse")
 }
 
 func init() {
@@ -406,41 +496,47 @@ func init() {
 }
 
 var fileDescriptor_6439ce36a3757d86 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0x4a, 0x8a, 0x9d, 0xde, 0xc6, 0xa4, 0xc8, 0xaa, 0x8b, 0x8e, 0x1a, 0x62, 0x0f,
-	0xbb, 0x26, 0x3d, 0x29, 0xfe, 0x01, 0x51, 0x44, 0xf0, 0xa0, 0xa1, 0x28, 0x78, 0xa9, 0x9b, 0xec,
-	0x30, 0x5d, 0xd3, 0xce, 0x9b, 0xee, 0x4c, 0xa2, 0x45, 0x72, 0xf1, 0x20, 0xde, 0x14, 0xfc, 0x0c,
-	0x5e, 0xc5, 0x8f, 0x51, 0xf0, 0x52, 0xf0, 0xe2, 0x51, 0x12, 0xc1, 0xcf, 0xe0, 0x4d, 0x76, 0x77,
-	0xa6, 0x6d, 0xd2, 0xec, 0x34, 0x49, 0x6f, 0x3b, 0x7f, 0xde, 0x67, 0x9e, 0xdf, 0xbc, 0xf3, 0xb0,
-	0xb8, 0xf2, 0x3a, 0x10, 0x2d, 0x15, 0x81, 0xf0, 0x7b, 0x51, 0xc8, 0xa0, 0xc1, 0x44, 0xc8, 0xe2,
-	0x48, 0x70, 0xbf, 0x57, 0xf3, 0xb7, 0xbb, 0x2c, 0xde, 0xf1, 0x3a, 0x31, 0x28, 0x20, 0x8e, 0xd9,
-	0xe7, 0x8d, 0xee, 0xf3, 0x7a, 0x35, 0xc7, 0xa6, 0xa1, 0x76, 0x3a, 0x4c, 0x66, 0x1a, 0xce, 0x05,
-	0x0e, 0xc0, 0x37, 0x99, 0x1f, 0x74, 0x22, 0x3f, 0x10, 0x02, 0x54, 0x90, 0x14, 0x99, 0xd5, 0xf3,
-	0x2d, 0x90, 0x5b, 0x20, 0xb3, 0x53, 0xc7, 0x8e, 0x77, 0x4a, 0x1c, 0x38, 0xa4, 0x9f, 0x7e, 0xf2,
-	0x95, 0xcd, 0xd2, 0x9b, 0xf8, 0xf2, 0xb3, 0x64, 0xd3, 0x23, 0xa6, 0x9e, 0x8f, 0x9c, 0xbc, 0x16,
-	0xc8, 0x76, 0x83, 0x6d, 0x77, 0x99, 0x54, 0xa4, 0x84, 0x8b, 0x91, 0x08, 0xd9, 0xdb, 0x73, 0xe8,
-	0x12, 0xaa, 0x2e, 0x36, 0xb2, 0x01, 0xfd, 0x80, 0x30, 0xb5, 0xd5, 0xca, 0x0e, 0x08, 0xc9, 0xc8,
-	0x2b, 0x5c, 0x4a, 0x99, 0xd6, 0x63, 0xb3, 0xbc, 0xae, 0x02, 0xd9, 0x4e, 0xb5, 0x96, 0xea, 0x9e,
-	0x97, 0x7f, 0x2b, 0xde, 0x04, 0x55, 0xd2, 0x3b, 0x32, 0x47, 0xef, 0xe5, 0x31, 0x3c, 0x01, 0x2e,
-	0x0d, 0x83, 0x83, 0xcf, 0xa8, 0x8d, 0x98, 0x05, 0xe1, 0xe3, 0x50, 0x63, 0xec, 0x8f, 0x2d, 0x24,
-	0x99, 0x42, 0x3e, 0xc9, 0x26, 0x70, 0x39, 0x3b, 0x49, 0xaa, 0x3a, 0x46, 0x92, 0xcc, 0xd1, 0x15,
-	0x5c, 0x35, 0x3e, 0x9e, 0x32, 0x11, 0x46, 0x82, 0xe7, 0x36, 0x85, 0x7e, 0x42, 0xf8, 0xfa, 0x14,
-	0x9b, 0xb5, 0xf7, 0x26, 0x2e, 0x4f, 0xea, 0x42, 0x62, 0xfe, 0xf4, 0x1c, 0x6d, 0x38, 0x7b, 0xb4,
-	0x0d, 0x92, 0xfa, 0xb8, 0x6c, 0x0c, 0xbd, 0x80, 0xb8, 0xcd, 0x62, 0x73, 0xf7, 0xcb, 0x78, 0xe1,
-	0x4d, 0x3a, 0xa1, 0x6f, 0x5e, 0x8f, 0xe8, 0x1a, 0x5e, 0x1e, 0x2f, 0xd0, 0x76, 0x6f, 0x8d, 0x54,
-	0x2c, 0xd5, 0xa9, 0xcd, 0x9f, 0xae, 0xd5, 0x15, 0xf5, 0x7f, 0x45, 0x5c, 0x4c, 0x65, 0xc9, 0x2e,
-	0xc2, 0xe5, 0x89, 0x8f, 0x93, 0xdc, 0xb1, 0xe9, 0x1d, 0x1b, 0x08, 0xe7, 0xee, 0xbc, 0xe5, 0x19,
-	0x1e, 0xbd, 0xf1, 0xf1, 0xef, 0xf7, 0x15, 0xf4, 0xfe, 0xe7, 0x9f, 0x2f, 0xa7, 0xae, 0x91, 0x2b,
-	0xbe, 0x25, 0xfc, 0xef, 0xd2, 0xac, 0xf5, 0xc9, 0x8f, 0x49, 0x28, 0xc9, 0x9b, 0x99, 0x07, 0xe5,
-	0x50, 0x2e, 0xe6, 0x41, 0x39, 0x1c, 0x0a, 0xba, 0x7a, 0x80, 0x52, 0x25, 0x15, 0x2b, 0x8a, 0xc9,
-	0x5b, 0x9f, 0x7c, 0x45, 0x78, 0x71, 0xbf, 0xe9, 0xa4, 0x36, 0x8d, 0x85, 0x91, 0x17, 0xe5, 0xd4,
-	0x67, 0x29, 0xd1, 0x4e, 0x6b, 0x07, 0x4e, 0x2b, 0xe4, 0xaa, 0xd5, 0x69, 0xf6, 0x92, 0xfa, 0xe4,
-	0x1b, 0xc2, 0x17, 0x6d, 0xf1, 0x92, 0xe4, 0xc1, 0x34, 0x46, 0x8e, 0xcb, 0xb2, 0xf3, 0xf0, 0x84,
-	0x2a, 0x9a, 0xb0, 0x70, 0xff, 0xf6, 0xee, 0xc0, 0x45, 0x7b, 0x03, 0x17, 0xfd, 0x1e, 0xb8, 0xe8,
-	0xf3, 0xd0, 0x2d, 0xec, 0x0d, 0xdd, 0xc2, 0xaf, 0xa1, 0x5b, 0x78, 0x49, 0x79, 0xa4, 0x36, 0xba,
-	0x4d, 0xaf, 0x05, 0x5b, 0x79, 0xe8, 0xcd, 0x85, 0xf4, 0x9f, 0xb0, 0xfa, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x25, 0x39, 0xad, 0xd2, 0x06, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xae, 0x41, 0x1b, 0xcc, 0x1b, 0x4c, 0x32, 0xed, 0x84, 0xb2, 0x11, 0x81, 0x07, 0xa5, 0xec,
+	0x90, 0xd0, 0xee, 0xc0, 0xe7, 0x86, 0xc4, 0x37, 0x82, 0x03, 0x54, 0xd3, 0x90, 0xb8, 0x94, 0xb4,
+	0x31, 0x99, 0xe9, 0x66, 0x77, 0x71, 0x1a, 0xa8, 0xa6, 0x5e, 0x38, 0x20, 0x6e, 0x20, 0xf1, 0x1b,
+	0xb8, 0x22, 0x7e, 0xc6, 0x04, 0x12, 0x9a, 0xc4, 0x85, 0x23, 0x6a, 0x91, 0xf8, 0x1b, 0xa8, 0x89,
+	0xdd, 0xae, 0x1f, 0xc9, 0xda, 0xee, 0x16, 0xbf, 0x7e, 0xdf, 0xc7, 0xcf, 0xf3, 0xbe, 0x7e, 0x1c,
+	0x98, 0x7e, 0x6d, 0xb1, 0x92, 0x47, 0x39, 0x33, 0x7d, 0x6a, 0x13, 0x9e, 0x27, 0xcc, 0x26, 0x2e,
+	0x65, 0x8e, 0xe9, 0x67, 0xcd, 0xed, 0x2a, 0x71, 0x6b, 0x46, 0xc5, 0xe5, 0x1e, 0x47, 0x9a, 0xca,
+	0x33, 0xba, 0xf3, 0x0c, 0x3f, 0xab, 0xc5, 0x61, 0x78, 0xb5, 0x0a, 0x11, 0x21, 0x86, 0xb6, 0xe0,
+	0x70, 0xee, 0x6c, 0x12, 0xd3, 0xaa, 0x50, 0xd3, 0x62, 0x8c, 0x7b, 0x56, 0xab, 0x48, 0xed, 0xce,
+	0x97, 0xb8, 0xd8, 0xe2, 0x22, 0x3c, 0xb5, 0xe7, 0x78, 0x2d, 0xe9, 0x70, 0x87, 0x07, 0x9f, 0x66,
+	0xeb, 0x2b, 0x8c, 0xe2, 0x6b, 0xf0, 0xdc, 0xb3, 0x56, 0xd2, 0x03, 0xe2, 0xad, 0x77, 0x9d, 0xbc,
+	0x66, 0x89, 0x72, 0x9e, 0x6c, 0x57, 0x89, 0xf0, 0x50, 0x12, 0x4e, 0x50, 0x66, 0x93, 0xb7, 0xa7,
+	0xc1, 0x59, 0x90, 0x99, 0xca, 0x87, 0x0b, 0xfc, 0x1e, 0x40, 0x1c, 0x57, 0x2b, 0x2a, 0x9c, 0x09,
+	0x82, 0x5e, 0xc2, 0x64, 0xa0, 0xa9, 0xe0, 0xaa, 0xed, 0x82, 0x67, 0x89, 0x72, 0x80, 0x35, 0x9d,
+	0x33, 0x8c, 0xe8, 0xae, 0x18, 0x03, 0x50, 0x91, 0xdf, 0x17, 0xc3, 0xb7, 0xa2, 0x34, 0x3c, 0xe1,
+	0x8e, 0x50, 0x1a, 0x34, 0x78, 0xdc, 0xdb, 0x70, 0x89, 0x65, 0x3f, 0xb2, 0xa5, 0x8c, 0xf6, 0x3a,
+	0x46, 0x49, 0x88, 0x10, 0xad, 0x64, 0x93, 0x3b, 0x62, 0x74, 0x25, 0x01, 0x2a, 0xf2, 0xfb, 0x62,
+	0x78, 0x09, 0x66, 0x14, 0x8f, 0xa7, 0x84, 0xd9, 0x94, 0x39, 0x91, 0x43, 0xc1, 0x1f, 0x01, 0xbc,
+	0x34, 0x44, 0xb2, 0xe4, 0x5e, 0x84, 0xa9, 0x41, 0x53, 0x68, 0x91, 0x3f, 0x3a, 0xc6, 0x18, 0x4e,
+	0xf5, 0x8f, 0x41, 0x60, 0x13, 0xa6, 0x14, 0xa1, 0xe7, 0xdc, 0x2d, 0x13, 0x57, 0xf5, 0x7e, 0x0e,
+	0x4e, 0xbe, 0x09, 0x02, 0xb2, 0xf3, 0x72, 0x85, 0xd7, 0xe0, 0x5c, 0x6f, 0x81, 0xa4, 0x7b, 0xbd,
+	0xab, 0x62, 0x3a, 0x87, 0xe3, 0xf8, 0xc9, 0x5a, 0x85, 0x7a, 0x1f, 0xce, 0xb7, 0x87, 0x49, 0x5c,
+	0xfa, 0xaa, 0x46, 0x99, 0xf3, 0x98, 0xd4, 0x14, 0x99, 0x8b, 0x70, 0xb6, 0x44, 0xdd, 0x52, 0x95,
+	0x7a, 0x05, 0x9f, 0xb8, 0x82, 0x72, 0x26, 0x59, 0x9d, 0x94, 0xe1, 0xf5, 0x30, 0x8a, 0xef, 0xc0,
+	0x85, 0xc1, 0x38, 0x92, 0xe3, 0x22, 0x3c, 0xe1, 0xab, 0x78, 0xa1, 0x4c, 0x6a, 0x01, 0xcc, 0x4c,
+	0x7e, 0xc6, 0xdf, 0x97, 0x9c, 0xfb, 0x7e, 0x0c, 0x4e, 0x04, 0x28, 0x68, 0x17, 0xc0, 0xd4, 0x40,
+	0xa7, 0xa0, 0x95, 0x38, 0x71, 0x07, 0xba, 0x53, 0x5b, 0x1d, 0xb7, 0x3c, 0xd4, 0x81, 0x2f, 0x7f,
+	0xf8, 0xf7, 0x6d, 0x09, 0xbc, 0xfb, 0xf5, 0xf7, 0xf3, 0x91, 0x0b, 0x68, 0xd1, 0x8c, 0x79, 0x89,
+	0x76, 0x02, 0xe3, 0xd7, 0xd1, 0x8f, 0x41, 0x52, 0x5a, 0x17, 0x78, 0x1c, 0x29, 0xfb, 0x4c, 0xaa,
+	0xad, 0x8e, 0x5b, 0x2e, 0xa5, 0x2c, 0x77, 0xa4, 0x64, 0x50, 0x3a, 0x56, 0x8a, 0x32, 0x7f, 0x1d,
+	0x7d, 0x01, 0x70, 0xaa, 0x7d, 0x03, 0x51, 0x76, 0x18, 0x0a, 0x5d, 0xd7, 0x5b, 0xcb, 0x8d, 0x52,
+	0x22, 0x99, 0x66, 0x3b, 0x4c, 0xd3, 0xe8, 0x7c, 0x2c, 0xd3, 0xf0, 0x5a, 0xd7, 0xd1, 0x57, 0x00,
+	0xcf, 0xc4, 0x79, 0x5d, 0xa0, 0xbb, 0xc3, 0x10, 0x39, 0xe8, 0x61, 0xd1, 0xee, 0x1d, 0x12, 0x45,
+	0x2a, 0x4c, 0xa0, 0x9f, 0x00, 0xce, 0xf6, 0x98, 0x07, 0x5d, 0x19, 0x6a, 0xc2, 0xfd, 0xb6, 0xd5,
+	0xae, 0x8e, 0x5e, 0x28, 0x89, 0x3c, 0xec, 0xb4, 0x7a, 0x05, 0xdd, 0x88, 0x6b, 0x75, 0x97, 0x9d,
+	0xcd, 0x9d, 0x9e, 0x67, 0xa2, 0x7e, 0xfb, 0xe6, 0x6e, 0x43, 0x07, 0x7b, 0x0d, 0x1d, 0xfc, 0x69,
+	0xe8, 0xe0, 0x53, 0x53, 0x4f, 0xec, 0x35, 0xf5, 0xc4, 0xef, 0xa6, 0x9e, 0x78, 0x81, 0x1d, 0xea,
+	0x6d, 0x54, 0x8b, 0x46, 0x89, 0x6f, 0x45, 0x1d, 0x50, 0x9c, 0x0c, 0xfe, 0xb8, 0xcb, 0xff, 0x07,
+	0x00, 0x96, 0x47, 0x52, 0x46, 0x30, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -460,6 +556,8 @@ type QueryClient interface {
 	GetVideoRenderingLogs(ctx context.Context, in *QueryGetVideoRenderingLogsRequest, opts ...grpc.CallOption) (*QueryGetVideoRenderingLogsResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingVideoRenderingTasks(ctx context.Context, in *QueryGetPendingVideoRenderingTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingVideoRenderingTaskResponse, error)
+	// GetVerifyingKey returns the verifying key registered for the circuit version
+	GetVerifyingKey(ctx context.Context, in *QueryGetVerifyingKeyRequest, opts ...grpc.CallOption) (*QueryGetVerifyingKeyResponse, error)
 }
 
 type queryClient struct {
@@ -506,6 +604,15 @@ func (c *queryClient) GetPendingVideoRenderingTasks(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) GetVerifyingKey(ctx context.Context, in *QueryGetVerifyingKeyRequest, opts ...grpc.CallOption) (*QueryGetVerifyingKeyResponse, error) {
+	out := new(QueryGetVerifyingKeyResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Query/GetVerifyingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetVideoRenderingTask returns the task based on the taskId
@@ -513,6 +620,8 @@ type QueryServer interface {
 	GetVideoRenderingLogs(context.Context, *QueryGetVideoRenderingLogsRequest) (*QueryGetVideoRenderingLogsResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingVideoRenderingTasks(context.Context, *QueryGetPendingVideoRenderingTaskRequest) (*QueryGetPendingVideoRenderingTaskResponse, error)
+	// GetVerifyingKey returns the verifying key registered for the circuit version
+	GetVerifyingKey(context.Context, *QueryGetVerifyingKeyRequest) (*QueryGetVerifyingKeyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -531,6 +640,9 @@ func (*UnimplementedQueryServer) GetWorker(ctx context.Context, req *QueryGetWor
 func (*UnimplementedQueryServer) GetPendingVideoRenderingTasks(ctx context.Context, req *QueryGetPendingVideoRenderingTaskRequest) (*QueryGetPendingVideoRenderingTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingVideoRenderingTasks not implemented")
 }
+func (*UnimplementedQueryServer) GetVerifyingKey(ctx context.Context, req *QueryGetVerifyingKeyRequest) (*QueryGetVerifyingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifyingKey not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -608,6 +720,24 @@ func _Query_GetPendingVideoRenderingTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetVerifyingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerifyingKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerifyingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Query/GetVerifyingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerifyingKey(ctx, req.(*QueryGetVerifyingKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "janction.videoRendering.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -628,6 +758,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingVideoRenderingTasks",
 			Handler:    _Query_GetPendingVideoRenderingTasks_Handler,
 		},
+		{
+			MethodName: "GetVerifyingKey",
+			Handler:    _Query_GetVerifyingKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoRendering/v1/query.proto",
@@ -888,6 +1022,66 @@ func (m *QueryGetWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetVerifyingKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifyingKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifyingKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitVersion) > 0 {
+		i -= len(m.CircuitVersion)
+		copy(dAtA[i:], m.CircuitVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CircuitVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerifyingKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerifyingKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerifyingKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1001,6 +1195,32 @@ func (m *QueryGetWorkerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetVerifyingKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CircuitVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVerifyingKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1645,6 +1865,172 @@ func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetVerifyingKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifyingKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifyingKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerifyingKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerifyingKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerifyingKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,123 @@
+package videoRendering
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/janction/videoRendering/vm"
+)
+
+const (
+	// max width and height of a rendered frame
+	maxRenderResolution = 16384
+	// max samples per pixel of a rendered frame
+	maxRenderSamples = 65536
+	// blender names are limited to 63 bytes
+	maxBlenderNameLength = 63
+	// longest frame timeout a task can set, in seconds
+	maxFrameTimeout = 24 * 60 * 60
+)
+
+// blender engine of each task engine, as the render script expects it
+var renderEngines = map[RenderSettings_Engine]string{
+	RenderSettings_CYCLES:    "CYCLES",
+	RenderSettings_EEVEE:     "EEVEE",
+	RenderSettings_WORKBENCH: "WORKBENCH",
+}
+
+// blender output format and color depth of each task output format.
+var renderOutputFormats = map[RenderSettings_OutputFormat]struct{ format, colorDepth string }{
+	RenderSettings_PNG:                 {"PNG", "8"},
+	RenderSettings_PNG16:               {"PNG", "16"},
+	RenderSettings_JPEG:                {"JPEG", "8"},
+	RenderSettings_TIFF:                {"TIFF", "16"},
+	RenderSettings_OPEN_EXR:            {"OPEN_EXR", "32"},
+	RenderSettings_OPEN_EXR_MULTILAYER: {"OPEN_EXR_MULTILAYER", "32"},
+}
+
+// Validate returns an error if the render settings of a task can't be rendered
+func (s *RenderSettings) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if _, ok := renderEngines[s.Engine]; !ok {
+		return fmt.Errorf("unknown render engine %v", s.Engine)
+	}
+	if _, ok := renderOutputFormats[s.OutputFormat]; !ok {
+		return fmt.Errorf("unknown output format %v", s.OutputFormat)
+	}
+	if (s.ResolutionX == 0) != (s.ResolutionY == 0) {
+		return fmt.Errorf("resolution must have both width and height, got %vx%v", s.ResolutionX, s.ResolutionY)
+	}
+	if s.ResolutionX > maxRenderResolution || s.ResolutionY > maxRenderResolution {
+		return fmt.Errorf("resolution %vx%v is bigger than %v", s.ResolutionX, s.ResolutionY, maxRenderResolution)
+	}
+	if s.ResolutionPercentage > 100 {
+		return fmt.Errorf("resolution percentage must be at most 100, got %v", s.ResolutionPercentage)
+	}
+	if s.Samples > maxRenderSamples {
+		return fmt.Errorf("samples must be at most %v, got %v", maxRenderSamples, s.Samples)
+	}
+	if s.Samples > 0 && s.Engine == RenderSettings_WORKBENCH {
+		return fmt.Errorf("samples can't be set for the workbench engine")
+	}
+	if s.FrameTimeoutSeconds > maxFrameTimeout {
+		return fmt.Errorf("frame timeout must be at most %v seconds, got %v", maxFrameTimeout, s.FrameTimeoutSeconds)
+	}
+
+	names := []struct{ field, name string }{{"scene", s.Scene}, {"camera", s.Camera}, {"view layer", s.ViewLayer}}
+	for _, n := range names {
+		if err := validateBlenderName(n.name); err != nil {
+			return fmt.Errorf("invalid %s name: %w", n.field, err)
+		}
+	}
+	return nil
+}
+
+// validates names are blender names that can be passed as arguments. Empty names aren't overridden
+func validateBlenderName(name string) error {
+	if len(name) > maxBlenderNameLength {
+		return fmt.Errorf("%s is longer than %v bytes", name, maxBlenderNameLength)
+	}
+	// names starting with a dash would be parsed as arguments
+	if strings.HasPrefix(name, "-") {
+		return fmt.Errorf("%s can't start with a dash", name)
+	}
+	if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return fmt.Errorf("%q has control characters", name)
+	}
+	return nil
+}
+
+// VMSettings returns the settings the vm renders with: the enforced ones plus the ones of the task
+func (s *RenderSettings) VMSettings() vm.RenderSettings {
+	settings := vm.DefaultRenderSettings()
+	if s == nil {
+		return settings
+	}
+
+	settings.Engine = renderEngines[s.Engine]
+	settings.Format = renderOutputFormats[s.OutputFormat].format
+	settings.ColorDepth = renderOutputFormats[s.OutputFormat].colorDepth
+	settings.ResolutionX = int(s.ResolutionX)
+	settings.ResolutionY = int(s.ResolutionY)
+	settings.ResolutionPercentage = int(s.ResolutionPercentage)
+	settings.Samples = int(s.Samples)
+	settings.Scene = s.Scene
+	settings.Camera = s.Camera
+	settings.ViewLayer = s.ViewLayer
+	settings.FrameTimeout = int64(s.FrameTimeoutSeconds)
+	return settings
+}
+
+// FrameExtension returns the extension of the frames of the task, given by its output format
+func (t VideoRenderingTask) FrameExtension() string {
+	return vm.FrameExtension(t.RenderSettings.VMSettings().Format)
+}
+
+// FrameExtension returns the extension of the frames of the thread, given by the output format of the task
+func (t VideoRenderingThread) FrameExtension() string {
+	return vm.FrameExtension(t.RenderSettings.VMSettings().Format)
+}
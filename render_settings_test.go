@@ -0,0 +1,85 @@
+package videoRendering
+
+import (
+	"testing"
+
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for RenderSettings.Validate ---
+func TestRenderSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *RenderSettings
+		valid    bool
+	}{
+		{"no settings", nil, true},
+		{"defaults", &RenderSettings{}, true},
+		{"full settings", &RenderSettings{Engine: RenderSettings_EEVEE, ResolutionX: 3840, ResolutionY: 2160, ResolutionPercentage: 50, Samples: 64, Scene: "Shot 2", Camera: "Camera.001", ViewLayer: "Foreground"}, true},
+		{"unknown engine", &RenderSettings{Engine: 7}, false},
+		{"multilayer exr", &RenderSettings{OutputFormat: RenderSettings_OPEN_EXR_MULTILAYER}, true},
+		{"unknown output format", &RenderSettings{OutputFormat: 7}, false},
+		{"resolution without height", &RenderSettings{ResolutionX: 1920}, false},
+		{"resolution too big", &RenderSettings{ResolutionX: 32768, ResolutionY: 1080}, false},
+		{"percentage over 100", &RenderSettings{ResolutionPercentage: 101}, false},
+		{"too many samples", &RenderSettings{Samples: maxRenderSamples + 1}, false},
+		{"workbench samples", &RenderSettings{Engine: RenderSettings_WORKBENCH, Samples: 16}, false},
+		{"name too long", &RenderSettings{Camera: "Camera.0000000000000000000000000000000000000000000000000000000000"}, false},
+		{"name parsed as argument", &RenderSettings{Scene: "--python-expr"}, false},
+		{"name with control characters", &RenderSettings{ViewLayer: "View\nLayer"}, false},
+		{"frame timeout", &RenderSettings{FrameTimeoutSeconds: 7200}, true},
+		{"frame timeout too long", &RenderSettings{FrameTimeoutSeconds: maxFrameTimeout + 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// --- Test for RenderSettings.VMSettings ---
+func TestRenderSettingsVMSettings(t *testing.T) {
+	t.Run("no settings renders with the defaults", func(t *testing.T) {
+		require.Equal(t, vm.DefaultRenderSettings(), (*RenderSettings)(nil).VMSettings())
+	})
+
+	t.Run("task settings are added to the enforced ones", func(t *testing.T) {
+		settings := (&RenderSettings{Engine: RenderSettings_WORKBENCH, ResolutionX: 1920, ResolutionY: 1080, ResolutionPercentage: 50, Scene: "Shot 2", Camera: "Camera.001", ViewLayer: "Foreground", FrameTimeoutSeconds: 600}).VMSettings()
+
+		expected := vm.DefaultRenderSettings()
+		expected.Engine = "WORKBENCH"
+		expected.ResolutionX = 1920
+		expected.ResolutionY = 1080
+		expected.ResolutionPercentage = 50
+		expected.Scene = "Shot 2"
+		expected.Camera = "Camera.001"
+		expected.ViewLayer = "Foreground"
+		expected.FrameTimeout = 600
+		require.Equal(t, expected, settings)
+	})
+	t.Run("output formats set their color depth", func(t *testing.T) {
+		settings := (&RenderSettings{OutputFormat: RenderSettings_PNG16}).VMSettings()
+		require.Equal(t, "PNG", settings.Format)
+		require.Equal(t, "16", settings.ColorDepth)
+
+		settings = (&RenderSettings{OutputFormat: RenderSettings_OPEN_EXR}).VMSettings()
+		require.Equal(t, "OPEN_EXR", settings.Format)
+		require.Equal(t, "32", settings.ColorDepth)
+	})
+}
+
+// --- Test for VideoRenderingThread.FrameExtension ---
+func TestFrameExtension(t *testing.T) {
+	require.Equal(t, "png", VideoRenderingThread{}.FrameExtension())
+	require.Equal(t, "png", VideoRenderingThread{RenderSettings: &RenderSettings{OutputFormat: RenderSettings_PNG16}}.FrameExtension())
+	require.Equal(t, "jpg", VideoRenderingThread{RenderSettings: &RenderSettings{OutputFormat: RenderSettings_JPEG}}.FrameExtension())
+	require.Equal(t, "tif", VideoRenderingThread{RenderSettings: &RenderSettings{OutputFormat: RenderSettings_TIFF}}.FrameExtension())
+	require.Equal(t, "exr", VideoRenderingThread{RenderSettings: &RenderSettings{OutputFormat: RenderSettings_OPEN_EXR_MULTILAYER}}.FrameExtension())
+}
@@ -0,0 +1,67 @@
+package videoRendering
+
+import (
+	"github.com/janction/videoRendering/vm"
+)
+
+// VMImage returns the image the vm renders with
+func (i RendererImage) VMImage() vm.RendererImage {
+	return vm.RendererImage{Name: i.Name, BlenderVersion: i.BlenderVersion, Architecture: i.Architecture, Digest: i.Digest}
+}
+
+// Validate returns an error if the image isn't pinned by a sha256 digest
+func (i RendererImage) Validate() error {
+	return i.VMImage().Validate()
+}
+
+// vmRendererImages returns the approved images the vm renders with, or the baseline images while none is approved
+func vmRendererImages(images []RendererImage) []vm.RendererImage {
+	if len(images) == 0 {
+		return vm.BaselineRendererImages
+	}
+	vmImages := make([]vm.RendererImage, len(images))
+	for i, image := range images {
+		vmImages[i] = image.VMImage()
+	}
+	return vmImages
+}
+
+// SelectRendererImage returns the approved image workers of the architecture render the blender version with
+func SelectRendererImage(images []RendererImage, architecture string, blenderVersion string) (vm.RendererImage, bool) {
+	return vm.SelectRendererImage(vmRendererImages(images), architecture, blenderVersion)
+}
+
+// HasRendererImage returns true if any architecture has an approved image for the blender version
+func HasRendererImage(images []RendererImage, blenderVersion string) bool {
+	for _, image := range vmRendererImages(images) {
+		if vm.MatchesVersion(blenderVersion, image.BlenderVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRender returns true if the worker declared it renders the blender version, and its architecture has an approved image for it
+func (w Worker) CanRender(images []RendererImage, blenderVersion string) bool {
+	if blenderVersion == "" {
+		return true
+	}
+	if _, ok := SelectRendererImage(images, w.Architecture, blenderVersion); !ok {
+		return false
+	}
+	return ServesBlenderVersion(w.BlenderVersions, blenderVersion)
+}
+
+// ServesBlenderVersion returns true if any of the declared versions overlaps the requested one: declaring 4.2 serves
+// tasks needing 4, 4.2 or 4.2.1. Workers that don't declare versions serve all of them
+func ServesBlenderVersion(declared []string, blenderVersion string) bool {
+	if len(declared) == 0 {
+		return true
+	}
+	for _, version := range declared {
+		if vm.MatchesVersion(blenderVersion, version) || vm.MatchesVersion(version, blenderVersion) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package videoRendering
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// image threads render with in the tests
+var testRendererImage = vm.RendererImage{Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: vm.Architecture(), Digest: "sha256:" + strings.Repeat("ab", 32)}
+
+// renderer threads render with in the tests, in the default vm
+var testRenderer = vm.Renderer{VM: vm.DefaultVM(), Image: testRendererImage}
+
+// --- Test for Worker.CanRender ---
+func TestWorkerCanRender(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	images := []RendererImage{
+		{Name: "blendergrid/blender", BlenderVersion: "4.2.1", Architecture: "amd64", Digest: digest},
+		{Name: "blendergrid/blender", BlenderVersion: "3.6.5", Architecture: "amd64", Digest: digest},
+		{Name: "blender_render", BlenderVersion: "4.2.0", Architecture: "arm64", Digest: digest},
+	}
+	tests := []struct {
+		name    string
+		worker  Worker
+		version string
+		can     bool
+	}{
+		{"any version", Worker{}, "", true},
+		{"every approved version", Worker{Architecture: "amd64"}, "3.6", true},
+		{"declared version", Worker{Architecture: "amd64", BlenderVersions: []string{"4.2"}}, "4.2.1", true},
+		{"declared release of the version", Worker{Architecture: "amd64", BlenderVersions: []string{"4.2"}}, "4", true},
+		{"undeclared version", Worker{Architecture: "amd64", BlenderVersions: []string{"4.2"}}, "3.6", false},
+		{"no image for the architecture", Worker{Architecture: "arm64"}, "3.6", false},
+		{"no image for the release", Worker{Architecture: "arm64"}, "4.2.1", false},
+		{"worker without architecture", Worker{}, "4.2", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.can, test.worker.CanRender(images, test.version))
+		})
+	}
+
+	require.True(t, HasRendererImage(images, "4.2"))
+	require.False(t, HasRendererImage(images, "2.93"))
+}
+
+// --- Test for the baseline images ---
+func TestWorkerCanRender_Baseline(t *testing.T) {
+	// until governance approves an image, workers render tasks without a blender version with the baseline images
+	require.True(t, Worker{Architecture: "amd64"}.CanRender(nil, ""))
+	require.False(t, Worker{Architecture: "amd64"}.CanRender(nil, "4.2"))
+	require.True(t, HasRendererImage(nil, ""))
+	require.False(t, HasRendererImage(nil, "4.2"))
+
+	image, ok := SelectRendererImage(nil, "arm64", "")
+	require.True(t, ok)
+	require.Equal(t, "blender_render", image.Reference())
+	image, ok = SelectRendererImage(nil, "amd64", "")
+	require.True(t, ok)
+	require.Equal(t, "blendergrid/blender", image.Reference())
+}
@@ -0,0 +1,320 @@
+package videoRendering
+
+import (
+	"context"
+	fmt "fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janction/videoRendering/db"
+	"github.com/janction/videoRendering/ipfs"
+	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
+)
+
+const (
+	// frames between the ones rendered by the preview pass of tasks that don't set it
+	defaultPreviewStride = 10
+	// the preview pass renders at a quarter of the resolution, with few samples, so a single worker renders it quickly
+	previewResolutionDivisor = 4
+	previewSamples           = 16
+	// render seconds assumed for frames rendered in less than a second, so every frame has some cost
+	minFrameCost = 1
+	// workers designated to render the preview pass of a task, and how many of them must submit matching costs.
+	// A single previewer could skew the split of the threads at will, so costs are only accepted once confirmed
+	previewWorkers = 3
+	previewQuorum  = 2
+	// workers have different hardware, so the render seconds of a frame match if they differ at most this many times
+	previewCostTolerance = 2
+)
+
+// IsPreview returns true if the frames are split from the render times of a preview pass
+func (s *ThreadSplit) IsPreview() bool {
+	return s != nil && s.Strategy == ThreadSplit_PREVIEW
+}
+
+// Stride returns every how many frames the preview pass renders one
+func (s *ThreadSplit) Stride() int64 {
+	if s == nil || s.PreviewStride == 0 {
+		return defaultPreviewStride
+	}
+	return int64(s.PreviewStride)
+}
+
+// Validate returns an error if the split requested by the creator of a task is invalid
+func (s *ThreadSplit) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if _, ok := ThreadSplit_Strategy_name[int32(s.Strategy)]; !ok {
+		return fmt.Errorf("unknown split strategy %v", s.Strategy)
+	}
+	if !s.IsPreview() && s.PreviewStride != 0 {
+		return fmt.Errorf("preview stride requires the %s strategy", ThreadSplit_PREVIEW)
+	}
+	// the rest is set by the chain and the preview pass
+	if s.PreviewDeadline != 0 || len(s.Previewers) > 0 || len(s.Submissions) > 0 || len(s.Costs) > 0 {
+		return fmt.Errorf("preview deadline, previewers, submissions and costs can't be set on creation")
+	}
+	return nil
+}
+
+// DesignatePreviewers designates the workers rendering the preview pass of the task among the eligible ones,
+// starting at an offset given by the task id so previews are spread across the network.
+// Returns false if there aren't enough eligible workers to confirm the costs of the preview
+func (t *VideoRenderingTask) DesignatePreviewers(eligible []string) bool {
+	if len(eligible) < previewQuorum {
+		return false
+	}
+	id, _ := strconv.ParseInt(t.TaskId, 10, 64)
+	offset := int(id % int64(len(eligible)))
+	for i := 0; i < min(previewWorkers, len(eligible)); i++ {
+		t.Split.Previewers = append(t.Split.Previewers, eligible[(offset+i)%len(eligible)])
+	}
+	return true
+}
+
+// IsPreviewer returns true if the worker is designated to render the preview pass of the task and didn't submit it yet
+func (t VideoRenderingTask) IsPreviewer(worker string) bool {
+	if !slices.Contains(t.Split.GetPreviewers(), worker) {
+		return false
+	}
+	for _, submission := range t.Split.Submissions {
+		if submission.Worker == worker {
+			return false
+		}
+	}
+	return true
+}
+
+// AddPreviewSubmission records the costs submitted by a previewer. Once enough submissions match, the costs of the split
+// are set to their average and true is returned
+func (s *ThreadSplit) AddPreviewSubmission(worker string, costs []*FrameCost) bool {
+	submission := &PreviewSubmission{Worker: worker, Costs: costs}
+	s.Submissions = append(s.Submissions, submission)
+
+	matching := []*PreviewSubmission{submission}
+	for _, other := range s.Submissions[:len(s.Submissions)-1] {
+		if matchingCosts(costs, other.Costs) {
+			matching = append(matching, other)
+		}
+	}
+	if len(matching) < previewQuorum {
+		return false
+	}
+
+	s.Costs = averageCosts(matching)
+	return true
+}
+
+// matchingCosts returns true if the render seconds of every frame of both previews are within the tolerance
+func matchingCosts(a, b []*FrameCost) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := max(int64(a[i].Seconds), minFrameCost), max(int64(b[i].Seconds), minFrameCost)
+		if max(x, y) > previewCostTolerance*min(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// averageCosts returns the average render seconds of every frame of the submissions.
+// Integer math keeps the average identical on every node
+func averageCosts(submissions []*PreviewSubmission) []*FrameCost {
+	res := make([]*FrameCost, len(submissions[0].Costs))
+	for i, cost := range submissions[0].Costs {
+		var total int64
+		for _, submission := range submissions {
+			total += int64(submission.Costs[i].Seconds)
+		}
+		res[i] = &FrameCost{Frame: cost.Frame, Seconds: uint32(total / int64(len(submissions)))}
+	}
+	return res
+}
+
+// IsPreviewPending returns true if the threads of the task wait for its preview pass
+func (t VideoRenderingTask) IsPreviewPending() bool {
+	return !t.Completed && t.Split.IsPreview() && len(t.Threads) == 0
+}
+
+// PreviewFrames returns the frames rendered by the preview pass: one every stride frames, plus the last one
+func (t VideoRenderingTask) PreviewFrames() []int64 {
+	var frames []int64
+	for frame := int64(t.StartFrame); frame <= int64(t.EndFrame); frame += t.Split.Stride() {
+		frames = append(frames, frame)
+	}
+	if len(frames) > 0 && frames[len(frames)-1] != int64(t.EndFrame) {
+		frames = append(frames, int64(t.EndFrame))
+	}
+	return frames
+}
+
+// PreviewSettings returns the settings of the task with the resolution and samples lowered for the preview pass
+func (t VideoRenderingTask) PreviewSettings() vm.RenderSettings {
+	settings := t.RenderSettings.VMSettings()
+
+	percentage := settings.ResolutionPercentage
+	if percentage == 0 {
+		percentage = 100
+	}
+	settings.ResolutionPercentage = max(percentage/previewResolutionDivisor, 1)
+
+	if settings.Engine != renderEngines[RenderSettings_WORKBENCH] && (settings.Samples == 0 || settings.Samples > previewSamples) {
+		settings.Samples = previewSamples
+	}
+	return settings
+}
+
+// ParseFrameCosts parses the frame=seconds costs submitted by a previewer, which must be the preview frames in order
+func (t VideoRenderingTask) ParseFrameCosts(costs []string) ([]*FrameCost, error) {
+	frames := t.PreviewFrames()
+	if len(costs) != len(frames) {
+		return nil, fmt.Errorf("preview has %v frames, got %v costs", len(frames), len(costs))
+	}
+
+	res := make([]*FrameCost, len(costs))
+	for i, cost := range costs {
+		frame, seconds, found := strings.Cut(cost, "=")
+		if !found {
+			return nil, fmt.Errorf("cost %s isn't frame=seconds", cost)
+		}
+		frameNumber, err := strconv.ParseInt(frame, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid frame of cost %s: %w", cost, err)
+		}
+		if frameNumber != frames[i] {
+			return nil, fmt.Errorf("cost %v is for frame %v, expected frame %v", i, frameNumber, frames[i])
+		}
+		secondsValue, err := strconv.ParseUint(seconds, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seconds of cost %s: %w", cost, err)
+		}
+		res[i] = &FrameCost{Frame: frameNumber, Seconds: uint32(secondsValue)}
+	}
+	return res, nil
+}
+
+// frameCosts estimates the render seconds of every frame of the task, interpolating linearly between the previewed frames.
+// Integer math keeps the estimates, and so the threads, identical on every node
+func (t VideoRenderingTask) frameCosts() []int64 {
+	previewed := t.Split.GetCosts()
+	res := make([]int64, 0, t.EndFrame-t.StartFrame+1)
+	j := 0
+	for frame := int64(t.StartFrame); frame <= int64(t.EndFrame); frame++ {
+		for j < len(previewed)-1 && previewed[j+1].Frame <= frame {
+			j++
+		}
+		before := previewed[j]
+		cost := int64(before.Seconds)
+		if j < len(previewed)-1 && frame > before.Frame {
+			after := previewed[j+1]
+			cost += (int64(after.Seconds) - int64(before.Seconds)) * (frame - before.Frame) / (after.Frame - before.Frame)
+		}
+		res = append(res, max(cost, minFrameCost))
+	}
+	return res
+}
+
+// splitFramesByCost divides the frames into threads of similar total cost instead of similar frame count.
+// Every thread ends at the frame whose accumulated cost is closest to its share of the total, and keeps at least one frame
+func splitFramesByCost(startFrame int, costs []int64, threads int) []frameRange {
+	if threads <= 0 || len(costs) < threads {
+		return splitFrames(startFrame, startFrame+len(costs)-1, threads)
+	}
+
+	var total int64
+	for _, cost := range costs {
+		total += cost
+	}
+
+	result := make([]frameRange, 0, threads)
+	currentStart := 0
+	var accumulated int64
+	for i := 1; i < threads; i++ {
+		target := total * int64(i) / int64(threads)
+		// the remaining threads need a frame each
+		maxEnd := len(costs) - (threads - i) - 1
+
+		end := currentStart
+		accumulated += costs[end]
+		for end < maxEnd && abs(accumulated+costs[end+1]-target) < abs(accumulated-target) {
+			end++
+			accumulated += costs[end]
+		}
+		result = append(result, frameRange{StartFrame: startFrame + currentStart, EndFrame: startFrame + end})
+		currentStart = end + 1
+	}
+	result = append(result, frameRange{StartFrame: startFrame + currentStart, EndFrame: startFrame + len(costs) - 1})
+
+	return result
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// previewThreadId identifies the preview pass of a task in the local database and its render container
+func previewThreadId(taskId string) string {
+	return "preview" + taskId
+}
+
+// RenderPreview renders the preview frames of the task with cheap settings in the renderer image selected for the preview,
+// and submits how long each of them took, so the chain splits the frames into threads of similar render time
+func (t VideoRenderingTask) RenderPreview(ctx context.Context, workerAddress, rootPath string, renderer vm.Renderer, db *db.DB) error {
+	db.UpdatePreview(t.TaskId, true)
+	id := previewThreadId(t.TaskId)
+	fail := func(err error) error {
+		db.UpdatePreview(t.TaskId, false)
+		db.AddLogEntry(id, fmt.Sprintf("Error rendering preview. %s", err.Error()), time.Now().Unix(), 2)
+		videoRenderingLogger.Logger.Error("unable to render preview of task %s: %s", t.TaskId, err.Error())
+		return err
+	}
+
+	// frames of a previous attempt would be timed again, so the preview starts from a clean directory
+	path := filepath.Join(rootPath, "previews", t.TaskId)
+	if err := os.RemoveAll(path); err != nil {
+		return fail(err)
+	}
+
+	ipfs.EnsureIPFSRunning()
+	if err := ipfs.IPFSGet(t.Cid, path); err != nil {
+		return fail(err)
+	}
+
+	frames := t.PreviewFrames()
+	settings := t.PreviewSettings()
+	settings.Image = renderer.Image
+	if err := renderer.RenderVideo(ctx, t.Cid, frames, id, path, settings, db); err != nil {
+		return fail(err)
+	}
+
+	durations, err := db.ReadRenderDurations(id)
+	if err != nil {
+		return fail(err)
+	}
+	args := []string{"tx", "videoRendering", "submit-preview", t.TaskId}
+	for _, frame := range frames {
+		duration, ok := durations[frame]
+		if !ok {
+			return fail(fmt.Errorf("frame %v wasn't rendered", frame))
+		}
+		args = append(args, fmt.Sprintf("%v=%v", frame, duration))
+	}
+	args = append(args, "--yes", "--from", workerAddress)
+
+	if err := ExecuteCli(args); err != nil {
+		return fail(err)
+	}
+	db.AddLogEntry(id, fmt.Sprintf("Submitted preview of %v frames.", len(frames)), time.Now().Unix(), 1)
+	return nil
+}
@@ -0,0 +1,154 @@
+package videoRendering
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for splitFramesByCost ---
+func TestSplitFramesByCost(t *testing.T) {
+	t.Run("Expensive frames get threads with less frames", func(t *testing.T) {
+		expected := []frameRange{
+			{StartFrame: 0, EndFrame: 4},
+			{StartFrame: 5, EndFrame: 7},
+		}
+		result := splitFramesByCost(0, []int64{1, 1, 1, 1, 10, 10, 1, 1}, 2)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("Every thread keeps a frame", func(t *testing.T) {
+		expected := []frameRange{
+			{StartFrame: 1, EndFrame: 1},
+			{StartFrame: 2, EndFrame: 3},
+			{StartFrame: 4, EndFrame: 10},
+		}
+		result := splitFramesByCost(1, []int64{10, 1, 1, 1, 1, 1, 1, 1, 1, 1}, 3)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("Same costs split like splitFrames", func(t *testing.T) {
+		result := splitFramesByCost(1, []int64{3, 3, 3, 3, 3, 3, 3, 3, 3, 3}, 2)
+		require.Equal(t, splitFrames(1, 10, 2), result)
+	})
+
+	t.Run("Less frames than threads split like splitFrames", func(t *testing.T) {
+		result := splitFramesByCost(1, []int64{3, 3}, 3)
+		require.Equal(t, splitFrames(1, 2, 3), result)
+	})
+}
+
+// --- Test for PreviewFrames ---
+func TestPreviewFrames(t *testing.T) {
+	task := VideoRenderingTask{StartFrame: 0, EndFrame: 10, Split: &ThreadSplit{Strategy: ThreadSplit_PREVIEW, PreviewStride: 4}}
+	require.Equal(t, []int64{0, 4, 8, 10}, task.PreviewFrames())
+
+	// the default stride includes the last frame once
+	task = VideoRenderingTask{StartFrame: 1, EndFrame: 21, Split: &ThreadSplit{Strategy: ThreadSplit_PREVIEW}}
+	require.Equal(t, []int64{1, 11, 21}, task.PreviewFrames())
+}
+
+// --- Test for ParseFrameCosts ---
+func TestParseFrameCosts(t *testing.T) {
+	task := VideoRenderingTask{StartFrame: 0, EndFrame: 10, Split: &ThreadSplit{Strategy: ThreadSplit_PREVIEW, PreviewStride: 5}}
+
+	costs, err := task.ParseFrameCosts([]string{"0=2", "5=12", "10=2"})
+	require.NoError(t, err)
+	require.Equal(t, []*FrameCost{{Frame: 0, Seconds: 2}, {Frame: 5, Seconds: 12}, {Frame: 10, Seconds: 2}}, costs)
+
+	invalid := [][]string{
+		{"0=2", "5=12"},
+		{"0=2", "6=12", "10=2"},
+		{"0=2", "5", "10=2"},
+		{"0=2", "5=-1", "10=2"},
+		{"0=2", "five=1", "10=2"},
+	}
+	for _, c := range invalid {
+		_, err := task.ParseFrameCosts(c)
+		require.Error(t, err, c)
+	}
+}
+
+// --- Test for GenerateThreads of tasks with a preview pass ---
+func TestGenerateThreads_Preview(t *testing.T) {
+	task := &VideoRenderingTask{TaskId: "1", StartFrame: 0, EndFrame: 10, ThreadAmount: 2, Split: &ThreadSplit{Strategy: ThreadSplit_PREVIEW, PreviewStride: 5}}
+
+	// without a preview, frames are split evenly
+	threads := task.GenerateThreads(task.TaskId)
+	require.Equal(t, int64(5), threads[0].EndFrame)
+
+	// costs of the unpreviewed frames are interpolated
+	task.Split.Costs = []*FrameCost{{Frame: 0, Seconds: 2}, {Frame: 5, Seconds: 2}, {Frame: 10, Seconds: 22}}
+	require.Equal(t, []int64{2, 2, 2, 2, 2, 2, 6, 10, 14, 18, 22}, task.frameCosts())
+
+	threads = task.GenerateThreads(task.TaskId)
+	require.Len(t, threads, 2)
+	for i, thread := range threads {
+		require.Equal(t, task.TaskId+strconv.Itoa(i), thread.ThreadId)
+	}
+	require.Equal(t, int64(0), threads[0].StartFrame)
+	require.Equal(t, int64(8), threads[0].EndFrame)
+	require.Equal(t, int64(9), threads[1].StartFrame)
+	require.Equal(t, int64(10), threads[1].EndFrame)
+}
+
+// --- Test for ThreadSplit.Validate ---
+func TestThreadSplitValidate(t *testing.T) {
+	require.NoError(t, (*ThreadSplit)(nil).Validate())
+	require.NoError(t, (&ThreadSplit{Strategy: ThreadSplit_PREVIEW, PreviewStride: 3}).Validate())
+
+	require.Error(t, (&ThreadSplit{Strategy: 7}).Validate())
+	require.Error(t, (&ThreadSplit{PreviewStride: 3}).Validate())
+	require.Error(t, (&ThreadSplit{Strategy: ThreadSplit_PREVIEW, PreviewDeadline: 10}).Validate())
+	require.Error(t, (&ThreadSplit{Strategy: ThreadSplit_PREVIEW, Costs: []*FrameCost{{Frame: 1, Seconds: 1}}}).Validate())
+	require.Error(t, (&ThreadSplit{Strategy: ThreadSplit_PREVIEW, Previewers: []string{"worker1"}}).Validate())
+	require.Error(t, (&ThreadSplit{Strategy: ThreadSplit_PREVIEW, Submissions: []*PreviewSubmission{{Worker: "worker1"}}}).Validate())
+}
+
+// --- Test for DesignatePreviewers and IsPreviewer ---
+func TestDesignatePreviewers(t *testing.T) {
+	task := &VideoRenderingTask{TaskId: "4", Split: &ThreadSplit{Strategy: ThreadSplit_PREVIEW}}
+	require.False(t, task.DesignatePreviewers([]string{"worker0"}))
+	require.Empty(t, task.Split.Previewers)
+
+	// the task id decides where the designation starts
+	require.True(t, task.DesignatePreviewers([]string{"worker0", "worker1", "worker2", "worker3", "worker4"}))
+	require.Equal(t, []string{"worker4", "worker0", "worker1"}, task.Split.Previewers)
+
+	require.True(t, task.IsPreviewer("worker0"))
+	require.False(t, task.IsPreviewer("worker2"))
+	task.Split.AddPreviewSubmission("worker0", []*FrameCost{{Frame: 0, Seconds: 2}})
+	require.False(t, task.IsPreviewer("worker0"))
+}
+
+// --- Test for AddPreviewSubmission ---
+func TestAddPreviewSubmission(t *testing.T) {
+	split := &ThreadSplit{Strategy: ThreadSplit_PREVIEW}
+	require.False(t, split.AddPreviewSubmission("worker0", []*FrameCost{{Frame: 0, Seconds: 2}, {Frame: 5, Seconds: 100}}))
+
+	// costs far from the first submission aren't accepted
+	require.False(t, split.AddPreviewSubmission("worker1", []*FrameCost{{Frame: 0, Seconds: 2}, {Frame: 5, Seconds: 1}}))
+	require.Empty(t, split.Costs)
+
+	// costs within the tolerance confirm each other, and their average is used
+	require.True(t, split.AddPreviewSubmission("worker2", []*FrameCost{{Frame: 0, Seconds: 0}, {Frame: 5, Seconds: 150}}))
+	require.Equal(t, []*FrameCost{{Frame: 0, Seconds: 1}, {Frame: 5, Seconds: 125}}, split.Costs)
+	require.Len(t, split.Submissions, 3)
+}
+
+// --- Test for PreviewSettings ---
+func TestPreviewSettings(t *testing.T) {
+	task := VideoRenderingTask{}
+	settings := task.PreviewSettings()
+	require.Equal(t, 25, settings.ResolutionPercentage)
+	require.Equal(t, previewSamples, settings.Samples)
+
+	task = VideoRenderingTask{RenderSettings: &RenderSettings{ResolutionPercentage: 2, Samples: 8}}
+	settings = task.PreviewSettings()
+	require.Equal(t, 1, settings.ResolutionPercentage)
+	require.Equal(t, 8, settings.Samples)
+
+	task = VideoRenderingTask{RenderSettings: &RenderSettings{Engine: RenderSettings_WORKBENCH}}
+	require.Equal(t, 0, task.PreviewSettings().Samples)
+}
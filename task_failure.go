@@ -0,0 +1,119 @@
+package videoRendering
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/janction/videoRendering/ipfs"
+	"github.com/janction/videoRendering/vm"
+)
+
+// reason reported for every kind of failure of a frame
+var unrenderableReasons = map[vm.FailureKind]VideoRenderingThread_UnrenderableFrame_Reason{
+	vm.FailureTimeout:       VideoRenderingThread_UnrenderableFrame_TIMEOUT,
+	vm.FailureCrash:         VideoRenderingThread_UnrenderableFrame_CRASH,
+	vm.FailureMissingOutput: VideoRenderingThread_UnrenderableFrame_MISSING_OUTPUT,
+}
+
+// CliArg returns the reason as the cli parses it, like missing-output
+func (r VideoRenderingThread_UnrenderableFrame_Reason) CliArg() string {
+	return strings.ReplaceAll(strings.ToLower(r.String()), "_", "-")
+}
+
+// AddUnrenderableFrame records the frame a worker couldn't render, replacing its previous report of the frame
+func (t *VideoRenderingThread) AddUnrenderableFrame(report VideoRenderingThread_UnrenderableFrame) {
+	for _, previous := range t.UnrenderableFrames {
+		if previous.Worker == report.Worker && previous.Frame == report.Frame {
+			*previous = report
+			return
+		}
+	}
+	t.UnrenderableFrames = append(t.UnrenderableFrames, &report)
+}
+
+// UnconfirmedFailures returns the frames other workers of the thread reported unrenderable, which the worker didn't
+// report. The worker renders them again, so a failure is only confirmed by workers rendering the same frame
+func (t VideoRenderingThread) UnconfirmedFailures(worker string) []int64 {
+	var reported, frames []int64
+	for _, report := range t.UnrenderableFrames {
+		if report.Worker == worker {
+			reported = append(reported, report.Frame)
+		}
+	}
+	for _, report := range t.UnrenderableFrames {
+		if report.Worker != worker && !slices.Contains(reported, report.Frame) && !slices.Contains(frames, report.Frame) {
+			frames = append(frames, report.Frame)
+		}
+	}
+	return frames
+}
+
+// ReportTaskFailure uploads the log of the last attempt of the frame the worker couldn't render, and reports it
+func (t VideoRenderingThread) ReportTaskFailure(failure *vm.FrameFailure, workerAddress string, path string) error {
+	logPath := filepath.Join(path, "logs", fmt.Sprintf("frame_%06d.log", failure.Frame))
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(logPath, []byte(failure.Logs), 0644); err != nil {
+		return err
+	}
+	logCid, err := ipfs.UploadFile(logPath)
+	if err != nil {
+		return fmt.Errorf("unable to upload render log: %w", err)
+	}
+
+	args := []string{"tx", "videoRendering", "report-task-failure", t.TaskId, t.ThreadId, strconv.FormatInt(failure.Frame, 10), unrenderableReasons[failure.Kind].CliArg(), strconv.Itoa(failure.Attempts), logCid}
+	args = append(args, "--yes", "--from", workerAddress)
+	return ExecuteCli(args)
+}
+
+// FailureReporters returns the workers that reported the frame of the thread unrenderable for the reason
+func (t VideoRenderingThread) FailureReporters(frame int64, reason VideoRenderingThread_UnrenderableFrame_Reason) []string {
+	var reporters []string
+	for _, report := range t.UnrenderableFrames {
+		if report.Frame == frame && report.Reason == reason && slices.Contains(t.Workers, report.Worker) && !slices.Contains(reporters, report.Worker) {
+			reporters = append(reporters, report.Worker)
+		}
+	}
+	return reporters
+}
+
+// ReportedFailure returns the first frame and reason reported by at least the required workers of the thread, and who
+// reported it. Reports of other frames or reasons are different failures, so they don't add up
+func (t VideoRenderingThread) ReportedFailure(required int) (int64, VideoRenderingThread_UnrenderableFrame_Reason, []string, bool) {
+	for _, report := range t.UnrenderableFrames {
+		if reporters := t.FailureReporters(report.Frame, report.Reason); len(reporters) >= required {
+			return report.Frame, report.Reason, reporters, true
+		}
+	}
+	return 0, 0, nil, false
+}
+
+// UnpaidReward returns the reward of the task that wasn't paid to the winners of its threads
+func (t *VideoRenderingTask) UnpaidReward() types.Coin {
+	paid := math.ZeroInt()
+	for _, thread := range t.Threads {
+		if thread.Completed && thread.Solution != nil && thread.Solution.Dir != "" {
+			paid = paid.Add(t.GetWinnerReward().Amount)
+		}
+	}
+	return types.NewCoin(t.Reward.Denom, math.MaxInt(t.Reward.Amount.Sub(paid), math.ZeroInt()))
+}
+
+// FailureRefund splits the unpaid reward of a failed task into the refund of the requester and the fee of every reporter.
+// What can't be split evenly among the reporters is refunded
+func (t *VideoRenderingTask) FailureRefund(reporters int, feePercentage uint32) (refund types.Coin, fee types.Coin) {
+	unpaid := t.UnpaidReward()
+	fee = types.NewCoin(unpaid.Denom, math.ZeroInt())
+	if reporters > 0 {
+		fee.Amount = unpaid.Amount.MulRaw(int64(feePercentage)).QuoRaw(100).QuoRaw(int64(reporters))
+	}
+	refund = unpaid.Sub(types.NewCoin(unpaid.Denom, fee.Amount.MulRaw(int64(reporters))))
+	return refund, fee
+}
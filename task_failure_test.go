@@ -0,0 +1,95 @@
+package videoRendering
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for VideoRenderingThread.AddUnrenderableFrame ---
+func TestAddUnrenderableFrame(t *testing.T) {
+	thread := VideoRenderingThread{}
+	thread.AddUnrenderableFrame(VideoRenderingThread_UnrenderableFrame{Worker: "worker1", Frame: 3, Reason: VideoRenderingThread_UnrenderableFrame_TIMEOUT, Attempts: 3})
+	thread.AddUnrenderableFrame(VideoRenderingThread_UnrenderableFrame{Worker: "worker2", Frame: 3, Reason: VideoRenderingThread_UnrenderableFrame_CRASH, Attempts: 2})
+	require.Len(t, thread.UnrenderableFrames, 2)
+
+	// a worker's new report of a frame replaces its previous one
+	thread.AddUnrenderableFrame(VideoRenderingThread_UnrenderableFrame{Worker: "worker1", Frame: 3, Reason: VideoRenderingThread_UnrenderableFrame_MISSING_OUTPUT, Attempts: 1})
+	require.Len(t, thread.UnrenderableFrames, 2)
+	require.Equal(t, VideoRenderingThread_UnrenderableFrame_MISSING_OUTPUT, thread.UnrenderableFrames[0].Reason)
+
+	// reports of other frames are kept
+	thread.AddUnrenderableFrame(VideoRenderingThread_UnrenderableFrame{Worker: "worker1", Frame: 4, Reason: VideoRenderingThread_UnrenderableFrame_CRASH, Attempts: 3})
+	require.Len(t, thread.UnrenderableFrames, 3)
+}
+
+// --- Test for VideoRenderingThread.UnconfirmedFailures ---
+func TestUnconfirmedFailures(t *testing.T) {
+	thread := VideoRenderingThread{UnrenderableFrames: []*VideoRenderingThread_UnrenderableFrame{
+		{Worker: "worker1", Frame: 3, Reason: VideoRenderingThread_UnrenderableFrame_CRASH},
+		{Worker: "worker2", Frame: 5, Reason: VideoRenderingThread_UnrenderableFrame_CRASH},
+		{Worker: "worker3", Frame: 5, Reason: VideoRenderingThread_UnrenderableFrame_TIMEOUT},
+	}}
+
+	require.Equal(t, []int64{5}, thread.UnconfirmedFailures("worker1"))
+	require.Equal(t, []int64{3}, thread.UnconfirmedFailures("worker2"))
+	require.Equal(t, []int64{3, 5}, thread.UnconfirmedFailures("worker4"))
+}
+
+// --- Test for VideoRenderingThread_UnrenderableFrame_Reason.CliArg ---
+func TestUnrenderableReasonCliArg(t *testing.T) {
+	require.Equal(t, "timeout", VideoRenderingThread_UnrenderableFrame_TIMEOUT.CliArg())
+	require.Equal(t, "missing-output", VideoRenderingThread_UnrenderableFrame_MISSING_OUTPUT.CliArg())
+	// every kind of failure of the vm has a reason
+	require.Len(t, unrenderableReasons, len(VideoRenderingThread_UnrenderableFrame_Reason_name))
+}
+
+// --- Test for VideoRenderingThread.ReportedFailure ---
+func TestReportedFailure(t *testing.T) {
+	thread := VideoRenderingThread{Workers: []string{"worker1", "worker2", "worker3", "worker4"}, UnrenderableFrames: []*VideoRenderingThread_UnrenderableFrame{
+		{Worker: "worker1", Frame: 1, Reason: VideoRenderingThread_UnrenderableFrame_CRASH},
+		{Worker: "worker2", Frame: 1, Reason: VideoRenderingThread_UnrenderableFrame_TIMEOUT},
+		{Worker: "worker3", Frame: 2, Reason: VideoRenderingThread_UnrenderableFrame_CRASH},
+		// reports of workers that aren't assigned to the thread don't count
+		{Worker: "worker5", Frame: 1, Reason: VideoRenderingThread_UnrenderableFrame_CRASH},
+	}}
+
+	// other frames or reasons are different failures
+	_, _, _, failed := thread.ReportedFailure(2)
+	require.False(t, failed)
+
+	thread.AddUnrenderableFrame(VideoRenderingThread_UnrenderableFrame{Worker: "worker4", Frame: 1, Reason: VideoRenderingThread_UnrenderableFrame_CRASH})
+	frame, reason, reporters, failed := thread.ReportedFailure(2)
+	require.True(t, failed)
+	require.Equal(t, int64(1), frame)
+	require.Equal(t, VideoRenderingThread_UnrenderableFrame_CRASH, reason)
+	require.Equal(t, []string{"worker1", "worker4"}, reporters)
+
+	_, _, _, failed = thread.ReportedFailure(3)
+	require.False(t, failed)
+}
+
+// --- Test for VideoRenderingTask.FailureRefund ---
+func TestFailureRefund(t *testing.T) {
+	reward := types.NewCoin("jct", math.NewInt(1000))
+	task := VideoRenderingTask{Reward: &reward, Threads: []*VideoRenderingThread{{}, {}}}
+
+	refund, fee := task.FailureRefund(2, 2)
+	require.Equal(t, types.NewCoin("jct", math.NewInt(980)), refund)
+	require.Equal(t, types.NewCoin("jct", math.NewInt(10)), fee)
+
+	// the winners of completed threads were already paid, and fees that can't be split evenly are refunded
+	task.Threads[0].Completed = true
+	task.Threads[0].Solution = &VideoRenderingThread_Solution{Dir: "bafkframes"}
+	require.Equal(t, types.NewCoin("jct", math.NewInt(750)), task.UnpaidReward())
+	refund, fee = task.FailureRefund(3, 2)
+	require.Equal(t, types.NewCoin("jct", math.NewInt(735)), refund)
+	require.Equal(t, types.NewCoin("jct", math.NewInt(5)), fee)
+
+	// without a fee the whole unpaid reward is refunded
+	refund, fee = task.FailureRefund(3, 0)
+	require.Equal(t, task.UnpaidReward(), refund)
+	require.True(t, fee.IsZero())
+}
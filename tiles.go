@@ -0,0 +1,140 @@
+package videoRendering
+
+import (
+	fmt "fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+
+	"github.com/janction/videoRendering/vm"
+)
+
+// max columns and rows of a tile grid
+const maxTileGridSize = 16
+
+// IsTiled returns true if the grid splits frames in more than one tile
+func (g *TileGrid) IsTiled() bool {
+	return g != nil && g.Columns*g.Rows > 1
+}
+
+// Tiles returns the amount of tiles of every frame
+func (g *TileGrid) Tiles() int {
+	if !g.IsTiled() {
+		return 1
+	}
+	return int(g.Columns * g.Rows)
+}
+
+// Validate returns an error if frames rendered with the settings can't be split with the grid
+func (g *TileGrid) Validate(settings *RenderSettings) error {
+	if g == nil {
+		return nil
+	}
+	if g.Columns == 0 || g.Rows == 0 {
+		return fmt.Errorf("tile grid must have columns and rows, got %vx%v", g.Columns, g.Rows)
+	}
+	if g.Columns > maxTileGridSize || g.Rows > maxTileGridSize {
+		return fmt.Errorf("tile grid %vx%v is bigger than %v", g.Columns, g.Rows, maxTileGridSize)
+	}
+	// tiles are stitched with the png encoder
+	if format := settings.VMSettings().Format; g.IsTiled() && format != "PNG" {
+		return fmt.Errorf("tiled frames must be rendered as PNG, got %s", format)
+	}
+	return nil
+}
+
+// VMSettings returns the settings the vm renders the thread with: the ones of the task plus the tile of the thread
+func (t VideoRenderingThread) VMSettings() vm.RenderSettings {
+	settings := t.RenderSettings.VMSettings()
+	if t.TileGrid.IsTiled() {
+		settings.TileColumns = int(t.TileGrid.Columns)
+		settings.TileRows = int(t.TileGrid.Rows)
+		settings.Tile = int(t.Tile)
+	}
+	return settings
+}
+
+// tileThread returns the thread rendering the tile of the frame
+func (t VideoRenderingTask) tileThread(frame int64, tile uint32) *VideoRenderingThread {
+	for _, thread := range t.Threads {
+		if thread.Tile == tile && thread.StartFrame <= frame && frame <= thread.EndFrame {
+			return thread
+		}
+	}
+	return nil
+}
+
+// StitchTiles stitches the tiles of a frame, ordered by tile number, into the PNG frame at outPath.
+// Every tile is placed next to the previous one of its row, so the frame is rebuilt from the sizes blender cropped the tiles to.
+func StitchTiles(tilePaths []string, columns int, outPath string) error {
+	if columns <= 0 || len(tilePaths) == 0 || len(tilePaths)%columns != 0 {
+		return fmt.Errorf("%v tiles can't be stitched in %v columns", len(tilePaths), columns)
+	}
+
+	tiles := make([]image.Image, len(tilePaths))
+	deep := false
+	for i, tilePath := range tilePaths {
+		// tiles are PNG, so blender isn't needed to decode them
+		tile, err := decodeFrame(tilePath, vm.Renderer{})
+		if err != nil {
+			return err
+		}
+		switch tile.(type) {
+		case *image.RGBA64, *image.NRGBA64, *image.Gray16:
+			deep = true
+		}
+		tiles[i] = tile
+	}
+
+	// every row must be as wide as the frame, and every tile as tall as its row
+	width, height := 0, 0
+	for row := 0; row < len(tiles)/columns; row++ {
+		rowWidth, rowHeight := 0, tiles[row*columns].Bounds().Dy()
+		for column := 0; column < columns; column++ {
+			bounds := tiles[row*columns+column].Bounds()
+			if bounds.Dy() != rowHeight {
+				return fmt.Errorf("tile %v is %v pixels tall, its row is %v", row*columns+column, bounds.Dy(), rowHeight)
+			}
+			rowWidth += bounds.Dx()
+		}
+		if row > 0 && rowWidth != width {
+			return fmt.Errorf("row %v is %v pixels wide, the frame is %v", row, rowWidth, width)
+		}
+		width = rowWidth
+		height += rowHeight
+	}
+
+	var frame interface {
+		image.Image
+		Set(x, y int, c color.Color)
+	}
+	if deep {
+		frame = image.NewNRGBA64(image.Rect(0, 0, width, height))
+	} else {
+		frame = image.NewNRGBA(image.Rect(0, 0, width, height))
+	}
+
+	y := 0
+	for row := 0; row < len(tiles)/columns; row++ {
+		x := 0
+		for column := 0; column < columns; column++ {
+			tile := tiles[row*columns+column]
+			bounds := tile.Bounds()
+			for ty := 0; ty < bounds.Dy(); ty++ {
+				for tx := 0; tx < bounds.Dx(); tx++ {
+					frame.Set(x+tx, y+ty, tile.At(bounds.Min.X+tx, bounds.Min.Y+ty))
+				}
+			}
+			x += bounds.Dx()
+		}
+		y += tiles[row*columns].Bounds().Dy()
+	}
+
+	file, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return png.Encode(file, frame)
+}
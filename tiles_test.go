@@ -0,0 +1,125 @@
+package videoRendering
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/janction/videoRendering/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for GenerateThreads of tiled tasks ---
+func TestGenerateThreads_Tiled(t *testing.T) {
+	task := &VideoRenderingTask{TaskId: "1", StartFrame: 1, EndFrame: 3, ThreadAmount: 3, TileGrid: &TileGrid{Columns: 2, Rows: 2}}
+
+	threads := task.GenerateThreads(task.TaskId)
+
+	// every frame has a thread per tile
+	require.Len(t, threads, 12)
+	for i, thread := range threads {
+		require.Equal(t, task.TaskId+strconv.Itoa(i), thread.ThreadId)
+		require.Equal(t, int64(1+i/4), thread.StartFrame)
+		require.Equal(t, thread.StartFrame, thread.EndFrame)
+		require.Equal(t, uint32(i%4), thread.Tile)
+		require.Equal(t, task.TileGrid, thread.TileGrid)
+	}
+
+	task.Threads = threads
+	require.Equal(t, threads[6], task.tileThread(2, 2))
+	require.Nil(t, task.tileThread(4, 0))
+}
+
+// --- Test for TileGrid.Validate ---
+func TestTileGridValidate(t *testing.T) {
+	require.NoError(t, (*TileGrid)(nil).Validate(nil))
+	require.NoError(t, (&TileGrid{Columns: 1, Rows: 1}).Validate(&RenderSettings{OutputFormat: RenderSettings_OPEN_EXR}))
+	require.NoError(t, (&TileGrid{Columns: 4, Rows: 2}).Validate(&RenderSettings{OutputFormat: RenderSettings_PNG16}))
+
+	require.Error(t, (&TileGrid{Columns: 4}).Validate(nil))
+	require.Error(t, (&TileGrid{Columns: maxTileGridSize + 1, Rows: 1}).Validate(nil))
+	// tiles are only stitched as PNG
+	require.Error(t, (&TileGrid{Columns: 2, Rows: 2}).Validate(&RenderSettings{OutputFormat: RenderSettings_OPEN_EXR}))
+}
+
+// --- Test for VideoRenderingThread.VMSettings ---
+func TestThreadVMSettings(t *testing.T) {
+	thread := VideoRenderingThread{}
+	require.Equal(t, 1, thread.VMSettings().TileColumns)
+
+	thread = VideoRenderingThread{TileGrid: &TileGrid{Columns: 4, Rows: 2}, Tile: 5}
+	settings := thread.VMSettings()
+	require.Equal(t, 4, settings.TileColumns)
+	require.Equal(t, 2, settings.TileRows)
+	require.Equal(t, 5, settings.Tile)
+
+	// threads of other tiles render with other settings
+	other := VideoRenderingThread{TileGrid: &TileGrid{Columns: 4, Rows: 2}, Tile: 6}
+	require.NotEqual(t, settings.Fingerprint(), other.VMSettings().Fingerprint())
+}
+
+// writes a tile filled with the given gray
+func writeTile(t *testing.T, path string, width, height int, gray uint8) string {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: gray, G: gray, B: gray, A: 255})
+		}
+	}
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, png.Encode(file, img))
+	return path
+}
+
+// --- Test for StitchTiles ---
+func TestStitchTiles(t *testing.T) {
+	dir := t.TempDir()
+	// a 3x3 frame split in a 2x2 grid, where blender cropped tiles of uneven sizes
+	tiles := []string{
+		writeTile(t, filepath.Join(dir, "0.png"), 1, 2, 10),
+		writeTile(t, filepath.Join(dir, "1.png"), 2, 2, 20),
+		writeTile(t, filepath.Join(dir, "2.png"), 1, 1, 30),
+		writeTile(t, filepath.Join(dir, "3.png"), 2, 1, 40),
+	}
+	out := filepath.Join(dir, "frame_000001.png")
+
+	require.NoError(t, StitchTiles(tiles, 2, out))
+
+	frame, err := decodeFrame(out, vm.Renderer{})
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 3, 3), frame.Bounds())
+	expected := [][]uint8{{10, 20, 20}, {10, 20, 20}, {30, 40, 40}}
+	for y, row := range expected {
+		for x, gray := range row {
+			r, _, _, _ := frame.At(x, y).RGBA()
+			require.Equal(t, uint32(gray), r>>8, "pixel %v,%v", x, y)
+		}
+	}
+}
+
+func TestStitchTiles_Mismatch(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "frame_000001.png")
+
+	// rows of different widths
+	tiles := []string{
+		writeTile(t, filepath.Join(dir, "0.png"), 1, 1, 10),
+		writeTile(t, filepath.Join(dir, "1.png"), 1, 1, 20),
+		writeTile(t, filepath.Join(dir, "2.png"), 2, 1, 30),
+		writeTile(t, filepath.Join(dir, "3.png"), 1, 1, 40),
+	}
+	require.Error(t, StitchTiles(tiles, 2, out))
+
+	// tiles of different heights in the same row
+	tiles[1] = writeTile(t, filepath.Join(dir, "1.png"), 1, 2, 20)
+	require.Error(t, StitchTiles(tiles, 2, out))
+
+	// tiles that don't fill the grid
+	require.Error(t, StitchTiles(tiles[:3], 2, out))
+}
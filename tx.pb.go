@@ -41,6 +41,18 @@ type MsgCreateVideoRenderingTask struct {
 	EndFrame   int32       `protobuf:"varint,4,opt,name=endFrame,proto3" json:"endFrame,omitempty"`
 	Threads    int32       `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
 	Reward     *types.Coin `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	// optional tolerance for renderers that aren't deterministic. Frames must be identical when empty
+	Comparison *FrameComparison `protobuf:"bytes,7,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// optional render settings overriding the ones of the .blend file
+	RenderSettings *RenderSettings `protobuf:"bytes,8,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// frames per second of the assembled video. 24 when empty
+	FrameRate uint32 `protobuf:"varint,9,opt,name=frame_rate,json=frameRate,proto3" json:"frame_rate,omitempty"`
+	// optional grid splitting every frame into tiles rendered by different threads
+	TileGrid *TileGrid `protobuf:"bytes,10,opt,name=tile_grid,json=tileGrid,proto3" json:"tile_grid,omitempty"`
+	// optional preview pass splitting the frames into threads of similar render time
+	Split *ThreadSplit `protobuf:"bytes,11,opt,name=split,proto3" json:"split,omitempty"`
+	// optional blender version the scene needs. A version like 4.2 is rendered by any approved 4.2.x image
+	BlenderVersion string `protobuf:"bytes,12,opt,name=blender_version,json=blenderVersion,proto3" json:"blender_version,omitempty"`
 }
 
 func (m *MsgCreateVideoRenderingTask) Reset()         { *m = MsgCreateVideoRenderingTask{} }
@@ -118,6 +130,48 @@ func (m *MsgCreateVideoRenderingTask) GetReward() *types.Coin {
 	return nil
 }
 
+func (m *MsgCreateVideoRenderingTask) GetComparison() *FrameComparison {
+	if m != nil {
+		return m.Comparison
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoRenderingTask) GetRenderSettings() *RenderSettings {
+	if m != nil {
+		return m.RenderSettings
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoRenderingTask) GetFrameRate() uint32 {
+	if m != nil {
+		return m.FrameRate
+	}
+	return 0
+}
+
+func (m *MsgCreateVideoRenderingTask) GetTileGrid() *TileGrid {
+	if m != nil {
+		return m.TileGrid
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoRenderingTask) GetSplit() *ThreadSplit {
+	if m != nil {
+		return m.Split
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoRenderingTask) GetBlenderVersion() string {
+	if m != nil {
+		return m.BlenderVersion
+	}
+	return ""
+}
+
 // MsgCreateGameResponse defines the Msg/CreateGame response type.
 type MsgCreateVideoRenderingTaskResponse struct {
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -168,6 +222,10 @@ type MsgAddWorker struct {
 	PublicIp string     `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	IpfsId   string     `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
 	Stake    types.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake"`
+	// GOARCH of the worker
+	Architecture string `protobuf:"bytes,5,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// blender versions the worker renders. Empty renders every version with an approved image for its architecture
+	BlenderVersions []string `protobuf:"bytes,6,rep,name=blender_versions,json=blenderVersions,proto3" json:"blender_versions,omitempty"`
 }
 
 func (m *MsgAddWorker) Reset()         { *m = MsgAddWorker{} }
@@ -231,6 +289,20 @@ func (m *MsgAddWorker) GetStake() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddWorker) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *MsgAddWorker) GetBlenderVersions() []string {
+	if m != nil {
+		return m.BlenderVersions
+	}
+	return nil
+}
+
 type MsgAddWorkerResponse struct {
 	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -390,11 +462,24 @@ func (m *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
 // Msg to Propose a solution to an specific thread
 // Actual solution is a map of hashes
 type MsgProposeSolution struct {
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId  string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// hex encoded merkle root of the MiMC commitments of all frames, ordered by frame number
+	MerkleRoot string `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// optional hex encoded groth16 proof of knowledge of the hashes and salt committed in the merkle root by the creator
+	ZkProof string `protobuf:"bytes,7,opt,name=zk_proof,json=zkProof,proto3" json:"zk_proof,omitempty"`
+	// version of the circuit zk_proof was generated with
+	ZkCircuitVersion string `protobuf:"bytes,9,opt,name=zk_circuit_version,json=zkCircuitVersion,proto3" json:"zk_circuit_version,omitempty"`
+	// version of the pixel hashes committed in the merkle root. First version when empty
+	HashVersion string `protobuf:"bytes,10,opt,name=hash_version,json=hashVersion,proto3" json:"hash_version,omitempty"`
+	// hex encoded fingerprint of the render settings enforced while rendering
+	RenderSettings string `protobuf:"bytes,11,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// digest of the approved renderer image the frames were rendered with
+	RendererDigest string `protobuf:"bytes,12,opt,name=renderer_digest,json=rendererDigest,proto3" json:"renderer_digest,omitempty"`
+	// average seconds the creator took to render a frame, which sizes the commit window of the validators
+	AverageRenderSeconds int64 `protobuf:"varint,13,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
 }
 
 func (m *MsgProposeSolution) Reset()         { *m = MsgProposeSolution{} }
@@ -458,11 +543,53 @@ func (m *MsgProposeSolution) GetPublicKey() string {
 	return ""
 }
 
-func (m *MsgProposeSolution) GetSignatures() []string {
+func (m *MsgProposeSolution) GetMerkleRoot() string {
 	if m != nil {
-		return m.Signatures
+		return m.MerkleRoot
 	}
-	return nil
+	return ""
+}
+
+func (m *MsgProposeSolution) GetZkProof() string {
+	if m != nil {
+		return m.ZkProof
+	}
+	return ""
+}
+
+func (m *MsgProposeSolution) GetZkCircuitVersion() string {
+	if m != nil {
+		return m.ZkCircuitVersion
+	}
+	return ""
+}
+
+func (m *MsgProposeSolution) GetHashVersion() string {
+	if m != nil {
+		return m.HashVersion
+	}
+	return ""
+}
+
+func (m *MsgProposeSolution) GetRenderSettings() string {
+	if m != nil {
+		return m.RenderSettings
+	}
+	return ""
+}
+
+func (m *MsgProposeSolution) GetRendererDigest() string {
+	if m != nil {
+		return m.RendererDigest
+	}
+	return ""
+}
+
+func (m *MsgProposeSolution) GetAverageRenderSeconds() int64 {
+	if m != nil {
+		return m.AverageRenderSeconds
+	}
+	return 0
 }
 
 // no response needed to a proposed solution
@@ -502,13 +629,15 @@ func (m *MsgProposeSolutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProposeSolutionResponse proto.InternalMessageInfo
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
+// Msg to reveal the solution of an specific thread
+// Only sampled frames are revealed, as filename=cid:hash:proof with the merkle proof of the commitment
 type MsgRevealSolution struct {
 	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
 	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
 	Frames   []string `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+	// hex encoded salt used in the commitments of the merkle root
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *MsgRevealSolution) Reset()         { *m = MsgRevealSolution{} }
@@ -572,6 +701,13 @@ func (m *MsgRevealSolution) GetFrames() []string {
 	return nil
 }
 
+func (m *MsgRevealSolution) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
 // no response needed to a proposed solution
 type MsgRevealSolutionResponse struct {
 }
@@ -609,12 +745,14 @@ func (m *MsgRevealSolutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevealSolutionResponse proto.InternalMessageInfo
 
+// Commits a validator to the hashes of the sampled frames, as filename=commitment
+// where commitment is the hex encoded H(hash || salt || address)
 type MsgSubmitValidation struct {
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId      string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId    string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey   string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Commitments []string `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments,omitempty"`
 }
 
 func (m *MsgSubmitValidation) Reset()         { *m = MsgSubmitValidation{} }
@@ -678,9 +816,9 @@ func (m *MsgSubmitValidation) GetPublicKey() string {
 	return ""
 }
 
-func (m *MsgSubmitValidation) GetSignatures() []string {
+func (m *MsgSubmitValidation) GetCommitments() []string {
 	if m != nil {
-		return m.Signatures
+		return m.Commitments
 	}
 	return nil
 }
@@ -721,6 +859,120 @@ func (m *MsgSubmitValidationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitValidationResponse proto.InternalMessageInfo
 
+// Reveals the committed hashes of a validation once the commit deadline is over,
+// as filename=hash:signature with the signature of the frame sign doc
+type MsgRevealValidation struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Salt     string   `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	Frames   []string `protobuf:"bytes,5,rep,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (m *MsgRevealValidation) Reset()         { *m = MsgRevealValidation{} }
+func (m *MsgRevealValidation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealValidation) ProtoMessage()    {}
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{12}
+}
+func (m *MsgRevealValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealValidation.Merge(m, src)
+}
+func (m *MsgRevealValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealValidation proto.InternalMessageInfo
+
+func (m *MsgRevealValidation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevealValidation) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *MsgRevealValidation) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *MsgRevealValidation) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *MsgRevealValidation) GetFrames() []string {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
+}
+
+type MsgRevealValidationResponse struct {
+}
+
+func (m *MsgRevealValidationResponse) Reset()         { *m = MsgRevealValidationResponse{} }
+func (m *MsgRevealValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealValidationResponse) ProtoMessage()    {}
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{13}
+}
+func (m *MsgRevealValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealValidationResponse.Merge(m, src)
+}
+func (m *MsgRevealValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealValidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealValidationResponse proto.InternalMessageInfo
+
 type MsgSubmitSolution struct {
 	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
@@ -733,7 +985,7 @@ func (m *MsgSubmitSolution) Reset()         { *m = MsgSubmitSolution{} }
 func (m *MsgSubmitSolution) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSolution) ProtoMessage()    {}
 func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6250ca283f34de9, []int{12}
+	return fileDescriptor_b6250ca283f34de9, []int{14}
 }
 func (m *MsgSubmitSolution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +1056,7 @@ func (m *MsgSubmitSolutionResponse) Reset()         { *m = MsgSubmitSolutionResp
 func (m *MsgSubmitSolutionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitSolutionResponse) ProtoMessage()    {}
 func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6250ca283f34de9, []int{13}
+	return fileDescriptor_b6250ca283f34de9, []int{15}
 }
 func (m *MsgSubmitSolutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,940 +1085,1328 @@ func (m *MsgSubmitSolutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitSolutionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateVideoRenderingTask)(nil), "janction.videoRendering.v1.MsgCreateVideoRenderingTask")
-	proto.RegisterType((*MsgCreateVideoRenderingTaskResponse)(nil), "janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse")
-	proto.RegisterType((*MsgAddWorker)(nil), "janction.videoRendering.v1.MsgAddWorker")
-	proto.RegisterType((*MsgAddWorkerResponse)(nil), "janction.videoRendering.v1.MsgAddWorkerResponse")
-	proto.RegisterType((*MsgSubscribeWorkerToTask)(nil), "janction.videoRendering.v1.MsgSubscribeWorkerToTask")
-	proto.RegisterType((*MsgSubscribeWorkerToTaskResponse)(nil), "janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse")
-	proto.RegisterType((*MsgProposeSolution)(nil), "janction.videoRendering.v1.MsgProposeSolution")
-	proto.RegisterType((*MsgProposeSolutionResponse)(nil), "janction.videoRendering.v1.MsgProposeSolutionResponse")
-	proto.RegisterType((*MsgRevealSolution)(nil), "janction.videoRendering.v1.MsgRevealSolution")
-	proto.RegisterType((*MsgRevealSolutionResponse)(nil), "janction.videoRendering.v1.MsgRevealSolutionResponse")
-	proto.RegisterType((*MsgSubmitValidation)(nil), "janction.videoRendering.v1.MsgSubmitValidation")
-	proto.RegisterType((*MsgSubmitValidationResponse)(nil), "janction.videoRendering.v1.MsgSubmitValidationResponse")
-	proto.RegisterType((*MsgSubmitSolution)(nil), "janction.videoRendering.v1.MsgSubmitSolution")
-	proto.RegisterType((*MsgSubmitSolutionResponse)(nil), "janction.videoRendering.v1.MsgSubmitSolutionResponse")
+// MsgRegisterVerifyingKey registers the verifying key every node uses to verify
+// proofs of a frame proof circuit version
+type MsgRegisterVerifyingKey struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CircuitVersion string `protobuf:"bytes,2,opt,name=circuit_version,json=circuitVersion,proto3" json:"circuit_version,omitempty"`
+	VerifyingKey   []byte `protobuf:"bytes,3,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("janction/videoRendering/v1/tx.proto", fileDescriptor_b6250ca283f34de9)
+func (m *MsgRegisterVerifyingKey) Reset()         { *m = MsgRegisterVerifyingKey{} }
+func (m *MsgRegisterVerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVerifyingKey) ProtoMessage()    {}
+func (*MsgRegisterVerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{16}
 }
-
-var fileDescriptor_b6250ca283f34de9 = []byte{
-	// 861 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0x64, 0x93, 0x4d, 0xf6, 0x51, 0x95, 0x60, 0x42, 0xea, 0x38, 0xad, 0x89, 0x5c, 0x09,
-	0xad, 0x2a, 0xd5, 0x66, 0x4b, 0x4b, 0x25, 0x54, 0x15, 0x68, 0x25, 0xa4, 0x15, 0x5a, 0x09, 0x39,
-	0x55, 0x91, 0xb8, 0xac, 0x66, 0xed, 0xa9, 0x3b, 0xec, 0xda, 0x63, 0xcd, 0xcc, 0x1a, 0x56, 0xe2,
-	0x80, 0xe0, 0xc0, 0x81, 0x0b, 0x07, 0xbe, 0x01, 0x1f, 0x80, 0xdc, 0xf8, 0x0a, 0x3d, 0xf6, 0xc8,
-	0x09, 0x55, 0xc9, 0xa1, 0x5f, 0x03, 0xd9, 0x33, 0x36, 0xbb, 0xce, 0xfe, 0x49, 0x22, 0x55, 0xe2,
-	0x36, 0x6f, 0xde, 0xbf, 0xdf, 0xef, 0xbd, 0x79, 0xcf, 0x86, 0x9b, 0xdf, 0xe2, 0x24, 0x90, 0x94,
-	0x25, 0x5e, 0x46, 0x43, 0xc2, 0x7c, 0x92, 0x84, 0x84, 0xd3, 0x24, 0xf2, 0xb2, 0x8e, 0x27, 0xbf,
-	0x77, 0x53, 0xce, 0x24, 0x33, 0xac, 0xd2, 0xc8, 0x9d, 0x35, 0x72, 0xb3, 0x8e, 0x75, 0x2d, 0x60,
-	0x22, 0x66, 0xc2, 0x8b, 0x45, 0xe1, 0x13, 0x8b, 0x48, 0x39, 0x59, 0xb6, 0x56, 0x0c, 0xb0, 0x20,
-	0x5e, 0xd6, 0x19, 0x10, 0x89, 0x3b, 0x5e, 0xc0, 0x68, 0xa2, 0xf5, 0xbb, 0x11, 0x8b, 0x58, 0x71,
-	0xf4, 0xf2, 0x93, 0xbe, 0xfd, 0x60, 0x19, 0x9e, 0x49, 0x4a, 0x84, 0xb6, 0xdb, 0x57, 0xd1, 0xfb,
-	0x2a, 0x80, 0x12, 0xb4, 0xea, 0xba, 0x2c, 0x9c, 0x62, 0x9a, 0x48, 0x2f, 0xe0, 0x93, 0x54, 0x32,
-	0x6f, 0x48, 0x26, 0x5a, 0xeb, 0xbc, 0x42, 0x70, 0xd0, 0x13, 0xd1, 0x63, 0x4e, 0xb0, 0x24, 0x4f,
-	0x67, 0x72, 0x3c, 0xc1, 0x62, 0x68, 0x98, 0xb0, 0x15, 0xe4, 0x3a, 0xc6, 0x4d, 0x74, 0x88, 0xda,
-	0x2d, 0xbf, 0x14, 0x8d, 0x1d, 0x68, 0x04, 0x34, 0x34, 0xd7, 0x8b, 0xdb, 0xfc, 0x68, 0xd8, 0x00,
-	0x42, 0x62, 0x2e, 0xbf, 0xe0, 0x38, 0x26, 0x66, 0xe3, 0x10, 0xb5, 0x37, 0xfd, 0xa9, 0x1b, 0xc3,
-	0x82, 0x6d, 0x92, 0x84, 0x4a, 0xbb, 0x51, 0x68, 0x2b, 0x39, 0xcf, 0x23, 0x9f, 0x73, 0x82, 0x43,
-	0x61, 0x6e, 0x16, 0xaa, 0x52, 0x34, 0x3a, 0xd0, 0xe4, 0xe4, 0x3b, 0xcc, 0x43, 0xb3, 0x79, 0x88,
-	0xda, 0x6f, 0xdd, 0xd9, 0x77, 0x35, 0xbd, 0xbc, 0x92, 0xae, 0xae, 0xa4, 0xfb, 0x98, 0xd1, 0xc4,
-	0xd7, 0x86, 0x9f, 0x5c, 0xf9, 0xe9, 0xf5, 0xf1, 0xad, 0x12, 0xa8, 0xf3, 0x10, 0x6e, 0x2e, 0x61,
-	0xe8, 0x13, 0x91, 0xb2, 0x44, 0x10, 0xe3, 0x1a, 0x6c, 0x49, 0x2c, 0x86, 0x7d, 0x1a, 0x6a, 0xa6,
-	0xcd, 0x5c, 0xec, 0x86, 0xce, 0x1f, 0x08, 0xae, 0xf4, 0x44, 0xf4, 0x79, 0x18, 0x7e, 0xcd, 0xf8,
-	0x90, 0xf0, 0x25, 0x35, 0x39, 0x80, 0x56, 0x3a, 0x1e, 0x8c, 0x68, 0xd0, 0xa7, 0xa9, 0xae, 0xcc,
-	0xb6, 0xba, 0xe8, 0xa6, 0x79, 0x02, 0x9a, 0x3e, 0x13, 0x79, 0x82, 0x86, 0x4a, 0x90, 0x8b, 0xdd,
-	0xd0, 0xb8, 0x07, 0x9b, 0x42, 0xe2, 0xa1, 0x2a, 0xca, 0x32, 0x82, 0x8f, 0x36, 0x5e, 0xfc, 0xf3,
-	0xfe, 0x9a, 0xaf, 0xac, 0x6b, 0x2c, 0x3f, 0x83, 0xdd, 0x69, 0x90, 0x15, 0xad, 0xab, 0xb0, 0xce,
-	0x86, 0x05, 0xce, 0x6d, 0x7f, 0x9d, 0x15, 0x0d, 0x8d, 0x89, 0x10, 0x38, 0x22, 0x1a, 0x60, 0x29,
-	0x3a, 0x19, 0x98, 0x3d, 0x11, 0x1d, 0x8d, 0x07, 0x22, 0xe0, 0x74, 0x40, 0x54, 0x9c, 0x27, 0xac,
-	0x7c, 0x06, 0x38, 0x0c, 0x39, 0x11, 0xa2, 0xa4, 0xac, 0x45, 0x63, 0x0f, 0x74, 0x9d, 0x74, 0x38,
-	0x2d, 0xe5, 0xcd, 0x56, 0x1d, 0xec, 0x96, 0x74, 0x2b, 0x59, 0x23, 0xd7, 0x11, 0x9c, 0x87, 0x70,
-	0xb8, 0x28, 0x6f, 0xc5, 0x62, 0x3a, 0x1a, 0x9a, 0x8d, 0xe6, 0xfc, 0x89, 0xc0, 0xe8, 0x89, 0xe8,
-	0x2b, 0xce, 0x52, 0x26, 0xc8, 0x11, 0x1b, 0x8d, 0xf3, 0x81, 0x59, 0xd2, 0xa5, 0x4b, 0x40, 0x36,
-	0x6e, 0x00, 0xe8, 0xce, 0x0e, 0xc9, 0xa4, 0x68, 0x54, 0xcb, 0xd7, 0xbd, 0xfe, 0x92, 0x4c, 0x8a,
-	0xa7, 0x4f, 0xa3, 0x04, 0xcb, 0x31, 0x27, 0xf9, 0x0b, 0x6e, 0xb4, 0x5b, 0xfe, 0xd4, 0x4d, 0xad,
-	0x57, 0xd7, 0xc1, 0x3a, 0x0b, 0xb8, 0xe4, 0xea, 0xfc, 0x82, 0xe0, 0x9d, 0x9e, 0x88, 0x7c, 0x92,
-	0x11, 0x3c, 0x7a, 0x43, 0x74, 0xf6, 0xa0, 0xf9, 0x2c, 0x9f, 0x3b, 0x61, 0x6e, 0x14, 0x58, 0xb5,
-	0x54, 0xc3, 0x79, 0x00, 0xfb, 0x67, 0x80, 0x54, 0x30, 0x8f, 0x11, 0xbc, 0xab, 0xfa, 0x16, 0x53,
-	0xf9, 0x14, 0x8f, 0x68, 0x88, 0xff, 0xef, 0x75, 0xbf, 0x51, 0xec, 0xba, 0x3a, 0xe2, 0x8a, 0xd1,
-	0x5f, 0xaa, 0xf0, 0x4a, 0xff, 0x86, 0x0a, 0xbf, 0x03, 0x8d, 0x90, 0x72, 0x4d, 0x24, 0x3f, 0x1a,
-	0x77, 0x61, 0x0f, 0x67, 0x84, 0xe3, 0x88, 0xf4, 0x79, 0xb1, 0x98, 0xfa, 0x82, 0x04, 0x2c, 0xd1,
-	0x8b, 0xb0, 0xe1, 0xef, 0x6a, 0xad, 0xda, 0x5a, 0x47, 0x4a, 0x37, 0xb7, 0x51, 0xb3, 0xc0, 0x4b,
-	0x5a, 0x77, 0x7e, 0xde, 0x82, 0x46, 0x4f, 0x44, 0xc6, 0xef, 0x08, 0xcc, 0x85, 0x7b, 0xfe, 0xbe,
-	0xbb, 0xf8, 0xa3, 0xe6, 0x2e, 0x59, 0x9f, 0xd6, 0xa7, 0x97, 0x74, 0xac, 0x46, 0x3b, 0x82, 0xd6,
-	0x7f, 0xab, 0xb5, 0xbd, 0x22, 0x5a, 0x65, 0x69, 0x7d, 0x78, 0x5e, 0xcb, 0x2a, 0xd1, 0xaf, 0x08,
-	0xde, 0x9b, 0xbf, 0xdd, 0xee, 0xae, 0x88, 0x35, 0xd7, 0xcb, 0x7a, 0x70, 0x19, 0xaf, 0x0a, 0xcd,
-	0x04, 0xde, 0xae, 0x6f, 0x2c, 0x77, 0x45, 0xc0, 0x9a, 0xbd, 0xf5, 0xf1, 0xc5, 0xec, 0xab, 0xd4,
-	0x3f, 0xc0, 0xce, 0x99, 0xa9, 0xf5, 0x56, 0x93, 0x99, 0x71, 0xb0, 0xee, 0x5f, 0xd0, 0xa1, 0xca,
-	0x9e, 0xc1, 0xd5, 0xda, 0x6a, 0xbb, 0xbd, 0x22, 0xd4, 0xac, 0xb9, 0x75, 0xef, 0x42, 0xe6, 0xd3,
-	0x79, 0x6b, 0x93, 0x7d, 0xfb, 0x5c, 0x14, 0xce, 0x9d, 0x77, 0xfe, 0xf8, 0x59, 0x9b, 0x3f, 0xbe,
-	0x3e, 0xbe, 0x85, 0x1e, 0x3d, 0x78, 0x71, 0x62, 0xa3, 0x97, 0x27, 0x36, 0x7a, 0x75, 0x62, 0xa3,
-	0xdf, 0x4e, 0xed, 0xb5, 0x97, 0xa7, 0xf6, 0xda, 0xdf, 0xa7, 0xf6, 0xda, 0x37, 0x4e, 0x44, 0xe5,
-	0xf3, 0xf1, 0xc0, 0x0d, 0x58, 0xec, 0x2d, 0xf8, 0xdd, 0x1b, 0x34, 0x8b, 0xbf, 0xb5, 0x8f, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xab, 0x76, 0x24, 0x60, 0xa0, 0x0a, 0x00, 0x00,
+func (m *MsgRegisterVerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateGame create a game.
-	CreateVideoRenderingTask(ctx context.Context, in *MsgCreateVideoRenderingTask, opts ...grpc.CallOption) (*MsgCreateVideoRenderingTaskResponse, error)
-	// Adds a new worker
-	AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error)
-	SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
-	// Submits the solution to IPFS
-	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+func (m *MsgRegisterVerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterVerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVerifyingKey.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterVerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVerifyingKey.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateVideoRenderingTask(ctx context.Context, in *MsgCreateVideoRenderingTask, opts ...grpc.CallOption) (*MsgCreateVideoRenderingTaskResponse, error) {
-	out := new(MsgCreateVideoRenderingTaskResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/CreateVideoRenderingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterVerifyingKey proto.InternalMessageInfo
+
+func (m *MsgRegisterVerifyingKey) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error) {
-	out := new(MsgAddWorkerResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/AddWorker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterVerifyingKey) GetCircuitVersion() string {
+	if m != nil {
+		return m.CircuitVersion
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error) {
-	out := new(MsgSubscribeWorkerToTaskResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubscribeWorkerToTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterVerifyingKey) GetVerifyingKey() []byte {
+	if m != nil {
+		return m.VerifyingKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error) {
-	out := new(MsgProposeSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/ProposeSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterVerifyingKeyResponse struct {
 }
 
-func (c *msgClient) SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error) {
-	out := new(MsgSubmitValidationResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitValidation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterVerifyingKeyResponse) Reset()         { *m = MsgRegisterVerifyingKeyResponse{} }
+func (m *MsgRegisterVerifyingKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterVerifyingKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterVerifyingKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{17}
+}
+func (m *MsgRegisterVerifyingKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterVerifyingKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterVerifyingKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterVerifyingKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterVerifyingKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterVerifyingKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterVerifyingKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterVerifyingKeyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error) {
-	out := new(MsgRevealSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/RevealSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterVerifyingKeyResponse proto.InternalMessageInfo
+
+// MsgSubmitAssembledVideo submits the video the assembler encoded from the frames of every thread.
+// Other workers of the task submit the CID and hash of their own encode to confirm it.
+type MsgSubmitAssembledVideo struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VideoCid string `protobuf:"bytes,3,opt,name=video_cid,json=videoCid,proto3" json:"video_cid,omitempty"`
+	// hex encoded sha256 of the video file
+	VideoHash string `protobuf:"bytes,4,opt,name=video_hash,json=videoHash,proto3" json:"video_hash,omitempty"`
+}
+
+func (m *MsgSubmitAssembledVideo) Reset()         { *m = MsgSubmitAssembledVideo{} }
+func (m *MsgSubmitAssembledVideo) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAssembledVideo) ProtoMessage()    {}
+func (*MsgSubmitAssembledVideo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{18}
+}
+func (m *MsgSubmitAssembledVideo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAssembledVideo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAssembledVideo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitAssembledVideo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAssembledVideo.Merge(m, src)
+}
+func (m *MsgSubmitAssembledVideo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAssembledVideo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAssembledVideo.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
-	out := new(MsgSubmitSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitAssembledVideo proto.InternalMessageInfo
+
+func (m *MsgSubmitAssembledVideo) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateGame create a game.
-	CreateVideoRenderingTask(context.Context, *MsgCreateVideoRenderingTask) (*MsgCreateVideoRenderingTaskResponse, error)
-	// Adds a new worker
-	AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error)
-	SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	ProposeSolution(context.Context, *MsgProposeSolution) (*MsgProposeSolutionResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
-	// Submits the solution to IPFS
-	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+func (m *MsgSubmitAssembledVideo) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSubmitAssembledVideo) GetVideoCid() string {
+	if m != nil {
+		return m.VideoCid
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) CreateVideoRenderingTask(ctx context.Context, req *MsgCreateVideoRenderingTask) (*MsgCreateVideoRenderingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVideoRenderingTask not implemented")
+func (m *MsgSubmitAssembledVideo) GetVideoHash() string {
+	if m != nil {
+		return m.VideoHash
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) AddWorker(ctx context.Context, req *MsgAddWorker) (*MsgAddWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddWorker not implemented")
+
+type MsgSubmitAssembledVideoResponse struct {
 }
-func (*UnimplementedMsgServer) SubscribeWorkerToTask(ctx context.Context, req *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeWorkerToTask not implemented")
+
+func (m *MsgSubmitAssembledVideoResponse) Reset()         { *m = MsgSubmitAssembledVideoResponse{} }
+func (m *MsgSubmitAssembledVideoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAssembledVideoResponse) ProtoMessage()    {}
+func (*MsgSubmitAssembledVideoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{19}
 }
-func (*UnimplementedMsgServer) ProposeSolution(ctx context.Context, req *MsgProposeSolution) (*MsgProposeSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposeSolution not implemented")
+func (m *MsgSubmitAssembledVideoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitValidation(ctx context.Context, req *MsgSubmitValidation) (*MsgSubmitValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitValidation not implemented")
+func (m *MsgSubmitAssembledVideoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAssembledVideoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RevealSolution(ctx context.Context, req *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
+func (m *MsgSubmitAssembledVideoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAssembledVideoResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitSolution(ctx context.Context, req *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+func (m *MsgSubmitAssembledVideoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAssembledVideoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAssembledVideoResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSubmitAssembledVideoResponse proto.InternalMessageInfo
+
+type MsgSubmitPreview struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// render seconds of every previewed frame, as frame=seconds
+	Costs []string `protobuf:"bytes,3,rep,name=costs,proto3" json:"costs,omitempty"`
 }
 
-func _Msg_CreateVideoRenderingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVideoRenderingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVideoRenderingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/CreateVideoRenderingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVideoRenderingTask(ctx, req.(*MsgCreateVideoRenderingTask))
+func (m *MsgSubmitPreview) Reset()         { *m = MsgSubmitPreview{} }
+func (m *MsgSubmitPreview) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPreview) ProtoMessage()    {}
+func (*MsgSubmitPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{20}
+}
+func (m *MsgSubmitPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPreview.Merge(m, src)
+}
+func (m *MsgSubmitPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPreview.DiscardUnknown(m)
 }
 
-func _Msg_AddWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddWorker)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddWorker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/AddWorker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddWorker(ctx, req.(*MsgAddWorker))
+var xxx_messageInfo_MsgSubmitPreview proto.InternalMessageInfo
+
+func (m *MsgSubmitPreview) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubscribeWorkerToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubscribeWorkerToTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubscribeWorkerToTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/SubscribeWorkerToTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubscribeWorkerToTask(ctx, req.(*MsgSubscribeWorkerToTask))
+func (m *MsgSubmitPreview) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ProposeSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProposeSolution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProposeSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/ProposeSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProposeSolution(ctx, req.(*MsgProposeSolution))
+func (m *MsgSubmitPreview) GetCosts() []string {
+	if m != nil {
+		return m.Costs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubmitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitValidation)
-	if err := dec(in); err != nil {
-		return nil, err
+type MsgSubmitPreviewResponse struct {
+}
+
+func (m *MsgSubmitPreviewResponse) Reset()         { *m = MsgSubmitPreviewResponse{} }
+func (m *MsgSubmitPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPreviewResponse) ProtoMessage()    {}
+func (*MsgSubmitPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{21}
+}
+func (m *MsgSubmitPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitValidation(ctx, in)
+}
+func (m *MsgSubmitPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPreviewResponse.Merge(m, src)
+}
+func (m *MsgSubmitPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPreviewResponse proto.InternalMessageInfo
+
+// MsgRegisterRendererImage approves the renderer image workers of its architecture render with, or revokes it
+type MsgRegisterRendererImage struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Image     RendererImage `protobuf:"bytes,2,opt,name=image,proto3" json:"image"`
+	// removes the image with the digest instead of approving it
+	Revoke bool `protobuf:"varint,3,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (m *MsgRegisterRendererImage) Reset()         { *m = MsgRegisterRendererImage{} }
+func (m *MsgRegisterRendererImage) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRendererImage) ProtoMessage()    {}
+func (*MsgRegisterRendererImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{22}
+}
+func (m *MsgRegisterRendererImage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRendererImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRendererImage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/SubmitValidation",
+}
+func (m *MsgRegisterRendererImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRendererImage.Merge(m, src)
+}
+func (m *MsgRegisterRendererImage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRendererImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRendererImage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRendererImage proto.InternalMessageInfo
+
+func (m *MsgRegisterRendererImage) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitValidation(ctx, req.(*MsgSubmitValidation))
+	return ""
+}
+
+func (m *MsgRegisterRendererImage) GetImage() RendererImage {
+	if m != nil {
+		return m.Image
 	}
-	return interceptor(ctx, in, info, handler)
+	return RendererImage{}
 }
 
-func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevealSolution)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRegisterRendererImage) GetRevoke() bool {
+	if m != nil {
+		return m.Revoke
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevealSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/RevealSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevealSolution(ctx, req.(*MsgRevealSolution))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSolution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoRendering.v1.Msg/SubmitSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSolution(ctx, req.(*MsgSubmitSolution))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRegisterRendererImageResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "janction.videoRendering.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVideoRenderingTask",
-			Handler:    _Msg_CreateVideoRenderingTask_Handler,
-		},
-		{
-			MethodName: "AddWorker",
-			Handler:    _Msg_AddWorker_Handler,
-		},
-		{
-			MethodName: "SubscribeWorkerToTask",
-			Handler:    _Msg_SubscribeWorkerToTask_Handler,
-		},
-		{
-			MethodName: "ProposeSolution",
-			Handler:    _Msg_ProposeSolution_Handler,
-		},
-		{
-			MethodName: "SubmitValidation",
-			Handler:    _Msg_SubmitValidation_Handler,
-		},
-		{
-			MethodName: "RevealSolution",
-			Handler:    _Msg_RevealSolution_Handler,
-		},
-		{
-			MethodName: "SubmitSolution",
-			Handler:    _Msg_SubmitSolution_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "janction/videoRendering/v1/tx.proto",
+func (m *MsgRegisterRendererImageResponse) Reset()         { *m = MsgRegisterRendererImageResponse{} }
+func (m *MsgRegisterRendererImageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRendererImageResponse) ProtoMessage()    {}
+func (*MsgRegisterRendererImageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{23}
 }
-
-func (m *MsgCreateVideoRenderingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterRendererImageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRendererImageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRendererImageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterRendererImageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRendererImageResponse.Merge(m, src)
+}
+func (m *MsgRegisterRendererImageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRendererImageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRendererImageResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterRendererImageResponse proto.InternalMessageInfo
+
+// MsgReportTaskFailure reports a frame the worker couldn't render within the attempts of its render policy.
+// The task fails once enough workers report the same reason
+type MsgReportTaskFailure struct {
+	Creator  string                                        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string                                        `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ThreadId string                                        `protobuf:"bytes,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Frame    int64                                         `protobuf:"varint,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Reason   VideoRenderingThread_UnrenderableFrame_Reason `protobuf:"varint,5,opt,name=reason,proto3,enum=janction.videoRendering.v1.VideoRenderingThread_UnrenderableFrame_Reason" json:"reason,omitempty"`
+	// renders of the frame the worker tried
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// cid of the blender log of the last attempt, so the requester can fix the scene
+	LogCid string `protobuf:"bytes,7,opt,name=log_cid,json=logCid,proto3" json:"log_cid,omitempty"`
 }
 
-func (m *MsgCreateVideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Reward != nil {
-		{
-			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgReportTaskFailure) Reset()         { *m = MsgReportTaskFailure{} }
+func (m *MsgReportTaskFailure) String() string { return proto.CompactTextString(m) }
+func (*MsgReportTaskFailure) ProtoMessage()    {}
+func (*MsgReportTaskFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{24}
+}
+func (m *MsgReportTaskFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportTaskFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportTaskFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Threads != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threads))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MsgReportTaskFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportTaskFailure.Merge(m, src)
+}
+func (m *MsgReportTaskFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportTaskFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportTaskFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportTaskFailure proto.InternalMessageInfo
+
+func (m *MsgReportTaskFailure) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgReportTaskFailure) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgReportTaskFailure) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateVideoRenderingTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportTaskFailure) GetFrame() int64 {
+	if m != nil {
+		return m.Frame
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateVideoRenderingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportTaskFailure) GetReason() VideoRenderingThread_UnrenderableFrame_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return VideoRenderingThread_UnrenderableFrame_TIMEOUT
 }
 
-func (m *MsgCreateVideoRenderingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgReportTaskFailure) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddWorker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportTaskFailure) GetLogCid() string {
+	if m != nil {
+		return m.LogCid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddWorker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgReportTaskFailureResponse struct {
 }
 
-func (m *MsgAddWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReportTaskFailureResponse) Reset()         { *m = MsgReportTaskFailureResponse{} }
+func (m *MsgReportTaskFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportTaskFailureResponse) ProtoMessage()    {}
+func (*MsgReportTaskFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6250ca283f34de9, []int{25}
+}
+func (m *MsgReportTaskFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportTaskFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportTaskFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.IpfsId) > 0 {
-		i -= len(m.IpfsId)
-		copy(dAtA[i:], m.IpfsId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PublicIp) > 0 {
-		i -= len(m.PublicIp)
-		copy(dAtA[i:], m.PublicIp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicIp)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddWorkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReportTaskFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportTaskFailureResponse.Merge(m, src)
+}
+func (m *MsgReportTaskFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportTaskFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportTaskFailureResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReportTaskFailureResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateVideoRenderingTask)(nil), "janction.videoRendering.v1.MsgCreateVideoRenderingTask")
+	proto.RegisterType((*MsgCreateVideoRenderingTaskResponse)(nil), "janction.videoRendering.v1.MsgCreateVideoRenderingTaskResponse")
+	proto.RegisterType((*MsgAddWorker)(nil), "janction.videoRendering.v1.MsgAddWorker")
+	proto.RegisterType((*MsgAddWorkerResponse)(nil), "janction.videoRendering.v1.MsgAddWorkerResponse")
+	proto.RegisterType((*MsgSubscribeWorkerToTask)(nil), "janction.videoRendering.v1.MsgSubscribeWorkerToTask")
+	proto.RegisterType((*MsgSubscribeWorkerToTaskResponse)(nil), "janction.videoRendering.v1.MsgSubscribeWorkerToTaskResponse")
+	proto.RegisterType((*MsgProposeSolution)(nil), "janction.videoRendering.v1.MsgProposeSolution")
+	proto.RegisterType((*MsgProposeSolutionResponse)(nil), "janction.videoRendering.v1.MsgProposeSolutionResponse")
+	proto.RegisterType((*MsgRevealSolution)(nil), "janction.videoRendering.v1.MsgRevealSolution")
+	proto.RegisterType((*MsgRevealSolutionResponse)(nil), "janction.videoRendering.v1.MsgRevealSolutionResponse")
+	proto.RegisterType((*MsgSubmitValidation)(nil), "janction.videoRendering.v1.MsgSubmitValidation")
+	proto.RegisterType((*MsgSubmitValidationResponse)(nil), "janction.videoRendering.v1.MsgSubmitValidationResponse")
+	proto.RegisterType((*MsgRevealValidation)(nil), "janction.videoRendering.v1.MsgRevealValidation")
+	proto.RegisterType((*MsgRevealValidationResponse)(nil), "janction.videoRendering.v1.MsgRevealValidationResponse")
+	proto.RegisterType((*MsgSubmitSolution)(nil), "janction.videoRendering.v1.MsgSubmitSolution")
+	proto.RegisterType((*MsgSubmitSolutionResponse)(nil), "janction.videoRendering.v1.MsgSubmitSolutionResponse")
+	proto.RegisterType((*MsgRegisterVerifyingKey)(nil), "janction.videoRendering.v1.MsgRegisterVerifyingKey")
+	proto.RegisterType((*MsgRegisterVerifyingKeyResponse)(nil), "janction.videoRendering.v1.MsgRegisterVerifyingKeyResponse")
+	proto.RegisterType((*MsgSubmitAssembledVideo)(nil), "janction.videoRendering.v1.MsgSubmitAssembledVideo")
+	proto.RegisterType((*MsgSubmitAssembledVideoResponse)(nil), "janction.videoRendering.v1.MsgSubmitAssembledVideoResponse")
+	proto.RegisterType((*MsgSubmitPreview)(nil), "janction.videoRendering.v1.MsgSubmitPreview")
+	proto.RegisterType((*MsgSubmitPreviewResponse)(nil), "janction.videoRendering.v1.MsgSubmitPreviewResponse")
+	proto.RegisterType((*MsgRegisterRendererImage)(nil), "janction.videoRendering.v1.MsgRegisterRendererImage")
+	proto.RegisterType((*MsgRegisterRendererImageResponse)(nil), "janction.videoRendering.v1.MsgRegisterRendererImageResponse")
+	proto.RegisterType((*MsgReportTaskFailure)(nil), "janction.videoRendering.v1.MsgReportTaskFailure")
+	proto.RegisterType((*MsgReportTaskFailureResponse)(nil), "janction.videoRendering.v1.MsgReportTaskFailureResponse")
 }
 
-func (m *MsgAddWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Ok {
-		i--
-		if m.Ok {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("janction/videoRendering/v1/tx.proto", fileDescriptor_b6250ca283f34de9)
 }
 
-func (m *MsgSubscribeWorkerToTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_b6250ca283f34de9 = []byte{
+	// 1663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x8f, 0x1b, 0x49,
+	0x19, 0x4f, 0xc7, 0x8f, 0xd8, 0xdf, 0x3c, 0xe2, 0x6d, 0x86, 0xa4, 0xd3, 0x93, 0x75, 0xbc, 0x0e,
+	0x22, 0xb3, 0x61, 0x63, 0xef, 0x4c, 0x66, 0x77, 0xd1, 0x6e, 0x58, 0x98, 0x0c, 0x2c, 0xcc, 0xae,
+	0x46, 0x8a, 0x7a, 0x42, 0x90, 0xb8, 0x58, 0xe5, 0xee, 0x9a, 0x9e, 0xc2, 0xee, 0x2e, 0xab, 0xaa,
+	0xc6, 0x8b, 0x47, 0x48, 0x20, 0x4e, 0x48, 0x5c, 0x38, 0x20, 0x2e, 0x2b, 0x2e, 0xfc, 0x03, 0xec,
+	0x01, 0x89, 0x33, 0x12, 0x87, 0x95, 0xe0, 0xb0, 0xe2, 0xc4, 0x09, 0xa1, 0xe4, 0x90, 0x3b, 0x7f,
+	0x01, 0xaa, 0x47, 0xf7, 0xb8, 0xdb, 0x1e, 0xdb, 0x63, 0x29, 0x7b, 0xf3, 0x57, 0xf5, 0xbd, 0x7e,
+	0xdf, 0xab, 0xbe, 0x36, 0xdc, 0xfd, 0x19, 0x8a, 0x7d, 0x41, 0x68, 0xdc, 0x1e, 0x92, 0x00, 0x53,
+	0x0f, 0xc7, 0x01, 0x66, 0x24, 0x0e, 0xdb, 0xc3, 0xed, 0xb6, 0xf8, 0x79, 0x6b, 0xc0, 0xa8, 0xa0,
+	0xb6, 0x9b, 0x30, 0xb5, 0xb2, 0x4c, 0xad, 0xe1, 0xb6, 0x7b, 0xd3, 0xa7, 0x3c, 0xa2, 0xbc, 0x1d,
+	0x71, 0x25, 0x13, 0xf1, 0x50, 0x0b, 0xb9, 0x75, 0x73, 0xd1, 0x45, 0x1c, 0xb7, 0x87, 0xdb, 0x5d,
+	0x2c, 0xd0, 0x76, 0xdb, 0xa7, 0x24, 0x36, 0xf7, 0x1b, 0x21, 0x0d, 0xa9, 0xfa, 0xd9, 0x96, 0xbf,
+	0xcc, 0xe9, 0x37, 0x67, 0xf9, 0x33, 0x1a, 0x60, 0x6e, 0xf8, 0x6e, 0x69, 0xed, 0x1d, 0xad, 0x40,
+	0x13, 0xe6, 0xea, 0xb6, 0x50, 0x42, 0x11, 0x89, 0x45, 0xdb, 0x67, 0xa3, 0x81, 0xa0, 0xed, 0x1e,
+	0x1e, 0x99, 0xdb, 0xe6, 0x3f, 0x8b, 0xb0, 0x79, 0xc8, 0xc3, 0x7d, 0x86, 0x91, 0xc0, 0xcf, 0x32,
+	0x36, 0x9e, 0x22, 0xde, 0xb3, 0x1d, 0xb8, 0xe6, 0xcb, 0x3b, 0xca, 0x1c, 0xab, 0x61, 0x6d, 0x55,
+	0xbd, 0x84, 0xb4, 0x6b, 0x50, 0xf0, 0x49, 0xe0, 0x5c, 0x55, 0xa7, 0xf2, 0xa7, 0x5d, 0x07, 0xe0,
+	0x02, 0x31, 0xf1, 0x11, 0x43, 0x11, 0x76, 0x0a, 0x0d, 0x6b, 0xab, 0xe4, 0x8d, 0x9d, 0xd8, 0x2e,
+	0x54, 0x70, 0x1c, 0xe8, 0xdb, 0xa2, 0xba, 0x4d, 0x69, 0x69, 0x47, 0x9c, 0x30, 0x8c, 0x02, 0xee,
+	0x94, 0xd4, 0x55, 0x42, 0xda, 0xdb, 0x50, 0x66, 0xf8, 0x53, 0xc4, 0x02, 0xa7, 0xdc, 0xb0, 0xb6,
+	0x56, 0x76, 0x6e, 0xb5, 0x0c, 0x3c, 0x19, 0xc9, 0x96, 0x89, 0x64, 0x6b, 0x9f, 0x92, 0xd8, 0x33,
+	0x8c, 0xf6, 0x27, 0x00, 0x3e, 0x8d, 0x06, 0x88, 0x11, 0x4e, 0x63, 0xe7, 0x9a, 0x12, 0xfb, 0x56,
+	0xeb, 0xe2, 0xac, 0xb5, 0x94, 0x0f, 0xfb, 0xa9, 0x88, 0x37, 0x26, 0x6e, 0x1f, 0xc1, 0x75, 0xa6,
+	0x78, 0x3b, 0x1c, 0x0b, 0x41, 0xe2, 0x90, 0x3b, 0x15, 0xa5, 0xf1, 0xfe, 0x2c, 0x8d, 0x9a, 0x38,
+	0x32, 0x12, 0xde, 0x3a, 0xcb, 0xd0, 0xf6, 0xeb, 0x00, 0xc7, 0xd2, 0x66, 0x87, 0x21, 0x81, 0x9d,
+	0x6a, 0xc3, 0xda, 0x5a, 0xf3, 0xaa, 0xea, 0xc4, 0x43, 0x02, 0xdb, 0x7b, 0x50, 0x15, 0xa4, 0x8f,
+	0x3b, 0x21, 0x23, 0x81, 0x03, 0xca, 0xda, 0x37, 0x66, 0x59, 0x7b, 0x4a, 0xfa, 0xf8, 0x87, 0x8c,
+	0x04, 0x5e, 0x45, 0x98, 0x5f, 0xf6, 0x77, 0xa0, 0xc4, 0x07, 0x7d, 0x22, 0x9c, 0x15, 0x25, 0x7e,
+	0x6f, 0xa6, 0xb8, 0x0a, 0xf5, 0x91, 0x64, 0xf7, 0xb4, 0x94, 0x7d, 0x0f, 0xae, 0x77, 0xfb, 0x1a,
+	0xf6, 0x10, 0x33, 0x4e, 0x68, 0xec, 0xac, 0xaa, 0x4c, 0xaf, 0x9b, 0xe3, 0x67, 0xfa, 0xf4, 0xfd,
+	0xd5, 0x5f, 0xbf, 0xfc, 0xfc, 0x7e, 0x52, 0x14, 0xcd, 0x0f, 0xe1, 0xee, 0x8c, 0x6a, 0xf2, 0x30,
+	0x1f, 0xd0, 0x98, 0x63, 0xfb, 0x26, 0x5c, 0x13, 0x88, 0xf7, 0x3a, 0x24, 0x30, 0x55, 0x55, 0x96,
+	0xe4, 0x41, 0xd0, 0xfc, 0x9f, 0x05, 0xab, 0x87, 0x3c, 0xdc, 0x0b, 0x82, 0x9f, 0x50, 0xd6, 0xc3,
+	0x6c, 0x46, 0xfd, 0x6d, 0x42, 0x75, 0x70, 0xda, 0xed, 0x13, 0xbf, 0x43, 0x06, 0xa6, 0x0a, 0x2b,
+	0xfa, 0xe0, 0x60, 0x20, 0x0d, 0x90, 0xc1, 0x31, 0x97, 0x06, 0x0a, 0xda, 0x80, 0x24, 0x0f, 0x02,
+	0xfb, 0x1d, 0x28, 0x71, 0x81, 0x7a, 0xba, 0x00, 0x67, 0x15, 0xd3, 0xe3, 0xe2, 0x17, 0xff, 0xb9,
+	0x73, 0xc5, 0xd3, 0xdc, 0x76, 0x13, 0x56, 0x11, 0xf3, 0x4f, 0x88, 0xc0, 0xbe, 0x38, 0x65, 0x58,
+	0xd5, 0x68, 0xd5, 0xcb, 0x9c, 0xd9, 0x6f, 0x42, 0x2d, 0x17, 0x32, 0xee, 0x94, 0x1b, 0x85, 0xad,
+	0xaa, 0x77, 0x3d, 0x1b, 0x33, 0x9e, 0x0b, 0xda, 0xf7, 0x60, 0x63, 0x1c, 0x73, 0x1a, 0xa5, 0x75,
+	0xb8, 0x4a, 0x7b, 0x0a, 0x76, 0xc5, 0xbb, 0x4a, 0x55, 0x2f, 0x46, 0x98, 0x73, 0x14, 0x62, 0x83,
+	0x37, 0x21, 0x9b, 0x43, 0x70, 0x0e, 0x79, 0x78, 0x74, 0xda, 0xe5, 0x3e, 0x23, 0x5d, 0xac, 0xf5,
+	0x3c, 0xa5, 0x49, 0x07, 0xa3, 0x20, 0x60, 0x98, 0xf3, 0x24, 0x82, 0x86, 0xb4, 0x6f, 0x80, 0x09,
+	0xbb, 0x51, 0x67, 0x28, 0xd9, 0xa7, 0xba, 0xf9, 0x0e, 0x92, 0xe8, 0xa5, 0xb4, 0xf1, 0xdc, 0x68,
+	0x68, 0x7e, 0x08, 0x8d, 0x8b, 0xec, 0xa6, 0x28, 0xc6, 0xb5, 0x59, 0x59, 0x6d, 0xcd, 0x7f, 0x14,
+	0xc0, 0x3e, 0xe4, 0xe1, 0x13, 0x46, 0x07, 0x94, 0xe3, 0x23, 0xda, 0x3f, 0x95, 0x15, 0x3a, 0x23,
+	0xe9, 0x4b, 0xb8, 0x2c, 0x7b, 0xcd, 0x14, 0x4a, 0x0f, 0x8f, 0x54, 0xde, 0xab, 0x9e, 0x29, 0x9d,
+	0x4f, 0xf0, 0xc8, 0xbe, 0x03, 0x2b, 0x11, 0x66, 0xbd, 0x3e, 0xee, 0x30, 0x4a, 0x85, 0x1a, 0x32,
+	0x55, 0x0f, 0xf4, 0x91, 0x47, 0xa9, 0xb0, 0x6f, 0x41, 0xe5, 0xac, 0x27, 0x27, 0x2b, 0x3d, 0x56,
+	0xb3, 0xa4, 0xea, 0x5d, 0x3b, 0xeb, 0x3d, 0x91, 0xa4, 0xfd, 0x16, 0xd8, 0x67, 0xbd, 0x8e, 0x4f,
+	0x98, 0x7f, 0x4a, 0x44, 0xda, 0x28, 0x55, 0xc5, 0x54, 0x3b, 0xeb, 0xed, 0xeb, 0x0b, 0x93, 0x76,
+	0xfb, 0x0d, 0x58, 0x3d, 0x41, 0xfc, 0x24, 0xe5, 0x03, 0xc5, 0xb7, 0x22, 0xcf, 0x12, 0x96, 0x7b,
+	0x93, 0xc3, 0x66, 0x45, 0xb7, 0x5d, 0x6e, 0x80, 0xa4, 0x8c, 0x98, 0x75, 0x02, 0x12, 0x62, 0x2e,
+	0x92, 0xfe, 0x4c, 0x8e, 0xbf, 0xaf, 0x4e, 0xed, 0x5d, 0xb8, 0x81, 0x86, 0x98, 0xa1, 0x10, 0x77,
+	0x52, 0xcd, 0x3e, 0x8d, 0x03, 0xee, 0xac, 0x35, 0xac, 0xad, 0x82, 0xb7, 0x61, 0x6e, 0x93, 0x81,
+	0xa5, 0xee, 0xb2, 0x05, 0xfa, 0x71, 0xb1, 0x52, 0xaa, 0x95, 0x3f, 0x2e, 0x56, 0x2a, 0xb5, 0xaa,
+	0x07, 0x9c, 0x84, 0x31, 0x92, 0x05, 0xcf, 0xbd, 0x35, 0x09, 0x9e, 0x46, 0x11, 0x11, 0x11, 0x8e,
+	0x45, 0xf3, 0x36, 0xb8, 0x93, 0xc9, 0x4c, 0xea, 0xa0, 0xf9, 0x99, 0x05, 0xaf, 0x1d, 0xf2, 0xd0,
+	0xc3, 0x43, 0x8c, 0xfa, 0xaf, 0x28, 0xd5, 0x37, 0xa0, 0xac, 0x86, 0x28, 0x77, 0x8a, 0xaa, 0xf1,
+	0x0c, 0x65, 0xdb, 0x50, 0xe4, 0xa8, 0x2f, 0x4c, 0xdb, 0xaa, 0xdf, 0xb9, 0x1e, 0xdc, 0x84, 0x5b,
+	0x13, 0xce, 0xa5, 0xae, 0xff, 0xdd, 0x82, 0xaf, 0xe9, 0x3a, 0x8f, 0x88, 0x78, 0x86, 0xfa, 0x24,
+	0x40, 0x5f, 0x7d, 0x9d, 0x36, 0x60, 0xe5, 0x3c, 0xd6, 0xc9, 0x64, 0x19, 0x3f, 0x9a, 0x96, 0xb4,
+	0xf1, 0x74, 0x35, 0x5f, 0x87, 0xcd, 0x29, 0x28, 0x52, 0x94, 0x7f, 0xd4, 0x28, 0x75, 0x0c, 0x5e,
+	0x19, 0xca, 0x24, 0x15, 0xc5, 0xf3, 0x54, 0x8c, 0xa5, 0xad, 0x34, 0x9e, 0xb6, 0x5c, 0x8a, 0xb4,
+	0xfb, 0x79, 0xf7, 0x52, 0xf7, 0xff, 0xaa, 0xeb, 0x4b, 0xc3, 0x7b, 0x45, 0xf5, 0x55, 0x83, 0x42,
+	0x40, 0x98, 0xf1, 0x5d, 0xfe, 0x9c, 0xd1, 0x5e, 0xa5, 0x45, 0xdb, 0xcb, 0xd4, 0x5e, 0xd6, 0xf1,
+	0x14, 0xd6, 0x9f, 0x2d, 0xb8, 0xa9, 0x60, 0x87, 0x84, 0x0b, 0xf5, 0x84, 0x90, 0xe3, 0x11, 0x89,
+	0x43, 0x59, 0x12, 0xef, 0x42, 0x15, 0x9d, 0x8a, 0x13, 0xca, 0x88, 0x18, 0x69, 0x78, 0x8f, 0x9d,
+	0x7f, 0xfd, 0xe5, 0xc1, 0x86, 0x79, 0xd3, 0xf6, 0xf4, 0x94, 0x3e, 0x12, 0xf2, 0xfd, 0xf5, 0xce,
+	0x59, 0xe5, 0xf0, 0xc8, 0xcf, 0x2c, 0x1d, 0x83, 0x75, 0x3f, 0x3b, 0xb1, 0xee, 0xc2, 0xda, 0x30,
+	0x31, 0xa8, 0xaa, 0x52, 0x06, 0x64, 0xd5, 0x5b, 0x1d, 0x8e, 0x79, 0xf1, 0xfe, 0xba, 0x04, 0x73,
+	0xae, 0xbd, 0xf9, 0x06, 0xdc, 0xb9, 0xc0, 0xe1, 0x14, 0xd4, 0x1f, 0x34, 0x28, 0x0d, 0x79, 0x8f,
+	0x73, 0x1c, 0x75, 0xfb, 0x38, 0x50, 0x0b, 0xc3, 0x8c, 0x8c, 0x8d, 0x6d, 0x0d, 0xd9, 0x94, 0x6d,
+	0x42, 0x55, 0x2d, 0x35, 0x1d, 0x3f, 0x7d, 0xef, 0x2b, 0xea, 0x60, 0x9f, 0xa8, 0xb6, 0xd2, 0x97,
+	0x72, 0xce, 0x26, 0x6d, 0xa5, 0x4e, 0x7e, 0x84, 0xf8, 0x49, 0x2e, 0x15, 0xda, 0xf7, 0x69, 0x7e,
+	0xa5, 0xbe, 0x13, 0xa8, 0xa5, 0x2c, 0x4f, 0x18, 0x1e, 0x12, 0xfc, 0xe9, 0x32, 0x3e, 0x6f, 0x40,
+	0xc9, 0xa7, 0x5c, 0x70, 0xa7, 0xa0, 0x4a, 0x5e, 0x13, 0x39, 0x6f, 0x5c, 0x70, 0xf2, 0xa6, 0x52,
+	0x37, 0xfe, 0x66, 0x81, 0x33, 0x16, 0x66, 0xcf, 0x4c, 0xfd, 0x83, 0x08, 0x85, 0x78, 0xe9, 0xc2,
+	0xf8, 0x01, 0x94, 0x48, 0x94, 0xec, 0x17, 0x2b, 0x3b, 0x6f, 0xce, 0xdf, 0x70, 0x8d, 0xc5, 0x64,
+	0x5b, 0x52, 0xd2, 0xb2, 0xb5, 0x18, 0x1e, 0xd2, 0x9e, 0xfe, 0x08, 0xa8, 0x78, 0x86, 0x9a, 0xa8,
+	0x94, 0x26, 0x34, 0x2e, 0x82, 0x90, 0xe2, 0xfc, 0xd3, 0x55, 0xb5, 0x1d, 0x79, 0x78, 0x40, 0x99,
+	0x90, 0x8b, 0xc5, 0x47, 0x88, 0xf4, 0xe5, 0xba, 0xb5, 0x5c, 0x9d, 0xe8, 0x56, 0xee, 0x90, 0xc9,
+	0xde, 0xde, 0x80, 0xd2, 0x71, 0xfa, 0x69, 0x52, 0xf0, 0x34, 0x61, 0x23, 0x09, 0x05, 0xc9, 0xcf,
+	0x08, 0xd9, 0xcf, 0xeb, 0x3b, 0x07, 0xb3, 0x42, 0x92, 0xdb, 0x78, 0x95, 0xe6, 0xd6, 0x8f, 0x63,
+	0x3d, 0x18, 0x50, 0xb7, 0x8f, 0xd5, 0x77, 0x46, 0xcb, 0x53, 0x0a, 0x3d, 0xa3, 0x58, 0x0e, 0x1c,
+	0x24, 0x04, 0x8e, 0x06, 0x6a, 0xaa, 0xcb, 0x2f, 0x81, 0x94, 0x96, 0x50, 0xfa, 0x34, 0x54, 0x75,
+	0xad, 0x57, 0x8f, 0x72, 0x9f, 0x86, 0xfb, 0x24, 0xc8, 0x15, 0x4a, 0x1d, 0x6e, 0x4f, 0x8b, 0x51,
+	0x12, 0xc4, 0x9d, 0xcf, 0xd6, 0xa0, 0x70, 0xc8, 0x43, 0xfb, 0xf7, 0x16, 0x38, 0x17, 0x7e, 0xea,
+	0xbd, 0x37, 0x0b, 0xda, 0x8c, 0xad, 0xde, 0xfd, 0xee, 0x92, 0x82, 0xe9, 0x8a, 0x18, 0x42, 0xf5,
+	0x7c, 0xe3, 0xdf, 0x9a, 0xa3, 0x2d, 0xe5, 0x74, 0xdf, 0x5e, 0x94, 0x33, 0x35, 0xf4, 0x5b, 0x0b,
+	0xbe, 0x3e, 0x7d, 0x4b, 0xde, 0x9d, 0xa3, 0x6b, 0xaa, 0x94, 0xfb, 0x68, 0x19, 0xa9, 0xd4, 0x9b,
+	0x11, 0x5c, 0xcf, 0x6f, 0xbe, 0xad, 0x39, 0x0a, 0x73, 0xfc, 0xee, 0xbb, 0x97, 0xe3, 0x4f, 0x4d,
+	0xff, 0x02, 0x6a, 0x13, 0xdb, 0x4c, 0x7b, 0x3e, 0x98, 0x8c, 0x80, 0xfb, 0xde, 0x25, 0x05, 0x52,
+	0xeb, 0x43, 0x58, 0xcf, 0xad, 0x81, 0x0f, 0xe6, 0xa8, 0xca, 0xb2, 0xbb, 0xef, 0x5c, 0x8a, 0x7d,
+	0x1c, 0xf5, 0xc4, 0x76, 0xd3, 0x5e, 0x48, 0xd5, 0x25, 0x50, 0x5f, 0xb4, 0xa0, 0x48, 0xd4, 0xb9,
+	0xe5, 0xe4, 0xc1, 0x42, 0x01, 0x5c, 0x18, 0xf5, 0xf4, 0x0d, 0xc2, 0xfe, 0x8d, 0x05, 0x1b, 0x53,
+	0xd7, 0x87, 0x87, 0x73, 0x91, 0x4c, 0x0a, 0xb9, 0x1f, 0x2c, 0x21, 0x94, 0x71, 0x65, 0xea, 0xa3,
+	0xff, 0x70, 0x21, 0x68, 0x59, 0x21, 0xf7, 0x83, 0x25, 0x84, 0x52, 0x57, 0x38, 0xac, 0x65, 0xdf,
+	0xf0, 0xb7, 0x16, 0xd2, 0x66, 0xb8, 0xdd, 0xdd, 0xcb, 0x70, 0x67, 0xe6, 0xcf, 0xf4, 0x17, 0x7b,
+	0x77, 0xc1, 0xb0, 0x66, 0xa4, 0xdc, 0x47, 0xcb, 0x48, 0xa5, 0xde, 0xfc, 0x12, 0x5e, 0x9b, 0x7c,
+	0x56, 0xdf, 0x9e, 0xab, 0x32, 0x27, 0xe1, 0x7e, 0xfb, 0xb2, 0x12, 0x89, 0x03, 0x6e, 0xe9, 0x57,
+	0x2f, 0x3f, 0xbf, 0x6f, 0x3d, 0x7e, 0xf4, 0xc5, 0xf3, 0xba, 0xf5, 0xe5, 0xf3, 0xba, 0xf5, 0xdf,
+	0xe7, 0x75, 0xeb, 0x77, 0x2f, 0xea, 0x57, 0xbe, 0x7c, 0x51, 0xbf, 0xf2, 0xef, 0x17, 0xf5, 0x2b,
+	0x3f, 0x6d, 0x86, 0x44, 0x9c, 0x9c, 0x76, 0x5b, 0x3e, 0x8d, 0xda, 0x17, 0xfc, 0x13, 0xda, 0x2d,
+	0xab, 0x3f, 0x32, 0x1f, 0xfe, 0x7f, 0x00, 0x46, 0xda, 0x72, 0x56, 0xbb, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateGame create a game.
+	CreateVideoRenderingTask(ctx context.Context, in *MsgCreateVideoRenderingTask, opts ...grpc.CallOption) (*MsgCreateVideoRenderingTaskResponse, error)
+	// Adds a new worker
+	AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error)
+	SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
+	// Reveals the hashes a validator committed to
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
+	// Submits the solution to IPFS
+	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+	// Registers the verifying key of a frame proof circuit version. Authority-gated
+	RegisterVerifyingKey(ctx context.Context, in *MsgRegisterVerifyingKey, opts ...grpc.CallOption) (*MsgRegisterVerifyingKeyResponse, error)
+	// Submits the assembled video of a completed task, or confirms it by hash
+	SubmitAssembledVideo(ctx context.Context, in *MsgSubmitAssembledVideo, opts ...grpc.CallOption) (*MsgSubmitAssembledVideoResponse, error)
+	// Submits the render times of the preview pass of a task, splitting its threads
+	SubmitPreview(ctx context.Context, in *MsgSubmitPreview, opts ...grpc.CallOption) (*MsgSubmitPreviewResponse, error)
+	// Approves or revokes a renderer image. Authority-gated
+	RegisterRendererImage(ctx context.Context, in *MsgRegisterRendererImage, opts ...grpc.CallOption) (*MsgRegisterRendererImageResponse, error)
+	// Reports the frame of a thread the worker couldn't render, failing the task once enough workers report it
+	ReportTaskFailure(ctx context.Context, in *MsgReportTaskFailure, opts ...grpc.CallOption) (*MsgReportTaskFailureResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateVideoRenderingTask(ctx context.Context, in *MsgCreateVideoRenderingTask, opts ...grpc.CallOption) (*MsgCreateVideoRenderingTaskResponse, error) {
+	out := new(MsgCreateVideoRenderingTaskResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/CreateVideoRenderingTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubscribeWorkerToTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error) {
+	out := new(MsgAddWorkerResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/AddWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubscribeWorkerToTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error) {
+	out := new(MsgSubscribeWorkerToTaskResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubscribeWorkerToTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error) {
+	out := new(MsgProposeSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/ProposeSolution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error) {
+	out := new(MsgSubmitValidationResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error) {
+	out := new(MsgRevealSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/RevealSolution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgProposeSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/RevealValidation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProposeSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
+	out := new(MsgSubmitSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitSolution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProposeSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterVerifyingKey(ctx context.Context, in *MsgRegisterVerifyingKey, opts ...grpc.CallOption) (*MsgRegisterVerifyingKeyResponse, error) {
+	out := new(MsgRegisterVerifyingKeyResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/RegisterVerifyingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgProposeSolutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitAssembledVideo(ctx context.Context, in *MsgSubmitAssembledVideo, opts ...grpc.CallOption) (*MsgSubmitAssembledVideoResponse, error) {
+	out := new(MsgSubmitAssembledVideoResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitAssembledVideo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgProposeSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitPreview(ctx context.Context, in *MsgSubmitPreview, opts ...grpc.CallOption) (*MsgSubmitPreviewResponse, error) {
+	out := new(MsgSubmitPreviewResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/SubmitPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgProposeSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterRendererImage(ctx context.Context, in *MsgRegisterRendererImage, opts ...grpc.CallOption) (*MsgRegisterRendererImageResponse, error) {
+	out := new(MsgRegisterRendererImageResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/RegisterRendererImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevealSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReportTaskFailure(ctx context.Context, in *MsgReportTaskFailure, opts ...grpc.CallOption) (*MsgReportTaskFailureResponse, error) {
+	out := new(MsgReportTaskFailureResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoRendering.v1.Msg/ReportTaskFailure", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRevealSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateGame create a game.
+	CreateVideoRenderingTask(context.Context, *MsgCreateVideoRenderingTask) (*MsgCreateVideoRenderingTaskResponse, error)
+	// Adds a new worker
+	AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error)
+	SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	ProposeSolution(context.Context, *MsgProposeSolution) (*MsgProposeSolutionResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
+	// Reveals the hashes a validator committed to
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
+	// Submits the solution to IPFS
+	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+	// Registers the verifying key of a frame proof circuit version. Authority-gated
+	RegisterVerifyingKey(context.Context, *MsgRegisterVerifyingKey) (*MsgRegisterVerifyingKeyResponse, error)
+	// Submits the assembled video of a completed task, or confirms it by hash
+	SubmitAssembledVideo(context.Context, *MsgSubmitAssembledVideo) (*MsgSubmitAssembledVideoResponse, error)
+	// Submits the render times of the preview pass of a task, splitting its threads
+	SubmitPreview(context.Context, *MsgSubmitPreview) (*MsgSubmitPreviewResponse, error)
+	// Approves or revokes a renderer image. Authority-gated
+	RegisterRendererImage(context.Context, *MsgRegisterRendererImage) (*MsgRegisterRendererImageResponse, error)
+	// Reports the frame of a thread the worker couldn't render, failing the task once enough workers report it
+	ReportTaskFailure(context.Context, *MsgReportTaskFailure) (*MsgReportTaskFailureResponse, error)
 }
 
-func (m *MsgRevealSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Frames[iNdEx])
-			copy(dAtA[i:], m.Frames[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Frames[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRevealSolutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateVideoRenderingTask(ctx context.Context, req *MsgCreateVideoRenderingTask) (*MsgCreateVideoRenderingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVideoRenderingTask not implemented")
 }
-
-func (m *MsgRevealSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AddWorker(ctx context.Context, req *MsgAddWorker) (*MsgAddWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorker not implemented")
+}
+func (*UnimplementedMsgServer) SubscribeWorkerToTask(ctx context.Context, req *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeWorkerToTask not implemented")
+}
+func (*UnimplementedMsgServer) ProposeSolution(ctx context.Context, req *MsgProposeSolution) (*MsgProposeSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSolution not implemented")
+}
+func (*UnimplementedMsgServer) SubmitValidation(ctx context.Context, req *MsgSubmitValidation) (*MsgSubmitValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitValidation not implemented")
+}
+func (*UnimplementedMsgServer) RevealSolution(ctx context.Context, req *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
+}
+func (*UnimplementedMsgServer) RevealValidation(ctx context.Context, req *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealValidation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSolution(ctx context.Context, req *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+}
+func (*UnimplementedMsgServer) RegisterVerifyingKey(ctx context.Context, req *MsgRegisterVerifyingKey) (*MsgRegisterVerifyingKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterVerifyingKey not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAssembledVideo(ctx context.Context, req *MsgSubmitAssembledVideo) (*MsgSubmitAssembledVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAssembledVideo not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPreview(ctx context.Context, req *MsgSubmitPreview) (*MsgSubmitPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPreview not implemented")
+}
+func (*UnimplementedMsgServer) RegisterRendererImage(ctx context.Context, req *MsgRegisterRendererImage) (*MsgRegisterRendererImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRendererImage not implemented")
+}
+func (*UnimplementedMsgServer) ReportTaskFailure(ctx context.Context, req *MsgReportTaskFailure) (*MsgReportTaskFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTaskFailure not implemented")
 }
 
-func (m *MsgRevealSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSubmitValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateVideoRenderingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVideoRenderingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVideoRenderingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/CreateVideoRenderingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVideoRenderingTask(ctx, req.(*MsgCreateVideoRenderingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_AddWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWorker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).AddWorker(ctx, in)
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/AddWorker",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWorker(ctx, req.(*MsgAddWorker))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubscribeWorkerToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeWorkerToTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeWorkerToTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/SubscribeWorkerToTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeWorkerToTask(ctx, req.(*MsgSubscribeWorkerToTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ProposeSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeSolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/ProposeSolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeSolution(ctx, req.(*MsgProposeSolution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SubmitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/SubmitValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitValidation(ctx, req.(*MsgSubmitValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealSolution)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RevealSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/RevealSolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealSolution(ctx, req.(*MsgRevealSolution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/RevealValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgSubmitSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/SubmitSolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSolution(ctx, req.(*MsgSubmitSolution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterVerifyingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterVerifyingKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterVerifyingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/RegisterVerifyingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterVerifyingKey(ctx, req.(*MsgRegisterVerifyingKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAssembledVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAssembledVideo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAssembledVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/SubmitAssembledVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAssembledVideo(ctx, req.(*MsgSubmitAssembledVideo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPreview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/SubmitPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPreview(ctx, req.(*MsgSubmitPreview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterRendererImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRendererImage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRendererImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/RegisterRendererImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRendererImage(ctx, req.(*MsgRegisterRendererImage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportTaskFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportTaskFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportTaskFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoRendering.v1.Msg/ReportTaskFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportTaskFailure(ctx, req.(*MsgReportTaskFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "janction.videoRendering.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVideoRenderingTask",
+			Handler:    _Msg_CreateVideoRenderingTask_Handler,
+		},
+		{
+			MethodName: "AddWorker",
+			Handler:    _Msg_AddWorker_Handler,
+		},
+		{
+			MethodName: "SubscribeWorkerToTask",
+			Handler:    _Msg_SubscribeWorkerToTask_Handler,
+		},
+		{
+			MethodName: "ProposeSolution",
+			Handler:    _Msg_ProposeSolution_Handler,
+		},
+		{
+			MethodName: "SubmitValidation",
+			Handler:    _Msg_SubmitValidation_Handler,
+		},
+		{
+			MethodName: "RevealSolution",
+			Handler:    _Msg_RevealSolution_Handler,
+		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
+		{
+			MethodName: "SubmitSolution",
+			Handler:    _Msg_SubmitSolution_Handler,
+		},
+		{
+			MethodName: "RegisterVerifyingKey",
+			Handler:    _Msg_RegisterVerifyingKey_Handler,
+		},
+		{
+			MethodName: "SubmitAssembledVideo",
+			Handler:    _Msg_SubmitAssembledVideo_Handler,
+		},
+		{
+			MethodName: "SubmitPreview",
+			Handler:    _Msg_SubmitPreview_Handler,
+		},
+		{
+			MethodName: "RegisterRendererImage",
+			Handler:    _Msg_RegisterRendererImage_Handler,
+		},
+		{
+			MethodName: "ReportTaskFailure",
+			Handler:    _Msg_ReportTaskFailure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "janction/videoRendering/v1/tx.proto",
+}
+
+func (m *MsgCreateVideoRenderingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AverageRenderSeconds != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AverageRenderSeconds))
+	if len(m.BlenderVersion) > 0 {
+		i -= len(m.BlenderVersion)
+		copy(dAtA[i:], m.BlenderVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlenderVersion)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Split != nil {
+		{
+			size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TileGrid != nil {
+		{
+			size, err := m.TileGrid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.FrameRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FrameRate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RenderSettings != nil {
+		{
+			size, err := m.RenderSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Comparison != nil {
+		{
+			size, err := m.Comparison.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reward != nil {
+		{
+			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Threads != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threads))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dir)))
+	if m.EndFrame != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndFrame))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+	if m.StartFrame != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartFrame))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1780,7 +2420,7 @@ func (m *MsgSubmitSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSolutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateVideoRenderingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1790,306 +2430,3338 @@ func (m *MsgSubmitSolutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateVideoRenderingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateVideoRenderingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateVideoRenderingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddWorker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BlenderVersions) > 0 {
+		for iNdEx := len(m.BlenderVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlenderVersions[iNdEx])
+			copy(dAtA[i:], m.BlenderVersions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BlenderVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Architecture) > 0 {
+		i -= len(m.Architecture)
+		copy(dAtA[i:], m.Architecture)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Architecture)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTx(uint64(m.StartFrame))
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTx(uint64(m.EndFrame))
+	i--
+	dAtA[i] = 0x22
+	if len(m.IpfsId) > 0 {
+		i -= len(m.IpfsId)
+		copy(dAtA[i:], m.IpfsId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Threads != 0 {
-		n += 1 + sovTx(uint64(m.Threads))
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Reward != nil {
-		l = m.Reward.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateVideoRenderingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddWorker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PublicIp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IpfsId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Stake.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgAddWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Ok {
-		n += 2
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubscribeWorkerToTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubscribeWorkerToTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeWorkerToTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeWorkerToTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubscribeWorkerToTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposeSolution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AverageRenderSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AverageRenderSeconds))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RendererDigest) > 0 {
+		i -= len(m.RendererDigest)
+		copy(dAtA[i:], m.RendererDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RendererDigest)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RenderSettings) > 0 {
+		i -= len(m.RenderSettings)
+		copy(dAtA[i:], m.RenderSettings)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RenderSettings)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HashVersion) > 0 {
+		i -= len(m.HashVersion)
+		copy(dAtA[i:], m.HashVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HashVersion)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ZkCircuitVersion) > 0 {
+		i -= len(m.ZkCircuitVersion)
+		copy(dAtA[i:], m.ZkCircuitVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ZkCircuitVersion)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	if len(m.ZkProof) > 0 {
+		i -= len(m.ZkProof)
+		copy(dAtA[i:], m.ZkProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ZkProof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgProposeSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevealSolution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevealSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Frames) > 0 {
-		for _, s := range m.Frames {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frames[iNdEx])
+			copy(dAtA[i:], m.Frames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Frames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevealSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevealSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitValidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frames[iNdEx])
+			copy(dAtA[i:], m.Frames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Frames[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageRenderSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AverageRenderSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CircuitVersion) > 0 {
+		i -= len(m.CircuitVersion)
+		copy(dAtA[i:], m.CircuitVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CircuitVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterVerifyingKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterVerifyingKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterVerifyingKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAssembledVideo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAssembledVideo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAssembledVideo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VideoHash) > 0 {
+		i -= len(m.VideoHash)
+		copy(dAtA[i:], m.VideoHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VideoHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VideoCid) > 0 {
+		i -= len(m.VideoCid)
+		copy(dAtA[i:], m.VideoCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VideoCid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAssembledVideoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAssembledVideoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAssembledVideoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Costs) > 0 {
+		for iNdEx := len(m.Costs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Costs[iNdEx])
+			copy(dAtA[i:], m.Costs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Costs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRendererImage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRendererImage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRendererImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRendererImageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRendererImageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRendererImageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportTaskFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportTaskFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportTaskFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogCid) > 0 {
+		i -= len(m.LogCid)
+		copy(dAtA[i:], m.LogCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LogCid)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Frame != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportTaskFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportTaskFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportTaskFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVideoRenderingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTx(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTx(uint64(m.EndFrame))
+	}
+	if m.Threads != 0 {
+		n += 1 + sovTx(uint64(m.Threads))
+	}
+	if m.Reward != nil {
+		l = m.Reward.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Comparison != nil {
+		l = m.Comparison.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenderSettings != nil {
+		l = m.RenderSettings.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FrameRate != 0 {
+		n += 1 + sovTx(uint64(m.FrameRate))
+	}
+	if m.TileGrid != nil {
+		l = m.TileGrid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlenderVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateVideoRenderingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Architecture)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.BlenderVersions) > 0 {
+		for _, s := range m.BlenderVersions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubscribeWorkerToTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ZkProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ZkCircuitVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HashVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RenderSettings)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RendererDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AverageRenderSeconds != 0 {
+		n += 1 + sovTx(uint64(m.AverageRenderSeconds))
+	}
+	return n
+}
+
+func (m *MsgProposeSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, s := range m.Frames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, s := range m.Commitments {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, s := range m.Frames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevealValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AverageRenderSeconds != 0 {
+		n += 1 + sovTx(uint64(m.AverageRenderSeconds))
+	}
+	return n
+}
+
+func (m *MsgSubmitSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterVerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CircuitVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterVerifyingKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitAssembledVideo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VideoCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VideoHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAssembledVideoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Costs) > 0 {
+		for _, s := range m.Costs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterRendererImage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Image.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Revoke {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterRendererImageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportTaskFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Frame != 0 {
+		n += 1 + sovTx(uint64(m.Frame))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTx(uint64(m.Attempts))
+	}
+	l = len(m.LogCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportTaskFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVideoRenderingTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			m.Threads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threads |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Comparison == nil {
+				m.Comparison = &FrameComparison{}
+			}
+			if err := m.Comparison.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenderSettings == nil {
+				m.RenderSettings = &RenderSettings{}
+			}
+			if err := m.RenderSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameRate", wireType)
+			}
+			m.FrameRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TileGrid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TileGrid == nil {
+				m.TileGrid = &TileGrid{}
+			}
+			if err := m.TileGrid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Split == nil {
+				m.Split = &ThreadSplit{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlenderVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVideoRenderingTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVideoRenderingTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVideoRenderingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Architecture = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlenderVersions = append(m.BlenderVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZkProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZkProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZkCircuitVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZkCircuitVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenderSettings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RendererDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RendererDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+			}
+			m.AverageRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frames = append(m.Frames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSubmitValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSubmitSolution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AverageRenderSeconds != 0 {
-		n += 1 + sovTx(uint64(m.AverageRenderSeconds))
+func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSubmitSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,10 +5784,10 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVideoRenderingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2152,7 +5824,7 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2180,13 +5852,13 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
-			m.StartFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2196,16 +5868,29 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.EndFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2215,35 +5900,29 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Threads = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Threads |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2253,27 +5932,23 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reward == nil {
-				m.Reward = &types.Coin{}
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2296,7 +5971,7 @@ func (m *MsgCreateVideoRenderingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVideoRenderingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2319,44 +5994,12 @@ func (m *MsgCreateVideoRenderingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVideoRenderingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVideoRenderingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2378,7 +6021,7 @@ func (m *MsgCreateVideoRenderingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2401,15 +6044,47 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddWorker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2437,11 +6112,11 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2469,11 +6144,11 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2501,13 +6176,13 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2517,24 +6192,23 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Frames = append(m.Frames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2557,7 +6231,7 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2580,64 +6254,12 @@ func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Ok = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2659,7 +6281,7 @@ func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,15 +6304,15 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2718,7 +6340,7 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2784,59 +6406,9 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 			}
 			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2864,8 +6436,27 @@ func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+			}
+			m.AverageRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2887,7 +6478,7 @@ func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,79 +6501,65 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterVerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterVerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterVerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3010,11 +6587,11 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3042,13 +6619,13 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.CircuitVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3058,23 +6635,25 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3097,7 +6676,7 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterVerifyingKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3120,10 +6699,10 @@ func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterVerifyingKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterVerifyingKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3147,7 +6726,7 @@ func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAssembledVideo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3170,10 +6749,10 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAssembledVideo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAssembledVideo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3242,7 +6821,7 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoCid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3270,11 +6849,11 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.VideoCid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3302,7 +6881,7 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, string(dAtA[iNdEx:postIndex]))
+			m.VideoHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3325,7 +6904,7 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAssembledVideoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3348,10 +6927,10 @@ func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAssembledVideoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAssembledVideoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3375,7 +6954,7 @@ func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3398,10 +6977,10 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3470,7 +7049,7 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Costs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3498,11 +7077,111 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.Costs = append(m.Costs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterRendererImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRendererImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRendererImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3530,13 +7209,13 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3546,24 +7225,45 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoke = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3585,7 +7285,7 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterRendererImageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,10 +7308,10 @@ func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterRendererImageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterRendererImageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3635,7 +7335,7 @@ func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgReportTaskFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,10 +7358,10 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportTaskFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportTaskFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3761,10 +7461,10 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
 			}
-			var stringLen uint64
+			m.Frame = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3774,29 +7474,35 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Frame |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= VideoRenderingThread_UnrenderableFrame_Reason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			m.AverageRenderSeconds = 0
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3806,11 +7512,43 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3832,7 +7570,7 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportTaskFailureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3855,10 +7593,10 @@ func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportTaskFailureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportTaskFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -25,6 +25,189 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ThreadSplit_Strategy int32
+
+const (
+	// every thread renders the same amount of frames
+	ThreadSplit_EVEN ThreadSplit_Strategy = 0
+	// threads are split from the render times of the preview pass
+	ThreadSplit_PREVIEW ThreadSplit_Strategy = 1
+)
+
+var ThreadSplit_Strategy_name = map[int32]string{
+	0: "EVEN",
+	1: "PREVIEW",
+}
+
+var ThreadSplit_Strategy_value = map[string]int32{
+	"EVEN":    0,
+	"PREVIEW": 1,
+}
+
+func (x ThreadSplit_Strategy) String() string {
+	return proto.EnumName(ThreadSplit_Strategy_name, int32(x))
+}
+
+func (ThreadSplit_Strategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{6, 0}
+}
+
+type RenderSettings_Engine int32
+
+const (
+	RenderSettings_CYCLES    RenderSettings_Engine = 0
+	RenderSettings_EEVEE     RenderSettings_Engine = 1
+	RenderSettings_WORKBENCH RenderSettings_Engine = 2
+)
+
+var RenderSettings_Engine_name = map[int32]string{
+	0: "CYCLES",
+	1: "EEVEE",
+	2: "WORKBENCH",
+}
+
+var RenderSettings_Engine_value = map[string]int32{
+	"CYCLES":    0,
+	"EEVEE":     1,
+	"WORKBENCH": 2,
+}
+
+func (x RenderSettings_Engine) String() string {
+	return proto.EnumName(RenderSettings_Engine_name, int32(x))
+}
+
+func (RenderSettings_Engine) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{11, 0}
+}
+
+type RenderSettings_OutputFormat int32
+
+const (
+	RenderSettings_PNG RenderSettings_OutputFormat = 0
+	// 16 bits per channel PNG
+	RenderSettings_PNG16    RenderSettings_OutputFormat = 1
+	RenderSettings_JPEG     RenderSettings_OutputFormat = 2
+	RenderSettings_TIFF     RenderSettings_OutputFormat = 3
+	RenderSettings_OPEN_EXR RenderSettings_OutputFormat = 4
+	// OpenEXR with every pass of the view layers
+	RenderSettings_OPEN_EXR_MULTILAYER RenderSettings_OutputFormat = 5
+)
+
+var RenderSettings_OutputFormat_name = map[int32]string{
+	0: "PNG",
+	1: "PNG16",
+	2: "JPEG",
+	3: "TIFF",
+	4: "OPEN_EXR",
+	5: "OPEN_EXR_MULTILAYER",
+}
+
+var RenderSettings_OutputFormat_value = map[string]int32{
+	"PNG":                 0,
+	"PNG16":               1,
+	"JPEG":                2,
+	"TIFF":                3,
+	"OPEN_EXR":            4,
+	"OPEN_EXR_MULTILAYER": 5,
+}
+
+func (x RenderSettings_OutputFormat) String() string {
+	return proto.EnumName(RenderSettings_OutputFormat_name, int32(x))
+}
+
+func (RenderSettings_OutputFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{11, 1}
+}
+
+type FrameComparison_Mode int32
+
+const (
+	// pixel hashes must be identical
+	FrameComparison_EXACT FrameComparison_Mode = 0
+	// frames match when their perceptual fingerprints are within the tolerance
+	FrameComparison_PERCEPTUAL FrameComparison_Mode = 1
+)
+
+var FrameComparison_Mode_name = map[int32]string{
+	0: "EXACT",
+	1: "PERCEPTUAL",
+}
+
+var FrameComparison_Mode_value = map[string]int32{
+	"EXACT":      0,
+	"PERCEPTUAL": 1,
+}
+
+func (x FrameComparison_Mode) String() string {
+	return proto.EnumName(FrameComparison_Mode_name, int32(x))
+}
+
+func (FrameComparison_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{12, 0}
+}
+
+type VideoRenderingThread_Phase int32
+
+const (
+	// workers are rendering, no solution proposed yet
+	VideoRenderingThread_RENDERING VideoRenderingThread_Phase = 0
+	// a solution root is proposed and validators commit to their hashes
+	VideoRenderingThread_COMMIT VideoRenderingThread_Phase = 1
+	// commits are closed, proposer and validators reveal their hashes and salts
+	VideoRenderingThread_REVEAL VideoRenderingThread_Phase = 2
+)
+
+var VideoRenderingThread_Phase_name = map[int32]string{
+	0: "RENDERING",
+	1: "COMMIT",
+	2: "REVEAL",
+}
+
+var VideoRenderingThread_Phase_value = map[string]int32{
+	"RENDERING": 0,
+	"COMMIT":    1,
+	"REVEAL":    2,
+}
+
+func (x VideoRenderingThread_Phase) String() string {
+	return proto.EnumName(VideoRenderingThread_Phase_name, int32(x))
+}
+
+func (VideoRenderingThread_Phase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 0}
+}
+
+type VideoRenderingThread_UnrenderableFrame_Reason int32
+
+const (
+	// the render didn't finish before the frame timeout
+	VideoRenderingThread_UnrenderableFrame_TIMEOUT VideoRenderingThread_UnrenderableFrame_Reason = 0
+	// blender exited with an error
+	VideoRenderingThread_UnrenderableFrame_CRASH VideoRenderingThread_UnrenderableFrame_Reason = 1
+	// blender exited without writing a valid frame
+	VideoRenderingThread_UnrenderableFrame_MISSING_OUTPUT VideoRenderingThread_UnrenderableFrame_Reason = 2
+)
+
+var VideoRenderingThread_UnrenderableFrame_Reason_name = map[int32]string{
+	0: "TIMEOUT",
+	1: "CRASH",
+	2: "MISSING_OUTPUT",
+}
+
+var VideoRenderingThread_UnrenderableFrame_Reason_value = map[string]int32{
+	"TIMEOUT":        0,
+	"CRASH":          1,
+	"MISSING_OUTPUT": 2,
+}
+
+func (x VideoRenderingThread_UnrenderableFrame_Reason) String() string {
+	return proto.EnumName(VideoRenderingThread_UnrenderableFrame_Reason_name, int32(x))
+}
+
+func (VideoRenderingThread_UnrenderableFrame_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 2, 0}
+}
+
 type VideoRenderingLogs_VideoRenderingLog_SEVERITY int32
 
 const (
@@ -50,7 +233,7 @@ func (x VideoRenderingLogs_VideoRenderingLog_SEVERITY) String() string {
 }
 
 func (VideoRenderingLogs_VideoRenderingLog_SEVERITY) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{7, 0, 0}
+	return fileDescriptor_48dc248d3c391ada, []int{16, 0, 0}
 }
 
 // Params defines the parameters of the module.
@@ -58,6 +241,30 @@ type Params struct {
 	MinWorkerStaking    *types.Coin `protobuf:"bytes,1,opt,name=min_worker_staking,json=minWorkerStaking,proto3" json:"min_worker_staking,omitempty"`
 	MaxWorkersPerThread int64       `protobuf:"varint,2,opt,name=max_workers_per_thread,json=maxWorkersPerThread,proto3" json:"max_workers_per_thread,omitempty"`
 	MinValidators       int64       `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// minimum amount of blocks validators have to commit to their hashes once a solution is proposed.
+	// Threads get longer windows when rendering their frames takes longer
+	CommitPhaseBlocks int64 `protobuf:"varint,4,opt,name=commit_phase_blocks,json=commitPhaseBlocks,proto3" json:"commit_phase_blocks,omitempty"`
+	// percentage of the reward of new tasks paid to the worker that assembles their video
+	AssemblyRewardPercentage uint32 `protobuf:"varint,6,opt,name=assembly_reward_percentage,json=assemblyRewardPercentage,proto3" json:"assembly_reward_percentage,omitempty"`
+	// amount of blocks a worker has to submit the preview pass of a task before its frames are split evenly
+	PreviewPhaseBlocks int64 `protobuf:"varint,7,opt,name=preview_phase_blocks,json=previewPhaseBlocks,proto3" json:"preview_phase_blocks,omitempty"`
+	// workers that must report the same failure of a task before it fails
+	FailureReports uint32 `protobuf:"varint,8,opt,name=failure_reports,json=failureReports,proto3" json:"failure_reports,omitempty"`
+	// percentage of the refund of a failed task paid to the workers that reported its failure
+	FailureFeePercentage uint32 `protobuf:"varint,9,opt,name=failure_fee_percentage,json=failureFeePercentage,proto3" json:"failure_fee_percentage,omitempty"`
+	// ffmpeg image videos are encoded with, pinned as name@sha256:<hex> so every worker encodes the same file.
+	// Tasks created without one have no video assembled
+	EncoderImage string `protobuf:"bytes,10,opt,name=encoder_image,json=encoderImage,proto3" json:"encoder_image,omitempty"`
+	// amount of blocks the assembler has to get its video confirmed before the assembly is reassigned
+	AssemblyPhaseBlocks int64 `protobuf:"varint,11,opt,name=assembly_phase_blocks,json=assemblyPhaseBlocks,proto3" json:"assembly_phase_blocks,omitempty"`
+	// percentage of the stake an assembler loses when its video is rejected or not submitted in time
+	AssemblyPenaltyPercentage uint32 `protobuf:"varint,12,opt,name=assembly_penalty_percentage,json=assemblyPenaltyPercentage,proto3" json:"assembly_penalty_percentage,omitempty"`
+	// maximum amount of blocks of the commit window of a thread
+	MaxCommitPhaseBlocks int64 `protobuf:"varint,13,opt,name=max_commit_phase_blocks,json=maxCommitPhaseBlocks,proto3" json:"max_commit_phase_blocks,omitempty"`
+	// expected seconds between blocks, to turn the render time of a thread into blocks of its commit window
+	BlockSeconds int64 `protobuf:"varint,14,opt,name=block_seconds,json=blockSeconds,proto3" json:"block_seconds,omitempty"`
+	// amount of blocks validators have to reveal their hashes before the commit phase is reopened
+	RevealPhaseBlocks int64 `protobuf:"varint,15,opt,name=reveal_phase_blocks,json=revealPhaseBlocks,proto3" json:"reveal_phase_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -114,6 +321,83 @@ func (m *Params) GetMinValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetCommitPhaseBlocks() int64 {
+	if m != nil {
+		return m.CommitPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAssemblyRewardPercentage() uint32 {
+	if m != nil {
+		return m.AssemblyRewardPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetPreviewPhaseBlocks() int64 {
+	if m != nil {
+		return m.PreviewPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFailureReports() uint32 {
+	if m != nil {
+		return m.FailureReports
+	}
+	return 0
+}
+
+func (m *Params) GetFailureFeePercentage() uint32 {
+	if m != nil {
+		return m.FailureFeePercentage
+	}
+	return 0
+}
+
+func (m *Params) GetEncoderImage() string {
+	if m != nil {
+		return m.EncoderImage
+	}
+	return ""
+}
+
+func (m *Params) GetAssemblyPhaseBlocks() int64 {
+	if m != nil {
+		return m.AssemblyPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAssemblyPenaltyPercentage() uint32 {
+	if m != nil {
+		return m.AssemblyPenaltyPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCommitPhaseBlocks() int64 {
+	if m != nil {
+		return m.MaxCommitPhaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBlockSeconds() int64 {
+	if m != nil {
+		return m.BlockSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRevealPhaseBlocks() int64 {
+	if m != nil {
+		return m.RevealPhaseBlocks
+	}
+	return 0
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -124,6 +408,10 @@ type GenesisState struct {
 	VideoRenderingTaskList []IndexedVideoRenderingTask `protobuf:"bytes,4,rep,name=videoRenderingTaskList,proto3" json:"videoRenderingTaskList"`
 	// List of Workers
 	Workers []Worker `protobuf:"bytes,5,rep,name=workers,proto3" json:"workers"`
+	// Verifying keys of the frame proof circuit versions
+	VerifyingKeys []CircuitVerifyingKey `protobuf:"bytes,6,rep,name=verifyingKeys,proto3" json:"verifyingKeys"`
+	// Renderer images workers are allowed to render with
+	RendererImages []RendererImage `protobuf:"bytes,7,rep,name=rendererImages,proto3" json:"rendererImages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -187,6 +475,146 @@ func (m *GenesisState) GetWorkers() []Worker {
 	return nil
 }
 
+func (m *GenesisState) GetVerifyingKeys() []CircuitVerifyingKey {
+	if m != nil {
+		return m.VerifyingKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRendererImages() []RendererImage {
+	if m != nil {
+		return m.RendererImages
+	}
+	return nil
+}
+
+// Serialized groth16 verifying key of a version of the frame proof circuit
+type CircuitVerifyingKey struct {
+	// hex encoded sha256 of the compiled constraint system
+	CircuitVersion string `protobuf:"bytes,1,opt,name=circuit_version,json=circuitVersion,proto3" json:"circuit_version,omitempty"`
+	VerifyingKey   []byte `protobuf:"bytes,2,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+}
+
+func (m *CircuitVerifyingKey) Reset()         { *m = CircuitVerifyingKey{} }
+func (m *CircuitVerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitVerifyingKey) ProtoMessage()    {}
+func (*CircuitVerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{2}
+}
+func (m *CircuitVerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitVerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitVerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitVerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitVerifyingKey.Merge(m, src)
+}
+func (m *CircuitVerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitVerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitVerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitVerifyingKey proto.InternalMessageInfo
+
+func (m *CircuitVerifyingKey) GetCircuitVersion() string {
+	if m != nil {
+		return m.CircuitVersion
+	}
+	return ""
+}
+
+func (m *CircuitVerifyingKey) GetVerifyingKey() []byte {
+	if m != nil {
+		return m.VerifyingKey
+	}
+	return nil
+}
+
+// Blender image approved to render frames, pinned by the digest of its content
+type RendererImage struct {
+	// repository of the image, without tag nor digest
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BlenderVersion string `protobuf:"bytes,2,opt,name=blender_version,json=blenderVersion,proto3" json:"blender_version,omitempty"`
+	// GOARCH of the workers rendering with the image
+	Architecture string `protobuf:"bytes,3,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// sha256:<hex> digest of the image in its repository
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *RendererImage) Reset()         { *m = RendererImage{} }
+func (m *RendererImage) String() string { return proto.CompactTextString(m) }
+func (*RendererImage) ProtoMessage()    {}
+func (*RendererImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{3}
+}
+func (m *RendererImage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RendererImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RendererImage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RendererImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RendererImage.Merge(m, src)
+}
+func (m *RendererImage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RendererImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RendererImage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RendererImage proto.InternalMessageInfo
+
+func (m *RendererImage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RendererImage) GetBlenderVersion() string {
+	if m != nil {
+		return m.BlenderVersion
+	}
+	return ""
+}
+
+func (m *RendererImage) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *RendererImage) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
 type Worker struct {
 	Address            string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Reputation         *Worker_Reputation `protobuf:"bytes,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
@@ -195,13 +623,17 @@ type Worker struct {
 	CurrentThreadIndex int32              `protobuf:"varint,6,opt,name=current_thread_index,json=currentThreadIndex,proto3" json:"current_thread_index,omitempty"`
 	PublicIp           string             `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	IpfsId             string             `protobuf:"bytes,8,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	// GOARCH of the worker, which gives the renderer images it can run
+	Architecture string `protobuf:"bytes,9,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// blender versions the worker renders. Empty renders every version with an approved image for its architecture
+	BlenderVersions []string `protobuf:"bytes,10,rep,name=blender_versions,json=blenderVersions,proto3" json:"blender_versions,omitempty"`
 }
 
 func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{2}
+	return fileDescriptor_48dc248d3c391ada, []int{4}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +711,20 @@ func (m *Worker) GetIpfsId() string {
 	return ""
 }
 
+func (m *Worker) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *Worker) GetBlenderVersions() []string {
+	if m != nil {
+		return m.BlenderVersions
+	}
+	return nil
+}
+
 type Worker_Reputation struct {
 	Staked          *types.Coin `protobuf:"bytes,1,opt,name=staked,proto3" json:"staked,omitempty"`
 	Points          int64       `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
@@ -292,7 +738,7 @@ func (m *Worker_Reputation) Reset()         { *m = Worker_Reputation{} }
 func (m *Worker_Reputation) String() string { return proto.CompactTextString(m) }
 func (*Worker_Reputation) ProtoMessage()    {}
 func (*Worker_Reputation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{2, 0}
+	return fileDescriptor_48dc248d3c391ada, []int{4, 0}
 }
 func (m *Worker_Reputation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,13 +821,28 @@ type VideoRenderingTask struct {
 	Completed    bool                    `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
 	Reward       *types.Coin             `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
 	Threads      []*VideoRenderingThread `protobuf:"bytes,9,rep,name=threads,proto3" json:"threads,omitempty"`
+	// how validators renders are compared with the solution. Exact by default
+	Comparison *FrameComparison `protobuf:"bytes,10,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// settings the frames are rendered with. Unset values keep the ones of the .blend file
+	RenderSettings *RenderSettings `protobuf:"bytes,11,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// encoding of the frames of every thread into the video, once the task is completed
+	Assembly *VideoAssembly `protobuf:"bytes,12,opt,name=assembly,proto3" json:"assembly,omitempty"`
+	// optional grid splitting every frame into tiles rendered by different threads
+	TileGrid *TileGrid `protobuf:"bytes,13,opt,name=tile_grid,json=tileGrid,proto3" json:"tile_grid,omitempty"`
+	// how the frames are divided among the threads. Evenly by default
+	Split *ThreadSplit `protobuf:"bytes,14,opt,name=split,proto3" json:"split,omitempty"`
+	// blender version the scene needs, like 3.6 or 4.2.1. Rendered with the latest approved image when empty
+	BlenderVersion string `protobuf:"bytes,15,opt,name=blender_version,json=blenderVersion,proto3" json:"blender_version,omitempty"`
+	// the scene couldn't be rendered, enough workers reported the same failure and the requester was refunded.
+	// Failed tasks are completed without a video
+	Failed bool `protobuf:"varint,16,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (m *VideoRenderingTask) Reset()         { *m = VideoRenderingTask{} }
 func (m *VideoRenderingTask) String() string { return proto.CompactTextString(m) }
 func (*VideoRenderingTask) ProtoMessage()    {}
 func (*VideoRenderingTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{3}
+	return fileDescriptor_48dc248d3c391ada, []int{5}
 }
 func (m *VideoRenderingTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,32 +934,84 @@ func (m *VideoRenderingTask) GetThreads() []*VideoRenderingThread {
 	return nil
 }
 
-// A Video Rendering Thread is the smallest unit of work for a Task.
-// Workers will try to complete a thread as soon as possible to submit first a solution
-type VideoRenderingThread struct {
-	ThreadId             string                             `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
-	TaskId               string                             `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	StartFrame           int64                              `protobuf:"varint,3,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
-	EndFrame             int64                              `protobuf:"varint,4,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
-	Completed            bool                               `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	Workers              []string                           `protobuf:"bytes,6,rep,name=workers,proto3" json:"workers,omitempty"`
-	Solution             *VideoRenderingThread_Solution     `protobuf:"bytes,7,opt,name=solution,proto3" json:"solution,omitempty"`
-	Validations          []*VideoRenderingThread_Validation `protobuf:"bytes,8,rep,name=validations,proto3" json:"validations,omitempty"`
-	AverageRenderSeconds int64                              `protobuf:"varint,9,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+func (m *VideoRenderingTask) GetComparison() *FrameComparison {
+	if m != nil {
+		return m.Comparison
+	}
+	return nil
 }
 
-func (m *VideoRenderingThread) Reset()         { *m = VideoRenderingThread{} }
-func (m *VideoRenderingThread) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingThread) ProtoMessage()    {}
-func (*VideoRenderingThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{4}
+func (m *VideoRenderingTask) GetRenderSettings() *RenderSettings {
+	if m != nil {
+		return m.RenderSettings
+	}
+	return nil
 }
-func (m *VideoRenderingThread) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *VideoRenderingTask) GetAssembly() *VideoAssembly {
+	if m != nil {
+		return m.Assembly
+	}
+	return nil
 }
-func (m *VideoRenderingThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *VideoRenderingTask) GetTileGrid() *TileGrid {
+	if m != nil {
+		return m.TileGrid
+	}
+	return nil
+}
+
+func (m *VideoRenderingTask) GetSplit() *ThreadSplit {
+	if m != nil {
+		return m.Split
+	}
+	return nil
+}
+
+func (m *VideoRenderingTask) GetBlenderVersion() string {
+	if m != nil {
+		return m.BlenderVersion
+	}
+	return ""
+}
+
+func (m *VideoRenderingTask) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+// Thread Split defines how the frames of a task are divided among its threads.
+// With a preview pass, designated workers render a sample of the frames with cheap settings first,
+// so the frames are split into threads of similar render time instead of similar frame count
+type ThreadSplit struct {
+	Strategy ThreadSplit_Strategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=janction.videoRendering.v1.ThreadSplit_Strategy" json:"strategy,omitempty"`
+	// every how many frames the preview pass renders one. 10 when empty
+	PreviewStride uint32 `protobuf:"varint,2,opt,name=preview_stride,json=previewStride,proto3" json:"preview_stride,omitempty"`
+	// last block height at which the preview is accepted. Frames are split evenly after it
+	PreviewDeadline int64 `protobuf:"varint,3,opt,name=preview_deadline,json=previewDeadline,proto3" json:"preview_deadline,omitempty"`
+	// render seconds of every previewed frame, averaged from the submissions that matched
+	Costs []*FrameCost `protobuf:"bytes,5,rep,name=costs,proto3" json:"costs,omitempty"`
+	// workers designated when the task is created to render the preview pass
+	Previewers []string `protobuf:"bytes,6,rep,name=previewers,proto3" json:"previewers,omitempty"`
+	// costs submitted by the previewers. Costs are accepted once enough of them match
+	Submissions []*PreviewSubmission `protobuf:"bytes,7,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (m *ThreadSplit) Reset()         { *m = ThreadSplit{} }
+func (m *ThreadSplit) String() string { return proto.CompactTextString(m) }
+func (*ThreadSplit) ProtoMessage()    {}
+func (*ThreadSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{6}
+}
+func (m *ThreadSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThreadSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingThread.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ThreadSplit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -508,101 +1021,77 @@ func (m *VideoRenderingThread) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingThread) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingThread.Merge(m, src)
+func (m *ThreadSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadSplit.Merge(m, src)
 }
-func (m *VideoRenderingThread) XXX_Size() int {
+func (m *ThreadSplit) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingThread) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingThread.DiscardUnknown(m)
+func (m *ThreadSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadSplit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingThread proto.InternalMessageInfo
-
-func (m *VideoRenderingThread) GetThreadId() string {
-	if m != nil {
-		return m.ThreadId
-	}
-	return ""
-}
+var xxx_messageInfo_ThreadSplit proto.InternalMessageInfo
 
-func (m *VideoRenderingThread) GetTaskId() string {
+func (m *ThreadSplit) GetStrategy() ThreadSplit_Strategy {
 	if m != nil {
-		return m.TaskId
+		return m.Strategy
 	}
-	return ""
+	return ThreadSplit_EVEN
 }
 
-func (m *VideoRenderingThread) GetStartFrame() int64 {
+func (m *ThreadSplit) GetPreviewStride() uint32 {
 	if m != nil {
-		return m.StartFrame
+		return m.PreviewStride
 	}
 	return 0
 }
 
-func (m *VideoRenderingThread) GetEndFrame() int64 {
+func (m *ThreadSplit) GetPreviewDeadline() int64 {
 	if m != nil {
-		return m.EndFrame
+		return m.PreviewDeadline
 	}
 	return 0
 }
 
-func (m *VideoRenderingThread) GetCompleted() bool {
-	if m != nil {
-		return m.Completed
-	}
-	return false
-}
-
-func (m *VideoRenderingThread) GetWorkers() []string {
+func (m *ThreadSplit) GetCosts() []*FrameCost {
 	if m != nil {
-		return m.Workers
+		return m.Costs
 	}
 	return nil
 }
 
-func (m *VideoRenderingThread) GetSolution() *VideoRenderingThread_Solution {
+func (m *ThreadSplit) GetPreviewers() []string {
 	if m != nil {
-		return m.Solution
+		return m.Previewers
 	}
 	return nil
 }
 
-func (m *VideoRenderingThread) GetValidations() []*VideoRenderingThread_Validation {
+func (m *ThreadSplit) GetSubmissions() []*PreviewSubmission {
 	if m != nil {
-		return m.Validations
+		return m.Submissions
 	}
 	return nil
 }
 
-func (m *VideoRenderingThread) GetAverageRenderSeconds() int64 {
-	if m != nil {
-		return m.AverageRenderSeconds
-	}
-	return 0
-}
-
-type VideoRenderingThread_Solution struct {
-	ProposedBy string                        `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
-	Frames     []*VideoRenderingThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
-	PublicKey  string                        `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Dir        string                        `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	Accepted   bool                          `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+type FrameCost struct {
+	Frame   int64  `protobuf:"varint,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	Seconds uint32 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
 }
 
-func (m *VideoRenderingThread_Solution) Reset()         { *m = VideoRenderingThread_Solution{} }
-func (m *VideoRenderingThread_Solution) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingThread_Solution) ProtoMessage()    {}
-func (*VideoRenderingThread_Solution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{4, 0}
+func (m *FrameCost) Reset()         { *m = FrameCost{} }
+func (m *FrameCost) String() string { return proto.CompactTextString(m) }
+func (*FrameCost) ProtoMessage()    {}
+func (*FrameCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{7}
 }
-func (m *VideoRenderingThread_Solution) XXX_Unmarshal(b []byte) error {
+func (m *FrameCost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingThread_Solution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FrameCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingThread_Solution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FrameCost.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -612,72 +1101,101 @@ func (m *VideoRenderingThread_Solution) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingThread_Solution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingThread_Solution.Merge(m, src)
+func (m *FrameCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameCost.Merge(m, src)
 }
-func (m *VideoRenderingThread_Solution) XXX_Size() int {
+func (m *FrameCost) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingThread_Solution) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingThread_Solution.DiscardUnknown(m)
+func (m *FrameCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameCost.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingThread_Solution proto.InternalMessageInfo
+var xxx_messageInfo_FrameCost proto.InternalMessageInfo
 
-func (m *VideoRenderingThread_Solution) GetProposedBy() string {
+func (m *FrameCost) GetFrame() int64 {
 	if m != nil {
-		return m.ProposedBy
+		return m.Frame
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoRenderingThread_Solution) GetFrames() []*VideoRenderingThread_Frame {
+func (m *FrameCost) GetSeconds() uint32 {
 	if m != nil {
-		return m.Frames
+		return m.Seconds
 	}
-	return nil
+	return 0
 }
 
-func (m *VideoRenderingThread_Solution) GetPublicKey() string {
-	if m != nil {
-		return m.PublicKey
+type PreviewSubmission struct {
+	Worker string       `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Costs  []*FrameCost `protobuf:"bytes,2,rep,name=costs,proto3" json:"costs,omitempty"`
+}
+
+func (m *PreviewSubmission) Reset()         { *m = PreviewSubmission{} }
+func (m *PreviewSubmission) String() string { return proto.CompactTextString(m) }
+func (*PreviewSubmission) ProtoMessage()    {}
+func (*PreviewSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{8}
+}
+func (m *PreviewSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreviewSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreviewSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *PreviewSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewSubmission.Merge(m, src)
+}
+func (m *PreviewSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreviewSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewSubmission.DiscardUnknown(m)
 }
 
-func (m *VideoRenderingThread_Solution) GetDir() string {
+var xxx_messageInfo_PreviewSubmission proto.InternalMessageInfo
+
+func (m *PreviewSubmission) GetWorker() string {
 	if m != nil {
-		return m.Dir
+		return m.Worker
 	}
 	return ""
 }
 
-func (m *VideoRenderingThread_Solution) GetAccepted() bool {
+func (m *PreviewSubmission) GetCosts() []*FrameCost {
 	if m != nil {
-		return m.Accepted
+		return m.Costs
 	}
-	return false
+	return nil
 }
 
-type VideoRenderingThread_Validation struct {
-	Validator string                        `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	Frames    []*VideoRenderingThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
-	PublicKey string                        `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	IsReverse bool                          `protobuf:"varint,4,opt,name=is_reverse,json=isReverse,proto3" json:"is_reverse,omitempty"`
+type TileGrid struct {
+	Columns uint32 `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows    uint32 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (m *VideoRenderingThread_Validation) Reset()         { *m = VideoRenderingThread_Validation{} }
-func (m *VideoRenderingThread_Validation) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingThread_Validation) ProtoMessage()    {}
-func (*VideoRenderingThread_Validation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{4, 1}
+func (m *TileGrid) Reset()         { *m = TileGrid{} }
+func (m *TileGrid) String() string { return proto.CompactTextString(m) }
+func (*TileGrid) ProtoMessage()    {}
+func (*TileGrid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{9}
 }
-func (m *VideoRenderingThread_Validation) XXX_Unmarshal(b []byte) error {
+func (m *TileGrid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingThread_Validation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TileGrid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingThread_Validation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TileGrid.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,67 +1205,68 @@ func (m *VideoRenderingThread_Validation) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingThread_Validation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingThread_Validation.Merge(m, src)
+func (m *TileGrid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TileGrid.Merge(m, src)
 }
-func (m *VideoRenderingThread_Validation) XXX_Size() int {
+func (m *TileGrid) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingThread_Validation) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingThread_Validation.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VideoRenderingThread_Validation proto.InternalMessageInfo
-
-func (m *VideoRenderingThread_Validation) GetValidator() string {
-	if m != nil {
-		return m.Validator
-	}
-	return ""
+func (m *TileGrid) XXX_DiscardUnknown() {
+	xxx_messageInfo_TileGrid.DiscardUnknown(m)
 }
 
-func (m *VideoRenderingThread_Validation) GetFrames() []*VideoRenderingThread_Frame {
-	if m != nil {
-		return m.Frames
-	}
-	return nil
-}
+var xxx_messageInfo_TileGrid proto.InternalMessageInfo
 
-func (m *VideoRenderingThread_Validation) GetPublicKey() string {
+func (m *TileGrid) GetColumns() uint32 {
 	if m != nil {
-		return m.PublicKey
+		return m.Columns
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoRenderingThread_Validation) GetIsReverse() bool {
+func (m *TileGrid) GetRows() uint32 {
 	if m != nil {
-		return m.IsReverse
+		return m.Rows
 	}
-	return false
-}
-
-type VideoRenderingThread_Frame struct {
-	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
-	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
-	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	return 0
 }
 
-func (m *VideoRenderingThread_Frame) Reset()         { *m = VideoRenderingThread_Frame{} }
-func (m *VideoRenderingThread_Frame) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingThread_Frame) ProtoMessage()    {}
-func (*VideoRenderingThread_Frame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{4, 2}
-}
-func (m *VideoRenderingThread_Frame) XXX_Unmarshal(b []byte) error {
+// Video Assembly encodes the frames of a completed task into its video.
+// The assembler submits the video, and the other workers of the task confirm it
+// by encoding the frames themselves and comparing the hashes.
+type VideoAssembly struct {
+	// worker designated to assemble the video once the task is completed
+	Assembler string `protobuf:"bytes,1,opt,name=assembler,proto3" json:"assembler,omitempty"`
+	// slice of the task reward paid to the assembler once the video is verified
+	Reward    *types.Coin `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	FrameRate uint32      `protobuf:"varint,3,opt,name=frame_rate,json=frameRate,proto3" json:"frame_rate,omitempty"`
+	VideoCid  string      `protobuf:"bytes,4,opt,name=video_cid,json=videoCid,proto3" json:"video_cid,omitempty"`
+	// hex encoded sha256 of the video file
+	VideoHash string `protobuf:"bytes,5,opt,name=video_hash,json=videoHash,proto3" json:"video_hash,omitempty"`
+	// workers whose encode matched the submitted video, and workers whose encode didn't
+	Confirmations []string `protobuf:"bytes,6,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Rejections    []string `protobuf:"bytes,7,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	Verified      bool     `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	// ffmpeg image of the params when the task was created, so governance changes don't alter the encode
+	EncoderImage string `protobuf:"bytes,9,opt,name=encoder_image,json=encoderImage,proto3" json:"encoder_image,omitempty"`
+	// block the video of the current assembler must be confirmed by
+	Deadline int64 `protobuf:"varint,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// assemblers whose video was rejected or not submitted in time. They aren't designated again
+	FailedAssemblers []string `protobuf:"bytes,11,rep,name=failed_assemblers,json=failedAssemblers,proto3" json:"failed_assemblers,omitempty"`
+}
+
+func (m *VideoAssembly) Reset()         { *m = VideoAssembly{} }
+func (m *VideoAssembly) String() string { return proto.CompactTextString(m) }
+func (*VideoAssembly) ProtoMessage()    {}
+func (*VideoAssembly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{10}
+}
+func (m *VideoAssembly) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingThread_Frame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VideoAssembly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingThread_Frame.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VideoAssembly.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -757,77 +1276,126 @@ func (m *VideoRenderingThread_Frame) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingThread_Frame) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingThread_Frame.Merge(m, src)
+func (m *VideoAssembly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoAssembly.Merge(m, src)
 }
-func (m *VideoRenderingThread_Frame) XXX_Size() int {
+func (m *VideoAssembly) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingThread_Frame) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingThread_Frame.DiscardUnknown(m)
+func (m *VideoAssembly) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoAssembly.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingThread_Frame proto.InternalMessageInfo
+var xxx_messageInfo_VideoAssembly proto.InternalMessageInfo
 
-func (m *VideoRenderingThread_Frame) GetFilename() string {
+func (m *VideoAssembly) GetAssembler() string {
 	if m != nil {
-		return m.Filename
+		return m.Assembler
 	}
 	return ""
 }
 
-func (m *VideoRenderingThread_Frame) GetSignature() string {
+func (m *VideoAssembly) GetReward() *types.Coin {
 	if m != nil {
-		return m.Signature
+		return m.Reward
 	}
-	return ""
+	return nil
 }
 
-func (m *VideoRenderingThread_Frame) GetCid() string {
+func (m *VideoAssembly) GetFrameRate() uint32 {
 	if m != nil {
-		return m.Cid
+		return m.FrameRate
+	}
+	return 0
+}
+
+func (m *VideoAssembly) GetVideoCid() string {
+	if m != nil {
+		return m.VideoCid
 	}
 	return ""
 }
 
-func (m *VideoRenderingThread_Frame) GetHash() string {
+func (m *VideoAssembly) GetVideoHash() string {
 	if m != nil {
-		return m.Hash
+		return m.VideoHash
 	}
 	return ""
 }
 
-func (m *VideoRenderingThread_Frame) GetValidCount() int64 {
+func (m *VideoAssembly) GetConfirmations() []string {
 	if m != nil {
-		return m.ValidCount
+		return m.Confirmations
 	}
-	return 0
+	return nil
 }
 
-func (m *VideoRenderingThread_Frame) GetInvalidCount() int64 {
+func (m *VideoAssembly) GetRejections() []string {
 	if m != nil {
-		return m.InvalidCount
+		return m.Rejections
 	}
-	return 0
+	return nil
 }
 
-// Stores information about the Video Rendering  task
-type VideoRenderingTaskInfo struct {
-	NextId int64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+func (m *VideoAssembly) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
 }
 
-func (m *VideoRenderingTaskInfo) Reset()         { *m = VideoRenderingTaskInfo{} }
-func (m *VideoRenderingTaskInfo) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingTaskInfo) ProtoMessage()    {}
-func (*VideoRenderingTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{5}
+func (m *VideoAssembly) GetEncoderImage() string {
+	if m != nil {
+		return m.EncoderImage
+	}
+	return ""
 }
-func (m *VideoRenderingTaskInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *VideoAssembly) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *VideoAssembly) GetFailedAssemblers() []string {
+	if m != nil {
+		return m.FailedAssemblers
+	}
+	return nil
+}
+
+// Render Settings of a task, overriding the ones of the .blend file
+type RenderSettings struct {
+	Engine RenderSettings_Engine `protobuf:"varint,1,opt,name=engine,proto3,enum=janction.videoRendering.v1.RenderSettings_Engine" json:"engine,omitempty"`
+	// resolution in pixels. Both must be set or none
+	ResolutionX uint32 `protobuf:"varint,2,opt,name=resolution_x,json=resolutionX,proto3" json:"resolution_x,omitempty"`
+	ResolutionY uint32 `protobuf:"varint,3,opt,name=resolution_y,json=resolutionY,proto3" json:"resolution_y,omitempty"`
+	// percentage of the resolution that is rendered, from 1 to 100
+	ResolutionPercentage uint32 `protobuf:"varint,4,opt,name=resolution_percentage,json=resolutionPercentage,proto3" json:"resolution_percentage,omitempty"`
+	// render samples of CYCLES and EEVEE
+	Samples uint32 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// scene, camera and view layer names of the .blend file to render
+	Scene        string                      `protobuf:"bytes,6,opt,name=scene,proto3" json:"scene,omitempty"`
+	Camera       string                      `protobuf:"bytes,7,opt,name=camera,proto3" json:"camera,omitempty"`
+	ViewLayer    string                      `protobuf:"bytes,8,opt,name=view_layer,json=viewLayer,proto3" json:"view_layer,omitempty"`
+	OutputFormat RenderSettings_OutputFormat `protobuf:"varint,9,opt,name=output_format,json=outputFormat,proto3,enum=janction.videoRendering.v1.RenderSettings_OutputFormat" json:"output_format,omitempty"`
+	// seconds a frame renders before workers stop it, overriding their own timeout. Their timeout when empty
+	FrameTimeoutSeconds uint32 `protobuf:"varint,10,opt,name=frame_timeout_seconds,json=frameTimeoutSeconds,proto3" json:"frame_timeout_seconds,omitempty"`
+}
+
+func (m *RenderSettings) Reset()         { *m = RenderSettings{} }
+func (m *RenderSettings) String() string { return proto.CompactTextString(m) }
+func (*RenderSettings) ProtoMessage()    {}
+func (*RenderSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{11}
+}
+func (m *RenderSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingTaskInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderSettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -837,95 +1405,110 @@ func (m *VideoRenderingTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingTaskInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingTaskInfo.Merge(m, src)
+func (m *RenderSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderSettings.Merge(m, src)
 }
-func (m *VideoRenderingTaskInfo) XXX_Size() int {
+func (m *RenderSettings) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingTaskInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingTaskInfo.DiscardUnknown(m)
+func (m *RenderSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderSettings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingTaskInfo proto.InternalMessageInfo
+var xxx_messageInfo_RenderSettings proto.InternalMessageInfo
 
-func (m *VideoRenderingTaskInfo) GetNextId() int64 {
+func (m *RenderSettings) GetEngine() RenderSettings_Engine {
 	if m != nil {
-		return m.NextId
+		return m.Engine
+	}
+	return RenderSettings_CYCLES
+}
+
+func (m *RenderSettings) GetResolutionX() uint32 {
+	if m != nil {
+		return m.ResolutionX
 	}
 	return 0
 }
 
-// Stores the Video Rendering Task with the index
-type IndexedVideoRenderingTask struct {
-	Index              string             `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	VideoRenderingTask VideoRenderingTask `protobuf:"bytes,2,opt,name=videoRenderingTask,proto3" json:"videoRenderingTask"`
+func (m *RenderSettings) GetResolutionY() uint32 {
+	if m != nil {
+		return m.ResolutionY
+	}
+	return 0
 }
 
-func (m *IndexedVideoRenderingTask) Reset()         { *m = IndexedVideoRenderingTask{} }
-func (m *IndexedVideoRenderingTask) String() string { return proto.CompactTextString(m) }
-func (*IndexedVideoRenderingTask) ProtoMessage()    {}
-func (*IndexedVideoRenderingTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{6}
-}
-func (m *IndexedVideoRenderingTask) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *IndexedVideoRenderingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_IndexedVideoRenderingTask.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RenderSettings) GetResolutionPercentage() uint32 {
+	if m != nil {
+		return m.ResolutionPercentage
 	}
+	return 0
 }
-func (m *IndexedVideoRenderingTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexedVideoRenderingTask.Merge(m, src)
-}
-func (m *IndexedVideoRenderingTask) XXX_Size() int {
-	return m.Size()
+
+func (m *RenderSettings) GetSamples() uint32 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
 }
-func (m *IndexedVideoRenderingTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexedVideoRenderingTask.DiscardUnknown(m)
+
+func (m *RenderSettings) GetScene() string {
+	if m != nil {
+		return m.Scene
+	}
+	return ""
 }
 
-var xxx_messageInfo_IndexedVideoRenderingTask proto.InternalMessageInfo
+func (m *RenderSettings) GetCamera() string {
+	if m != nil {
+		return m.Camera
+	}
+	return ""
+}
 
-func (m *IndexedVideoRenderingTask) GetIndex() string {
+func (m *RenderSettings) GetViewLayer() string {
 	if m != nil {
-		return m.Index
+		return m.ViewLayer
 	}
 	return ""
 }
 
-func (m *IndexedVideoRenderingTask) GetVideoRenderingTask() VideoRenderingTask {
+func (m *RenderSettings) GetOutputFormat() RenderSettings_OutputFormat {
 	if m != nil {
-		return m.VideoRenderingTask
+		return m.OutputFormat
 	}
-	return VideoRenderingTask{}
+	return RenderSettings_PNG
 }
 
-type VideoRenderingLogs struct {
-	ThreadId string                                  `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Logs     []*VideoRenderingLogs_VideoRenderingLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+func (m *RenderSettings) GetFrameTimeoutSeconds() uint32 {
+	if m != nil {
+		return m.FrameTimeoutSeconds
+	}
+	return 0
 }
 
-func (m *VideoRenderingLogs) Reset()         { *m = VideoRenderingLogs{} }
-func (m *VideoRenderingLogs) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingLogs) ProtoMessage()    {}
-func (*VideoRenderingLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{7}
+// Frame Comparison defines when a validator render matches the render of the solution.
+// Renderers that aren't deterministic across architectures need a perceptual comparison
+type FrameComparison struct {
+	Mode FrameComparison_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=janction.videoRendering.v1.FrameComparison_Mode" json:"mode,omitempty"`
+	// max amount of different bits of the block-mean hashes of the fingerprints
+	MaxHashDistance uint32 `protobuf:"varint,2,opt,name=max_hash_distance,json=maxHashDistance,proto3" json:"max_hash_distance,omitempty"`
+	// min per mille similarity (SSIM of the tile statistics) every tile of the fingerprints must have
+	MinTileSimilarity uint32 `protobuf:"varint,3,opt,name=min_tile_similarity,json=minTileSimilarity,proto3" json:"min_tile_similarity,omitempty"`
 }
-func (m *VideoRenderingLogs) XXX_Unmarshal(b []byte) error {
+
+func (m *FrameComparison) Reset()         { *m = FrameComparison{} }
+func (m *FrameComparison) String() string { return proto.CompactTextString(m) }
+func (*FrameComparison) ProtoMessage()    {}
+func (*FrameComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{12}
+}
+func (m *FrameComparison) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FrameComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingLogs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FrameComparison.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -935,50 +1518,95 @@ func (m *VideoRenderingLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingLogs.Merge(m, src)
+func (m *FrameComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameComparison.Merge(m, src)
 }
-func (m *VideoRenderingLogs) XXX_Size() int {
+func (m *FrameComparison) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingLogs.DiscardUnknown(m)
+func (m *FrameComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameComparison.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingLogs proto.InternalMessageInfo
+var xxx_messageInfo_FrameComparison proto.InternalMessageInfo
 
-func (m *VideoRenderingLogs) GetThreadId() string {
+func (m *FrameComparison) GetMode() FrameComparison_Mode {
 	if m != nil {
-		return m.ThreadId
+		return m.Mode
 	}
-	return ""
+	return FrameComparison_EXACT
 }
 
-func (m *VideoRenderingLogs) GetLogs() []*VideoRenderingLogs_VideoRenderingLog {
+func (m *FrameComparison) GetMaxHashDistance() uint32 {
 	if m != nil {
-		return m.Logs
+		return m.MaxHashDistance
 	}
-	return nil
+	return 0
 }
 
-type VideoRenderingLogs_VideoRenderingLog struct {
-	Log       string                                        `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
-	Timestamp int64                                         `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Severity  VideoRenderingLogs_VideoRenderingLog_SEVERITY `protobuf:"varint,4,opt,name=severity,proto3,enum=janction.videoRendering.v1.VideoRenderingLogs_VideoRenderingLog_SEVERITY" json:"severity,omitempty"`
+func (m *FrameComparison) GetMinTileSimilarity() uint32 {
+	if m != nil {
+		return m.MinTileSimilarity
+	}
+	return 0
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) Reset()         { *m = VideoRenderingLogs_VideoRenderingLog{} }
-func (m *VideoRenderingLogs_VideoRenderingLog) String() string { return proto.CompactTextString(m) }
-func (*VideoRenderingLogs_VideoRenderingLog) ProtoMessage()    {}
-func (*VideoRenderingLogs_VideoRenderingLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dc248d3c391ada, []int{7, 0}
+// A Video Rendering Thread is the smallest unit of work for a Task.
+// Workers will try to complete a thread as soon as possible to submit first a solution
+type VideoRenderingThread struct {
+	ThreadId             string                             `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	TaskId               string                             `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StartFrame           int64                              `protobuf:"varint,3,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
+	EndFrame             int64                              `protobuf:"varint,4,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
+	Completed            bool                               `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	Workers              []string                           `protobuf:"bytes,6,rep,name=workers,proto3" json:"workers,omitempty"`
+	Solution             *VideoRenderingThread_Solution     `protobuf:"bytes,7,opt,name=solution,proto3" json:"solution,omitempty"`
+	Validations          []*VideoRenderingThread_Validation `protobuf:"bytes,8,rep,name=validations,proto3" json:"validations,omitempty"`
+	AverageRenderSeconds int64                              `protobuf:"varint,9,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+	// frames validators must render and sign. Derived from the block hash when the solution is proposed
+	SampledFrames []int64 `protobuf:"varint,10,rep,packed,name=sampled_frames,json=sampledFrames,proto3" json:"sampled_frames,omitempty"`
+	// amount of interleaved render orders of the thread. Assigned when the first worker subscribes
+	RenderSlots int64 `protobuf:"varint,11,opt,name=render_slots,json=renderSlots,proto3" json:"render_slots,omitempty"`
+	// current phase of the commit-reveal protocol of the thread
+	Phase VideoRenderingThread_Phase `protobuf:"varint,12,opt,name=phase,proto3,enum=janction.videoRendering.v1.VideoRenderingThread_Phase" json:"phase,omitempty"`
+	// last block height at which commitments are accepted. Reveals are accepted after it
+	CommitDeadline int64 `protobuf:"varint,13,opt,name=commit_deadline,json=commitDeadline,proto3" json:"commit_deadline,omitempty"`
+	// comparison of the task, so workers know what to commit to
+	Comparison *FrameComparison `protobuf:"bytes,14,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// render settings of the task, so workers render the thread with them
+	RenderSettings *RenderSettings `protobuf:"bytes,15,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// tile grid of the task and the tile of every frame the thread renders, when the task is tiled
+	TileGrid *TileGrid `protobuf:"bytes,16,opt,name=tile_grid,json=tileGrid,proto3" json:"tile_grid,omitempty"`
+	Tile     uint32    `protobuf:"varint,17,opt,name=tile,proto3" json:"tile,omitempty"`
+	// blender version of the task, so workers render the thread with a matching image
+	BlenderVersion string `protobuf:"bytes,18,opt,name=blender_version,json=blenderVersion,proto3" json:"blender_version,omitempty"`
+	// frames workers couldn't render within the attempts of their render policy
+	UnrenderableFrames []*VideoRenderingThread_UnrenderableFrame `protobuf:"bytes,19,rep,name=unrenderable_frames,json=unrenderableFrames,proto3" json:"unrenderable_frames,omitempty"`
+	// amount of blocks of the commit window, derived from the frames of the thread and their render time
+	CommitBlocks int64 `protobuf:"varint,20,opt,name=commit_blocks,json=commitBlocks,proto3" json:"commit_blocks,omitempty"`
+	// last block height at which reveals are counted. The commit phase is reopened if too few validators revealed by then
+	RevealDeadline int64 `protobuf:"varint,21,opt,name=reveal_deadline,json=revealDeadline,proto3" json:"reveal_deadline,omitempty"`
+	// times the commit phase was reopened. Validators commit once per round
+	CommitRound uint32 `protobuf:"varint,22,opt,name=commit_round,json=commitRound,proto3" json:"commit_round,omitempty"`
+	// last block height at which the proposer reveals the sampled frames, set once enough validators revealed.
+	// The solution is dropped and the thread reopened if they aren't revealed by then
+	SolutionRevealDeadline int64 `protobuf:"varint,23,opt,name=solution_reveal_deadline,json=solutionRevealDeadline,proto3" json:"solution_reveal_deadline,omitempty"`
+	// times the solution of the thread was dropped. Proposers commit to their frames once per round
+	ProposalRound uint32 `protobuf:"varint,24,opt,name=proposal_round,json=proposalRound,proto3" json:"proposal_round,omitempty"`
 }
-func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Unmarshal(b []byte) error {
+
+func (m *VideoRenderingThread) Reset()         { *m = VideoRenderingThread{} }
+func (m *VideoRenderingThread) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingThread) ProtoMessage()    {}
+func (*VideoRenderingThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13}
+}
+func (m *VideoRenderingThread) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VideoRenderingThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VideoRenderingThread.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -988,1319 +1616,6878 @@ func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.Merge(m, src)
+func (m *VideoRenderingThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingThread.Merge(m, src)
 }
-func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Size() int {
+func (m *VideoRenderingThread) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoRenderingLogs_VideoRenderingLog) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.DiscardUnknown(m)
+func (m *VideoRenderingThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingThread.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog proto.InternalMessageInfo
+var xxx_messageInfo_VideoRenderingThread proto.InternalMessageInfo
 
-func (m *VideoRenderingLogs_VideoRenderingLog) GetLog() string {
+func (m *VideoRenderingThread) GetThreadId() string {
 	if m != nil {
-		return m.Log
+		return m.ThreadId
 	}
 	return ""
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) GetTimestamp() int64 {
+func (m *VideoRenderingThread) GetTaskId() string {
 	if m != nil {
-		return m.Timestamp
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *VideoRenderingThread) GetStartFrame() int64 {
+	if m != nil {
+		return m.StartFrame
 	}
 	return 0
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) GetSeverity() VideoRenderingLogs_VideoRenderingLog_SEVERITY {
+func (m *VideoRenderingThread) GetEndFrame() int64 {
 	if m != nil {
-		return m.Severity
+		return m.EndFrame
 	}
-	return VideoRenderingLogs_VideoRenderingLog_INFO
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("janction.videoRendering.v1.VideoRenderingLogs_VideoRenderingLog_SEVERITY", VideoRenderingLogs_VideoRenderingLog_SEVERITY_name, VideoRenderingLogs_VideoRenderingLog_SEVERITY_value)
-	proto.RegisterType((*Params)(nil), "janction.videoRendering.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "janction.videoRendering.v1.GenesisState")
-	proto.RegisterType((*Worker)(nil), "janction.videoRendering.v1.Worker")
-	proto.RegisterType((*Worker_Reputation)(nil), "janction.videoRendering.v1.Worker.Reputation")
-	proto.RegisterType((*VideoRenderingTask)(nil), "janction.videoRendering.v1.VideoRenderingTask")
-	proto.RegisterType((*VideoRenderingThread)(nil), "janction.videoRendering.v1.VideoRenderingThread")
-	proto.RegisterType((*VideoRenderingThread_Solution)(nil), "janction.videoRendering.v1.VideoRenderingThread.Solution")
-	proto.RegisterType((*VideoRenderingThread_Validation)(nil), "janction.videoRendering.v1.VideoRenderingThread.Validation")
-	proto.RegisterType((*VideoRenderingThread_Frame)(nil), "janction.videoRendering.v1.VideoRenderingThread.Frame")
-	proto.RegisterType((*VideoRenderingTaskInfo)(nil), "janction.videoRendering.v1.VideoRenderingTaskInfo")
-	proto.RegisterType((*IndexedVideoRenderingTask)(nil), "janction.videoRendering.v1.IndexedVideoRenderingTask")
-	proto.RegisterType((*VideoRenderingLogs)(nil), "janction.videoRendering.v1.VideoRenderingLogs")
-	proto.RegisterType((*VideoRenderingLogs_VideoRenderingLog)(nil), "janction.videoRendering.v1.VideoRenderingLogs.VideoRenderingLog")
+func (m *VideoRenderingThread) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("janction/videoRendering/v1/types.proto", fileDescriptor_48dc248d3c391ada)
+func (m *VideoRenderingThread) GetWorkers() []string {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
 }
 
-var fileDescriptor_48dc248d3c391ada = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0xb7, 0xf5, 0x71, 0x2f, 0x61, 0x88, 0x82, 0x6b, 0xa8, 0x6b, 0x59, 0xa2, 0x0a,
-	0x12, 0x5d, 0x37, 0x2e, 0x54, 0xaa, 0xca, 0x43, 0x9b, 0x90, 0x56, 0x86, 0xd2, 0x56, 0xe3, 0x34,
-	0x08, 0x24, 0xb4, 0x1a, 0x7b, 0x27, 0xce, 0x10, 0xef, 0xec, 0x32, 0x33, 0x76, 0x93, 0x7f, 0xc1,
-	0x13, 0xfc, 0x08, 0xc4, 0x1b, 0x12, 0x7f, 0xa1, 0x8f, 0x55, 0x9f, 0xfa, 0x84, 0x20, 0xfd, 0x0d,
-	0x3c, 0xf1, 0x00, 0x9a, 0xcb, 0xda, 0x75, 0x9d, 0x0b, 0x11, 0x12, 0x6f, 0x3b, 0xdf, 0x39, 0x73,
-	0x2e, 0xdf, 0xb9, 0xec, 0x2e, 0x5c, 0xfd, 0x96, 0xf0, 0xbe, 0x62, 0x09, 0x6f, 0x8d, 0x59, 0x44,
-	0x13, 0x4c, 0x79, 0x44, 0x05, 0xe3, 0x83, 0xd6, 0x78, 0xad, 0xa5, 0x0e, 0x52, 0x2a, 0x83, 0x54,
-	0x24, 0x2a, 0x41, 0xb5, 0x4c, 0x2f, 0x98, 0xd5, 0x0b, 0xc6, 0x6b, 0xb5, 0x7a, 0x3f, 0x91, 0x71,
-	0x22, 0x5b, 0x3d, 0x22, 0x69, 0x6b, 0xbc, 0xd6, 0xa3, 0x8a, 0xac, 0xb5, 0xfa, 0x09, 0xe3, 0xf6,
-	0x6e, 0xed, 0x92, 0x95, 0x87, 0xe6, 0xd4, 0xb2, 0x07, 0x27, 0x5a, 0x1e, 0x24, 0x83, 0xc4, 0xe2,
-	0xfa, 0xc9, 0xa2, 0xcd, 0x9f, 0x3d, 0x28, 0x3e, 0x26, 0x82, 0xc4, 0x12, 0xdd, 0x07, 0x14, 0x33,
-	0x1e, 0x3e, 0x4d, 0xc4, 0x1e, 0x15, 0xa1, 0x54, 0x64, 0x8f, 0xf1, 0x41, 0xd5, 0x6b, 0x78, 0xab,
-	0x95, 0xf6, 0xa5, 0xc0, 0xd9, 0xd2, 0x8e, 0x03, 0xe7, 0x38, 0xd8, 0x48, 0x18, 0xc7, 0x4b, 0x31,
-	0xe3, 0x5f, 0x9a, 0x3b, 0x5d, 0x7b, 0x05, 0xdd, 0x80, 0x95, 0x98, 0xec, 0x3b, 0x43, 0x32, 0x4c,
-	0xa9, 0x08, 0xd5, 0xae, 0xa0, 0x24, 0xaa, 0x2e, 0x36, 0xbc, 0xd5, 0x1c, 0x7e, 0x3b, 0x26, 0xfb,
-	0xf6, 0x86, 0x7c, 0x4c, 0xc5, 0x96, 0x11, 0xa1, 0xf7, 0xe1, 0x82, 0xf6, 0x3e, 0x26, 0x43, 0x16,
-	0x11, 0x95, 0x08, 0x59, 0xcd, 0x19, 0xe5, 0xf3, 0x31, 0xe3, 0xdb, 0x13, 0xb0, 0xf9, 0xd7, 0x22,
-	0x9c, 0xbb, 0x4f, 0x39, 0x95, 0x4c, 0x76, 0x15, 0x51, 0x14, 0xdd, 0x81, 0x62, 0x6a, 0xe2, 0x77,
-	0x91, 0x36, 0x83, 0xe3, 0xe9, 0x0b, 0x6c, 0xa6, 0xeb, 0xf9, 0x67, 0xbf, 0x5d, 0x59, 0xc0, 0xee,
-	0x1e, 0x4a, 0x61, 0x65, 0x56, 0x73, 0x8b, 0xc8, 0xbd, 0x0e, 0xdf, 0x49, 0x4c, 0x04, 0x95, 0x76,
-	0xfb, 0x24, 0x8b, 0xdb, 0x47, 0xde, 0x74, 0x1e, 0x8e, 0xb1, 0x8b, 0xe4, 0x51, 0x1e, 0x1f, 0x30,
-	0xa9, 0xaa, 0xf9, 0x46, 0x6e, 0xb5, 0xd2, 0xfe, 0xf8, 0x24, 0x8f, 0x1d, 0x1e, 0xd1, 0x7d, 0x1a,
-	0xcd, 0x3b, 0x3e, 0xde, 0xa9, 0x36, 0x8d, 0xd6, 0xa1, 0xe4, 0x2a, 0x52, 0x2d, 0x18, 0x2f, 0x27,
-	0x32, 0x65, 0xeb, 0xe3, 0x4c, 0x66, 0x17, 0x9b, 0xbf, 0xe6, 0xa1, 0x68, 0x25, 0xa8, 0x0d, 0x25,
-	0x12, 0x45, 0x82, 0x4a, 0x4b, 0x7c, 0x79, 0xbd, 0xfa, 0xe2, 0x97, 0x6b, 0xcb, 0xae, 0x4b, 0xee,
-	0x5a, 0x49, 0x57, 0x69, 0x73, 0x38, 0x53, 0x44, 0x5f, 0x00, 0x08, 0x9a, 0x8e, 0x14, 0xd1, 0x4e,
-	0x1d, 0xbb, 0xd7, 0x4e, 0x8f, 0x22, 0xc0, 0x93, 0x4b, 0xf8, 0x35, 0x03, 0xa8, 0x0a, 0x25, 0xca,
-	0x49, 0x6f, 0x48, 0xa3, 0x6a, 0xbe, 0xe1, 0xad, 0xfa, 0x38, 0x3b, 0xa2, 0xab, 0x70, 0xb1, 0x3f,
-	0x12, 0x82, 0x72, 0x15, 0x2a, 0x22, 0xf7, 0x42, 0x16, 0x55, 0x0b, 0x3a, 0x48, 0x7c, 0xde, 0xc1,
-	0xa6, 0x14, 0x11, 0xba, 0x0e, 0xcb, 0x13, 0x3d, 0xd3, 0x86, 0x21, 0xd3, 0xec, 0x56, 0x8b, 0x0d,
-	0x6f, 0xb5, 0x80, 0x51, 0xa6, 0x6c, 0x44, 0x86, 0x77, 0xf4, 0x2e, 0x94, 0xd3, 0x51, 0x6f, 0xc8,
-	0xfa, 0x21, 0x4b, 0xab, 0x25, 0x63, 0xd3, 0xb7, 0x40, 0x27, 0x45, 0xef, 0x40, 0x89, 0xa5, 0x3b,
-	0x52, 0xbb, 0xf3, 0x8d, 0xa8, 0xa8, 0x8f, 0x9d, 0xa8, 0xf6, 0xb7, 0x07, 0x30, 0x4d, 0x02, 0xad,
-	0x41, 0x51, 0x8f, 0x17, 0x8d, 0x4e, 0x9f, 0x2e, 0xa7, 0x88, 0x56, 0xa0, 0x98, 0x26, 0x8c, 0x2b,
-	0xe9, 0x66, 0xc8, 0x9d, 0x50, 0x03, 0x2a, 0x6e, 0x64, 0x58, 0xc2, 0xed, 0xcc, 0x14, 0xf0, 0xeb,
-	0x10, 0x7a, 0x0f, 0xca, 0x32, 0x19, 0x8e, 0xac, 0x3c, 0x6f, 0xe4, 0x53, 0x00, 0xdd, 0x06, 0xff,
-	0x29, 0xe3, 0x9c, 0xf1, 0x81, 0x34, 0x14, 0x9d, 0x14, 0x8c, 0xeb, 0x86, 0xc9, 0x05, 0xf4, 0x01,
-	0x2c, 0x09, 0x53, 0xae, 0x30, 0x1a, 0x09, 0x17, 0x41, 0xb1, 0x91, 0x5b, 0xcd, 0xe1, 0x8b, 0x16,
-	0xff, 0x34, 0x83, 0x9b, 0x7f, 0x2e, 0x02, 0x9a, 0x6f, 0x59, 0x9d, 0x96, 0x32, 0xa5, 0xb0, 0x4d,
-	0x84, 0xdd, 0x09, 0xdd, 0x84, 0xb2, 0xa0, 0xdf, 0x8d, 0xa8, 0x54, 0x54, 0x98, 0x8c, 0x4f, 0xea,
-	0xaf, 0xa9, 0x2a, 0x5a, 0x82, 0x5c, 0x9f, 0x45, 0x86, 0x86, 0x32, 0xd6, 0x8f, 0xe8, 0x0a, 0x54,
-	0xa4, 0x22, 0x42, 0x85, 0x3b, 0x82, 0xc4, 0xd4, 0x11, 0x00, 0x06, 0xba, 0xa7, 0x11, 0x5d, 0x51,
-	0xca, 0x23, 0x27, 0x2e, 0x18, 0xb1, 0x4f, 0x79, 0x64, 0x85, 0x4d, 0x38, 0x67, 0x1b, 0xe3, 0x6e,
-	0x9c, 0x8c, 0xb8, 0x72, 0x8d, 0x31, 0x83, 0x69, 0x82, 0xfb, 0x49, 0x9c, 0x0e, 0xa9, 0xa2, 0x91,
-	0x69, 0x09, 0x1f, 0x4f, 0x01, 0x5d, 0x6b, 0x41, 0x9f, 0x12, 0x61, 0x5b, 0xe2, 0xe4, 0x5a, 0x5b,
-	0x45, 0xf4, 0x19, 0x94, 0xac, 0x03, 0x59, 0x2d, 0x9b, 0x49, 0xbd, 0x7e, 0x86, 0x0d, 0x64, 0x2e,
-	0xe2, 0xcc, 0x40, 0xf3, 0x07, 0x1f, 0x96, 0x8f, 0xd2, 0xd0, 0x69, 0x67, 0x2d, 0x9f, 0x91, 0xef,
-	0x5b, 0xa0, 0x13, 0xe9, 0x46, 0xce, 0xe6, 0x66, 0x71, 0xa6, 0x2e, 0x6f, 0xb0, 0x69, 0x57, 0xf4,
-	0xb1, 0x6c, 0xe6, 0x8d, 0x78, 0xca, 0xe6, 0x0c, 0x53, 0x85, 0x37, 0x99, 0xaa, 0x4e, 0x17, 0x94,
-	0x6e, 0xa2, 0xf2, 0x64, 0xed, 0xa0, 0x27, 0xe0, 0x67, 0x1d, 0x6b, 0x08, 0xae, 0xb4, 0x6f, 0x9d,
-	0x95, 0x91, 0xa0, 0xeb, 0x0c, 0xe0, 0x89, 0x29, 0xf4, 0xcd, 0xec, 0xec, 0xf8, 0x86, 0xeb, 0xdb,
-	0x67, 0xb6, 0xbc, 0x3d, 0xb1, 0x31, 0x3b, 0x78, 0x1f, 0xc1, 0x0a, 0x19, 0x53, 0x41, 0x06, 0x34,
-	0x74, 0x53, 0x22, 0x69, 0x3f, 0xe1, 0xa6, 0xaa, 0x9a, 0x97, 0x65, 0x27, 0xb5, 0xf6, 0xba, 0x56,
-	0x56, 0x3b, 0xf4, 0xc0, 0xcf, 0x62, 0x45, 0xb7, 0xa0, 0x92, 0x8a, 0x24, 0x4d, 0x24, 0x8d, 0xc2,
-	0xde, 0xc1, 0xa9, 0x8b, 0x16, 0x32, 0xe5, 0xf5, 0x03, 0xf4, 0x10, 0x8a, 0xa6, 0x08, 0x7a, 0x61,
-	0xe8, 0xbc, 0x6e, 0x9e, 0x39, 0x2f, 0x53, 0x33, 0xec, 0xac, 0xa0, 0xcb, 0x00, 0x6e, 0xf1, 0xed,
-	0xd1, 0x03, 0x37, 0x60, 0x6e, 0x15, 0x7e, 0x4e, 0x0f, 0xf4, 0xe0, 0x45, 0x4c, 0x98, 0x8a, 0x97,
-	0xb1, 0x7e, 0x44, 0x35, 0xf0, 0x49, 0xbf, 0x4f, 0xd3, 0x69, 0xad, 0x27, 0xe7, 0xda, 0x0b, 0x0f,
-	0x60, 0x4a, 0x9b, 0x9e, 0xf6, 0xc9, 0x7b, 0xff, 0xd4, 0x24, 0xa7, 0xaa, 0xff, 0x77, 0x8e, 0x97,
-	0x01, 0x98, 0x0c, 0x05, 0x1d, 0x53, 0x21, 0xa9, 0x7b, 0xe5, 0x94, 0x99, 0xc4, 0x16, 0xa8, 0xfd,
-	0xe4, 0x41, 0xc1, 0xf6, 0x79, 0x0d, 0xfc, 0x1d, 0x36, 0xa4, 0x5c, 0xcf, 0x80, 0x1b, 0xad, 0xec,
-	0x6c, 0xd6, 0x31, 0x1b, 0x70, 0xa2, 0x46, 0x82, 0xba, 0xe1, 0x9a, 0x02, 0x47, 0xec, 0x2f, 0x04,
-	0xf9, 0x5d, 0x22, 0x77, 0x1d, 0xb3, 0xe6, 0x19, 0xd5, 0x01, 0x0c, 0x09, 0x1b, 0x66, 0x27, 0x15,
-	0xec, 0x10, 0x4e, 0x11, 0xbd, 0xb5, 0x18, 0x7f, 0x4d, 0xa3, 0x68, 0x34, 0x66, 0xb0, 0xe6, 0x75,
-	0x58, 0x39, 0xfa, 0xdb, 0x45, 0xef, 0x64, 0x4e, 0xf7, 0x95, 0xdb, 0xc9, 0x39, 0xec, 0x4e, 0xcd,
-	0x1f, 0x3d, 0xb8, 0x74, 0xec, 0xc7, 0x07, 0x5a, 0x86, 0x82, 0x7d, 0x77, 0xda, 0x84, 0xed, 0x01,
-	0x45, 0x80, 0xe6, 0x3f, 0x47, 0x4c, 0xda, 0x95, 0x76, 0x70, 0xb6, 0xef, 0x2a, 0xf7, 0xf6, 0x39,
-	0xc2, 0x5e, 0xf3, 0x8f, 0xb9, 0x97, 0xcb, 0x83, 0x64, 0x20, 0x75, 0x19, 0xb2, 0x8d, 0x36, 0xb7,
-	0xe1, 0xb6, 0x20, 0x3f, 0x4c, 0x06, 0x59, 0xe3, 0xdc, 0xf9, 0xf7, 0xa1, 0x68, 0xcb, 0xf3, 0x10,
-	0x36, 0xd6, 0x6a, 0x2f, 0x3d, 0x78, 0x6b, 0x4e, 0xa6, 0x8b, 0x3a, 0x4c, 0x06, 0xae, 0xd8, 0xfa,
-	0x51, 0x37, 0x81, 0x62, 0x31, 0x95, 0x8a, 0xc4, 0xa9, 0x5b, 0xa2, 0x53, 0x00, 0x51, 0xf0, 0xa5,
-	0xee, 0x29, 0xa6, 0x0e, 0x4c, 0xd9, 0x2f, 0xb4, 0x3b, 0xff, 0x35, 0xbe, 0xa0, 0xbb, 0xb9, 0xbd,
-	0x89, 0x3b, 0x5b, 0x5f, 0xe1, 0x89, 0xe9, 0xe6, 0x87, 0xe0, 0x67, 0x28, 0xf2, 0x21, 0xdf, 0x79,
-	0x78, 0xef, 0xd1, 0xd2, 0x02, 0xaa, 0x40, 0xa9, 0xfb, 0x64, 0x63, 0x63, 0xb3, 0xdb, 0x5d, 0xf2,
-	0x50, 0x19, 0x0a, 0x9b, 0x18, 0x3f, 0xc2, 0x4b, 0x8b, 0xeb, 0x9f, 0x3c, 0x3b, 0xac, 0x7b, 0xcf,
-	0x0f, 0xeb, 0xde, 0xef, 0x87, 0x75, 0xef, 0xfb, 0x57, 0xf5, 0x85, 0xe7, 0xaf, 0xea, 0x0b, 0x2f,
-	0x5f, 0xd5, 0x17, 0xbe, 0x6e, 0x0e, 0x98, 0xda, 0x1d, 0xf5, 0x82, 0x7e, 0x12, 0xb7, 0x8e, 0xf9,
-	0xc5, 0xe9, 0x15, 0xcd, 0xdf, 0xc6, 0x8d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xc0, 0xb0,
-	0x02, 0x04, 0x0d, 0x00, 0x00,
+func (m *VideoRenderingThread) GetSolution() *VideoRenderingThread_Solution {
+	if m != nil {
+		return m.Solution
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread) GetValidations() []*VideoRenderingThread_Validation {
+	if m != nil {
+		return m.Validations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread) GetAverageRenderSeconds() int64 {
+	if m != nil {
+		return m.AverageRenderSeconds
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinValidators != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidators))
-		i--
-		dAtA[i] = 0x18
+func (m *VideoRenderingThread) GetSampledFrames() []int64 {
+	if m != nil {
+		return m.SampledFrames
 	}
-	if m.MaxWorkersPerThread != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWorkersPerThread))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *VideoRenderingThread) GetRenderSlots() int64 {
+	if m != nil {
+		return m.RenderSlots
 	}
-	if m.MinWorkerStaking != nil {
-		{
-			size, err := m.MinWorkerStaking.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *VideoRenderingThread) GetPhase() VideoRenderingThread_Phase {
+	if m != nil {
+		return m.Phase
 	}
-	return len(dAtA) - i, nil
+	return VideoRenderingThread_RENDERING
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread) GetCommitDeadline() int64 {
+	if m != nil {
+		return m.CommitDeadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread) GetComparison() *FrameComparison {
+	if m != nil {
+		return m.Comparison
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.VideoRenderingTaskList) > 0 {
-		for iNdEx := len(m.VideoRenderingTaskList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VideoRenderingTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.VideoRenderingTaskInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *VideoRenderingThread) GetRenderSettings() *RenderSettings {
+	if m != nil {
+		return m.RenderSettings
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Worker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread) GetTileGrid() *TileGrid {
+	if m != nil {
+		return m.TileGrid
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread) GetTile() uint32 {
+	if m != nil {
+		return m.Tile
+	}
+	return 0
 }
 
-func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IpfsId) > 0 {
-		i -= len(m.IpfsId)
-		copy(dAtA[i:], m.IpfsId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IpfsId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PublicIp) > 0 {
-		i -= len(m.PublicIp)
-		copy(dAtA[i:], m.PublicIp)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicIp)))
-		i--
-		dAtA[i] = 0x3a
+func (m *VideoRenderingThread) GetBlenderVersion() string {
+	if m != nil {
+		return m.BlenderVersion
 	}
-	if m.CurrentThreadIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentThreadIndex))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *VideoRenderingThread) GetUnrenderableFrames() []*VideoRenderingThread_UnrenderableFrame {
+	if m != nil {
+		return m.UnrenderableFrames
 	}
-	if len(m.CurrentTaskId) > 0 {
-		i -= len(m.CurrentTaskId)
-		copy(dAtA[i:], m.CurrentTaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentTaskId)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *VideoRenderingThread) GetCommitBlocks() int64 {
+	if m != nil {
+		return m.CommitBlocks
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *VideoRenderingThread) GetRevealDeadline() int64 {
+	if m != nil {
+		return m.RevealDeadline
 	}
-	if m.Reputation != nil {
-		{
-			size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *VideoRenderingThread) GetCommitRound() uint32 {
+	if m != nil {
+		return m.CommitRound
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *VideoRenderingThread) GetSolutionRevealDeadline() int64 {
+	if m != nil {
+		return m.SolutionRevealDeadline
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Worker_Reputation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread) GetProposalRound() uint32 {
+	if m != nil {
+		return m.ProposalRound
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Worker_Reputation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoRenderingThread_Solution struct {
+	ProposedBy string                        `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	Frames     []*VideoRenderingThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	PublicKey  string                        `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Dir        string                        `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Accepted   bool                          `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// hex encoded merkle root of the commitments of all frames. Only sampled frames are revealed against it
+	MerkleRoot string `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// version of the pixel hashes of the solution. Validators hash their frames with it
+	HashVersion string `protobuf:"bytes,8,opt,name=hash_version,json=hashVersion,proto3" json:"hash_version,omitempty"`
+	// fingerprint of the render settings enforced while rendering the solution
+	RenderSettings string `protobuf:"bytes,9,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+	// digest of the approved renderer image the solution was rendered with
+	RendererDigest string `protobuf:"bytes,10,opt,name=renderer_digest,json=rendererDigest,proto3" json:"renderer_digest,omitempty"`
 }
 
-func (m *Worker_Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RenderDurations) > 0 {
-		dAtA6 := make([]byte, len(m.RenderDurations)*10)
-		var j5 int
-		for _, num1 := range m.RenderDurations {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTypes(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.Winnings.MarshalToSizedBuffer(dAtA[:i])
+func (m *VideoRenderingThread_Solution) Reset()         { *m = VideoRenderingThread_Solution{} }
+func (m *VideoRenderingThread_Solution) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingThread_Solution) ProtoMessage()    {}
+func (*VideoRenderingThread_Solution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 0}
+}
+func (m *VideoRenderingThread_Solution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingThread_Solution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingThread_Solution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Solutions != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Solutions))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Validations != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Validations))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Points != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Points))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Staked != nil {
-		{
-			size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VideoRenderingThread_Solution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingThread_Solution.Merge(m, src)
+}
+func (m *VideoRenderingThread_Solution) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingThread_Solution) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingThread_Solution.DiscardUnknown(m)
 }
 
-func (m *VideoRenderingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VideoRenderingThread_Solution proto.InternalMessageInfo
+
+func (m *VideoRenderingThread_Solution) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread_Solution) GetFrames() []*VideoRenderingThread_Frame {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
 }
 
-func (m *VideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Threads) > 0 {
-		for iNdEx := len(m.Threads) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Threads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.Reward != nil {
-		{
-			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ThreadAmount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ThreadAmount))
-		i--
-		dAtA[i] = 0x30
+func (m *VideoRenderingThread_Solution) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *VideoRenderingThread_Solution) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *VideoRenderingThread_Solution) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *VideoRenderingThread_Solution) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VideoRenderingThread_Solution) GetHashVersion() string {
+	if m != nil {
+		return m.HashVersion
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *VideoRenderingThread_Solution) GetRenderSettings() string {
+	if m != nil {
+		return m.RenderSettings
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoRenderingThread) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread_Solution) GetRendererDigest() string {
+	if m != nil {
+		return m.RendererDigest
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoRenderingThread) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoRenderingThread_Validation struct {
+	Validator string                        `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Frames    []*VideoRenderingThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	PublicKey string                        `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// render order the validator used while rendering the thread
+	RenderSlot int64 `protobuf:"varint,5,opt,name=render_slot,json=renderSlot,proto3" json:"render_slot,omitempty"`
 }
 
-func (m *VideoRenderingThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AverageRenderSeconds != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AverageRenderSeconds))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Validations) > 0 {
-		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.Solution != nil {
-		{
-			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Workers[iNdEx])
-			copy(dAtA[i:], m.Workers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Workers[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *VideoRenderingThread_Validation) Reset()         { *m = VideoRenderingThread_Validation{} }
+func (m *VideoRenderingThread_Validation) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingThread_Validation) ProtoMessage()    {}
+func (*VideoRenderingThread_Validation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 1}
+}
+func (m *VideoRenderingThread_Validation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingThread_Validation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingThread_Validation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *VideoRenderingThread_Validation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingThread_Validation.Merge(m, src)
+}
+func (m *VideoRenderingThread_Validation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingThread_Validation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingThread_Validation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoRenderingThread_Validation proto.InternalMessageInfo
+
+func (m *VideoRenderingThread_Validation) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VideoRenderingThread_Validation) GetFrames() []*VideoRenderingThread_Frame {
+	if m != nil {
+		return m.Frames
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *VideoRenderingThread_Validation) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoRenderingThread_Solution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread_Validation) GetRenderSlot() int64 {
+	if m != nil {
+		return m.RenderSlot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VideoRenderingThread_Solution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// frame a worker couldn't render, and why its last attempt failed
+type VideoRenderingThread_UnrenderableFrame struct {
+	Worker   string                                        `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Frame    int64                                         `protobuf:"varint,2,opt,name=frame,proto3" json:"frame,omitempty"`
+	Reason   VideoRenderingThread_UnrenderableFrame_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=janction.videoRendering.v1.VideoRenderingThread_UnrenderableFrame_Reason" json:"reason,omitempty"`
+	Attempts uint32                                        `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// cid of the blender log of the last attempt
+	LogCid string `protobuf:"bytes,5,opt,name=log_cid,json=logCid,proto3" json:"log_cid,omitempty"`
 }
 
-func (m *VideoRenderingThread_Solution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *VideoRenderingThread_UnrenderableFrame) Reset() {
+	*m = VideoRenderingThread_UnrenderableFrame{}
+}
+func (m *VideoRenderingThread_UnrenderableFrame) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingThread_UnrenderableFrame) ProtoMessage()    {}
+func (*VideoRenderingThread_UnrenderableFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 2}
+}
+func (m *VideoRenderingThread_UnrenderableFrame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingThread_UnrenderableFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingThread_UnrenderableFrame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *VideoRenderingThread_UnrenderableFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingThread_UnrenderableFrame.Merge(m, src)
+}
+func (m *VideoRenderingThread_UnrenderableFrame) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingThread_UnrenderableFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingThread_UnrenderableFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoRenderingThread_UnrenderableFrame proto.InternalMessageInfo
+
+func (m *VideoRenderingThread_UnrenderableFrame) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) GetFrame() int64 {
+	if m != nil {
+		return m.Frame
 	}
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) GetReason() VideoRenderingThread_UnrenderableFrame_Reason {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.ProposedBy) > 0 {
-		i -= len(m.ProposedBy)
-		copy(dAtA[i:], m.ProposedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposedBy)))
-		i--
-		dAtA[i] = 0xa
+	return VideoRenderingThread_UnrenderableFrame_TIMEOUT
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *VideoRenderingThread_Validation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread_UnrenderableFrame) GetLogCid() string {
+	if m != nil {
+		return m.LogCid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoRenderingThread_Validation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoRenderingThread_Frame struct {
+	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
+	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	// hex encoded H(hash || salt || address) committed by a validator before the hash is revealed
+	Commitment string `protobuf:"bytes,7,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *VideoRenderingThread_Validation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReverse {
-		i--
-		if m.IsReverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *VideoRenderingThread_Frame) Reset()         { *m = VideoRenderingThread_Frame{} }
+func (m *VideoRenderingThread_Frame) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingThread_Frame) ProtoMessage()    {}
+func (*VideoRenderingThread_Frame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{13, 3}
+}
+func (m *VideoRenderingThread_Frame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingThread_Frame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingThread_Frame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *VideoRenderingThread_Frame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingThread_Frame.Merge(m, src)
+}
+func (m *VideoRenderingThread_Frame) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingThread_Frame) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingThread_Frame.DiscardUnknown(m)
 }
 
-func (m *VideoRenderingThread_Frame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VideoRenderingThread_Frame proto.InternalMessageInfo
+
+func (m *VideoRenderingThread_Frame) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoRenderingThread_Frame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread_Frame) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-func (m *VideoRenderingThread_Frame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0xa
+func (m *VideoRenderingThread_Frame) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoRenderingTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread_Frame) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoRenderingTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoRenderingThread_Frame) GetValidCount() int64 {
+	if m != nil {
+		return m.ValidCount
+	}
+	return 0
 }
 
-func (m *VideoRenderingTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NextId))
-		i--
-		dAtA[i] = 0x8
+func (m *VideoRenderingThread_Frame) GetInvalidCount() int64 {
+	if m != nil {
+		return m.InvalidCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IndexedVideoRenderingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoRenderingThread_Frame) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IndexedVideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Stores information about the Video Rendering  task
+type VideoRenderingTaskInfo struct {
+	NextId int64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
 }
 
-func (m *IndexedVideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VideoRenderingTask.MarshalToSizedBuffer(dAtA[:i])
+func (m *VideoRenderingTaskInfo) Reset()         { *m = VideoRenderingTaskInfo{} }
+func (m *VideoRenderingTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingTaskInfo) ProtoMessage()    {}
+func (*VideoRenderingTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{14}
+}
+func (m *VideoRenderingTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VideoRenderingTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingTaskInfo.Merge(m, src)
+}
+func (m *VideoRenderingTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingTaskInfo.DiscardUnknown(m)
 }
 
-func (m *VideoRenderingLogs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VideoRenderingTaskInfo proto.InternalMessageInfo
+
+func (m *VideoRenderingTaskInfo) GetNextId() int64 {
+	if m != nil {
+		return m.NextId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VideoRenderingLogs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Stores the Video Rendering Task with the index
+type IndexedVideoRenderingTask struct {
+	Index              string             `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	VideoRenderingTask VideoRenderingTask `protobuf:"bytes,2,opt,name=videoRenderingTask,proto3" json:"videoRenderingTask"`
 }
 
-func (m *VideoRenderingLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *IndexedVideoRenderingTask) Reset()         { *m = IndexedVideoRenderingTask{} }
+func (m *IndexedVideoRenderingTask) String() string { return proto.CompactTextString(m) }
+func (*IndexedVideoRenderingTask) ProtoMessage()    {}
+func (*IndexedVideoRenderingTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{15}
+}
+func (m *IndexedVideoRenderingTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedVideoRenderingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedVideoRenderingTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IndexedVideoRenderingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedVideoRenderingTask.Merge(m, src)
+}
+func (m *IndexedVideoRenderingTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedVideoRenderingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedVideoRenderingTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedVideoRenderingTask proto.InternalMessageInfo
+
+func (m *IndexedVideoRenderingTask) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IndexedVideoRenderingTask) GetVideoRenderingTask() VideoRenderingTask {
+	if m != nil {
+		return m.VideoRenderingTask
 	}
-	return dAtA[:n], nil
+	return VideoRenderingTask{}
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoRenderingLogs struct {
+	ThreadId string                                  `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Logs     []*VideoRenderingLogs_VideoRenderingLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-func (m *VideoRenderingLogs_VideoRenderingLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Severity != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Severity))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Log) > 0 {
-		i -= len(m.Log)
-		copy(dAtA[i:], m.Log)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i--
-		dAtA[i] = 0x12
+func (m *VideoRenderingLogs) Reset()         { *m = VideoRenderingLogs{} }
+func (m *VideoRenderingLogs) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingLogs) ProtoMessage()    {}
+func (*VideoRenderingLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{16}
+}
+func (m *VideoRenderingLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingLogs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VideoRenderingLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingLogs.Merge(m, src)
+}
+func (m *VideoRenderingLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingLogs.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_VideoRenderingLogs proto.InternalMessageInfo
+
+func (m *VideoRenderingLogs) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinWorkerStaking != nil {
-		l = m.MinWorkerStaking.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.MaxWorkersPerThread != 0 {
-		n += 1 + sovTypes(uint64(m.MaxWorkersPerThread))
-	}
-	if m.MinValidators != 0 {
-		n += 1 + sovTypes(uint64(m.MinValidators))
+
+func (m *VideoRenderingLogs) GetLogs() []*VideoRenderingLogs_VideoRenderingLog {
+	if m != nil {
+		return m.Logs
 	}
-	return n
+	return nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.VideoRenderingTaskInfo.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.VideoRenderingTaskList) > 0 {
-		for _, e := range m.VideoRenderingTaskList {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+type VideoRenderingLogs_VideoRenderingLog struct {
+	Log       string                                        `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Timestamp int64                                         `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Severity  VideoRenderingLogs_VideoRenderingLog_SEVERITY `protobuf:"varint,4,opt,name=severity,proto3,enum=janction.videoRendering.v1.VideoRenderingLogs_VideoRenderingLog_SEVERITY" json:"severity,omitempty"`
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) Reset()         { *m = VideoRenderingLogs_VideoRenderingLog{} }
+func (m *VideoRenderingLogs_VideoRenderingLog) String() string { return proto.CompactTextString(m) }
+func (*VideoRenderingLogs_VideoRenderingLog) ProtoMessage()    {}
+func (*VideoRenderingLogs_VideoRenderingLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{16, 0}
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.Merge(m, src)
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog.DiscardUnknown(m)
 }
 
-func (m *Worker) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_VideoRenderingLogs_VideoRenderingLog proto.InternalMessageInfo
+
+func (m *VideoRenderingLogs_VideoRenderingLog) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Reputation != nil {
-		l = m.Reputation.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) GetSeverity() VideoRenderingLogs_VideoRenderingLog_SEVERITY {
+	if m != nil {
+		return m.Severity
 	}
-	if m.Enabled {
-		n += 2
+	return VideoRenderingLogs_VideoRenderingLog_INFO
+}
+
+// Frame Sign Doc
+// Canonical message workers and validators sign for each frame.
+// It's bound to the chain, task, thread and frame so signatures can't be replayed elsewhere.
+type FrameSignDoc struct {
+	Version       uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Domain        string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	ChainId       string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TaskId        string `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ThreadId      string `protobuf:"bytes,5,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	FrameNumber   int64  `protobuf:"varint,6,opt,name=frame_number,json=frameNumber,proto3" json:"frame_number,omitempty"`
+	Hash          string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	HashAlgorithm string `protobuf:"bytes,8,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	WorkerAddress string `protobuf:"bytes,9,opt,name=worker_address,json=workerAddress,proto3" json:"worker_address,omitempty"`
+	// fingerprint of the render settings the frame was rendered with
+	RenderSettings string `protobuf:"bytes,10,opt,name=render_settings,json=renderSettings,proto3" json:"render_settings,omitempty"`
+}
+
+func (m *FrameSignDoc) Reset()         { *m = FrameSignDoc{} }
+func (m *FrameSignDoc) String() string { return proto.CompactTextString(m) }
+func (*FrameSignDoc) ProtoMessage()    {}
+func (*FrameSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dc248d3c391ada, []int{17}
+}
+func (m *FrameSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.CurrentTaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *FrameSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameSignDoc.Merge(m, src)
+}
+func (m *FrameSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrameSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameSignDoc proto.InternalMessageInfo
+
+func (m *FrameSignDoc) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	if m.CurrentThreadIndex != 0 {
-		n += 1 + sovTypes(uint64(m.CurrentThreadIndex))
+	return 0
+}
+
+func (m *FrameSignDoc) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	l = len(m.PublicIp)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *FrameSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = len(m.IpfsId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *FrameSignDoc) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return n
+	return ""
 }
 
-func (m *Worker_Reputation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FrameSignDoc) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	var l int
-	_ = l
-	if m.Staked != nil {
-		l = m.Staked.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *FrameSignDoc) GetFrameNumber() int64 {
+	if m != nil {
+		return m.FrameNumber
 	}
-	if m.Points != 0 {
-		n += 1 + sovTypes(uint64(m.Points))
+	return 0
+}
+
+func (m *FrameSignDoc) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if m.Validations != 0 {
-		n += 1 + sovTypes(uint64(m.Validations))
+	return ""
+}
+
+func (m *FrameSignDoc) GetHashAlgorithm() string {
+	if m != nil {
+		return m.HashAlgorithm
 	}
-	if m.Solutions != 0 {
-		n += 1 + sovTypes(uint64(m.Solutions))
+	return ""
+}
+
+func (m *FrameSignDoc) GetWorkerAddress() string {
+	if m != nil {
+		return m.WorkerAddress
 	}
-	l = m.Winnings.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.RenderDurations) > 0 {
-		l = 0
-		for _, e := range m.RenderDurations {
-			l += sovTypes(uint64(e))
-		}
-		n += 1 + sovTypes(uint64(l)) + l
+	return ""
+}
+
+func (m *FrameSignDoc) GetRenderSettings() string {
+	if m != nil {
+		return m.RenderSettings
 	}
-	return n
+	return ""
 }
 
-func (m *VideoRenderingTask) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("janction.videoRendering.v1.ThreadSplit_Strategy", ThreadSplit_Strategy_name, ThreadSplit_Strategy_value)
+	proto.RegisterEnum("janction.videoRendering.v1.RenderSettings_Engine", RenderSettings_Engine_name, RenderSettings_Engine_value)
+	proto.RegisterEnum("janction.videoRendering.v1.RenderSettings_OutputFormat", RenderSettings_OutputFormat_name, RenderSettings_OutputFormat_value)
+	proto.RegisterEnum("janction.videoRendering.v1.FrameComparison_Mode", FrameComparison_Mode_name, FrameComparison_Mode_value)
+	proto.RegisterEnum("janction.videoRendering.v1.VideoRenderingThread_Phase", VideoRenderingThread_Phase_name, VideoRenderingThread_Phase_value)
+	proto.RegisterEnum("janction.videoRendering.v1.VideoRenderingThread_UnrenderableFrame_Reason", VideoRenderingThread_UnrenderableFrame_Reason_name, VideoRenderingThread_UnrenderableFrame_Reason_value)
+	proto.RegisterEnum("janction.videoRendering.v1.VideoRenderingLogs_VideoRenderingLog_SEVERITY", VideoRenderingLogs_VideoRenderingLog_SEVERITY_name, VideoRenderingLogs_VideoRenderingLog_SEVERITY_value)
+	proto.RegisterType((*Params)(nil), "janction.videoRendering.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "janction.videoRendering.v1.GenesisState")
+	proto.RegisterType((*CircuitVerifyingKey)(nil), "janction.videoRendering.v1.CircuitVerifyingKey")
+	proto.RegisterType((*RendererImage)(nil), "janction.videoRendering.v1.RendererImage")
+	proto.RegisterType((*Worker)(nil), "janction.videoRendering.v1.Worker")
+	proto.RegisterType((*Worker_Reputation)(nil), "janction.videoRendering.v1.Worker.Reputation")
+	proto.RegisterType((*VideoRenderingTask)(nil), "janction.videoRendering.v1.VideoRenderingTask")
+	proto.RegisterType((*ThreadSplit)(nil), "janction.videoRendering.v1.ThreadSplit")
+	proto.RegisterType((*FrameCost)(nil), "janction.videoRendering.v1.FrameCost")
+	proto.RegisterType((*PreviewSubmission)(nil), "janction.videoRendering.v1.PreviewSubmission")
+	proto.RegisterType((*TileGrid)(nil), "janction.videoRendering.v1.TileGrid")
+	proto.RegisterType((*VideoAssembly)(nil), "janction.videoRendering.v1.VideoAssembly")
+	proto.RegisterType((*RenderSettings)(nil), "janction.videoRendering.v1.RenderSettings")
+	proto.RegisterType((*FrameComparison)(nil), "janction.videoRendering.v1.FrameComparison")
+	proto.RegisterType((*VideoRenderingThread)(nil), "janction.videoRendering.v1.VideoRenderingThread")
+	proto.RegisterType((*VideoRenderingThread_Solution)(nil), "janction.videoRendering.v1.VideoRenderingThread.Solution")
+	proto.RegisterType((*VideoRenderingThread_Validation)(nil), "janction.videoRendering.v1.VideoRenderingThread.Validation")
+	proto.RegisterType((*VideoRenderingThread_UnrenderableFrame)(nil), "janction.videoRendering.v1.VideoRenderingThread.UnrenderableFrame")
+	proto.RegisterType((*VideoRenderingThread_Frame)(nil), "janction.videoRendering.v1.VideoRenderingThread.Frame")
+	proto.RegisterType((*VideoRenderingTaskInfo)(nil), "janction.videoRendering.v1.VideoRenderingTaskInfo")
+	proto.RegisterType((*IndexedVideoRenderingTask)(nil), "janction.videoRendering.v1.IndexedVideoRenderingTask")
+	proto.RegisterType((*VideoRenderingLogs)(nil), "janction.videoRendering.v1.VideoRenderingLogs")
+	proto.RegisterType((*VideoRenderingLogs_VideoRenderingLog)(nil), "janction.videoRendering.v1.VideoRenderingLogs.VideoRenderingLog")
+	proto.RegisterType((*FrameSignDoc)(nil), "janction.videoRendering.v1.FrameSignDoc")
+}
+
+func init() {
+	proto.RegisterFile("janction/videoRendering/v1/types.proto", fileDescriptor_48dc248d3c391ada)
+}
+
+var fileDescriptor_48dc248d3c391ada = []byte{
+	// 3089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x73, 0x1b, 0xc7,
+	0xb1, 0x17, 0x3e, 0xb9, 0x68, 0x7c, 0x10, 0x1c, 0xd1, 0x14, 0x44, 0xbf, 0x47, 0x53, 0xeb, 0x2f,
+	0xd9, 0x7e, 0x06, 0x25, 0xfa, 0xe3, 0xd9, 0x25, 0xbf, 0x17, 0x93, 0x10, 0x24, 0x43, 0xa6, 0x48,
+	0xd6, 0x00, 0xa4, 0xac, 0x24, 0xae, 0xad, 0xe5, 0xee, 0x10, 0x1c, 0x13, 0xbb, 0x8b, 0xec, 0x2c,
+	0x28, 0x32, 0xc7, 0x1c, 0x52, 0xb9, 0xa4, 0x2a, 0xb7, 0x9c, 0x73, 0xc8, 0xc1, 0xc7, 0x54, 0xe5,
+	0x8f, 0x70, 0x55, 0x2e, 0xae, 0x1c, 0x52, 0xce, 0xc5, 0x95, 0xd8, 0x55, 0xf9, 0x03, 0x72, 0xcd,
+	0x21, 0xa9, 0xe9, 0x99, 0x5d, 0x2c, 0x48, 0x88, 0x14, 0xed, 0x54, 0x4e, 0xd8, 0xe9, 0xe9, 0xee,
+	0x99, 0x9d, 0xe9, 0x8f, 0x5f, 0xf7, 0x02, 0x5e, 0xf9, 0xcc, 0xf6, 0x9d, 0x88, 0x07, 0xfe, 0xca,
+	0x11, 0x77, 0x59, 0x40, 0x99, 0xef, 0xb2, 0x90, 0xfb, 0xfd, 0x95, 0xa3, 0xdb, 0x2b, 0xd1, 0xc9,
+	0x90, 0x89, 0xe6, 0x30, 0x0c, 0xa2, 0x80, 0x2c, 0xc6, 0x7c, 0xcd, 0x49, 0xbe, 0xe6, 0xd1, 0xed,
+	0xc5, 0x25, 0x27, 0x10, 0x5e, 0x20, 0x56, 0xf6, 0x6c, 0xc1, 0x56, 0x8e, 0x6e, 0xef, 0xb1, 0xc8,
+	0xbe, 0xbd, 0xe2, 0x04, 0xdc, 0x57, 0xb2, 0x8b, 0xd7, 0xd5, 0xbc, 0x85, 0xa3, 0x15, 0x35, 0xd0,
+	0x53, 0xf3, 0xfd, 0xa0, 0x1f, 0x28, 0xba, 0x7c, 0x52, 0x54, 0xf3, 0xef, 0x05, 0x28, 0x6e, 0xdb,
+	0xa1, 0xed, 0x09, 0x72, 0x1f, 0x88, 0xc7, 0x7d, 0xeb, 0x49, 0x10, 0x1e, 0xb2, 0xd0, 0x12, 0x91,
+	0x7d, 0xc8, 0xfd, 0x7e, 0x23, 0xb3, 0x9c, 0xb9, 0x59, 0x5e, 0xbd, 0xde, 0xd4, 0xba, 0xe4, 0xc2,
+	0x4d, 0xbd, 0x70, 0xb3, 0x15, 0x70, 0x9f, 0xd6, 0x3d, 0xee, 0x3f, 0x42, 0x99, 0xae, 0x12, 0x21,
+	0x6f, 0xc1, 0x82, 0x67, 0x1f, 0x6b, 0x45, 0xc2, 0x1a, 0xb2, 0xd0, 0x8a, 0x0e, 0x42, 0x66, 0xbb,
+	0x8d, 0xec, 0x72, 0xe6, 0x66, 0x8e, 0x5e, 0xf5, 0xec, 0x63, 0x25, 0x21, 0xb6, 0x59, 0xd8, 0xc3,
+	0x29, 0xf2, 0x32, 0xd4, 0xe4, 0xea, 0x47, 0xf6, 0x80, 0xbb, 0x76, 0x14, 0x84, 0xa2, 0x91, 0x43,
+	0xe6, 0xaa, 0xc7, 0xfd, 0xdd, 0x84, 0x48, 0x9a, 0x70, 0xd5, 0x09, 0x3c, 0x8f, 0x47, 0xd6, 0xf0,
+	0xc0, 0x16, 0xcc, 0xda, 0x1b, 0x04, 0xce, 0xa1, 0x68, 0xe4, 0x91, 0x77, 0x4e, 0x4d, 0x6d, 0xcb,
+	0x99, 0x75, 0x9c, 0x20, 0x1f, 0xc0, 0xa2, 0x2d, 0x04, 0xf3, 0xf6, 0x06, 0x27, 0x56, 0xc8, 0x9e,
+	0xd8, 0xa1, 0x2b, 0xf7, 0xe3, 0x30, 0x3f, 0xb2, 0xfb, 0xac, 0x51, 0x5c, 0xce, 0xdc, 0xac, 0xd2,
+	0x46, 0xcc, 0x41, 0x91, 0x61, 0x3b, 0x99, 0x27, 0xb7, 0x60, 0x7e, 0x18, 0xb2, 0x23, 0xce, 0x9e,
+	0x4c, 0x2e, 0x37, 0x83, 0xcb, 0x11, 0x3d, 0x97, 0x5e, 0xef, 0x55, 0x98, 0xdd, 0xb7, 0xf9, 0x60,
+	0x14, 0x32, 0x2b, 0x64, 0xc3, 0x20, 0x8c, 0x44, 0xc3, 0xc0, 0x45, 0x6a, 0x9a, 0x4c, 0x15, 0x95,
+	0xbc, 0x0d, 0x0b, 0x31, 0xe3, 0x3e, 0x63, 0xe9, 0x4d, 0x95, 0x90, 0x7f, 0x5e, 0xcf, 0xde, 0x63,
+	0x2c, 0xb5, 0xa1, 0x17, 0xa1, 0xca, 0x7c, 0x27, 0x70, 0x59, 0x68, 0x71, 0x4f, 0x32, 0xc3, 0x72,
+	0xe6, 0x66, 0x89, 0x56, 0x34, 0xb1, 0x23, 0x69, 0x64, 0x15, 0x9e, 0x4b, 0xde, 0x79, 0x62, 0xdb,
+	0x65, 0x75, 0xfc, 0xf1, 0x64, 0x7a, 0xdf, 0xff, 0x0f, 0xcf, 0x8f, 0x65, 0x98, 0x6f, 0x0f, 0xa2,
+	0x93, 0xf4, 0x9e, 0x2a, 0xb8, 0xa7, 0xeb, 0x89, 0xa4, 0xe2, 0x48, 0x6d, 0xec, 0x1d, 0xb8, 0x26,
+	0xef, 0x7c, 0xda, 0xdd, 0x54, 0x71, 0xd5, 0x79, 0xcf, 0x3e, 0x6e, 0x9d, 0xb9, 0x9e, 0x17, 0xa1,
+	0x8a, 0x5c, 0x96, 0x60, 0x4e, 0xe0, 0xbb, 0xa2, 0x51, 0x43, 0xe6, 0x0a, 0x12, 0xbb, 0x8a, 0x26,
+	0xef, 0x3c, 0x64, 0x47, 0xcc, 0x1e, 0x4c, 0xea, 0x9d, 0x55, 0x77, 0xae, 0xa6, 0x52, 0x4a, 0x1f,
+	0xe4, 0x8d, 0x42, 0xbd, 0x48, 0xaf, 0xef, 0x87, 0xb6, 0xc7, 0xa4, 0x27, 0x04, 0xfb, 0xd6, 0x11,
+	0x0b, 0xf9, 0xfe, 0x09, 0xf7, 0xfb, 0xd6, 0x21, 0x3b, 0x31, 0x7f, 0x97, 0x87, 0xca, 0x7d, 0xe6,
+	0x33, 0xc1, 0x45, 0x37, 0xb2, 0x23, 0x46, 0x3e, 0x84, 0xe2, 0x10, 0x9d, 0x40, 0x9b, 0xbb, 0xd9,
+	0x7c, 0xba, 0x0f, 0x36, 0x95, 0xbb, 0xac, 0xe7, 0xbf, 0xf8, 0xfa, 0x85, 0x2b, 0x54, 0xcb, 0x91,
+	0x21, 0x2c, 0x4c, 0x72, 0xf6, 0x6c, 0x71, 0xd8, 0xf1, 0xf7, 0x03, 0x34, 0xe3, 0xf2, 0xea, 0xea,
+	0x79, 0x1a, 0x77, 0xa7, 0x4a, 0xea, 0x15, 0x9e, 0xa2, 0x97, 0x88, 0x69, 0x2b, 0x6e, 0x70, 0x11,
+	0x35, 0xf2, 0xcb, 0xb9, 0x9b, 0xe5, 0xd5, 0x77, 0xce, 0x5b, 0xb1, 0xe3, 0xbb, 0xec, 0x98, 0xb9,
+	0x67, 0x17, 0x7e, 0xfa, 0xa2, 0x52, 0x35, 0x59, 0x87, 0x19, 0xed, 0xd6, 0x8d, 0xc2, 0x72, 0xee,
+	0xa2, 0x93, 0x52, 0x4e, 0xae, 0x55, 0xc6, 0x82, 0xe4, 0x47, 0x50, 0x4d, 0xae, 0xe3, 0x63, 0x76,
+	0x22, 0x1a, 0x45, 0xd4, 0xb4, 0x72, 0x9e, 0xa6, 0x16, 0x0f, 0x9d, 0x11, 0x8f, 0x76, 0x53, 0x72,
+	0x5a, 0xed, 0xa4, 0x2e, 0xf2, 0x08, 0x6a, 0x21, 0x0a, 0x6a, 0x67, 0x90, 0xbe, 0x2a, 0xb5, 0xbf,
+	0x76, 0x9e, 0x76, 0x9a, 0x96, 0xd0, 0x7a, 0x4f, 0xa9, 0x31, 0x1d, 0xb8, 0x3a, 0x65, 0x13, 0xd2,
+	0xdf, 0x1d, 0x45, 0x96, 0x36, 0x26, 0x78, 0xe0, 0xa3, 0x09, 0x95, 0x68, 0xcd, 0x49, 0xb8, 0x25,
+	0x55, 0x5a, 0xfa, 0x84, 0x11, 0x62, 0x2c, 0xac, 0xd0, 0x4a, 0x7a, 0xfb, 0xe6, 0x2f, 0x32, 0x50,
+	0x9d, 0xd8, 0x0c, 0x21, 0x90, 0xf7, 0x6d, 0x8f, 0x69, 0xa5, 0xf8, 0x2c, 0xd7, 0xdc, 0x1b, 0x20,
+	0x57, 0xb2, 0x66, 0x56, 0xad, 0xa9, 0xc9, 0xf1, 0x9a, 0x26, 0x54, 0xec, 0xd0, 0x39, 0xe0, 0x11,
+	0x73, 0xa2, 0x51, 0xc8, 0xd0, 0x14, 0x4b, 0x74, 0x82, 0x46, 0x16, 0xa0, 0xe8, 0xf2, 0x3e, 0x43,
+	0xb3, 0x91, 0xb3, 0x7a, 0x64, 0xfe, 0xbc, 0x00, 0x45, 0x75, 0x7f, 0x64, 0x15, 0x66, 0x6c, 0xd7,
+	0x0d, 0x99, 0x50, 0xee, 0x51, 0x5a, 0x6f, 0xfc, 0xf1, 0xf7, 0x6f, 0xce, 0xeb, 0x84, 0xb0, 0xa6,
+	0x66, 0xba, 0x91, 0x3c, 0x4c, 0x1a, 0x33, 0x92, 0x87, 0x00, 0x21, 0x1b, 0x8e, 0x22, 0x5b, 0x1e,
+	0xb9, 0xf6, 0x81, 0x37, 0x2f, 0xb6, 0x95, 0x26, 0x4d, 0x84, 0x68, 0x4a, 0x01, 0x69, 0xc0, 0x0c,
+	0xf3, 0xed, 0xbd, 0x01, 0x73, 0x71, 0x9b, 0x06, 0x8d, 0x87, 0xe4, 0x15, 0x98, 0x75, 0x46, 0x61,
+	0xc8, 0xfc, 0xc8, 0x8a, 0x6c, 0x71, 0x68, 0x71, 0xb7, 0x51, 0xc0, 0x17, 0xa9, 0x6a, 0x32, 0x3a,
+	0x8c, 0x2b, 0x43, 0x79, 0xc2, 0x87, 0x19, 0xc7, 0xe2, 0xd2, 0x07, 0x30, 0x05, 0x14, 0x28, 0x89,
+	0x99, 0x71, 0x0a, 0xbd, 0x83, 0x3c, 0x0f, 0xa5, 0xe1, 0x68, 0x6f, 0xc0, 0x1d, 0x8b, 0x0f, 0x31,
+	0xe2, 0x97, 0xa8, 0xa1, 0x08, 0x9d, 0x21, 0xb9, 0x06, 0x33, 0x7c, 0xb8, 0x2f, 0xe4, 0x72, 0x86,
+	0x3a, 0x37, 0x39, 0xec, 0xb8, 0x67, 0xce, 0xbc, 0x34, 0xe5, 0xcc, 0x5f, 0x83, 0xfa, 0xa9, 0x0b,
+	0x14, 0x0d, 0x58, 0xce, 0xdd, 0x2c, 0xd1, 0xd9, 0xc9, 0x1b, 0x14, 0x8b, 0xff, 0xcc, 0x00, 0x8c,
+	0xcf, 0x84, 0xdc, 0x86, 0xa2, 0x4c, 0xcc, 0xcc, 0xbd, 0x38, 0x2f, 0x6b, 0x46, 0x79, 0xc1, 0xc3,
+	0x80, 0xfb, 0x91, 0xd0, 0xd9, 0x57, 0x8f, 0xc8, 0x32, 0x94, 0x75, 0xb2, 0xc5, 0xf5, 0x73, 0x78,
+	0x0e, 0x69, 0x12, 0xf9, 0x2f, 0x28, 0x89, 0x60, 0x30, 0x52, 0xf3, 0x79, 0x9c, 0x1f, 0x13, 0xc8,
+	0x1d, 0x30, 0x9e, 0x70, 0xdf, 0xe7, 0x7e, 0x5f, 0x34, 0x0a, 0x17, 0x6c, 0x46, 0xfb, 0x54, 0x22,
+	0x20, 0x4f, 0x40, 0xf9, 0x97, 0xe5, 0x8e, 0x42, 0xbd, 0x03, 0x19, 0x06, 0x72, 0x74, 0x56, 0xd1,
+	0xef, 0xc6, 0x64, 0xf3, 0x37, 0x45, 0x20, 0x67, 0xe3, 0x94, 0x7c, 0xad, 0x08, 0x6f, 0x56, 0xbb,
+	0x86, 0x1e, 0x91, 0x77, 0xa1, 0x14, 0xb2, 0x9f, 0x8c, 0x98, 0x88, 0x58, 0xd8, 0xc8, 0x5e, 0x60,
+	0xae, 0x63, 0x56, 0x52, 0x87, 0x9c, 0xc3, 0x5d, 0xed, 0x22, 0xf2, 0x91, 0xbc, 0x00, 0x65, 0x11,
+	0xd9, 0x61, 0x64, 0x61, 0x22, 0xd1, 0x07, 0x00, 0x48, 0xba, 0x27, 0x29, 0xd2, 0x40, 0x98, 0xef,
+	0xea, 0xe9, 0x02, 0x4e, 0x1b, 0xcc, 0x77, 0xd5, 0xa4, 0x09, 0x15, 0x65, 0x67, 0x6b, 0x5e, 0x30,
+	0xf2, 0x23, 0x6d, 0x67, 0x13, 0x34, 0x79, 0xc0, 0x4e, 0xe0, 0x0d, 0x07, 0x2c, 0x62, 0x2e, 0x5a,
+	0x98, 0x41, 0xc7, 0x04, 0x79, 0xd7, 0x0a, 0xb1, 0x34, 0x8c, 0x0b, 0x8e, 0x97, 0x6a, 0x46, 0xf2,
+	0x00, 0x66, 0xd4, 0x02, 0xa2, 0x51, 0xc2, 0xb0, 0x77, 0xeb, 0x12, 0x69, 0x07, 0x05, 0x69, 0xac,
+	0x80, 0x7c, 0x0c, 0x20, 0xf7, 0x62, 0x87, 0x5c, 0x04, 0x3e, 0xe2, 0x8c, 0xf2, 0xea, 0x1b, 0xe7,
+	0xa9, 0xc3, 0xf7, 0x6e, 0x25, 0x22, 0x34, 0x25, 0x4e, 0xba, 0xa0, 0xef, 0xd5, 0x12, 0x2c, 0x8a,
+	0xd0, 0x66, 0xca, 0xa8, 0xf1, 0xf5, 0x8b, 0xe3, 0x72, 0x57, 0x4b, 0xc4, 0x21, 0x39, 0x1e, 0x93,
+	0x36, 0x18, 0x31, 0x20, 0x41, 0x80, 0x72, 0x41, 0x94, 0xc7, 0xd7, 0x5d, 0xd3, 0x02, 0x34, 0x11,
+	0x25, 0x6b, 0x50, 0x8a, 0xf8, 0x80, 0x59, 0xfd, 0x90, 0xbb, 0x08, 0x56, 0xca, 0xab, 0x2f, 0x9d,
+	0xa7, 0xa7, 0xc7, 0x07, 0xec, 0x7e, 0xc8, 0x5d, 0x6a, 0x44, 0xfa, 0x89, 0xfc, 0x1f, 0x14, 0xc4,
+	0x70, 0xc0, 0x23, 0x84, 0x2f, 0xe5, 0xd5, 0x57, 0xcf, 0x15, 0xc7, 0xf3, 0xed, 0x4a, 0x76, 0xaa,
+	0xa4, 0xa6, 0x05, 0xf4, 0xd9, 0xa9, 0x01, 0x7d, 0x01, 0x8a, 0x12, 0x16, 0x32, 0xb7, 0x51, 0x47,
+	0x6b, 0xd1, 0x23, 0xf3, 0xf3, 0x1c, 0x94, 0x53, 0x7a, 0xc9, 0x06, 0x18, 0x22, 0x0a, 0xed, 0x88,
+	0xf5, 0x4f, 0xd0, 0x3d, 0x6a, 0xe7, 0x1b, 0x42, 0x4a, 0xb4, 0xd9, 0xd5, 0x72, 0x34, 0xd1, 0x20,
+	0xa1, 0x79, 0x8c, 0x82, 0x45, 0x14, 0x72, 0x97, 0xa1, 0x5f, 0x55, 0x69, 0x55, 0x53, 0xbb, 0x48,
+	0x94, 0x3e, 0x1d, 0xb3, 0xb9, 0xcc, 0x76, 0x07, 0xdc, 0x67, 0x1a, 0xc3, 0xcf, 0x6a, 0xfa, 0x5d,
+	0x4d, 0x26, 0x77, 0xa0, 0xe0, 0x04, 0x22, 0x8a, 0x41, 0xc4, 0xcb, 0xcf, 0x60, 0x56, 0x22, 0xa2,
+	0x4a, 0x86, 0xbc, 0x07, 0xa0, 0xf5, 0xb1, 0x50, 0x45, 0x8d, 0xf3, 0x5c, 0x3c, 0xc5, 0x4b, 0xb6,
+	0xa0, 0x2c, 0x46, 0x7b, 0x1e, 0x17, 0x2a, 0xe4, 0x2a, 0x64, 0x70, 0x6e, 0x56, 0xda, 0xd6, 0x6f,
+	0x98, 0x48, 0xd1, 0xb4, 0x06, 0xf3, 0x06, 0x18, 0xf1, 0x79, 0x11, 0x03, 0xf2, 0xed, 0xdd, 0xf6,
+	0x66, 0xfd, 0x0a, 0x29, 0xc3, 0xcc, 0x36, 0x6d, 0xef, 0x76, 0xda, 0x8f, 0xea, 0x99, 0x07, 0x79,
+	0x23, 0x5f, 0x2f, 0xd0, 0x52, 0xb2, 0x0b, 0xf3, 0x0e, 0x94, 0x92, 0x57, 0x22, 0xf3, 0x50, 0x50,
+	0xe1, 0x23, 0x83, 0x07, 0xa5, 0x06, 0x32, 0xdb, 0xc5, 0x78, 0x58, 0x9d, 0x74, 0x3c, 0x34, 0x7f,
+	0x96, 0x81, 0xb9, 0x33, 0x7b, 0x22, 0xb7, 0xa0, 0xa8, 0xc0, 0xd5, 0x85, 0xf9, 0x59, 0xf3, 0x8d,
+	0x2f, 0x20, 0x7b, 0xf9, 0x0b, 0x30, 0xdf, 0x03, 0x23, 0xf6, 0x01, 0xb9, 0x55, 0x27, 0x18, 0x8c,
+	0x3c, 0x5f, 0x61, 0x83, 0x2a, 0x8d, 0x87, 0x12, 0xb9, 0x84, 0xc1, 0x93, 0xf8, 0x0d, 0xf0, 0xd9,
+	0xfc, 0x6d, 0x0e, 0xaa, 0x13, 0x6e, 0x28, 0xc3, 0xb5, 0x76, 0xc4, 0x67, 0xd8, 0xfd, 0x98, 0x35,
+	0x15, 0x1c, 0xb3, 0xcf, 0x1a, 0x1c, 0xff, 0x1b, 0x40, 0x95, 0x04, 0xf2, 0xc2, 0xd0, 0x32, 0xab,
+	0xb4, 0x84, 0x14, 0x2a, 0x6b, 0x80, 0xe7, 0xa1, 0x84, 0xef, 0x6e, 0xc9, 0x34, 0xa0, 0xb0, 0x90,
+	0x81, 0x84, 0x16, 0x47, 0x59, 0x35, 0x79, 0x60, 0x8b, 0x03, 0x0d, 0x30, 0x14, 0xfb, 0x47, 0xb6,
+	0x38, 0x20, 0x2f, 0x41, 0xd5, 0x09, 0xfc, 0x7d, 0x1e, 0x7a, 0xa9, 0x5c, 0x56, 0xa2, 0x93, 0x44,
+	0xb2, 0x04, 0x10, 0xb2, 0xcf, 0x98, 0x13, 0x25, 0xd6, 0x57, 0xa2, 0x29, 0x0a, 0x59, 0x04, 0x03,
+	0xd1, 0x20, 0x67, 0x2a, 0xe4, 0x1b, 0x34, 0x19, 0x9f, 0x2d, 0xfc, 0x4a, 0x53, 0x0a, 0xbf, 0x45,
+	0x30, 0x12, 0xcf, 0x03, 0x34, 0xa8, 0x64, 0x4c, 0xde, 0x80, 0x39, 0x15, 0x2c, 0xac, 0xe4, 0x10,
+	0x65, 0x0c, 0x96, 0x7b, 0xa8, 0xab, 0x89, 0xb5, 0x84, 0x6e, 0x7e, 0x9d, 0x87, 0xda, 0x64, 0xf0,
+	0x25, 0x1d, 0x28, 0x32, 0xbf, 0x2f, 0x35, 0xab, 0x80, 0x72, 0xfb, 0xd9, 0x03, 0x77, 0xb3, 0x8d,
+	0x82, 0x54, 0x2b, 0x20, 0x37, 0xa0, 0x12, 0xb2, 0x18, 0x48, 0x58, 0xc7, 0xda, 0x42, 0xca, 0x63,
+	0xda, 0x27, 0xa7, 0x58, 0x4e, 0x1a, 0xb9, 0xd3, 0x2c, 0x8f, 0xc9, 0x5b, 0xf0, 0x5c, 0x8a, 0x25,
+	0x55, 0xab, 0xe6, 0x55, 0xfd, 0x3c, 0x9e, 0x4c, 0x95, 0xa9, 0xd2, 0xb3, 0x6c, 0x99, 0x60, 0x15,
+	0x66, 0xa9, 0xd2, 0x78, 0x28, 0x3d, 0x51, 0x38, 0xcc, 0x57, 0x3d, 0x81, 0x12, 0x55, 0x03, 0x19,
+	0x70, 0x1d, 0xdb, 0x63, 0xa1, 0xad, 0x01, 0xa0, 0x1e, 0x29, 0x7b, 0x60, 0x4f, 0xac, 0x81, 0x7d,
+	0xc2, 0x42, 0x8d, 0x00, 0x4b, 0x92, 0xb2, 0x21, 0x09, 0xe4, 0xc7, 0x50, 0x0d, 0x46, 0xd1, 0x70,
+	0x14, 0x59, 0xfb, 0x81, 0xbc, 0x7e, 0xbc, 0xad, 0xda, 0xea, 0xff, 0x5e, 0xe2, 0xcc, 0xb6, 0x50,
+	0xfe, 0x1e, 0x8a, 0xd3, 0x4a, 0x90, 0x1a, 0xc9, 0xfa, 0x5e, 0x19, 0x72, 0xc4, 0x3d, 0x16, 0x8c,
+	0xa2, 0xa4, 0x78, 0x06, 0x7c, 0xa5, 0xab, 0x38, 0xd9, 0x53, 0x73, 0xba, 0x86, 0x36, 0x9b, 0x50,
+	0x54, 0xb7, 0x40, 0x00, 0x8a, 0xad, 0xc7, 0xad, 0x8d, 0x76, 0xb7, 0x7e, 0x85, 0x94, 0xa0, 0xd0,
+	0x6e, 0xef, 0xb6, 0xdb, 0xf5, 0x0c, 0xa9, 0x42, 0xe9, 0xd1, 0x16, 0xfd, 0x78, 0xbd, 0xbd, 0xd9,
+	0xfa, 0xa8, 0x9e, 0x35, 0x3f, 0x85, 0x4a, 0x7a, 0x07, 0x64, 0x06, 0x72, 0xdb, 0x9b, 0xf7, 0x95,
+	0xc8, 0xf6, 0xe6, 0xfd, 0xdb, 0xef, 0xd6, 0x33, 0x32, 0xe2, 0x3d, 0xd8, 0x6e, 0xdf, 0xaf, 0x67,
+	0xe5, 0x53, 0xaf, 0x73, 0xef, 0x5e, 0x3d, 0x47, 0x2a, 0x60, 0x6c, 0x6d, 0xb7, 0x37, 0xad, 0xf6,
+	0x27, 0xb4, 0x9e, 0x27, 0xd7, 0xe0, 0x6a, 0x3c, 0xb2, 0x1e, 0xee, 0x6c, 0xf4, 0x3a, 0x1b, 0x6b,
+	0x8f, 0xdb, 0xb4, 0x5e, 0x30, 0xff, 0x94, 0x81, 0xd9, 0x53, 0x78, 0x81, 0xdc, 0x85, 0xbc, 0x17,
+	0xb8, 0xec, 0x59, 0x12, 0xd6, 0x29, 0xd1, 0xe6, 0xc3, 0xc0, 0x65, 0x14, 0xa5, 0xc9, 0xeb, 0x30,
+	0x27, 0x1b, 0x11, 0xd2, 0x4f, 0x2d, 0x97, 0x8b, 0xc8, 0xf6, 0x9d, 0x38, 0x5f, 0xcd, 0x7a, 0xf6,
+	0xb1, 0x74, 0xd7, 0xbb, 0x9a, 0x2c, 0x1b, 0x0b, 0xb2, 0xe7, 0x84, 0xd9, 0x5f, 0x70, 0x8f, 0x0f,
+	0xec, 0x90, 0x47, 0xb1, 0xb1, 0xcd, 0x79, 0xdc, 0x97, 0x61, 0xae, 0x9b, 0x4c, 0x98, 0x37, 0x20,
+	0x2f, 0x57, 0xc2, 0x63, 0xfb, 0x64, 0xad, 0xd5, 0xab, 0x5f, 0x21, 0x35, 0x80, 0xed, 0x36, 0x6d,
+	0xb5, 0xb7, 0x7b, 0x3b, 0x6b, 0x1b, 0xf5, 0x8c, 0xf9, 0x0f, 0x02, 0xf3, 0xd3, 0x70, 0x95, 0x0c,
+	0x2f, 0x71, 0xdd, 0x11, 0x43, 0x56, 0x43, 0x11, 0x3a, 0xae, 0xac, 0x26, 0xe2, 0xe2, 0x25, 0x3b,
+	0x81, 0x66, 0x4f, 0x61, 0x50, 0x95, 0x4e, 0x9f, 0x8a, 0x41, 0x55, 0x17, 0x6c, 0x8c, 0x41, 0x27,
+	0xf0, 0x65, 0xe1, 0x34, 0xbe, 0x6c, 0x8c, 0x6b, 0x79, 0x15, 0xae, 0xe2, 0x21, 0xd9, 0x01, 0x23,
+	0xf6, 0x1d, 0xb4, 0xfb, 0xf2, 0xea, 0xfb, 0x97, 0xc5, 0x91, 0xcd, 0xae, 0x56, 0x40, 0x13, 0x55,
+	0xe4, 0xd3, 0xc9, 0x8a, 0xc3, 0xc0, 0xd4, 0x73, 0xe7, 0xd2, 0x9a, 0x77, 0x13, 0x1d, 0x93, 0xe5,
+	0xca, 0xdb, 0xb0, 0x60, 0x1f, 0xb1, 0xd0, 0xee, 0x33, 0x2b, 0xc1, 0x9a, 0xca, 0x2f, 0x4a, 0xaa,
+	0x03, 0xa5, 0x67, 0x63, 0x47, 0xc3, 0x39, 0x09, 0x6e, 0x54, 0x08, 0xd0, 0x87, 0xa8, 0x2a, 0xb1,
+	0x1c, 0xad, 0x6a, 0x2a, 0x9e, 0xa4, 0x50, 0x01, 0x49, 0x29, 0x1d, 0x04, 0x51, 0xdc, 0x4a, 0x2b,
+	0x2b, 0x5a, 0x57, 0x92, 0xc8, 0x06, 0x14, 0xb0, 0x3f, 0x85, 0x58, 0xb4, 0xb6, 0xfa, 0xee, 0xa5,
+	0x5f, 0x0c, 0x7b, 0x58, 0x54, 0x29, 0xc1, 0xc6, 0x82, 0x6a, 0xa6, 0x25, 0x21, 0x5d, 0x35, 0xd2,
+	0x6a, 0x8a, 0x9c, 0x60, 0xa9, 0x49, 0x9c, 0x5e, 0xfb, 0xb7, 0xe3, 0xf4, 0xd9, 0xef, 0x8d, 0xd3,
+	0x27, 0x00, 0x76, 0xfd, 0x3b, 0x01, 0x6c, 0x02, 0x79, 0xf9, 0xdc, 0x98, 0x53, 0x60, 0x42, 0x3e,
+	0x4f, 0x43, 0xcd, 0x64, 0x2a, 0x6a, 0x16, 0x70, 0x75, 0xe4, 0xab, 0x3d, 0xc9, 0x9e, 0x41, 0x7c,
+	0xcf, 0x57, 0xd1, 0xfe, 0xd6, 0x2f, 0x7d, 0x4d, 0x3b, 0x29, 0x5d, 0x78, 0x96, 0x94, 0x8c, 0x4e,
+	0x93, 0xb0, 0xb3, 0xa9, 0xef, 0x4f, 0xb7, 0x2b, 0xe7, 0x55, 0x67, 0x53, 0x11, 0xc7, 0xdd, 0x62,
+	0xdd, 0xd9, 0x4c, 0x2e, 0xf9, 0x39, 0x75, 0xc9, 0x8a, 0x9c, 0x5c, 0xf2, 0x0d, 0xd0, 0x82, 0x56,
+	0x18, 0x8c, 0x7c, 0xb7, 0xb1, 0xa0, 0xf2, 0xa1, 0xa2, 0x51, 0x49, 0x22, 0xef, 0x41, 0x23, 0xc9,
+	0x86, 0xa7, 0x95, 0x5e, 0x43, 0xa5, 0x0b, 0xf1, 0x3c, 0x9d, 0x54, 0x8e, 0xf8, 0x3e, 0x18, 0x06,
+	0xc2, 0x1e, 0x68, 0xf5, 0x8d, 0x18, 0xdf, 0x2b, 0x2a, 0x2e, 0xb0, 0xf8, 0xcb, 0x1c, 0x18, 0xb1,
+	0x57, 0x93, 0xf7, 0xa1, 0xac, 0x66, 0x99, 0x6b, 0xed, 0x9d, 0x5c, 0x88, 0xdc, 0x20, 0x66, 0x5e,
+	0x3f, 0x21, 0x9b, 0x50, 0xd4, 0x37, 0xa0, 0xc0, 0xe7, 0xe5, 0x1d, 0x45, 0x9d, 0xba, 0xd6, 0x22,
+	0x73, 0xb1, 0xee, 0xd3, 0xc8, 0xb6, 0x9a, 0x2a, 0xe0, 0x75, 0xe7, 0x46, 0x76, 0xe8, 0xea, 0x90,
+	0x73, 0x79, 0xa8, 0x11, 0x9d, 0x7c, 0x94, 0x30, 0xc9, 0x76, 0x1c, 0x36, 0x1c, 0x47, 0xc5, 0x64,
+	0x2c, 0x03, 0xae, 0xc7, 0xc2, 0xc3, 0x01, 0xb3, 0xc2, 0x20, 0x88, 0x34, 0x18, 0x00, 0x45, 0xa2,
+	0x41, 0x10, 0xc9, 0x9b, 0xc0, 0xdc, 0x12, 0x9b, 0x9c, 0xca, 0xfd, 0x65, 0x49, 0x8b, 0xed, 0xed,
+	0xd5, 0xb3, 0x4e, 0xa4, 0xd0, 0xda, 0x69, 0xc7, 0x48, 0x18, 0x59, 0x68, 0xe9, 0x26, 0x1c, 0xa4,
+	0x19, 0x59, 0x78, 0x17, 0xa9, 0x0f, 0xf2, 0xc6, 0x4c, 0xdd, 0xa0, 0xd5, 0x9f, 0x1e, 0xea, 0x06,
+	0xbb, 0xc7, 0xfc, 0x68, 0xf1, 0x6f, 0x19, 0x80, 0x71, 0x2c, 0x94, 0x48, 0x3a, 0xf9, 0x78, 0x72,
+	0x31, 0x92, 0x4e, 0x58, 0xff, 0xd3, 0xd7, 0xf1, 0x02, 0x94, 0x53, 0x81, 0x14, 0xcf, 0x3f, 0x47,
+	0x61, 0x1c, 0x47, 0x75, 0xc1, 0x04, 0x5c, 0xa0, 0x15, 0x87, 0x82, 0x2d, 0x7e, 0x9e, 0x85, 0xb9,
+	0x33, 0x4e, 0xf7, 0x1d, 0x8a, 0x9e, 0xa4, 0xd8, 0xca, 0xa6, 0x8b, 0x2d, 0x5b, 0x56, 0x12, 0xb6,
+	0xd0, 0x5d, 0xca, 0xda, 0x6a, 0xe7, 0xfb, 0x07, 0x84, 0x26, 0x45, 0x85, 0x54, 0x2b, 0x46, 0x83,
+	0x8b, 0x22, 0xe6, 0x0d, 0x23, 0xa1, 0xd1, 0x69, 0x32, 0x96, 0xa9, 0x7f, 0x10, 0xf4, 0xb1, 0xe8,
+	0x50, 0x65, 0x45, 0x71, 0x10, 0xf4, 0x5b, 0xdc, 0x35, 0xdf, 0x86, 0xa2, 0x52, 0x23, 0xeb, 0xc9,
+	0x5e, 0xe7, 0x61, 0x7b, 0x6b, 0xa7, 0xa7, 0xf0, 0x57, 0x8b, 0xae, 0x75, 0x3f, 0xaa, 0x67, 0x08,
+	0x81, 0xda, 0xc3, 0x4e, 0xb7, 0xdb, 0xd9, 0xbc, 0x6f, 0x6d, 0xed, 0xf4, 0xb6, 0x77, 0x7a, 0xf5,
+	0xec, 0xe2, 0x1f, 0x32, 0x50, 0x50, 0xe7, 0xb3, 0x08, 0xc6, 0x3e, 0x1f, 0xb0, 0x54, 0xf7, 0x38,
+	0x19, 0x63, 0x67, 0x8f, 0xf7, 0x7d, 0x1b, 0x3b, 0x94, 0x0a, 0x71, 0x8c, 0x09, 0x53, 0x5a, 0x61,
+	0x04, 0xf2, 0x58, 0xf8, 0x28, 0x27, 0xc2, 0x67, 0x59, 0xcd, 0xa0, 0x11, 0xb5, 0xb0, 0xbd, 0xa5,
+	0xef, 0x71, 0x4c, 0x91, 0x0d, 0x30, 0xee, 0xa7, 0x38, 0x8a, 0x2a, 0xfe, 0xa5, 0x69, 0x52, 0xc7,
+	0xd8, 0xa0, 0x35, 0xc4, 0x4e, 0x51, 0xcc, 0x26, 0x14, 0x30, 0x29, 0x4a, 0x74, 0x4a, 0xdb, 0x9b,
+	0x77, 0xdb, 0xb4, 0x83, 0x20, 0x54, 0x62, 0xd8, 0xad, 0x87, 0x0f, 0x3b, 0xbd, 0x7a, 0x46, 0x3e,
+	0xd3, 0xf6, 0x6e, 0x7b, 0x6d, 0xa3, 0x9e, 0x35, 0x6f, 0xc1, 0xc2, 0xf4, 0x6f, 0x29, 0x12, 0xc8,
+	0xfb, 0xec, 0x38, 0xd2, 0xed, 0xc2, 0x1c, 0xd5, 0x23, 0xf3, 0xd7, 0x19, 0xb8, 0xfe, 0xd4, 0x8f,
+	0x21, 0xd2, 0x62, 0x54, 0x97, 0x58, 0x1d, 0xa0, 0x1a, 0x10, 0x17, 0xc8, 0xd9, 0xcf, 0x23, 0xba,
+	0x0e, 0x6d, 0x5e, 0xee, 0x3b, 0x8f, 0x6e, 0x8c, 0x4e, 0xd1, 0x67, 0xfe, 0x35, 0x7b, 0xba, 0xef,
+	0xb9, 0x11, 0xf4, 0xb1, 0x48, 0x8c, 0x61, 0xe3, 0x19, 0x18, 0xd9, 0x83, 0xfc, 0x20, 0xe8, 0xc7,
+	0x8e, 0xfc, 0xe1, 0xb3, 0x6f, 0x45, 0x6a, 0x3e, 0x4b, 0xa2, 0xa8, 0x6d, 0xf1, 0xab, 0x0c, 0xcc,
+	0x9d, 0x99, 0x93, 0x46, 0x32, 0x08, 0xfa, 0xda, 0x78, 0xe4, 0xa3, 0x34, 0x2a, 0x59, 0x90, 0x88,
+	0xc8, 0xf6, 0x86, 0x1a, 0xa9, 0x8e, 0x09, 0x84, 0x81, 0x21, 0xa4, 0x3f, 0x4b, 0x80, 0x9d, 0xbf,
+	0xac, 0xa3, 0x4d, 0xdf, 0x5f, 0xb3, 0xdb, 0xde, 0x6d, 0xd3, 0x4e, 0xef, 0x31, 0x4d, 0x54, 0x9b,
+	0xff, 0x03, 0x46, 0x4c, 0x95, 0x55, 0x49, 0x67, 0xf3, 0xde, 0x96, 0xea, 0xc8, 0x74, 0x77, 0x5a,
+	0xad, 0x76, 0xb7, 0x5b, 0xcf, 0x20, 0x7a, 0xa7, 0x74, 0x8b, 0xd6, 0xb3, 0xe6, 0x9f, 0xb3, 0x50,
+	0x41, 0x6f, 0xe9, 0xf2, 0xbe, 0x7f, 0x37, 0x70, 0x24, 0x26, 0x4e, 0x7f, 0xc6, 0xa9, 0xd2, 0x78,
+	0x88, 0xdf, 0x49, 0x02, 0xcf, 0xe6, 0xf1, 0xb7, 0x16, 0x3d, 0x22, 0xd7, 0xc1, 0x70, 0x0e, 0x6c,
+	0xee, 0x5b, 0x89, 0xc7, 0xcc, 0xe0, 0x78, 0x12, 0xd5, 0xe7, 0x27, 0x50, 0xfd, 0x44, 0x2d, 0x50,
+	0x38, 0x75, 0x89, 0x37, 0xa0, 0xa2, 0xaa, 0x3b, 0x7f, 0xe4, 0xed, 0xb1, 0x50, 0xfb, 0x4d, 0x19,
+	0x69, 0x9b, 0x48, 0x4a, 0xdc, 0x71, 0x26, 0xe5, 0x8e, 0x2f, 0x43, 0x4d, 0xfe, 0x5a, 0xf6, 0xa0,
+	0x1f, 0x84, 0x3c, 0x3a, 0xf0, 0x74, 0x66, 0xaa, 0x4a, 0xea, 0x5a, 0x4c, 0x24, 0x3f, 0x80, 0x9a,
+	0xfe, 0xc0, 0x1f, 0x7f, 0xd2, 0x29, 0x5d, 0x10, 0x3d, 0xab, 0x8a, 0x5f, 0x13, 0xa7, 0x25, 0x37,
+	0x98, 0x96, 0xdc, 0xd6, 0x3f, 0xf8, 0xe2, 0x9b, 0xa5, 0xcc, 0x97, 0xdf, 0x2c, 0x65, 0xfe, 0xf2,
+	0xcd, 0x52, 0xe6, 0x57, 0xdf, 0x2e, 0x5d, 0xf9, 0xf2, 0xdb, 0xa5, 0x2b, 0x5f, 0x7d, 0xbb, 0x74,
+	0xe5, 0x87, 0x66, 0x9f, 0x47, 0x07, 0xa3, 0xbd, 0xa6, 0x13, 0x78, 0x2b, 0x4f, 0xf9, 0x4f, 0xc4,
+	0x5e, 0x11, 0xff, 0x9e, 0xf0, 0xd6, 0xbf, 0x06, 0x00, 0x0d, 0xf0, 0x23, 0x1b, 0x35, 0x21, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RevealPhaseBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealPhaseBlocks))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.BlockSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockSeconds))
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.MaxCommitPhaseBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCommitPhaseBlocks))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTypes(uint64(m.StartFrame))
+	if m.AssemblyPenaltyPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AssemblyPenaltyPercentage))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTypes(uint64(m.EndFrame))
+	if m.AssemblyPhaseBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AssemblyPhaseBlocks))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.ThreadAmount != 0 {
-		n += 1 + sovTypes(uint64(m.ThreadAmount))
+	if len(m.EncoderImage) > 0 {
+		i -= len(m.EncoderImage)
+		copy(dAtA[i:], m.EncoderImage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncoderImage)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Completed {
-		n += 2
+	if m.FailureFeePercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailureFeePercentage))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Reward != nil {
-		l = m.Reward.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.FailureReports != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailureReports))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Threads) > 0 {
-		for _, e := range m.Threads {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.PreviewPhaseBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PreviewPhaseBlocks))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *VideoRenderingThread) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AssemblyRewardPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AssemblyRewardPercentage))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.CommitPhaseBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitPhaseBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTypes(uint64(m.StartFrame))
-	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTypes(uint64(m.EndFrame))
-	}
-	if m.Completed {
-		n += 2
-	}
-	if len(m.Workers) > 0 {
-		for _, s := range m.Workers {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.MinValidators != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Solution != nil {
-		l = m.Solution.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.MaxWorkersPerThread != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWorkersPerThread))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Validations) > 0 {
-		for _, e := range m.Validations {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.MinWorkerStaking != nil {
+		{
+			size, err := m.MinWorkerStaking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.AverageRenderSeconds != 0 {
-		n += 1 + sovTypes(uint64(m.AverageRenderSeconds))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VideoRenderingThread_Solution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProposedBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RendererImages) > 0 {
+		for iNdEx := len(m.RendererImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RendererImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if len(m.Frames) > 0 {
-		for _, e := range m.Frames {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.VerifyingKeys) > 0 {
+		for iNdEx := len(m.VerifyingKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifyingKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.VideoRenderingTaskList) > 0 {
+		for iNdEx := len(m.VideoRenderingTaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VideoRenderingTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Accepted {
-		n += 2
+	{
+		size, err := m.VideoRenderingTaskInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *VideoRenderingThread_Validation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitVerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitVerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitVerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Frames) > 0 {
-		for _, e := range m.Frames {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsReverse {
-		n += 2
+	if len(m.CircuitVersion) > 0 {
+		i -= len(m.CircuitVersion)
+		copy(dAtA[i:], m.CircuitVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CircuitVersion)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VideoRenderingThread_Frame) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RendererImage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RendererImage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RendererImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Architecture) > 0 {
+		i -= len(m.Architecture)
+		copy(dAtA[i:], m.Architecture)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Architecture)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ValidCount != 0 {
-		n += 1 + sovTypes(uint64(m.ValidCount))
+	if len(m.BlenderVersion) > 0 {
+		i -= len(m.BlenderVersion)
+		copy(dAtA[i:], m.BlenderVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlenderVersion)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InvalidCount != 0 {
-		n += 1 + sovTypes(uint64(m.InvalidCount))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VideoRenderingTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Worker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NextId != 0 {
-		n += 1 + sovTypes(uint64(m.NextId))
+	if len(m.BlenderVersions) > 0 {
+		for iNdEx := len(m.BlenderVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlenderVersions[iNdEx])
+			copy(dAtA[i:], m.BlenderVersions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.BlenderVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return n
-}
-
-func (m *IndexedVideoRenderingTask) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Architecture) > 0 {
+		i -= len(m.Architecture)
+		copy(dAtA[i:], m.Architecture)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Architecture)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.IpfsId) > 0 {
+		i -= len(m.IpfsId)
+		copy(dAtA[i:], m.IpfsId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IpfsId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CurrentThreadIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentThreadIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CurrentTaskId) > 0 {
+		i -= len(m.CurrentTaskId)
+		copy(dAtA[i:], m.CurrentTaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentTaskId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reputation != nil {
+		{
+			size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Worker_Reputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker_Reputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Worker_Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RenderDurations) > 0 {
+		dAtA6 := make([]byte, len(m.RenderDurations)*10)
+		var j5 int
+		for _, num1 := range m.RenderDurations {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = m.VideoRenderingTask.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	{
+		size, err := m.Winnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Solutions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Solutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Validations != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Validations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Points != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Staked != nil {
+		{
+			size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VideoRenderingLogs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VideoRenderingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.BlenderVersion) > 0 {
+		i -= len(m.BlenderVersion)
+		copy(dAtA[i:], m.BlenderVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlenderVersion)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Split != nil {
+		{
+			size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TileGrid != nil {
+		{
+			size, err := m.TileGrid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Assembly != nil {
+		{
+			size, err := m.Assembly.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RenderSettings != nil {
+		{
+			size, err := m.RenderSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Comparison != nil {
+		{
+			size, err := m.Comparison.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Threads) > 0 {
+		for iNdEx := len(m.Threads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Reward != nil {
+		{
+			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ThreadAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThreadAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThreadSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThreadSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThreadSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Previewers) > 0 {
+		for iNdEx := len(m.Previewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Previewers[iNdEx])
+			copy(dAtA[i:], m.Previewers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Previewers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Costs) > 0 {
+		for iNdEx := len(m.Costs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Costs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PreviewDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PreviewDeadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviewStride != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PreviewStride))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Frame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreviewSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreviewSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreviewSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Costs) > 0 {
+		for iNdEx := len(m.Costs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Costs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TileGrid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TileGrid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TileGrid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rows != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Columns != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Columns))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoAssembly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoAssembly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoAssembly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedAssemblers) > 0 {
+		for iNdEx := len(m.FailedAssemblers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedAssemblers[iNdEx])
+			copy(dAtA[i:], m.FailedAssemblers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.FailedAssemblers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.EncoderImage) > 0 {
+		i -= len(m.EncoderImage)
+		copy(dAtA[i:], m.EncoderImage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncoderImage)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rejections[iNdEx])
+			copy(dAtA[i:], m.Rejections[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Rejections[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Confirmations[iNdEx])
+			copy(dAtA[i:], m.Confirmations[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Confirmations[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VideoHash) > 0 {
+		i -= len(m.VideoHash)
+		copy(dAtA[i:], m.VideoHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VideoHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VideoCid) > 0 {
+		i -= len(m.VideoCid)
+		copy(dAtA[i:], m.VideoCid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VideoCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FrameRate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FrameRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reward != nil {
+		{
+			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Assembler) > 0 {
+		i -= len(m.Assembler)
+		copy(dAtA[i:], m.Assembler)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Assembler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrameTimeoutSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FrameTimeoutSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OutputFormat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OutputFormat))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ViewLayer) > 0 {
+		i -= len(m.ViewLayer)
+		copy(dAtA[i:], m.ViewLayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ViewLayer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Camera) > 0 {
+		i -= len(m.Camera)
+		copy(dAtA[i:], m.Camera)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Camera)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Scene) > 0 {
+		i -= len(m.Scene)
+		copy(dAtA[i:], m.Scene)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Scene)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Samples != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ResolutionPercentage != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolutionPercentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResolutionY != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolutionY))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResolutionX != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolutionX))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Engine != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Engine))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinTileSimilarity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinTileSimilarity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxHashDistance != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxHashDistance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingThread) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingThread) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalRound != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalRound))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.SolutionRevealDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SolutionRevealDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.CommitRound != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitRound))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RevealDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RevealDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CommitBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.UnrenderableFrames) > 0 {
+		for iNdEx := len(m.UnrenderableFrames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnrenderableFrames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.BlenderVersion) > 0 {
+		i -= len(m.BlenderVersion)
+		copy(dAtA[i:], m.BlenderVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BlenderVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Tile != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Tile))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TileGrid != nil {
+		{
+			size, err := m.TileGrid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RenderSettings != nil {
+		{
+			size, err := m.RenderSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Comparison != nil {
+		{
+			size, err := m.Comparison.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.CommitDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CommitDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Phase != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RenderSlots != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RenderSlots))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.SampledFrames) > 0 {
+		dAtA20 := make([]byte, len(m.SampledFrames)*10)
+		var j19 int
+		for _, num1 := range m.SampledFrames {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTypes(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AverageRenderSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AverageRenderSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Solution != nil {
+		{
+			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Workers[iNdEx])
+			copy(dAtA[i:], m.Workers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Workers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingThread_Solution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingThread_Solution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingThread_Solution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RendererDigest) > 0 {
+		i -= len(m.RendererDigest)
+		copy(dAtA[i:], m.RendererDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RendererDigest)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RenderSettings) > 0 {
+		i -= len(m.RenderSettings)
+		copy(dAtA[i:], m.RenderSettings)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RenderSettings)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.HashVersion) > 0 {
+		i -= len(m.HashVersion)
+		copy(dAtA[i:], m.HashVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.HashVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingThread_Validation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingThread_Validation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingThread_Validation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenderSlot != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RenderSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogCid) > 0 {
+		i -= len(m.LogCid)
+		copy(dAtA[i:], m.LogCid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LogCid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Frame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingThread_Frame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingThread_Frame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingThread_Frame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InvalidCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedVideoRenderingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedVideoRenderingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedVideoRenderingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VideoRenderingTask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RenderSettings) > 0 {
+		i -= len(m.RenderSettings)
+		copy(dAtA[i:], m.RenderSettings)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RenderSettings)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.WorkerAddress) > 0 {
+		i -= len(m.WorkerAddress)
+		copy(dAtA[i:], m.WorkerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WorkerAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.HashAlgorithm) > 0 {
+		i -= len(m.HashAlgorithm)
+		copy(dAtA[i:], m.HashAlgorithm)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.HashAlgorithm)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FrameNumber != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FrameNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinWorkerStaking != nil {
+		l = m.MinWorkerStaking.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxWorkersPerThread != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWorkersPerThread))
+	}
+	if m.MinValidators != 0 {
+		n += 1 + sovTypes(uint64(m.MinValidators))
+	}
+	if m.CommitPhaseBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.CommitPhaseBlocks))
+	}
+	if m.AssemblyRewardPercentage != 0 {
+		n += 1 + sovTypes(uint64(m.AssemblyRewardPercentage))
+	}
+	if m.PreviewPhaseBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.PreviewPhaseBlocks))
+	}
+	if m.FailureReports != 0 {
+		n += 1 + sovTypes(uint64(m.FailureReports))
+	}
+	if m.FailureFeePercentage != 0 {
+		n += 1 + sovTypes(uint64(m.FailureFeePercentage))
+	}
+	l = len(m.EncoderImage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AssemblyPhaseBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.AssemblyPhaseBlocks))
+	}
+	if m.AssemblyPenaltyPercentage != 0 {
+		n += 1 + sovTypes(uint64(m.AssemblyPenaltyPercentage))
+	}
+	if m.MaxCommitPhaseBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCommitPhaseBlocks))
+	}
+	if m.BlockSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.BlockSeconds))
+	}
+	if m.RevealPhaseBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.RevealPhaseBlocks))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.VideoRenderingTaskInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.VideoRenderingTaskList) > 0 {
+		for _, e := range m.VideoRenderingTaskList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.VerifyingKeys) > 0 {
+		for _, e := range m.VerifyingKeys {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RendererImages) > 0 {
+		for _, e := range m.RendererImages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitVerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CircuitVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RendererImage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BlenderVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Architecture)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Worker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reputation != nil {
+		l = m.Reputation.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.CurrentTaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CurrentThreadIndex != 0 {
+		n += 1 + sovTypes(uint64(m.CurrentThreadIndex))
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IpfsId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Architecture)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.BlenderVersions) > 0 {
+		for _, s := range m.BlenderVersions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Worker_Reputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Staked != nil {
+		l = m.Staked.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovTypes(uint64(m.Points))
+	}
+	if m.Validations != 0 {
+		n += 1 + sovTypes(uint64(m.Validations))
+	}
+	if m.Solutions != 0 {
+		n += 1 + sovTypes(uint64(m.Solutions))
+	}
+	l = m.Winnings.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RenderDurations) > 0 {
+		l = 0
+		for _, e := range m.RenderDurations {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *VideoRenderingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTypes(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTypes(uint64(m.EndFrame))
+	}
+	if m.ThreadAmount != 0 {
+		n += 1 + sovTypes(uint64(m.ThreadAmount))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.Reward != nil {
+		l = m.Reward.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Threads) > 0 {
+		for _, e := range m.Threads {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Comparison != nil {
+		l = m.Comparison.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RenderSettings != nil {
+		l = m.RenderSettings.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Assembly != nil {
+		l = m.Assembly.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TileGrid != nil {
+		l = m.TileGrid.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BlenderVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Failed {
+		n += 3
+	}
+	return n
+}
+
+func (m *ThreadSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovTypes(uint64(m.Strategy))
+	}
+	if m.PreviewStride != 0 {
+		n += 1 + sovTypes(uint64(m.PreviewStride))
+	}
+	if m.PreviewDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.PreviewDeadline))
+	}
+	if len(m.Costs) > 0 {
+		for _, e := range m.Costs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Previewers) > 0 {
+		for _, s := range m.Previewers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrameCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frame != 0 {
+		n += 1 + sovTypes(uint64(m.Frame))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovTypes(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *PreviewSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Costs) > 0 {
+		for _, e := range m.Costs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TileGrid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Columns != 0 {
+		n += 1 + sovTypes(uint64(m.Columns))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovTypes(uint64(m.Rows))
+	}
+	return n
+}
+
+func (m *VideoAssembly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Assembler)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reward != nil {
+		l = m.Reward.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FrameRate != 0 {
+		n += 1 + sovTypes(uint64(m.FrameRate))
+	}
+	l = len(m.VideoCid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VideoHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Confirmations) > 0 {
+		for _, s := range m.Confirmations {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Rejections) > 0 {
+		for _, s := range m.Rejections {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.EncoderImage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	if len(m.FailedAssemblers) > 0 {
+		for _, s := range m.FailedAssemblers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RenderSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Engine != 0 {
+		n += 1 + sovTypes(uint64(m.Engine))
+	}
+	if m.ResolutionX != 0 {
+		n += 1 + sovTypes(uint64(m.ResolutionX))
+	}
+	if m.ResolutionY != 0 {
+		n += 1 + sovTypes(uint64(m.ResolutionY))
+	}
+	if m.ResolutionPercentage != 0 {
+		n += 1 + sovTypes(uint64(m.ResolutionPercentage))
+	}
+	if m.Samples != 0 {
+		n += 1 + sovTypes(uint64(m.Samples))
+	}
+	l = len(m.Scene)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Camera)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ViewLayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OutputFormat != 0 {
+		n += 1 + sovTypes(uint64(m.OutputFormat))
+	}
+	if m.FrameTimeoutSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.FrameTimeoutSeconds))
+	}
+	return n
+}
+
+func (m *FrameComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	if m.MaxHashDistance != 0 {
+		n += 1 + sovTypes(uint64(m.MaxHashDistance))
+	}
+	if m.MinTileSimilarity != 0 {
+		n += 1 + sovTypes(uint64(m.MinTileSimilarity))
+	}
+	return n
+}
+
+func (m *VideoRenderingThread) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTypes(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTypes(uint64(m.EndFrame))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if len(m.Workers) > 0 {
+		for _, s := range m.Workers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Solution != nil {
+		l = m.Solution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AverageRenderSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.AverageRenderSeconds))
+	}
+	if len(m.SampledFrames) > 0 {
+		l = 0
+		for _, e := range m.SampledFrames {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.RenderSlots != 0 {
+		n += 1 + sovTypes(uint64(m.RenderSlots))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTypes(uint64(m.Phase))
+	}
+	if m.CommitDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.CommitDeadline))
+	}
+	if m.Comparison != nil {
+		l = m.Comparison.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RenderSettings != nil {
+		l = m.RenderSettings.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TileGrid != nil {
+		l = m.TileGrid.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Tile != 0 {
+		n += 2 + sovTypes(uint64(m.Tile))
+	}
+	l = len(m.BlenderVersion)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.UnrenderableFrames) > 0 {
+		for _, e := range m.UnrenderableFrames {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CommitBlocks != 0 {
+		n += 2 + sovTypes(uint64(m.CommitBlocks))
+	}
+	if m.RevealDeadline != 0 {
+		n += 2 + sovTypes(uint64(m.RevealDeadline))
+	}
+	if m.CommitRound != 0 {
+		n += 2 + sovTypes(uint64(m.CommitRound))
+	}
+	if m.SolutionRevealDeadline != 0 {
+		n += 2 + sovTypes(uint64(m.SolutionRevealDeadline))
+	}
+	if m.ProposalRound != 0 {
+		n += 2 + sovTypes(uint64(m.ProposalRound))
+	}
+	return n
+}
+
+func (m *VideoRenderingThread_Solution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.HashVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RenderSettings)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RendererDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoRenderingThread_Validation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RenderSlot != 0 {
+		n += 1 + sovTypes(uint64(m.RenderSlot))
+	}
+	return n
+}
+
+func (m *VideoRenderingThread_UnrenderableFrame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Frame != 0 {
+		n += 1 + sovTypes(uint64(m.Frame))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTypes(uint64(m.Attempts))
+	}
+	l = len(m.LogCid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoRenderingThread_Frame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidCount != 0 {
+		n += 1 + sovTypes(uint64(m.ValidCount))
+	}
+	if m.InvalidCount != 0 {
+		n += 1 + sovTypes(uint64(m.InvalidCount))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoRenderingTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextId != 0 {
+		n += 1 + sovTypes(uint64(m.NextId))
+	}
+	return n
+}
+
+func (m *IndexedVideoRenderingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.VideoRenderingTask.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VideoRenderingLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VideoRenderingLogs_VideoRenderingLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovTypes(uint64(m.Severity))
+	}
+	return n
+}
+
+func (m *FrameSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FrameNumber != 0 {
+		n += 1 + sovTypes(uint64(m.FrameNumber))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.HashAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.WorkerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RenderSettings)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerStaking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinWorkerStaking == nil {
+				m.MinWorkerStaking = &types.Coin{}
+			}
+			if err := m.MinWorkerStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkersPerThread", wireType)
+			}
+			m.MaxWorkersPerThread = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkersPerThread |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitPhaseBlocks", wireType)
+			}
+			m.CommitPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitPhaseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssemblyRewardPercentage", wireType)
+			}
+			m.AssemblyRewardPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssemblyRewardPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewPhaseBlocks", wireType)
+			}
+			m.PreviewPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviewPhaseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReports", wireType)
+			}
+			m.FailureReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureReports |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureFeePercentage", wireType)
+			}
+			m.FailureFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureFeePercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncoderImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncoderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssemblyPhaseBlocks", wireType)
+			}
+			m.AssemblyPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssemblyPhaseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssemblyPenaltyPercentage", wireType)
+			}
+			m.AssemblyPenaltyPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssemblyPenaltyPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommitPhaseBlocks", wireType)
+			}
+			m.MaxCommitPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommitPhaseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSeconds", wireType)
+			}
+			m.BlockSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPhaseBlocks", wireType)
+			}
+			m.RevealPhaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPhaseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTaskInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VideoRenderingTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoRenderingTaskList = append(m.VideoRenderingTaskList, IndexedVideoRenderingTask{})
+			if err := m.VideoRenderingTaskList[len(m.VideoRenderingTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, Worker{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKeys = append(m.VerifyingKeys, CircuitVerifyingKey{})
+			if err := m.VerifyingKeys[len(m.VerifyingKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RendererImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RendererImages = append(m.RendererImages, RendererImage{})
+			if err := m.RendererImages[len(m.RendererImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitVerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitVerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitVerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RendererImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RendererImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RendererImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlenderVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Architecture = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reputation == nil {
+				m.Reputation = &Worker_Reputation{}
+			}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentTaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentThreadIndex", wireType)
+			}
+			m.CurrentThreadIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentThreadIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Architecture = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlenderVersions = append(m.BlenderVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Staked == nil {
+				m.Staked = &types.Coin{}
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			m.Validations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solutions", wireType)
+			}
+			m.Solutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Solutions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Winnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RenderDurations = append(m.RenderDurations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RenderDurations) == 0 {
+					m.RenderDurations = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RenderDurations = append(m.RenderDurations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderDurations", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoRenderingTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadAmount", wireType)
+			}
+			m.ThreadAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThreadAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threads = append(m.Threads, &VideoRenderingThread{})
+			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Comparison == nil {
+				m.Comparison = &FrameComparison{}
+			}
+			if err := m.Comparison.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenderSettings == nil {
+				m.RenderSettings = &RenderSettings{}
+			}
+			if err := m.RenderSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assembly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Assembly == nil {
+				m.Assembly = &VideoAssembly{}
+			}
+			if err := m.Assembly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TileGrid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TileGrid == nil {
+				m.TileGrid = &TileGrid{}
+			}
+			if err := m.TileGrid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Split == nil {
+				m.Split = &ThreadSplit{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlenderVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThreadSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThreadSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThreadSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= ThreadSplit_Strategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewStride", wireType)
+			}
+			m.PreviewStride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviewStride |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewDeadline", wireType)
+			}
+			m.PreviewDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviewDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Costs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Costs = append(m.Costs, &FrameCost{})
+			if err := m.Costs[len(m.Costs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Previewers = append(m.Previewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &PreviewSubmission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			m.Frame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frame |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreviewSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreviewSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreviewSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Costs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Costs = append(m.Costs, &FrameCost{})
+			if err := m.Costs[len(m.Costs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TileGrid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TileGrid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TileGrid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			m.Columns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Columns |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoAssembly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoAssembly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoAssembly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assembler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assembler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameRate", wireType)
+			}
+			m.FrameRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejections = append(m.Rejections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncoderImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncoderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAssemblers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedAssemblers = append(m.FailedAssemblers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engine", wireType)
+			}
+			m.Engine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Engine |= RenderSettings_Engine(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionX", wireType)
+			}
+			m.ResolutionX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionX |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionY", wireType)
+			}
+			m.ResolutionY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionY |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionPercentage", wireType)
+			}
+			m.ResolutionPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scene", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scene = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Camera", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Camera = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewLayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewLayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFormat", wireType)
+			}
+			m.OutputFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputFormat |= RenderSettings_OutputFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameTimeoutSeconds", wireType)
+			}
+			m.FrameTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameTimeoutSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *VideoRenderingLogs_VideoRenderingLog) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTypes(uint64(m.Timestamp))
-	}
-	if m.Severity != 0 {
-		n += 1 + sovTypes(uint64(m.Severity))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FrameComparison) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2323,17 +8510,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameComparison: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameComparison: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerStaking", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2343,33 +8530,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Mode |= FrameComparison_Mode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MinWorkerStaking == nil {
-				m.MinWorkerStaking = &types.Coin{}
-			}
-			if err := m.MinWorkerStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkersPerThread", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHashDistance", wireType)
 			}
-			m.MaxWorkersPerThread = 0
+			m.MaxHashDistance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2379,16 +8549,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWorkersPerThread |= int64(b&0x7F) << shift
+				m.MaxHashDistance |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTileSimilarity", wireType)
 			}
-			m.MinValidators = 0
+			m.MinTileSimilarity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2398,7 +8568,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinValidators |= int64(b&0x7F) << shift
+				m.MinTileSimilarity |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2424,7 +8594,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2447,17 +8617,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: VideoRenderingThread: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VideoRenderingThread: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2467,30 +8637,119 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTaskInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2500,28 +8759,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VideoRenderingTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2548,14 +8806,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VideoRenderingTaskList = append(m.VideoRenderingTaskList, IndexedVideoRenderingTask{})
-			if err := m.VideoRenderingTaskList[len(m.VideoRenderingTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Solution == nil {
+				m.Solution = &VideoRenderingThread_Solution{}
+			}
+			if err := m.Solution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2582,66 +8842,130 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, Worker{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validations = append(m.Validations, &VideoRenderingThread_Validation{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.AverageRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+		case 10:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SampledFrames = append(m.SampledFrames, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SampledFrames) == 0 {
+					m.SampledFrames = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SampledFrames = append(m.SampledFrames, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledFrames", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSlots", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RenderSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RenderSlots |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var stringLen uint64
+			m.Phase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2651,27 +8975,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Phase |= VideoRenderingThread_Phase(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitDeadline", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CommitDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,38 +9028,18 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reputation == nil {
-				m.Reputation = &Worker_Reputation{}
+			if m.Comparison == nil {
+				m.Comparison = &FrameComparison{}
 			}
-			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Comparison.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enabled = bool(v != 0)
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2739,29 +9049,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentTaskId = string(dAtA[iNdEx:postIndex])
+			if m.RenderSettings == nil {
+				m.RenderSettings = &RenderSettings{}
+			}
+			if err := m.RenderSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentThreadIndex", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TileGrid", wireType)
 			}
-			m.CurrentThreadIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2771,16 +9085,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentThreadIndex |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TileGrid == nil {
+				m.TileGrid = &TileGrid{}
+			}
+			if err := m.TileGrid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tile", wireType)
+			}
+			m.Tile = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2790,27 +9121,14 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Tile |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlenderVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2838,61 +9156,11 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			m.BlenderVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Reputation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reputation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrenderableFrames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2919,18 +9187,16 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Staked == nil {
-				m.Staked = &types.Coin{}
-			}
-			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnrenderableFrames = append(m.UnrenderableFrames, &VideoRenderingThread_UnrenderableFrame{})
+			if err := m.UnrenderableFrames[len(m.UnrenderableFrames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitBlocks", wireType)
 			}
-			m.Points = 0
+			m.CommitBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2940,16 +9206,16 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Points |= int64(b&0x7F) << shift
+				m.CommitBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDeadline", wireType)
 			}
-			m.Validations = 0
+			m.RevealDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2959,16 +9225,16 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Validations |= int32(b&0x7F) << shift
+				m.RevealDeadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Solutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRound", wireType)
 			}
-			m.Solutions = 0
+			m.CommitRound = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2978,16 +9244,16 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Solutions |= int32(b&0x7F) << shift
+				m.CommitRound |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winnings", wireType)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SolutionRevealDeadline", wireType)
 			}
-			var msglen int
+			m.SolutionRevealDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2997,100 +9263,29 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SolutionRevealDeadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Winnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRound", wireType)
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RenderDurations = append(m.RenderDurations, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			m.ProposalRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RenderDurations) == 0 {
-					m.RenderDurations = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RenderDurations = append(m.RenderDurations, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenderDurations", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3113,7 +9308,7 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
+func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,15 +9331,81 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VideoRenderingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: Solution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Solution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frames = append(m.Frames, &VideoRenderingThread_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3172,11 +9433,11 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3204,11 +9465,31 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3236,13 +9517,13 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashVersion", wireType)
 			}
-			m.StartFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3252,16 +9533,29 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.EndFrame = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3271,16 +9565,29 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadAmount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.ThreadAmount = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenderSettings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RendererDigest", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3290,16 +9597,79 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThreadAmount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RendererDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3309,15 +9679,27 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3344,18 +9726,16 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reward == nil {
-				m.Reward = &types.Coin{}
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Frames = append(m.Frames, &VideoRenderingThread_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3365,26 +9745,43 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threads = append(m.Threads, &VideoRenderingThread{})
-			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSlot", wireType)
+			}
+			m.RenderSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RenderSlot |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3406,7 +9803,7 @@ func (m *VideoRenderingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
+func (m *VideoRenderingThread_UnrenderableFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3429,47 +9826,15 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VideoRenderingThread: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnrenderableFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoRenderingThread: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: UnrenderableFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3497,13 +9862,13 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
 			}
-			m.StartFrame = 0
+			m.Frame = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3513,16 +9878,16 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartFrame |= int64(b&0x7F) << shift
+				m.Frame |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.EndFrame = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3532,16 +9897,16 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndFrame |= int64(b&0x7F) << shift
+				m.Reason |= VideoRenderingThread_UnrenderableFrame_Reason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var v int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3551,15 +9916,14 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogCid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3587,97 +9951,8 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Solution == nil {
-				m.Solution = &VideoRenderingThread_Solution{}
-			}
-			if err := m.Solution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validations = append(m.Validations, &VideoRenderingThread_Validation{})
-			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LogCid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
-			}
-			m.AverageRenderSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageRenderSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3699,7 +9974,7 @@ func (m *VideoRenderingThread) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
+func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3722,15 +9997,15 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Solution: wiretype end group for non-group")
+			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Solution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3758,13 +10033,13 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3774,29 +10049,27 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, &VideoRenderingThread_Frame{})
-			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3824,11 +10097,81 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
+			}
+			m.ValidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+			}
+			m.InvalidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3856,13 +10199,63 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoRenderingTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoRenderingTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoRenderingTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
 			}
-			var v int
+			m.NextId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3872,12 +10265,11 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3899,7 +10291,7 @@ func (m *VideoRenderingThread_Solution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
+func (m *IndexedVideoRenderingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,15 +10314,15 @@ func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexedVideoRenderingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexedVideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3958,11 +10350,11 @@ func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3989,63 +10381,10 @@ func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, &VideoRenderingThread_Frame{})
-			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VideoRenderingTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsReverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4067,7 +10406,7 @@ func (m *VideoRenderingThread_Validation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
+func (m *VideoRenderingLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4090,15 +10429,15 @@ func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+			return fmt.Errorf("proto: VideoRenderingLogs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VideoRenderingLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4126,13 +10465,13 @@ func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4142,59 +10481,79 @@ func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			m.Logs = append(m.Logs, &VideoRenderingLogs_VideoRenderingLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoRenderingLogs_VideoRenderingLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoRenderingLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoRenderingLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4222,32 +10581,13 @@ func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
-			}
-			m.ValidCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.InvalidCount = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4257,66 +10597,16 @@ func (m *VideoRenderingThread_Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidCount |= int64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VideoRenderingTaskInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VideoRenderingTaskInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoRenderingTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
 			}
-			m.NextId = 0
+			m.Severity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4326,7 +10616,7 @@ func (m *VideoRenderingTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextId |= int64(b&0x7F) << shift
+				m.Severity |= VideoRenderingLogs_VideoRenderingLog_SEVERITY(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4352,7 +10642,7 @@ func (m *VideoRenderingTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexedVideoRenderingTask) Unmarshal(dAtA []byte) error {
+func (m *FrameSignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4375,15 +10665,34 @@ func (m *IndexedVideoRenderingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedVideoRenderingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameSignDoc: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedVideoRenderingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4411,13 +10720,13 @@ func (m *IndexedVideoRenderingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoRenderingTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4427,76 +10736,57 @@ func (m *IndexedVideoRenderingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VideoRenderingTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VideoRenderingLogs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VideoRenderingLogs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoRenderingLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
@@ -4528,11 +10818,30 @@ func (m *VideoRenderingLogs) Unmarshal(dAtA []byte) error {
 			}
 			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameNumber", wireType)
+			}
+			m.FrameNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4542,79 +10851,27 @@ func (m *VideoRenderingLogs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &VideoRenderingLogs_VideoRenderingLog{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VideoRenderingLogs_VideoRenderingLog) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VideoRenderingLog: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoRenderingLog: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4642,13 +10899,13 @@ func (m *VideoRenderingLogs_VideoRenderingLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Log = string(dAtA[iNdEx:postIndex])
+			m.HashAlgorithm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAddress", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4658,16 +10915,29 @@ func (m *VideoRenderingLogs_VideoRenderingLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Severity = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderSettings", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4677,11 +10947,24 @@ func (m *VideoRenderingLogs_VideoRenderingLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Severity |= VideoRenderingLogs_VideoRenderingLog_SEVERITY(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenderSettings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2,15 +2,21 @@ package videoRendering
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	fmt "fmt"
 	"image"
+	"image/color"
+	"math"
+	"math/bits"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
 )
 
 // Transforms a slice with format [key]=[value] to a map
@@ -39,17 +45,36 @@ func MapToKeyValueFormat(inputMap map[string]string) []string {
 		parts = append(parts, fmt.Sprintf("%s=%s", key, value))
 	}
 
-	// Join the key=value pairs with commas
+	// map order is random, we sort them so transactions are reproducible
+	slices.Sort(parts)
 	return parts
 }
 
 // Executes a cli command with their arguments
+// CliRunner runs the transactions of ExecuteCli instead of the janctiond binary, like an in-process devnet delivering
+// them to its own chain
+type CliRunner func(args []string) error
+
+// cliRunner runs the transactions of ExecuteCli when set
+var cliRunner CliRunner
+
+// SetCliRunner replaces the runner of the transactions of ExecuteCli and returns the previous one. A nil runner executes
+// janctiond
+func SetCliRunner(runner CliRunner) CliRunner {
+	previous := cliRunner
+	cliRunner = runner
+	return previous
+}
+
 func ExecuteCli(args []string) error {
 	executableName := "janctiond"
 	args = append(args, "--gas")
 	args = append(args, "auto")
 	args = append(args, "--gas-adjustment")
 	args = append(args, "1.3")
+	if cliRunner != nil {
+		return cliRunner(args)
+	}
 	cmd := exec.Command(executableName, args...)
 	videoRenderingLogger.Logger.Info("Executing %s", cmd.String())
 
@@ -63,8 +88,10 @@ func ExecuteCli(args []string) error {
 	return nil
 }
 
-func FromCliToFrames(entries []string) map[string]VideoRenderingThread_Frame {
+// Parses frames with format filename=cid:hash[:proof], where the optional proof is a comma separated merkle proof
+func FromCliToFrames(entries []string) (map[string]VideoRenderingThread_Frame, map[string][]string) {
 	result := make(map[string]VideoRenderingThread_Frame)
+	proofs := make(map[string][]string)
 
 	for _, entry := range entries {
 		parts := strings.Split(entry, "=")
@@ -75,94 +102,367 @@ func FromCliToFrames(entries []string) map[string]VideoRenderingThread_Frame {
 
 		filename := parts[0]
 		cidAndHash := strings.Split(parts[1], ":")
-		if len(cidAndHash) != 2 {
+		if len(cidAndHash) != 2 && len(cidAndHash) != 3 {
 			fmt.Println("Invalid CID:Hash format:", parts[1])
 			continue
 		}
 		frame := VideoRenderingThread_Frame{Filename: filename, Cid: cidAndHash[0], Hash: cidAndHash[1]}
 		result[filename] = frame
+		if len(cidAndHash) == 3 && cidAndHash[2] != "" {
+			proofs[filename] = strings.Split(cidAndHash[2], ",")
+		}
 	}
 
-	return result
+	return result, proofs
 }
 
-func FromFramesToCli(frames map[string]VideoRenderingThread_Frame) []string {
+// Formats frames as filename=cid:hash, adding the merkle proof of the frame when there is one
+func FromFramesToCli(frames map[string]VideoRenderingThread_Frame, proofs map[string][]string) []string {
 	var result []string
 
 	for filename, frame := range frames {
 		entry := fmt.Sprintf("%s=%s:%s", filename, frame.Cid, frame.Hash)
+		if proof, ok := proofs[filename]; ok {
+			entry = fmt.Sprintf("%s:%s", entry, strings.Join(proof, ","))
+		}
 		result = append(result, entry)
 	}
 
+	// map order is random, we sort them so transactions are reproducible
+	slices.Sort(result)
 	return result
 }
 
-// CalculateFileHash calculates the SHA-256 hash of a given file.
-func CalculateFileHash(filePath string) (string, error) {
-	hash, err := calculateImagePixelHash(filePath)
+const (
+	// first pixel hash: sha256 of the pixels truncated to 8 bits RGBA. Its hashes aren't tagged
+	PixelHashV1 = "px1"
+	// sha256 of the pixels at their native bit depth, prefixed by the dimensions and color model
+	PixelHashV2 = "px2"
+	// version of the hashes of the solutions proposed by this node
+	CurrentPixelHashVersion = PixelHashV2
+	// separates the version tag from the hex encoded hash
+	pixelHashTagSeparator = "-"
+)
+
+// algorithm of each pixel hash version, as it is signed in frame sign docs
+var pixelHashAlgorithms = map[string]string{
+	PixelHashV1: FrameHashAlgorithm,
+	PixelHashV2: "sha256-native-pixels-v2",
+}
+
+// PixelHashAlgorithm returns the algorithm of the pixel hash version
+func PixelHashAlgorithm(version string) (string, bool) {
+	algorithm, ok := pixelHashAlgorithms[version]
+	return algorithm, ok
+}
+
+// ParseFrameHash returns the version and hex encoded hash of a frame hash. Untagged hashes are of the first version
+func ParseFrameHash(hash string) (string, string, error) {
+	version, digest, found := strings.Cut(hash, pixelHashTagSeparator)
+	if !found {
+		version, digest = PixelHashV1, hash
+	}
+	if _, ok := pixelHashAlgorithms[version]; !ok {
+		return "", "", fmt.Errorf("unknown pixel hash version %s", version)
+	}
+	if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != sha256.Size {
+		return "", "", fmt.Errorf("invalid frame hash %s", hash)
+	}
+	return version, digest, nil
+}
+
+// CalculateFileHash calculates the pixel hash of a given file with the current version.
+func CalculateFileHash(filePath string, renderer vm.Renderer) (string, error) {
+	return CalculateFrameHash(filePath, CurrentPixelHashVersion, renderer)
+}
+
+// CalculateFrameHash calculates the pixel hash of a given file with the version of a solution, tagging it with the version.
+// Frames the image package can't read are decoded with the renderer image.
+func CalculateFrameHash(filePath string, version string, renderer vm.Renderer) (string, error) {
+	img, err := decodeFrame(filePath, renderer)
 	if err != nil {
 		return "", err
 	}
-	return hash, nil
+	return imageFrameHash(img, version)
 }
 
-// CalculateImagePixelHash computes the SHA-256 hash of an image based only on pixel values.
-func calculateImagePixelHash(filePath string) (string, error) {
-	file, err := os.Open(filePath)
+// CalculateFrameDigest calculates the frame digest of a given file: the pixel hash of the version and the fingerprint.
+// Both come from a single decode of the frame, which for formats decoded by blender is a container run
+func CalculateFrameDigest(filePath string, version string, renderer vm.Renderer) (string, error) {
+	img, err := decodeFrame(filePath, renderer)
 	if err != nil {
 		return "", err
 	}
-	defer file.Close()
-
-	// Decode the image
-	img, format, err := image.Decode(file)
+	hash, err := imageFrameHash(img, version)
+	if err != nil {
+		return "", err
+	}
+	fingerprint, err := imageFingerprint(img)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode image: %w", err)
+		return "", fmt.Errorf("image %s: %w", filePath, err)
 	}
+	return FrameDigest(hash, fingerprint), nil
+}
 
-	fmt.Println("Image format:", format) // Debugging purpose
+// imageFrameHash computes the pixel hash of the version of a decoded frame, tagging it with the version
+func imageFrameHash(img image.Image, version string) (string, error) {
+	switch version {
+	case PixelHashV1:
+		return calculateImagePixelHashV1(img), nil
+	case PixelHashV2:
+		return PixelHashV2 + pixelHashTagSeparator + calculateImagePixelHash(img), nil
+	default:
+		return "", fmt.Errorf("unknown pixel hash version %s", version)
+	}
+}
 
-	// Convert image pixels to a byte slice
+// calculateImagePixelHashV1 computes the SHA-256 hash of the 8 bits RGBA pixel values. Kept to verify solutions of the first version.
+func calculateImagePixelHashV1(img image.Image) string {
 	bounds := img.Bounds()
-	var pixelData []byte
-
-	// Extract RGB(A) pixel data
+	hasher := sha256.New()
+	row := make([]byte, 0, 4*bounds.Dx())
 	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row = row[:0]
 		for x := bounds.Min.X; x < bounds.Max.X; x++ {
 			r, g, b, a := img.At(x, y).RGBA()
-			pixelData = append(pixelData,
-				byte(r>>8), byte(g>>8), byte(b>>8), byte(a>>8)) // Convert 16-bit values to 8-bit
+			row = append(row, byte(r>>8), byte(g>>8), byte(b>>8), byte(a>>8)) // Convert 16-bit values to 8-bit
 		}
+		hasher.Write(row)
 	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
 
-	// Compute SHA-256 hash
+// calculateImagePixelHash computes the SHA-256 hash of an image at its native bit depth.
+// The image is fully decoded first. Rows of the concrete image types are then hashed from their pixel buffers as they are stored,
+// without converting them; other types are hashed as 16 bits RGBA.
+// The color model and the dimensions are part of the hash, so equal bytes of different images don't collide.
+func calculateImagePixelHash(img image.Image) string {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
 	hasher := sha256.New()
-	hasher.Write(pixelData)
-	return hex.EncodeToString(hasher.Sum(nil)), nil
+
+	// writes the header of the hash: the pixel layout and the dimensions
+	header := func(layout string) {
+		hasher.Write([]byte(layout))
+		hasher.Write([]byte{0})
+		hasher.Write(binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, uint32(width)), uint32(height)))
+	}
+	// writes each row of a pixel buffer
+	rows := func(pix []byte, stride, bytesPerPixel int) {
+		for y := 0; y < height; y++ {
+			hasher.Write(pix[y*stride : y*stride+width*bytesPerPixel])
+		}
+	}
+
+	switch img := img.(type) {
+	case *image.RGBA:
+		header("rgba8")
+		rows(img.Pix, img.Stride, 4)
+	case *image.NRGBA:
+		header("nrgba8")
+		rows(img.Pix, img.Stride, 4)
+	case *image.RGBA64:
+		header("rgba16")
+		rows(img.Pix, img.Stride, 8)
+	case *image.NRGBA64:
+		header("nrgba16")
+		rows(img.Pix, img.Stride, 8)
+	case *image.Gray:
+		header("gray8")
+		rows(img.Pix, img.Stride, 1)
+	case *image.Gray16:
+		header("gray16")
+		rows(img.Pix, img.Stride, 2)
+	case *canonicalFrame:
+		header("rgba32f")
+		rows(img.Pix, img.Width*canonicalPixelSize, canonicalPixelSize)
+	case *image.Paletted:
+		// the palette is hashed so indexes keep their meaning
+		header("paletted8")
+		for _, c := range img.Palette {
+			r, g, b, a := c.RGBA()
+			hasher.Write([]byte{byte(r >> 8), byte(g >> 8), byte(b >> 8), byte(a >> 8)})
+		}
+		rows(img.Pix, img.Stride, 1)
+	default:
+		header("rgba16-generic")
+		row := make([]byte, 0, 8*width)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			row = row[:0]
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				c := color.RGBA64Model.Convert(img.At(x, y)).(color.RGBA64)
+				row = binary.BigEndian.AppendUint16(row, c.R)
+				row = binary.BigEndian.AppendUint16(row, c.G)
+				row = binary.BigEndian.AppendUint16(row, c.B)
+				row = binary.BigEndian.AppendUint16(row, c.A)
+			}
+			hasher.Write(row)
+		}
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+const (
+	// size of the grid of blocks of the block-mean hash of a fingerprint
+	fingerprintHashGrid = 8
+	// size of the grid of tiles whose statistics are part of a fingerprint
+	fingerprintTileGrid = 4
+	fingerprintTiles    = fingerprintTileGrid * fingerprintTileGrid
+	// hex encoded size of a fingerprint: the hash, a mean and a deviation per tile
+	fingerprintSize = 2 * (8 + 2*fingerprintTiles)
+)
+
+// FrameFingerprint is a compact perceptual description of the luma of a frame.
+// Renders of the same frame on different architectures have close fingerprints even if their pixels differ.
+type FrameFingerprint struct {
+	// one bit per block, set when the block is brighter than the mean of all blocks
+	BlockHash uint64
+	// mean and standard deviation of the luma of each tile
+	TileMeans      [fingerprintTiles]uint8
+	TileDeviations [fingerprintTiles]uint8
+}
+
+// String hex encodes the fingerprint
+func (f FrameFingerprint) String() string {
+	encoded := binary.BigEndian.AppendUint64(nil, f.BlockHash)
+	encoded = append(encoded, f.TileMeans[:]...)
+	encoded = append(encoded, f.TileDeviations[:]...)
+	return hex.EncodeToString(encoded)
+}
+
+// ParseFrameFingerprint decodes an hex encoded fingerprint
+func ParseFrameFingerprint(encoded string) (FrameFingerprint, error) {
+	var fingerprint FrameFingerprint
+	if len(encoded) != fingerprintSize {
+		return fingerprint, fmt.Errorf("fingerprint must be %v hex characters, got %v", fingerprintSize, len(encoded))
+	}
+	decoded, err := hex.DecodeString(encoded)
+	if err != nil {
+		return fingerprint, fmt.Errorf("invalid fingerprint %s: %w", encoded, err)
+	}
+	fingerprint.BlockHash = binary.BigEndian.Uint64(decoded[:8])
+	copy(fingerprint.TileMeans[:], decoded[8:8+fingerprintTiles])
+	copy(fingerprint.TileDeviations[:], decoded[8+fingerprintTiles:])
+	return fingerprint, nil
+}
+
+// HashDistance returns the amount of different bits of the block-mean hashes
+func (f FrameFingerprint) HashDistance(other FrameFingerprint) int {
+	return bits.OnesCount64(f.BlockHash ^ other.BlockHash)
+}
+
+// TileSimilarity returns the per mille SSIM of the least similar tile, using the luminance and contrast terms.
+// Integer arithmetic only, so every node gets the same result.
+func (f FrameFingerprint) TileSimilarity(other FrameFingerprint) int {
+	// SSIM stabilization constants (0.01*255)^2 and (0.03*255)^2
+	const c1, c2 = 7, 59
+
+	similarity := 1000
+	for i := 0; i < fingerprintTiles; i++ {
+		ma, mb := int64(f.TileMeans[i]), int64(other.TileMeans[i])
+		da, db := int64(f.TileDeviations[i]), int64(other.TileDeviations[i])
+		numerator := (2*ma*mb + c1) * (2*da*db + c2)
+		denominator := (ma*ma + mb*mb + c1) * (da*da + db*db + c2)
+		similarity = min(similarity, int(1000*numerator/denominator))
+	}
+	return similarity
 }
 
-// GenerateDirectoryFileHashes walks through a directory and computes SHA-256 hashes for all files.
-func GenerateDirectoryFileHashes(dirPath string) (map[string]string, error) {
+// imageFingerprint computes the perceptual fingerprint of a decoded frame, next to its exact pixel hash
+func imageFingerprint(img image.Image) (FrameFingerprint, error) {
+	var fingerprint FrameFingerprint
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return fingerprint, fmt.Errorf("image is empty")
+	}
+
+	var blockSums, blockCounts [fingerprintHashGrid * fingerprintHashGrid]uint64
+	var tileSums, tileSquares, tileCounts [fingerprintTiles]uint64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			// BT.601 luma of the 8 bits channels
+			luma := (299*uint64(r>>8) + 587*uint64(g>>8) + 114*uint64(b>>8)) / 1000
+
+			block := (y*fingerprintHashGrid/height)*fingerprintHashGrid + x*fingerprintHashGrid/width
+			blockSums[block] += luma
+			blockCounts[block]++
+
+			tile := (y*fingerprintTileGrid/height)*fingerprintTileGrid + x*fingerprintTileGrid/width
+			tileSums[tile] += luma
+			tileSquares[tile] += luma * luma
+			tileCounts[tile]++
+		}
+	}
+
+	// images smaller than the grid leave empty blocks, which count as black
+	var blockMeans [fingerprintHashGrid * fingerprintHashGrid]uint64
+	var total uint64
+	for i := range blockSums {
+		if blockCounts[i] > 0 {
+			blockMeans[i] = blockSums[i] / blockCounts[i]
+		}
+		total += blockMeans[i]
+	}
+	mean := total / uint64(len(blockMeans))
+	for i, blockMean := range blockMeans {
+		if blockMean > mean {
+			fingerprint.BlockHash |= 1 << (len(blockMeans) - 1 - i)
+		}
+	}
+
+	for i := range tileSums {
+		if tileCounts[i] == 0 {
+			continue
+		}
+		tileMean := tileSums[i] / tileCounts[i]
+		variance := tileSquares[i]/tileCounts[i] - tileMean*tileMean
+		fingerprint.TileMeans[i] = uint8(tileMean)
+		fingerprint.TileDeviations[i] = uint8(math.Sqrt(float64(variance)))
+	}
+
+	return fingerprint, nil
+}
+
+// GenerateDirectoryFileHashes walks through a directory and computes the pixel hashes of the given version for all frames with the given extension.
+func GenerateDirectoryFileHashes(dirPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+	return generateDirectoryHashes(dirPath, extension, func(path string) (string, error) {
+		return CalculateFrameHash(path, version, renderer)
+	})
+}
+
+// GenerateDirectoryFrameDigests walks through a directory and computes the frame digests of the given version for all frames with the given extension.
+func GenerateDirectoryFrameDigests(dirPath string, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+	return generateDirectoryHashes(dirPath, extension, func(path string) (string, error) {
+		return CalculateFrameDigest(path, version, renderer)
+	})
+}
+
+// generateDirectoryHashes walks through a directory and hashes all frames with the given extension, keyed by relative path.
+func generateDirectoryHashes(dirPath string, extension string, hash func(path string) (string, error)) (map[string]string, error) {
 	hashes := make(map[string]string)
 
 	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		// Skip directories
-		if info.IsDir() {
+		// Skip directories and files of other formats
+		if info.IsDir() || filepath.Ext(path) != "."+extension {
 			return nil
 		}
 
 		// Compute file hash
-		hash, err := CalculateFileHash(path)
+		fileHash, err := hash(path)
 		if err != nil {
 			return err
 		}
 
 		// Store hash with filename (relative path)
 		relPath, _ := filepath.Rel(dirPath, path)
-		hashes[relPath] = hash
+		hashes[relPath] = fileHash
 
 		return nil
 	})
@@ -173,3 +473,33 @@ func GenerateDirectoryFileHashes(dirPath string) (map[string]string, error) {
 
 	return hashes, nil
 }
+
+// GenerateFrameHashes computes the pixel hash of the given version of the frames inside dirPath, keyed by frame filename.
+func GenerateFrameHashes(dirPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+	return generateFrameHashes(dirPath, frames, extension, func(path string) (string, error) {
+		return CalculateFrameHash(path, version, renderer)
+	})
+}
+
+// GenerateFrameDigests computes the frame digest of the given version of the frames inside dirPath, keyed by frame filename.
+func GenerateFrameDigests(dirPath string, frames []int64, version string, extension string, renderer vm.Renderer) (map[string]string, error) {
+	return generateFrameHashes(dirPath, frames, extension, func(path string) (string, error) {
+		return CalculateFrameDigest(path, version, renderer)
+	})
+}
+
+// generateFrameHashes hashes the frames inside dirPath, keyed by frame filename.
+func generateFrameHashes(dirPath string, frames []int64, extension string, hash func(path string) (string, error)) (map[string]string, error) {
+	hashes := make(map[string]string)
+
+	for _, frame := range frames {
+		filename := vm.FormatFrameFilename(int(frame), extension)
+		frameHash, err := hash(filepath.Join(dirPath, filename))
+		if err != nil {
+			return nil, err
+		}
+		hashes[filename] = frameHash
+	}
+
+	return hashes, nil
+}
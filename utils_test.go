@@ -3,15 +3,18 @@ package videoRendering
 import (
 	fmt "fmt"
 	"image"
+	"image/color"
 	"image/png"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/janction/videoRendering/vm"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -130,6 +133,7 @@ func TestFromCliToFrames(t *testing.T) {
 		name     string
 		entries  []string
 		expected map[string]VideoRenderingThread_Frame
+		proofs   map[string][]string
 	}{
 		{
 			name:    "Valid entry",
@@ -138,6 +142,16 @@ func TestFromCliToFrames(t *testing.T) {
 				"file1.png": {Filename: "file1.png", Cid: "cid123", Hash: "hash123"},
 			},
 		},
+		{
+			name:    "Valid entry with proof",
+			entries: []string{"file1.png=cid123:hash123:sibling1,sibling2"},
+			expected: map[string]VideoRenderingThread_Frame{
+				"file1.png": {Filename: "file1.png", Cid: "cid123", Hash: "hash123"},
+			},
+			proofs: map[string][]string{
+				"file1.png": {"sibling1", "sibling2"},
+			},
+		},
 		{
 			name:     "Invalid entry (missing '=')",
 			entries:  []string{"invalidEntryWithoutEquals"},
@@ -165,11 +179,17 @@ func TestFromCliToFrames(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := FromCliToFrames(tt.entries)
+			result, proofs := FromCliToFrames(tt.entries)
 
 			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("Expected: %+v, got: %+v", tt.expected, result)
 			}
+			if tt.proofs == nil {
+				tt.proofs = map[string][]string{}
+			}
+			if !reflect.DeepEqual(proofs, tt.proofs) {
+				t.Errorf("Expected proofs: %+v, got: %+v", tt.proofs, proofs)
+			}
 		})
 	}
 }
@@ -179,6 +199,7 @@ func TestFromFramesToCli(t *testing.T) {
 	tests := []struct {
 		name     string
 		frames   map[string]VideoRenderingThread_Frame
+		proofs   map[string][]string
 		expected []string
 	}{
 		{
@@ -201,16 +222,25 @@ func TestFromFramesToCli(t *testing.T) {
 			},
 			expected: []string{"file1=cid1:hash1", "file2=cid2:hash2"},
 		},
+		{
+			name: "Frames with proof",
+			frames: map[string]VideoRenderingThread_Frame{
+				"file2": {Filename: "file2", Cid: "cid2", Hash: "hash2"},
+				"file1": {Filename: "file1", Cid: "cid1", Hash: "hash1"},
+			},
+			proofs: map[string][]string{
+				"file2": {"sibling1", "sibling2"},
+			},
+			expected: []string{"file1=cid1:hash1", "file2=cid2:hash2:sibling1,sibling2"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := FromFramesToCli(tt.frames)
+			result := FromFramesToCli(tt.frames, tt.proofs)
 			if len(result) == 0 && len(tt.expected) == 0 {
 				return
 			}
-			sort.Strings(result)
-			sort.Strings(tt.expected)
 			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("Expected %v, got %v", tt.expected, result)
 			}
@@ -287,7 +317,7 @@ func TestCalculateFileHash(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			f, err := CalculateFileHash(tt.filePath)
+			f, err := CalculateFileHash(tt.filePath, vm.Renderer{})
 			if (err != nil) != tt.expectedToError {
 				t.Errorf("For test case %s, expected error: %v, got: %v", tt.name, tt.expectedToError, err != nil)
 			}
@@ -375,7 +405,7 @@ func TestGenerateDirectoryFileHashes(t *testing.T) {
 				t.Fatalf("Setup failed: %v", err)
 			}
 
-			hashes, err := GenerateDirectoryFileHashes(dir)
+			hashes, err := GenerateDirectoryFileHashes(dir, CurrentPixelHashVersion, "png", vm.Renderer{})
 
 			if (err != nil) != tc.expectError {
 				t.Errorf("Expected error: %v, got: %v", tc.expectError, err)
@@ -386,3 +416,197 @@ func TestGenerateDirectoryFileHashes(t *testing.T) {
 		})
 	}
 }
+
+// creates a gradient image, adding noise to every pixel so it differs from other renders of the same frame
+func createGradientImage(filePath string, noise uint8, inverted bool) error {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 64; x++ {
+			value := uint8(x*3) + uint8(y)
+			if inverted {
+				value = 255 - value
+			}
+			if (x+y)%2 == 0 && value <= 255-noise {
+				value += noise
+			}
+			img.Set(x, y, color.RGBA{R: value, G: value, B: value, A: 255})
+		}
+	}
+
+	imgFile, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer imgFile.Close()
+	return png.Encode(imgFile, img)
+}
+
+// --- Test for the fingerprints of CalculateFrameDigest ---
+func TestCalculateFrameDigest(t *testing.T) {
+	dir := t.TempDir()
+	original := filepath.Join(dir, "original.png")
+	noisy := filepath.Join(dir, "noisy.png")
+	inverted := filepath.Join(dir, "inverted.png")
+	assert.NoError(t, createGradientImage(original, 0, false))
+	assert.NoError(t, createGradientImage(noisy, 2, false))
+	assert.NoError(t, createGradientImage(inverted, 0, true))
+
+	fingerprint := func(path string) (string, FrameFingerprint) {
+		digest, err := CalculateFrameDigest(path, CurrentPixelHashVersion, vm.Renderer{})
+		assert.NoError(t, err)
+		hash, fingerprint, err := ParseFrameDigest(digest)
+		assert.NoError(t, err)
+		return hash, fingerprint
+	}
+	originalHash, originalFingerprint := fingerprint(original)
+	noisyHash, noisyFingerprint := fingerprint(noisy)
+	_, invertedFingerprint := fingerprint(inverted)
+
+	// the digest has the pixel hash of the frame
+	hash, _ := CalculateFileHash(original, vm.Renderer{})
+	assert.Equal(t, hash, originalHash)
+
+	// the pixels differ, but the fingerprints are close
+	assert.NotEqual(t, originalHash, noisyHash)
+	assert.LessOrEqual(t, originalFingerprint.HashDistance(noisyFingerprint), 2)
+	assert.GreaterOrEqual(t, originalFingerprint.TileSimilarity(noisyFingerprint), 990)
+
+	// a different frame is far
+	assert.Greater(t, originalFingerprint.HashDistance(invertedFingerprint), 32)
+	assert.Less(t, originalFingerprint.TileSimilarity(invertedFingerprint), 900)
+
+	// fingerprints are equal to themselves
+	assert.Equal(t, 0, originalFingerprint.HashDistance(originalFingerprint))
+	assert.Equal(t, 1000, originalFingerprint.TileSimilarity(originalFingerprint))
+
+	_, err := CalculateFrameDigest(filepath.Join(dir, "missing.png"), CurrentPixelHashVersion, vm.Renderer{})
+	assert.Error(t, err)
+}
+
+func TestParseFrameFingerprint(t *testing.T) {
+	fingerprint := FrameFingerprint{BlockHash: 0xf0f0f0f00f0f0f0f}
+	fingerprint.TileMeans[3] = 200
+	fingerprint.TileDeviations[15] = 12
+
+	parsed, err := ParseFrameFingerprint(fingerprint.String())
+	assert.NoError(t, err)
+	assert.Equal(t, fingerprint, parsed)
+
+	_, err = ParseFrameFingerprint("abc")
+	assert.Error(t, err)
+
+	_, err = ParseFrameFingerprint(strings.Repeat("zz", fingerprintSize/2))
+	assert.Error(t, err)
+}
+
+// --- Test for FrameComparison ---
+func TestFrameComparisonMatches(t *testing.T) {
+	near := FrameFingerprint{BlockHash: 0xff00ff00ff00ff00}
+	far := FrameFingerprint{BlockHash: 0x00ff00ff00ff00ff}
+	for i := range near.TileMeans {
+		near.TileMeans[i], near.TileDeviations[i] = 120, 30
+		far.TileMeans[i], far.TileDeviations[i] = 20, 5
+	}
+	closeToNear := near
+	closeToNear.BlockHash ^= 1
+	closeToNear.TileMeans[0] = 122
+
+	solution := FrameDigest("hash1", near)
+	perceptual := &FrameComparison{Mode: FrameComparison_PERCEPTUAL, MaxHashDistance: 4, MinTileSimilarity: 950}
+
+	t.Run("identical digests always match", func(t *testing.T) {
+		assert.True(t, (*FrameComparison)(nil).Matches("hash1", "hash1"))
+		assert.True(t, perceptual.Matches(solution, solution))
+	})
+
+	t.Run("exact comparison requires the same hash", func(t *testing.T) {
+		assert.False(t, (*FrameComparison)(nil).Matches(solution, FrameDigest("hash2", near)))
+		assert.False(t, (&FrameComparison{}).Matches(solution, FrameDigest("hash2", closeToNear)))
+	})
+
+	t.Run("perceptual comparison tolerates close fingerprints", func(t *testing.T) {
+		assert.True(t, perceptual.Matches(solution, FrameDigest("hash2", closeToNear)))
+		assert.False(t, perceptual.Matches(solution, FrameDigest("hash2", far)))
+	})
+
+	t.Run("perceptual comparison rejects digests without fingerprint", func(t *testing.T) {
+		assert.False(t, perceptual.Matches(solution, "hash2"))
+	})
+}
+
+func TestFrameComparisonValidate(t *testing.T) {
+	assert.NoError(t, (*FrameComparison)(nil).Validate())
+	assert.NoError(t, (&FrameComparison{Mode: FrameComparison_PERCEPTUAL, MaxHashDistance: 64, MinTileSimilarity: 1000}).Validate())
+	assert.Error(t, (&FrameComparison{Mode: FrameComparison_PERCEPTUAL, MaxHashDistance: 65}).Validate())
+	assert.Error(t, (&FrameComparison{Mode: FrameComparison_PERCEPTUAL, MinTileSimilarity: 1001}).Validate())
+}
+
+// --- Test for CalculateFrameHash ---
+func TestCalculateFrameHash(t *testing.T) {
+	dir := t.TempDir()
+	encode := func(name string, img image.Image) string {
+		path := filepath.Join(dir, name)
+		file, err := os.Create(path)
+		assert.NoError(t, err)
+		defer file.Close()
+		assert.NoError(t, png.Encode(file, img))
+		return path
+	}
+
+	// two 16 bits images that only differ in the low byte of a channel
+	deep := image.NewRGBA64(image.Rect(0, 0, 4, 4))
+	deepOther := image.NewRGBA64(image.Rect(0, 0, 4, 4))
+	deep.SetRGBA64(1, 1, color.RGBA64{R: 0x1200, A: 0xffff})
+	deepOther.SetRGBA64(1, 1, color.RGBA64{R: 0x1201, A: 0xffff})
+	deepPath := encode("deep.png", deep)
+	deepOtherPath := encode("deep_other.png", deepOther)
+
+	t.Run("current version is tagged and keeps the bit depth", func(t *testing.T) {
+		hash, err := CalculateFileHash(deepPath, vm.Renderer{})
+		assert.NoError(t, err)
+		otherHash, err := CalculateFileHash(deepOtherPath, vm.Renderer{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, hash, otherHash)
+		assert.True(t, strings.HasPrefix(hash, CurrentPixelHashVersion+"-"))
+
+		version, _, err := ParseFrameHash(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, CurrentPixelHashVersion, version)
+	})
+
+	t.Run("first version truncates to 8 bits and isn't tagged", func(t *testing.T) {
+		hash, err := CalculateFrameHash(deepPath, PixelHashV1, vm.Renderer{})
+		assert.NoError(t, err)
+		otherHash, err := CalculateFrameHash(deepOtherPath, PixelHashV1, vm.Renderer{})
+		assert.NoError(t, err)
+		assert.Equal(t, hash, otherHash)
+
+		version, digest, err := ParseFrameHash(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, PixelHashV1, version)
+		assert.Equal(t, hash, digest)
+	})
+
+	t.Run("dimensions are part of the hash", func(t *testing.T) {
+		wide := encode("wide.png", image.NewGray(image.Rect(0, 0, 4, 1)))
+		tall := encode("tall.png", image.NewGray(image.Rect(0, 0, 1, 4)))
+		wideHash, err := CalculateFileHash(wide, vm.Renderer{})
+		assert.NoError(t, err)
+		tallHash, err := CalculateFileHash(tall, vm.Renderer{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, wideHash, tallHash)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := CalculateFrameHash(deepPath, "px0", vm.Renderer{})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseFrameHash_Invalid(t *testing.T) {
+	_, _, err := ParseFrameHash("px9-" + strings.Repeat("ab", 32))
+	assert.Error(t, err)
+
+	_, _, err = ParseFrameHash(PixelHashV2 + "-notHex")
+	assert.Error(t, err)
+}
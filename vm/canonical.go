@@ -0,0 +1,80 @@
+package vm
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// script that decodes frames into canonical pixels
+//
+//go:embed canonical_decode.py
+var canonicalDecodeScript []byte
+
+const (
+	// directories of the frame and of the canonical file inside the container
+	canonicalFrameDir  = "/frames"
+	canonicalOutputDir = "/canonical"
+)
+
+// DecodeCanonicalFrame decodes a frame the image package can't read into canonicalPath, using blender in the renderer image
+// the frame was rendered with, so every worker decodes the frame the same way. The canonical file has the width and height as big endian uint32,
+// followed by the rows from top to bottom of RGBA big endian float32 pixels.
+func (v *VM) DecodeCanonicalFrame(ctx context.Context, renderer RendererImage, framePath string, canonicalPath string) error {
+	framePath, err := filepath.Abs(framePath)
+	if err != nil {
+		return err
+	}
+	canonicalDir, err := filepath.Abs(filepath.Dir(canonicalPath))
+	if err != nil {
+		return err
+	}
+
+	// frames come from other workers, so they are decoded in the sandbox
+	sandbox := v.sandboxProfile()
+	if err := outputDir(canonicalDir, sandbox); err != nil {
+		return fmt.Errorf("failed to create canonical directory: %w", err)
+	}
+
+	// the script is mounted next to the canonical file
+	scriptName := "canonical_decode.py"
+	if err := os.WriteFile(filepath.Join(canonicalDir, scriptName), canonicalDecodeScript, 0644); err != nil {
+		return fmt.Errorf("failed to write canonical decode script: %w", err)
+	}
+	defer os.Remove(filepath.Join(canonicalDir, scriptName))
+
+	image, err := v.rendererImage(ctx, renderer)
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	args = append(args, "--background", "--python-exit-code", "1")
+	args = append(args, "--python", filepath.Join(canonicalOutputDir, scriptName))
+	args = append(args, "--")
+	args = append(args, filepath.Join(canonicalFrameDir, filepath.Base(framePath)))
+	args = append(args, filepath.Join(canonicalOutputDir, filepath.Base(canonicalPath)))
+
+	spec := ContainerSpec{
+		Name:    containerName("myDecoder", canonicalPath),
+		Image:   image,
+		Program: rendererProgram,
+		Args:    args,
+		Mounts: []Mount{
+			{Source: filepath.Dir(framePath), Target: canonicalFrameDir, ReadOnly: true},
+			{Source: canonicalDir, Target: canonicalOutputDir},
+		},
+		Sandbox: sandbox,
+	}
+	videoRenderingLogger.Logger.Debug("Decoding frame %s", framePath)
+	output, err := v.runContainer(ctx, spec)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("failed to decode frame %s: %s", framePath, output)
+		return fmt.Errorf("failed to decode frame %s: %w", framePath, err)
+	}
+	return nil
+}
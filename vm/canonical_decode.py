@@ -0,0 +1,28 @@
+# Decodes a frame the go image package can't read, like OpenEXR and TIFF, into canonical pixels.
+# Blender runs it with --python. The frame and the canonical file are passed after "--".
+# The canonical file is the width and height as big endian uint32, followed by the rows from top
+# to bottom of RGBA big endian float32 pixels, as they are stored in the frame without color management.
+# Multilayer OpenEXR frames are decoded into their combined pass.
+import struct
+import sys
+
+import bpy
+
+argv = sys.argv[sys.argv.index("--") + 1:]
+if len(argv) != 2:
+    raise SystemExit("janction: usage canonical_decode.py -- <frame> <canonical>")
+frame_path, canonical_path = argv
+
+image = bpy.data.images.load(frame_path)
+width, height = image.size
+if width == 0 or height == 0:
+    raise SystemExit("janction: frame %s can't be decoded" % frame_path)
+
+pixels = image.pixels[:]
+row_format = ">%df" % (width * 4)
+with open(canonical_path, "wb") as canonical:
+    canonical.write(struct.pack(">II", width, height))
+    # blender stores the rows from bottom to top
+    for y in reversed(range(height)):
+        canonical.write(struct.pack(row_format, *pixels[y * width * 4:(y + 1) * width * 4]))
+print("janction: frame decoded", frame_path, width, height)
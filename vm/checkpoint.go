@@ -0,0 +1,80 @@
+package vm
+
+import (
+	"bytes"
+	"fmt"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/janction/videoRendering/db"
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// signatures of the formats blender is needed to decode
+var frameSignatures = map[string][][]byte{
+	"tif": {[]byte("II*\x00"), []byte("MM\x00*")},
+	"exr": {{0x76, 0x2f, 0x31, 0x01}},
+}
+
+// ValidateFrame returns an error if the frame is missing or isn't a complete image of its format.
+// PNG and JPEG frames are decoded, other formats are only checked by their signature since blender is needed to decode them
+func ValidateFrame(framePath string) error {
+	file, err := os.Open(framePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	extension := filepath.Ext(framePath)
+	switch extension {
+	case ".png":
+		_, err = png.Decode(file)
+	case ".jpg":
+		_, err = jpeg.Decode(file)
+	default:
+		signatures, ok := frameSignatures[strings.TrimPrefix(extension, ".")]
+		if !ok {
+			return fmt.Errorf("unsupported frame format %s", extension)
+		}
+		header := make([]byte, len(signatures[0]))
+		if _, err := io.ReadFull(file, header); err != nil {
+			return fmt.Errorf("frame %s is truncated: %w", framePath, err)
+		}
+		for _, signature := range signatures {
+			if bytes.Equal(header, signature) {
+				return nil
+			}
+		}
+		return fmt.Errorf("frame %s isn't a %s file", framePath, extension)
+	}
+	if err != nil {
+		return fmt.Errorf("frame %s is invalid: %w", framePath, err)
+	}
+	return nil
+}
+
+// MissingFrames returns the frames of the list that aren't recorded as rendered, or whose files are missing or invalid
+func MissingFrames(frames []int64, id string, path string, extension string, db db.Database) []int64 {
+	rendered, err := db.ReadRenderedFrames(id)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("unable to read rendered frames of %s, rendering all of them: %s", id, err.Error())
+		return frames
+	}
+
+	var missing []int64
+	for _, frame := range frames {
+		if rendered[frame] {
+			err := ValidateFrame(filepath.Join(path, "output", FormatFrameFilename(int(frame), extension)))
+			if err == nil {
+				continue
+			}
+			videoRenderingLogger.Logger.Error("rendered frame %v of %s is invalid: %s", frame, id, err.Error())
+		}
+		missing = append(missing, frame)
+	}
+	return missing
+}
@@ -0,0 +1,158 @@
+# Pins the render settings of the scene, so every worker renders bit-identical frames,
+# and applies the render settings of the task. Blender runs it with --python before rendering.
+# Settings are passed after "--". Zero or empty task settings keep the ones of the .blend file.
+import argparse
+import sys
+
+import bpy
+
+# render engine ids of each task engine. EEVEE was renamed in blender 4.2
+ENGINES = {
+    "CYCLES": ["CYCLES"],
+    "EEVEE": ["BLENDER_EEVEE_NEXT", "BLENDER_EEVEE"],
+    "WORKBENCH": ["BLENDER_WORKBENCH"],
+}
+
+
+def parse_settings():
+    argv = sys.argv[sys.argv.index("--") + 1:] if "--" in sys.argv else []
+    parser = argparse.ArgumentParser(prog="deterministic_render.py")
+    parser.add_argument("--seed", type=int, required=True)
+    parser.add_argument("--threads", type=int, required=True)
+    parser.add_argument("--denoiser", required=True)
+    parser.add_argument("--tile-size", type=int, required=True)
+    parser.add_argument("--engine", choices=ENGINES.keys(), default="CYCLES")
+    parser.add_argument("--resolution-x", type=int, default=0)
+    parser.add_argument("--resolution-y", type=int, default=0)
+    parser.add_argument("--resolution-percentage", type=int, default=0)
+    parser.add_argument("--samples", type=int, default=0)
+    parser.add_argument("--scene-name", default="")
+    parser.add_argument("--camera", default="")
+    parser.add_argument("--view-layer", default="")
+    parser.add_argument("--format", default="PNG")
+    parser.add_argument("--color-depth", default="")
+    parser.add_argument("--tile-columns", type=int, default=1)
+    parser.add_argument("--tile-rows", type=int, default=1)
+    parser.add_argument("--tile", type=int, default=0)
+    return parser.parse_args(argv)
+
+
+def pin(target, name, value):
+    # some settings don't exist in every blender version
+    if hasattr(target, name):
+        setattr(target, name, value)
+
+
+def pin_scene(scene, settings):
+    render = scene.render
+    render.threads_mode = "FIXED"
+    render.threads = settings.threads
+    # stamps can include the date and render time
+    render.use_stamp = False
+
+    cycles = scene.cycles
+    cycles.seed = settings.seed
+    # an animated seed changes the noise pattern of each frame
+    cycles.use_animated_seed = False
+    cycles.device = "CPU"
+    pin(cycles, "use_auto_tile", True)
+    pin(cycles, "tile_size", settings.tile_size)
+    # adaptive sampling stops on a time-dependent noise estimate
+    pin(cycles, "use_adaptive_sampling", False)
+    pin(cycles, "time_limit", 0)
+
+    denoise = settings.denoiser != "NONE"
+    cycles.use_denoising = denoise
+    if denoise:
+        cycles.denoiser = settings.denoiser
+    for view_layer in scene.view_layers:
+        pin(view_layer.cycles, "use_denoising", denoise)
+
+
+def set_engine(render, engine):
+    for engine_id in ENGINES[engine]:
+        try:
+            render.engine = engine_id
+            return
+        except TypeError:
+            continue
+    raise SystemExit("janction: render engine %s is not available" % engine)
+
+
+def apply_task_settings(scene, settings):
+    render = scene.render
+    set_engine(render, settings.engine)
+
+    if settings.resolution_x and settings.resolution_y:
+        render.resolution_x = settings.resolution_x
+        render.resolution_y = settings.resolution_y
+    if settings.resolution_percentage:
+        render.resolution_percentage = settings.resolution_percentage
+
+    if settings.samples:
+        if settings.engine == "CYCLES":
+            scene.cycles.samples = settings.samples
+        elif settings.engine == "EEVEE":
+            scene.eevee.taa_render_samples = settings.samples
+
+    if settings.camera:
+        camera = bpy.data.objects.get(settings.camera)
+        if camera is None or camera.type != "CAMERA":
+            raise SystemExit("janction: camera %s not found" % settings.camera)
+        scene.camera = camera
+
+    if settings.view_layer:
+        if scene.view_layers.get(settings.view_layer) is None:
+            raise SystemExit("janction: view layer %s not found" % settings.view_layer)
+        # only the selected view layer is rendered
+        for view_layer in scene.view_layers:
+            view_layer.use = view_layer.name == settings.view_layer
+
+
+def apply_output_settings(scene, settings):
+    image_settings = scene.render.image_settings
+    if image_settings.file_format != settings.format:
+        raise SystemExit("janction: output format %s was not set" % settings.format)
+    if settings.color_depth:
+        image_settings.color_depth = settings.color_depth
+    if settings.format in ("OPEN_EXR", "OPEN_EXR_MULTILAYER"):
+        # lossy exr codecs don't give the same pixels on every worker
+        image_settings.exr_codec = "ZIP"
+    if settings.format == "JPEG":
+        image_settings.quality = 90
+    if settings.format == "TIFF":
+        image_settings.tiff_codec = "DEFLATE"
+
+
+def apply_tile(scene, settings):
+    tiles = settings.tile_columns * settings.tile_rows
+    if tiles <= 1:
+        return
+    if not 0 <= settings.tile < tiles:
+        raise SystemExit("janction: tile %s is outside the grid" % settings.tile)
+
+    # tiles are numbered row by row from the top left, blender borders start at the bottom left.
+    # Adjacent tiles share the same border value, so they split the frame without gaps or overlaps
+    column = settings.tile % settings.tile_columns
+    row = settings.tile // settings.tile_columns
+    render = scene.render
+    render.use_border = True
+    render.use_crop_to_border = True
+    render.border_min_x = column / settings.tile_columns
+    render.border_max_x = (column + 1) / settings.tile_columns
+    render.border_min_y = 1 - (row + 1) / settings.tile_rows
+    render.border_max_y = 1 - row / settings.tile_rows
+
+
+settings = parse_settings()
+for scene in bpy.data.scenes:
+    pin_scene(scene, settings)
+
+# blender already selected the scene given with --scene
+scene = bpy.context.scene
+if settings.scene_name and scene.name != settings.scene_name:
+    raise SystemExit("janction: scene %s not found" % settings.scene_name)
+apply_task_settings(scene, settings)
+apply_output_settings(scene, settings)
+apply_tile(scene, settings)
+print("janction: render settings pinned", vars(settings))
@@ -1,12 +1,9 @@
 package vm
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -17,46 +14,55 @@ import (
 	"github.com/janction/videoRendering/videoRenderingLogger"
 )
 
-func IsContainerRunning(ctx context.Context, threadId string) bool {
-	name := fmt.Sprintf("myBlender%s", threadId)
+const (
+	// directories of the scene and of the rendered frames inside the renderer container. Only the output is writable
+	rendererInputDir  = "/input"
+	rendererOutputDir = "/output"
+)
+
+// RendererContainerName returns the name of the container rendering the thread at the given path.
+// Nodes sharing a runtime, like the ones of a local devnet, render the same thread in different paths
+func RendererContainerName(threadId string, path string) string {
+	return containerName("myBlender"+threadId+"-", path)
+}
 
-	// Command to check for running containers
-	cmd := exec.CommandContext(ctx, "docker", "ps", "--filter", fmt.Sprintf("name=%s", name), "--format", "{{.Names}}")
+// IsContainerRunning returns true if the container rendering the thread at the given path is running
+func (v *VM) IsContainerRunning(ctx context.Context, threadId string, path string) bool {
+	name := RendererContainerName(threadId, path)
 
-	output, err := cmd.Output()
+	state, err := v.Runtime.State(ctx, name)
 	if err != nil {
-		videoRenderingLogger.Logger.Error("Error executing Docker command: %v\n", err)
+		videoRenderingLogger.Logger.Error("Error checking container %s: %v", name, err)
 		return false
 	}
-
-	// Trim output and compare with container name
-	containerName := strings.TrimSpace(string(output))
-	return containerName == name
+	return state == ContainerRunning
 }
 
-func RenderVideo(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db *db.DB) {
-	if reverse {
-		for i := end; i >= start; i-- {
-			videoRenderingLogger.Logger.Info("Rendering frame %v in reverse", i)
-			renderVideoFrame(ctx, cid, i, id, path, db)
-		}
-	} else {
-		for i := start; i <= end; i++ {
-			videoRenderingLogger.Logger.Info("Rendering frame %v", i)
-			renderVideoFrame(ctx, cid, i, id, path, db)
+// RenderVideo renders the frames in the given order. Frames completed by a previous run are skipped,
+// so restarting a thread resumes from its last good frame. Rendering stops at the first frame that fails,
+// since the rest of the scene is likely broken too. A *FrameFailure is returned if the frame is unrenderable,
+// any other error means the worker couldn't render it
+func (v *VM) RenderVideo(ctx context.Context, cid string, frames []int64, id string, path string, settings RenderSettings, db db.Database) error {
+	extension := FrameExtension(settings.Format)
+	for _, frame := range MissingFrames(frames, id, path, extension, db) {
+		// the file of a frame that isn't recorded may be partially written by a crashed render
+		os.Remove(filepath.Join(path, "output", FormatFrameFilename(int(frame), extension)))
+		videoRenderingLogger.Logger.Info("Rendering frame %v", frame)
+		if err := v.renderVideoFrame(ctx, cid, frame, id, path, settings, db); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
-func renderVideoFrame(ctx context.Context, cid string, frameNumber int64, id string, path string, db *db.DB) error {
-	n := "myBlender" + id
+func (v *VM) renderVideoFrame(ctx context.Context, cid string, frameNumber int64, id string, path string, settings RenderSettings, db db.Database) error {
+	n := RendererContainerName(id, path)
 
 	started := time.Now().Unix()
 	db.AddLogEntry(id, fmt.Sprintf("Started rendering frame %v...", frameNumber), started, 0)
 
-	// Check if the container exists using `docker ps -a`
-	checkCmd := exec.CommandContext(ctx, "docker", "ps", "-a", "--filter", fmt.Sprintf("name=%s", n), "--format", "{{.Names}}")
-	output, err := checkCmd.Output()
+	// Check if the container exists
+	state, err := v.Runtime.State(ctx, n)
 	if err != nil {
 		db.AddLogEntry(id, "Error trying to verify if container already exists.", started, 2)
 		fail := fmt.Errorf("failed to check container existence: %w", err)
@@ -65,110 +71,179 @@ func renderVideoFrame(ctx context.Context, cid string, frameNumber int64, id str
 	}
 
 	// If the container already exists, exit the function
-	if string(output) != "" {
+	if state != ContainerMissing {
 		videoRenderingLogger.Logger.Debug("Container already exists.")
 		return nil
 	}
 
-	// Construct the bind path and command
-	bindPath := fmt.Sprintf("%s:/workspace", path)
+	// the script pinning the render settings is mounted read only
+	scriptMount, err := writeRenderScript(path)
+	if err != nil {
+		db.AddLogEntry(id, fmt.Sprintf("Error writing the render script. %s", err.Error()), started, 2)
+		videoRenderingLogger.Logger.Error("failed to write render script: %s", err.Error())
+		return fmt.Errorf("failed to write render script: %w", err)
+	}
+
+	// the scene is untrusted, so it's only readable and the container can only write its frames
+	sandbox := v.sandboxProfile()
+	if err := outputDir(filepath.Join(path, "output"), sandbox); err != nil {
+		db.AddLogEntry(id, fmt.Sprintf("Error creating the output directory. %s", err.Error()), started, 2)
+		videoRenderingLogger.Logger.Error("failed to create output directory: %s", err.Error())
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	scene := fmt.Sprintf("%s/%s", rendererInputDir, cid)
 
-	var blenderArgs []string
-	if isARM64() {
-		blenderArgs = append(blenderArgs, "blender")
+	// workers only render with the approved image of their architecture, so their frames are comparable
+	image, err := v.rendererImage(ctx, settings.Image)
+	if err != nil {
+		db.AddLogEntry(id, fmt.Sprintf("Error verifying the renderer image. %s", err.Error()), started, 2)
+		videoRenderingLogger.Logger.Error(err.Error())
+		return err
 	}
+
+	var blenderArgs []string
+	// python embedded in the scene, like drivers and startup scripts, never runs. It must be disabled before loading it
+	blenderArgs = append(blenderArgs, "--disable-autoexec")
 	blenderArgs = append(blenderArgs, "--background")
-	blenderArgs = append(blenderArgs, fmt.Sprintf("/workspace/%s", cid))
-	blenderArgs = append(blenderArgs, "--engine")
-	blenderArgs = append(blenderArgs, "CYCLES")
+	blenderArgs = append(blenderArgs, scene)
+	if settings.Scene != "" {
+		// the scene must be selected before rendering, the script pins the rest of the task settings
+		blenderArgs = append(blenderArgs, "--scene")
+		blenderArgs = append(blenderArgs, settings.Scene)
+	}
+	// the output format is set before the script runs, since it pins the color depth and codec of the format
+	blenderArgs = append(blenderArgs, "--render-format")
+	blenderArgs = append(blenderArgs, settings.Format)
+	// the render is aborted if the settings can't be enforced
+	blenderArgs = append(blenderArgs, "--python-exit-code")
+	blenderArgs = append(blenderArgs, "1")
+	blenderArgs = append(blenderArgs, "--python")
+	blenderArgs = append(blenderArgs, renderScriptContainerPath)
 
 	blenderArgs = append(blenderArgs, "--render-output")
-	blenderArgs = append(blenderArgs, "/workspace/output/frame_######")
-	blenderArgs = append(blenderArgs, "--render-format")
-	blenderArgs = append(blenderArgs, "PNG")
+	blenderArgs = append(blenderArgs, rendererOutputDir+"/frame_######")
 	blenderArgs = append(blenderArgs, "--render-frame")
 	blenderArgs = append(blenderArgs, strconv.FormatInt(frameNumber, 10))
+	// arguments after "--" are only read by the render script
+	blenderArgs = append(blenderArgs, "--")
+	blenderArgs = append(blenderArgs, settings.Args()...)
+
+	spec := ContainerSpec{
+		Name:    n,
+		Image:   image,
+		Program: rendererProgram,
+		Args:    blenderArgs,
+		Mounts: []Mount{
+			{Source: filepath.Join(path, cid), Target: scene, ReadOnly: true},
+			{Source: filepath.Join(path, "output"), Target: rendererOutputDir},
+			scriptMount,
+		},
+		Sandbox: sandbox,
+	}
+
+	frameFile := FormatFrameFilename(int(frameNumber), FrameExtension(settings.Format))
+	framePath := filepath.Join(path, "output", frameFile)
+	policy := v.Policy
+	timeout := policy.frameTimeout(settings)
 
-	var dockerArgs []string
-	dockerArgs = append(dockerArgs, "run")
+	// a broken scene is retried a bounded amount of times, then the frame is reported as unrenderable
+	var failure *FrameFailure
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			wait := policy.backoff(attempt)
+			videoRenderingLogger.Logger.Info("Retrying frame %v in %v, attempt %v of %v", frameNumber, wait, attempt, policy.MaxAttempts)
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
 
-	dockerArgs = append(dockerArgs, "--name")
-	dockerArgs = append(dockerArgs, n)
-	dockerArgs = append(dockerArgs, "-v")
-	dockerArgs = append(dockerArgs, bindPath)
-	dockerArgs = append(dockerArgs, "-d")
+		attemptStarted := time.Now()
+		kind, logs, err := v.renderFrameAttempt(ctx, spec, framePath, timeout)
+		if err != nil {
+			if kind == "" {
+				// the runtime failed, not the scene
+				db.AddLogEntry(id, fmt.Sprintf("Error rendering frame %v. %s", frameNumber, err.Error()), started, 2)
+				videoRenderingLogger.Logger.Error(err.Error())
+				return err
+			}
+			failure = &FrameFailure{Frame: frameNumber, Kind: kind, Attempts: attempt, Logs: logs, Err: err}
+			db.AddLogEntry(id, fmt.Sprintf("Attempt %v of frame %v failed with %s. %s", attempt, frameNumber, kind, err.Error()), time.Now().Unix(), 2)
+			videoRenderingLogger.Logger.Error("attempt %v of frame %v failed with %s: %s", attempt, frameNumber, kind, err.Error())
+			continue
+		}
 
-	// TODO if on Mac, we use another image that is non deterministic
-	if isARM64() {
-		dockerArgs = append(dockerArgs, "blender_render")
-	} else {
-		// if non Mac, this image generates deterministics results
-		dockerArgs = append(dockerArgs, "blendergrid/blender")
+		// we capture the duration of the rendering
+		finish := time.Now().Unix()
+		duration := int(time.Since(attemptStarted).Seconds())
+		// we add the log
+		db.AddLogEntry(id, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frameNumber, duration), finish, 1)
+		// and record the duration for the frame
+		videoRenderingLogger.Logger.Info("Recorded duration for frame %v: %v seconds", int(frameNumber), duration)
+		db.AddRenderDuration(id, int(frameNumber), duration)
+		// so a restart doesn't render it again
+		if err := db.AddRenderedFrame(id, frameNumber); err != nil {
+			videoRenderingLogger.Logger.Error("unable to record rendered frame %v: %s", frameNumber, err.Error())
+		}
+		return nil
 	}
-	dockerArgs = append(dockerArgs, blenderArgs...)
 
+	db.AddLogEntry(id, fmt.Sprintf("Frame %v is unrenderable. %s", frameNumber, failure.Error()), time.Now().Unix(), 2)
+	videoRenderingLogger.Logger.Error(failure.Error())
+	return failure
+}
+
+// renderFrameAttempt renders the frame once, stopping the container if it doesn't exit before the timeout.
+// Failures of the scene are classified by their kind, failures of the runtime have none
+func (v *VM) renderFrameAttempt(ctx context.Context, spec ContainerSpec, framePath string, timeout time.Duration) (FailureKind, string, error) {
 	// Create and start the container
-	runCmd := exec.CommandContext(ctx, "docker", dockerArgs...)
-	videoRenderingLogger.Logger.Info("Starting docker: %s", runCmd.String())
-	err = runCmd.Run()
-	if err != nil {
-		db.AddLogEntry(id, fmt.Sprintf("Error in creating the container. %s", err.Error()), started, 1)
-		videoRenderingLogger.Logger.Error("failed to create and start container: %s", err.Error())
-		return fmt.Errorf("failed to create and start container: %w", err)
+	videoRenderingLogger.Logger.Info("Starting container %s: %v", spec.Name, spec.command())
+	if err := v.Runtime.Start(ctx, spec); err != nil {
+		return "", "", fmt.Errorf("failed to create and start container: %w", err)
 	}
+	// stopping the container of a render that timed out kills blender
+	defer v.RemoveContainer(context.Background(), spec.Name)
 
 	// Wait for the container to finish
-	waitCmd := exec.CommandContext(ctx, "docker", "wait", n)
-	err = waitCmd.Run()
-	if err != nil {
-		videoRenderingLogger.Logger.Error("failed to wait for container: %s", err.Error())
-		return fmt.Errorf("failed to wait for container: %w", err)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	exitCode, err := v.Runtime.Wait(waitCtx, spec.Name)
+	timedOut := err != nil && ctx.Err() == nil && waitCtx.Err() == context.DeadlineExceeded
+	if err != nil && !timedOut {
+		return "", "", fmt.Errorf("failed to wait for container: %w", err)
 	}
 
 	// Retrieve and print logs
-	logsCmd := exec.CommandContext(ctx, "docker", "logs", n)
-	logsOutput, err := logsCmd.Output()
+	logs, err := v.Runtime.Logs(ctx, spec.Name)
 	if err != nil {
-		videoRenderingLogger.Logger.Error("failed to retrieve container logs: %s", err.Error())
-		return fmt.Errorf("failed to retrieve container logs: %w", err)
+		return "", "", fmt.Errorf("failed to retrieve container logs: %w", err)
 	}
 	videoRenderingLogger.Logger.Info("Container logs:")
-	videoRenderingLogger.Logger.Info(string(logsOutput))
-
-	RemoveContainer(ctx, n)
+	videoRenderingLogger.Logger.Info(logs)
 
-	// Verify the frame exists and log
-	frameFile := FormatFrameFilename(int(frameNumber))
-	framePath := filepath.Join(path, "output", frameFile)
-	finish := time.Now().Unix()
-	difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
-	if _, err := os.Stat(framePath); errors.Is(err, os.ErrNotExist) {
-		db.AddLogEntry(id, fmt.Sprintf("Error while rendering frame %v. %s file is not there", frameNumber, framePath), started, 2)
-		renderVideoFrame(ctx, cid, frameNumber, id, path, db)
-	} else {
-		// we capture the duration of the rendering
-		duration := int(difference.Seconds())
-		// we add the log
-		db.AddLogEntry(id, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frameNumber, duration), finish, 1)
-		// and record the duration for the frame
-		videoRenderingLogger.Logger.Info("Recorded duration for frame %v: %v seconds", int(frameNumber), duration)
-		db.AddRenderDuration(id, int(frameNumber), duration)
+	if timedOut {
+		return FailureTimeout, logs, fmt.Errorf("render didn't finish in %v", timeout)
 	}
-	return nil
+	if exitCode != 0 {
+		return FailureCrash, logs, fmt.Errorf("blender exited with code %v", exitCode)
+	}
+	// Verify the frame exists
+	if err := ValidateFrame(framePath); err != nil {
+		return FailureMissingOutput, logs, err
+	}
+	return "", logs, nil
 }
 
-func RemoveContainer(ctx context.Context, name string) error {
+func (v *VM) RemoveContainer(ctx context.Context, name string) error {
 	// Remove the container after completion
-	rmCmd := exec.CommandContext(ctx, "docker", "rm", name)
-	err := rmCmd.Run()
+	err := v.Runtime.Remove(ctx, name)
 	if err != nil {
 		videoRenderingLogger.Logger.Error(err.Error())
 	}
 	return err
 }
 
-// CountFilesInDirectory counts the number of files in a given directory
-func CountFilesInDirectory(directoryPath string) int {
+// CountFilesInDirectory counts the number of files with the given extension in a directory
+func CountFilesInDirectory(directoryPath string, extension string) int {
 	// Read the directory contents
 	files, err := os.ReadDir(directoryPath)
 	if err != nil {
@@ -179,16 +254,31 @@ func CountFilesInDirectory(directoryPath string) int {
 	// Count only files (not subdirectories)
 	fileCount := 0
 	for _, file := range files {
-		if !file.IsDir() {
+		if !file.IsDir() && filepath.Ext(file.Name()) == "."+extension {
 			fileCount++
 		}
 	}
 	return fileCount
 }
 
-// FormatFrameFilename returns the correct filename for a given frame number.
-func FormatFrameFilename(frameNumber int) string {
-	return fmt.Sprintf("frame_%06d.png", frameNumber)
+// FormatFrameFilename returns the correct filename for a given frame number and extension.
+func FormatFrameFilename(frameNumber int, extension string) string {
+	return fmt.Sprintf("frame_%06d.%s", frameNumber, extension)
+}
+
+// ParseFrameFilename returns the frame number of a filename generated with FormatFrameFilename for any output format.
+func ParseFrameFilename(filename string) (int64, error) {
+	extension := strings.TrimPrefix(filepath.Ext(filename), ".")
+	if !IsFrameExtension(extension) {
+		return 0, fmt.Errorf("invalid frame filename %s", filename)
+	}
+
+	var frameNumber int64
+	_, err := fmt.Sscanf(strings.TrimSuffix(filename, "."+extension), "frame_%d", &frameNumber)
+	if err != nil || FormatFrameFilename(int(frameNumber), extension) != filename {
+		return 0, fmt.Errorf("invalid frame filename %s", filename)
+	}
+	return frameNumber, nil
 }
 
 func isARM64() bool {
@@ -196,23 +286,11 @@ func isARM64() bool {
 	return runtime.GOARCH == "arm64"
 }
 
-func IsContainerExited(threadId string) (bool, error) {
-	containerName := "myBlender" + threadId
-	cmd := exec.Command(
-		"docker", "ps", "-a",
-		"--filter", "name="+containerName,
-		"--filter", "status=exited",
-		"--format", "{{.Names}}",
-	)
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	if err := cmd.Run(); err != nil {
+// IsContainerExited returns true if the container rendering the thread at the given path exited without being removed
+func (v *VM) IsContainerExited(threadId string, path string) (bool, error) {
+	state, err := v.Runtime.State(context.Background(), RendererContainerName(threadId, path))
+	if err != nil {
 		return false, err
 	}
-
-	// Trim and check if the container name appears in output
-	result := strings.TrimSpace(out.String())
-	return result == containerName, nil
+	return state == ContainerExited, nil
 }
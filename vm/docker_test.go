@@ -3,10 +3,13 @@ package vm
 import (
 	"context"
 	"fmt"
+	"image"
+	"image/png"
 	"os"
-	"os/exec"
-	"reflect"
+	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 	"testing"
 
 	"bou.ke/monkey"
@@ -20,20 +23,13 @@ import (
 func TestIsContainerRunningKo(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
+	machine, fake := newFakeVM()
 
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Output error")
-	})
-	defer patch2.Unpatch()
+	// 2. Simulate the runtime failing
+	fake.StateErr = fmt.Errorf("State error")
 
 	// 3. Execute method under test
-	b := IsContainerRunning(ctx, "1234")
+	b := machine.IsContainerRunning(ctx, "1234", "/tmp/renders/1234")
 
 	// 4. Verification
 	require.False(t, b)
@@ -42,78 +38,123 @@ func TestIsContainerRunningKo(t *testing.T) {
 func TestIsContainerRunningOk(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
+	machine, fake := newFakeVM()
 
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("myBlender1234"), nil
-	})
-	defer patch2.Unpatch()
+	// 2. Simulate the container running
+	fake.AddContainer(RendererContainerName("1234", "/tmp/renders/1234"), ContainerRunning)
 
 	// 3. Execute method under test
-	b := IsContainerRunning(ctx, "1234")
+	b := machine.IsContainerRunning(ctx, "1234", "/tmp/renders/1234")
 
 	// 4. Verification
 	require.True(t, b)
+	require.False(t, machine.IsContainerRunning(ctx, "5678", "/tmp/renders/5678"))
+	// the same thread rendered by another node
+	require.False(t, machine.IsContainerRunning(ctx, "1234", "/tmp/node2/renders/1234"))
 }
 
 // --- Test for RenderVideo ---
-func TestRenderVideoNoReverse(t *testing.T) {
+func TestRenderVideo(t *testing.T) {
 	// 1. Setup
 	mockDB := new(mocks.DB)
 	ctx := context.Background()
 	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	id := "thread123"
 	path := "/tmp/rendering/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := false
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
+	frames := []int64{2, 5, 8, 3, 6, 1, 4, 7}
+	function_calls := make([]int64, 0, 8) // Empty slice with a capacity of 8
+	machine := DefaultVM()
+	mockDB.On("ReadRenderedFrames", id).Return(map[int64]bool{}, nil)
 
 	// 2. Monkey patch the renderVideoFrame function to not actually call it, just save the call to a variable
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
+	patch1 := monkey.Patch((*VM).renderVideoFrame, func(v *VM, ctx context.Context, cid string, frameNumber int64, id string, path string, settings RenderSettings, db db.Database) error {
 		function_calls = append(function_calls, frameNumber)
 		return nil
 	})
 	defer patch1.Unpatch()
 
 	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
+	machine.RenderVideo(ctx, cid, frames, id, path, testRenderSettings(), mockDB)
 
 	// 5. Verification
-	expected := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	require.Equal(t, function_calls, expected)
+	require.Equal(t, frames, function_calls)
 }
 
-func TestRenderVideoReverse(t *testing.T) {
+func TestRenderVideo_SkipsRenderedFrames(t *testing.T) {
 	// 1. Setup
 	mockDB := new(mocks.DB)
 	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := true
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
-
-	// 2. Monkey patch the renderVideoFrame function to not actually call it, just count the number of times it is called
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
+	path := t.TempDir()
+	output := filepath.Join(path, "output")
+	require.NoError(t, os.MkdirAll(output, 0755))
+
+	// frames 1 and 2 were rendered before a restart, frame 3 is recorded but its file was truncated,
+	// and frame 4 was partially written without being recorded
+	writeTestFrame(t, filepath.Join(output, FormatFrameFilename(1, "png")))
+	writeTestFrame(t, filepath.Join(output, FormatFrameFilename(2, "png")))
+	require.NoError(t, os.WriteFile(filepath.Join(output, FormatFrameFilename(3, "png")), []byte("\x89PNG"), 0644))
+	writeTestFrame(t, filepath.Join(output, FormatFrameFilename(4, "png")))
+	mockDB.On("ReadRenderedFrames", id).Return(map[int64]bool{1: true, 2: true, 3: true}, nil)
+
+	var function_calls []int64
+	machine := DefaultVM()
+	patch1 := monkey.Patch((*VM).renderVideoFrame, func(v *VM, ctx context.Context, cid string, frameNumber int64, id string, path string, settings RenderSettings, db db.Database) error {
 		function_calls = append(function_calls, frameNumber)
 		return nil
 	})
 	defer patch1.Unpatch()
 
-	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
+	// 2. Execute method under test
+	machine.RenderVideo(ctx, "cid", []int64{1, 2, 3, 4, 5}, id, path, testRenderSettings(), mockDB)
 
-	// 5. Verification
-	expected := []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
-	require.Equal(t, function_calls, expected)
+	// 3. Verification
+	require.Equal(t, []int64{3, 4, 5}, function_calls)
+	// unrecorded frames are rendered from scratch
+	_, err := os.Stat(filepath.Join(output, FormatFrameFilename(4, "png")))
+	require.True(t, os.IsNotExist(err))
+	mockDB.AssertExpectations(t)
+}
+
+// --- Test for ValidateFrame ---
+func TestValidateFrame(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, FormatFrameFilename(1, "png"))
+	writeTestFrame(t, valid)
+	require.NoError(t, ValidateFrame(valid))
+
+	exr := filepath.Join(dir, FormatFrameFilename(1, "exr"))
+	require.NoError(t, os.WriteFile(exr, []byte{0x76, 0x2f, 0x31, 0x01, 0x02}, 0644))
+	require.NoError(t, ValidateFrame(exr))
+
+	tif := filepath.Join(dir, FormatFrameFilename(1, "tif"))
+	require.NoError(t, os.WriteFile(tif, []byte("MM\x00*\x00"), 0644))
+	require.NoError(t, ValidateFrame(tif))
+
+	// truncated, empty, missing and unknown frames are invalid
+	truncated := filepath.Join(dir, FormatFrameFilename(2, "png"))
+	content, err := os.ReadFile(valid)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(truncated, content[:len(content)/2], 0644))
+	require.Error(t, ValidateFrame(truncated))
+
+	empty := filepath.Join(dir, FormatFrameFilename(2, "exr"))
+	require.NoError(t, os.WriteFile(empty, nil, 0644))
+	require.Error(t, ValidateFrame(empty))
+
+	require.Error(t, ValidateFrame(filepath.Join(dir, FormatFrameFilename(3, "png"))))
+
+	unknown := filepath.Join(dir, "frame_000001.txt")
+	require.NoError(t, os.WriteFile(unknown, []byte("text"), 0644))
+	require.Error(t, ValidateFrame(unknown))
+}
+
+func writeTestFrame(t *testing.T, path string) {
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, png.Encode(file, image.NewGray(image.Rect(0, 0, 4, 4))))
 }
 
 // --- Test for renderVideoFrame ---
@@ -125,23 +166,16 @@ func TestRenderVideoFrame_ContainerVerificationError(t *testing.T) {
 	frameNumber := int64(42)
 	id := "thread123"
 	path := "/tmp/rendering/thread123/frame_42"
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Error verifying if container already exists")
-	})
-	defer patch2.Unpatch()
+	// 3. Simulate the runtime failing to check the container
+	fake.StateErr = fmt.Errorf("Error verifying if container already exists")
 
 	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
 	// 5. Verification
 	require.Error(t, err)
@@ -159,26 +193,20 @@ func TestRenderVideoFrame_ContainerAlreadyExist(t *testing.T) {
 	frameNumber := int64(42)
 	id := "thread123"
 	path := "/tmp/rendering/thread123/frame_42"
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("myBlender1234"), nil
-	})
-	defer patch2.Unpatch()
+	// 3. Simulate the container exists
+	fake.AddContainer(RendererContainerName(id, path), ContainerRunning)
 
 	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
 	// 5. Verification
 	require.NoError(t, err)
+	require.Empty(t, fake.Started())
 
 	// 6. Verify mock expectations
 	mockDB.AssertExpectations(t)
@@ -191,43 +219,85 @@ func TestRenderVideoFrame_CreatingContainerKo(t *testing.T) {
 	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	frameNumber := int64(42)
 	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
+	path := t.TempDir()
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
+	// 3. Simulate failure when creating the container
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		return "", 0, fmt.Errorf("Error creating container")
+	}
 
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate failure when creating the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("Error creating container")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 4. Execute the function under test
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
-	// 7. Assert the error
+	// 5. Assert the error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to create and start container: Error creating container")
 
-	// 8. Verify mock expectations
+	// 6. Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
+func TestRenderVideoFrame_EnforcesRenderSettings(t *testing.T) {
+	// 1. Setup
+	mockDB := new(mocks.DB)
+	ctx := context.Background()
+	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
+	path := t.TempDir()
+	machine, fake := newFakeVM()
+
+	// 2. Mock DB methods
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// 3. Capture the container and fail to create it
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		return "", 0, fmt.Errorf("Error creating container")
+	}
+
+	// 4. Execute the function under test
+	settings := testRenderSettings()
+	settings.Scene = "Shot 2"
+	machine.renderVideoFrame(ctx, cid, 42, "thread123", path, settings, mockDB)
+
+	// 5. The script is mounted and run with the enforced settings after the blender arguments
+	require.Len(t, fake.Started(), 1)
+	spec := fake.Started()[0]
+	require.Equal(t, RendererContainerName("thread123", path), spec.Name)
+	require.Equal(t, "blender", spec.Program)
+	require.Equal(t, testRendererImage.Reference(), spec.Image)
+	mount, _ := writeRenderScript(path)
+	require.Contains(t, spec.Mounts, mount)
+	runArgs := spec.Args
+
+	// the scene is only readable, and frames are written to their own mount
+	require.Contains(t, spec.Mounts, Mount{Source: filepath.Join(path, cid), Target: "/input/" + cid, ReadOnly: true})
+	require.Contains(t, spec.Mounts, Mount{Source: filepath.Join(path, "output"), Target: "/output"})
+	require.Equal(t, "/input/"+cid, runArgs[slices.Index(runArgs, "--background")+1])
+	require.Equal(t, "/output/frame_######", runArgs[slices.Index(runArgs, "--render-output")+1])
+	require.DirExists(t, filepath.Join(path, "output"))
+
+	// python of the scene is disabled before loading it, and blender runs in the sandbox
+	require.Less(t, slices.Index(runArgs, "--disable-autoexec"), slices.Index(runArgs, "--background"))
+	require.GreaterOrEqual(t, slices.Index(runArgs, "--disable-autoexec"), 0)
+	require.Equal(t, DefaultSandbox(), *spec.Sandbox)
+
+	script := slices.Index(runArgs, "--python")
+	require.Greater(t, script, 0)
+	require.Equal(t, renderScriptContainerPath, runArgs[script+1])
+	separator := slices.Index(runArgs, "--")
+	require.Greater(t, separator, script)
+	require.Equal(t, settings.Args(), runArgs[separator+1:])
+
+	// the scene is selected before the script runs
+	scene := slices.Index(runArgs, "--scene")
+	require.Greater(t, scene, 0)
+	require.Less(t, scene, script)
+	require.Equal(t, "Shot 2", runArgs[scene+1])
+}
+
 func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerKo(t *testing.T) {
 	// 1. Setup
 	mockDB := new(mocks.DB)
@@ -235,48 +305,23 @@ func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerKo(t *testing.T) {
 	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	frameNumber := int64(42)
 	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
+	path := t.TempDir()
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating the container and failure when waiting for it
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return fmt.Errorf("failed here")
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
+	// 3. Simulate failure when waiting for the container
+	fake.WaitErr = fmt.Errorf("failed here")
 
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 4. Execute the function under test
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
-	// 7. Assert the error
+	// 5. Assert the error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to wait for container: failed here")
 
-	// 8. Verify mock expectations
+	// 6. Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
@@ -287,54 +332,23 @@ func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsKo(
 	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	frameNumber := int64(42)
 	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
+	path := t.TempDir()
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs fail
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return nil, fmt.Errorf("failed here")
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container and failure when retrieving
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
+	// 3. Simulate failure when retrieving the logs
+	fake.LogsErr = fmt.Errorf("failed here")
 
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 4. Execute the function under test
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
-	// 7. Assert the error
+	// 5. Assert the error
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to retrieve container logs: failed here")
 
-	// 8. Verify mock expectations
+	// 6. Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
@@ -345,59 +359,30 @@ func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsOk_
 	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
 	frameNumber := int64(42)
 	id := "thread123"
-	path := "/tmp/rendering/thread123/frame_42"
+	path := t.TempDir()
+	machine, fake := newFakeVM()
 
 	// 2. Mock DB methods
 	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockDB.On("AddRenderDuration", id, int(frameNumber), mock.Anything).Return(nil)
+	mockDB.On("AddRenderedFrame", id, frameNumber).Return(nil)
 
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs succeeds
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return []byte(""), nil
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
+	// 3. Simulate the container rendering the frame
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		output := filepath.Join(path, "output")
+		require.NoError(t, os.MkdirAll(output, 0755))
+		writeTestFrame(t, filepath.Join(output, FormatFrameFilename(int(frameNumber), "png")))
+		return "Saved frame", 0, nil
+	}
 
-	// 6. Patch Os.Stat to simulate success when verifying that file exists
-	patch4 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return nil, nil
-	})
-	defer patch4.Unpatch()
-
-	// 7. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 4. Execute the function under test
+	err := machine.renderVideoFrame(ctx, cid, frameNumber, id, path, testRenderSettings(), mockDB)
 
-	// 8. Assert no error
+	// 5. Assert no error, and the container is removed
 	require.NoError(t, err)
+	require.Nil(t, fake.Container(RendererContainerName(id, path)))
 
-	// 9. Verify mock expectations
+	// 6. Verify mock expectations
 	mockDB.AssertExpectations(t)
 }
 
@@ -406,55 +391,32 @@ func TestRemoveContainerKo(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
 	name := "container123"
+	machine, fake := newFakeVM()
 
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("failed removing container")
-	})
-	defer patch2.Unpatch()
+	// 2. Simulate failure when removing the container
+	fake.RemoveErr = fmt.Errorf("Error removing container")
 
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
+	// 3. Execute the function under test
+	err := machine.RemoveContainer(ctx, name)
 
-	// 5. Assert the error
+	// 4. Assert the error
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed removing container")
+	require.Contains(t, err.Error(), "Error removing container")
 }
 
 func TestRemoveContainerOk(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
 	name := "container123"
+	machine, fake := newFakeVM()
+	fake.AddContainer(name, ContainerExited)
 
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return nil
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
+	// 2. Execute the function under test
+	err := machine.RemoveContainer(ctx, name)
 
-	// 5. Assert no error
+	// 3. Assert no error
 	require.NoError(t, err)
+	require.Nil(t, fake.Container(name))
 }
 
 // --- Test for CountFilesInDirectory ---
@@ -469,7 +431,7 @@ func TestCountFilesInDirectoryKo(t *testing.T) {
 	defer patch4.Unpatch()
 
 	// 3. Execute the function under test
-	count := CountFilesInDirectory(path)
+	count := CountFilesInDirectory(path, "png")
 
 	// 4. Assert that the count is 0
 	require.Equal(t, count, 0)
@@ -486,24 +448,57 @@ func TestCountFilesInDirectoryOk(t *testing.T) {
 	defer patch4.Unpatch()
 
 	// 3. Execute the function under test
-	count := CountFilesInDirectory(path)
+	count := CountFilesInDirectory(path, "png")
 
 	// 4. Assert that the count is 0
 	require.Equal(t, count, 0)
 }
 
+func TestCountFilesInDirectory_OnlyExtension(t *testing.T) {
+	path := t.TempDir()
+	for _, name := range []string{"frame_000001.exr", "frame_000002.exr", "frame_000001.png"} {
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), nil, 0644))
+	}
+
+	require.Equal(t, 2, CountFilesInDirectory(path, "exr"))
+	require.Equal(t, 1, CountFilesInDirectory(path, "png"))
+}
+
 // --- Test for FormatFrameFilename ---
 func TestFormatFrameFilename(t *testing.T) {
 	// 1. Setup
 	frame := 42
 
 	// 2. Execute the function under test
-	filename := FormatFrameFilename(frame)
+	filename := FormatFrameFilename(frame, "png")
 
 	// 3. Assert
 	require.Equal(t, filename, "frame_000042.png")
 }
 
+// --- Test for ParseFrameFilename ---
+func TestParseFrameFilename(t *testing.T) {
+	// 1. Execute the function under test
+	frame, err := ParseFrameFilename("frame_000042.png")
+
+	// 2. Assert
+	require.NoError(t, err)
+	require.Equal(t, int64(42), frame)
+
+	_, err = ParseFrameFilename("frame1.png")
+	require.Error(t, err)
+
+	_, err = ParseFrameFilename("video1.mp4")
+	require.Error(t, err)
+
+	frame, err = ParseFrameFilename("frame_000042.exr")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), frame)
+
+	_, err = ParseFrameFilename("frame_000042.gif")
+	require.Error(t, err)
+}
+
 // --- Test for IsARM64 ---
 func TestIsARM64(t *testing.T) {
 	// 1. Execute the function under test
@@ -517,64 +512,59 @@ func TestIsARM64(t *testing.T) {
 func TestIsContainerExitedKo(t *testing.T) {
 	// 1. Setup
 	id := "thread123"
+	machine, fake := newFakeVM()
 
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			return fmt.Errorf("Error creating container")
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
+	// 2. Simulate the runtime failing
+	fake.StateErr = fmt.Errorf("Error checking container")
 
-	// 4. Execute the function under test
-	result, err := IsContainerExited(id)
+	// 3. Execute the function under test
+	result, err := machine.IsContainerExited(id, "/tmp/renders/"+id)
 
-	// 5. Assert
+	// 4. Assert
 	require.Equal(t, result, false)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Error creating container")
+	require.Contains(t, err.Error(), "Error checking container")
 }
 
 func TestIsContainerExitedOk(t *testing.T) {
 	// 1. Setup
 	threadId := "thread123"
-	containerName := "myBlender" + threadId
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			cmd.Stdout.Write([]byte(containerName))
-			return nil
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
+	machine, fake := newFakeVM()
+	fake.AddContainer(RendererContainerName(threadId, "/tmp/renders/"+threadId), ContainerExited)
+	fake.AddContainer(RendererContainerName("thread456", "/tmp/renders/thread456"), ContainerRunning)
 
-	// 4. Execute the function under test
-	result, err := IsContainerExited(threadId)
+	// 2. Execute the function under test
+	result, err := machine.IsContainerExited(threadId, "/tmp/renders/"+threadId)
 
-	// 5. Assert
+	// 3. Assert
 	require.Equal(t, result, true)
 	require.NoError(t, err)
+
+	result, err = machine.IsContainerExited("thread456", "/tmp/renders/thread456")
+	require.False(t, result)
+	require.NoError(t, err)
+}
+
+// newFakeVM returns a vm running the containers of the test with an in-memory runtime
+func newFakeVM() (*VM, *FakeRuntime) {
+	fake := NewFakeRuntime()
+	return newTestVM(fake), fake
+}
+
+// renderer image approved in the tests
+var testRendererImage = RendererImage{Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: runtime.GOARCH, Digest: "sha256:" + strings.Repeat("ab", 32)}
+
+// ffmpeg image the videos of the tests are encoded with
+var testEncoderImage = "jrottenberg/ffmpeg@sha256:" + strings.Repeat("ef", 32)
+
+// testRenderSettings returns the default settings rendered with the approved image of the tests
+func testRenderSettings() RenderSettings {
+	settings := DefaultRenderSettings()
+	settings.Image = testRendererImage
+	return settings
+}
+
+// newTestVM returns a vm running the containers of the test with the runtime, the default sandbox and render policy
+func newTestVM(runtime ContainerRuntime) *VM {
+	return &VM{Runtime: runtime, Sandbox: DefaultSandbox(), Policy: DefaultRenderPolicy()}
 }
@@ -0,0 +1,101 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+const (
+	// directories of the frames and of the video inside the ffmpeg container. Frames are only readable
+	encoderInputDir  = "/input"
+	encoderOutputDir = "/output"
+	// directory of the assembly the video is encoded to, before moving it next to the frames
+	encoderOutput = "encoded"
+	// AssemblyFramesDir is the directory of the assembly with the frames of every thread
+	AssemblyFramesDir = "frames"
+	// AssembledVideoFilename is the name of the video encoded in the directory of the assembly
+	AssembledVideoFilename = "video.mp4"
+)
+
+// ValidateEncoderImage returns an error if the ffmpeg image isn't pinned by a sha256 digest, as name@sha256:<hex>
+func ValidateEncoderImage(reference string) error {
+	name, digest, ok := strings.Cut(reference, "@")
+	if !ok || name == "" || strings.Contains(name, " ") || !isDigest(digest) {
+		return fmt.Errorf("encoder image must be pinned by a sha256 digest, got %s", reference)
+	}
+	return nil
+}
+
+// encoderImage pulls the ffmpeg image videos are encoded with and returns its reference once its digest is verified
+func (v *VM) encoderImage(ctx context.Context, reference string) (string, error) {
+	if err := ValidateEncoderImage(reference); err != nil {
+		return "", err
+	}
+	_, digest, _ := strings.Cut(reference, "@")
+	if err := v.pullPinnedImage(ctx, reference, digest); err != nil {
+		return "", err
+	}
+	return reference, nil
+}
+
+// EncodeVideo encodes the frames inside the frames directory of dir into its video, starting at startFrame.
+// The encoder runs single threaded with bit exact flags and without metadata in the pinned image, so the video
+// can be re-encoded by any worker to verify its hash.
+func (v *VM) EncodeVideo(ctx context.Context, image string, dir string, startFrame int64, frameRate uint32, extension string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	image, err = v.encoderImage(ctx, image)
+	if err != nil {
+		return "", err
+	}
+
+	var args []string
+	args = append(args, "-y", "-hide_banner")
+	args = append(args, "-framerate", strconv.FormatUint(uint64(frameRate), 10))
+	args = append(args, "-start_number", strconv.FormatInt(startFrame, 10))
+	args = append(args, "-i", fmt.Sprintf("%s/frame_%%06d.%s", encoderInputDir, extension))
+	// timestamps and encoder versions are left out of the file
+	args = append(args, "-map_metadata", "-1", "-fflags", "+bitexact", "-flags:v", "+bitexact")
+	args = append(args, "-c:v", "libx264", "-preset", "medium", "-crf", "18", "-pix_fmt", "yuv420p")
+	// multi threaded encodes depend on scheduling
+	args = append(args, "-threads", "1")
+	args = append(args, fmt.Sprintf("%s/%s", encoderOutputDir, AssembledVideoFilename))
+
+	// frames come from other workers, so they are decoded in the sandbox
+	sandbox := v.sandboxProfile()
+	output := filepath.Join(dir, encoderOutput)
+	if err := outputDir(output, sandbox); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+	spec := ContainerSpec{
+		Name:    containerName("myFfmpeg", dir),
+		Image:   image,
+		Program: "ffmpeg",
+		Args:    args,
+		Mounts: []Mount{
+			{Source: filepath.Join(dir, AssemblyFramesDir), Target: encoderInputDir, ReadOnly: true},
+			{Source: output, Target: encoderOutputDir},
+		},
+		Sandbox: sandbox,
+	}
+	videoRenderingLogger.Logger.Info("Encoding video: %v", args)
+	logs, err := v.runContainer(ctx, spec)
+	if err != nil {
+		videoRenderingLogger.Logger.Error("failed to encode video in %s: %s", dir, logs)
+		return "", fmt.Errorf("failed to encode video in %s: %w", dir, err)
+	}
+
+	videoPath := filepath.Join(dir, AssembledVideoFilename)
+	if err := os.Rename(filepath.Join(output, AssembledVideoFilename), videoPath); err != nil {
+		return "", fmt.Errorf("failed to encode video in %s: %w", dir, err)
+	}
+	return videoPath, nil
+}
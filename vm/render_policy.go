@@ -0,0 +1,99 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// longest wait between two attempts of a frame, however many attempts the policy allows
+const maxRetryBackoff = 10 * time.Minute
+
+// RenderPolicy bounds the time and the attempts a worker spends on every frame, so a broken scene can't wedge it
+type RenderPolicy struct {
+	// seconds a frame renders before its container is stopped. Tasks can override it
+	FrameTimeout int64 `toml:"frame_timeout"`
+	// renders of a frame before it's reported as unrenderable
+	MaxAttempts int `toml:"max_attempts"`
+	// seconds waited before the second attempt of a frame, doubled before every following one
+	RetryBackoff int64 `toml:"retry_backoff"`
+}
+
+// DefaultRenderPolicy returns the policy of workers that don't configure one
+func DefaultRenderPolicy() RenderPolicy {
+	return RenderPolicy{
+		FrameTimeout: 3600,
+		MaxAttempts:  3,
+		RetryBackoff: 10,
+	}
+}
+
+// Validate returns an error if the policy doesn't render frames at least once within a timeout
+func (p RenderPolicy) Validate() error {
+	if p.FrameTimeout <= 0 || p.MaxAttempts <= 0 || p.RetryBackoff < 0 {
+		return fmt.Errorf("render policy needs a positive frame timeout and attempts, got %vs timeout, %v attempts and %vs backoff", p.FrameTimeout, p.MaxAttempts, p.RetryBackoff)
+	}
+	return nil
+}
+
+// frameTimeout returns how long a frame rendered with the settings can take: the timeout of the task, or the local one
+func (p RenderPolicy) frameTimeout(settings RenderSettings) time.Duration {
+	if settings.FrameTimeout > 0 {
+		return time.Duration(settings.FrameTimeout) * time.Second
+	}
+	return time.Duration(p.FrameTimeout) * time.Second
+}
+
+// backoff returns how long is waited before the given attempt of a frame
+func (p RenderPolicy) backoff(attempt int) time.Duration {
+	wait := time.Duration(p.RetryBackoff) * time.Second
+	for i := 2; i < attempt && wait < maxRetryBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxRetryBackoff)
+}
+
+// FailureKind classifies why a frame couldn't be rendered
+type FailureKind string
+
+const (
+	// the render didn't finish before the frame timeout
+	FailureTimeout FailureKind = "TIMEOUT"
+	// blender exited with an error
+	FailureCrash FailureKind = "CRASH"
+	// blender exited without writing a valid frame
+	FailureMissingOutput FailureKind = "MISSING_OUTPUT"
+)
+
+// FrameFailure is returned when a frame can't be rendered within the attempts of the render policy
+type FrameFailure struct {
+	Frame    int64
+	Kind     FailureKind
+	Attempts int
+	// logs of the last attempt
+	Logs string
+	Err  error
+}
+
+func (f *FrameFailure) Error() string {
+	return fmt.Sprintf("frame %v is unrenderable after %v attempts, last one failed with %s: %v", f.Frame, f.Attempts, f.Kind, f.Err)
+}
+
+func (f *FrameFailure) Unwrap() error {
+	return f.Err
+}
+
+// sleep waits for the duration, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
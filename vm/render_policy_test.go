@@ -0,0 +1,180 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/janction/videoRendering/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPolicy_Validate(t *testing.T) {
+	require.NoError(t, DefaultRenderPolicy().Validate())
+	require.NoError(t, RenderPolicy{FrameTimeout: 1, MaxAttempts: 1}.Validate())
+
+	for name, policy := range map[string]RenderPolicy{
+		"no timeout":       {MaxAttempts: 3, RetryBackoff: 10},
+		"no attempts":      {FrameTimeout: 60, RetryBackoff: 10},
+		"negative backoff": {FrameTimeout: 60, MaxAttempts: 3, RetryBackoff: -1},
+	} {
+		require.Error(t, policy.Validate(), name)
+	}
+}
+
+func TestRenderPolicy_Backoff(t *testing.T) {
+	policy := RenderPolicy{FrameTimeout: 60, MaxAttempts: 20, RetryBackoff: 10}
+	require.Equal(t, 10*time.Second, policy.backoff(2))
+	require.Equal(t, 20*time.Second, policy.backoff(3))
+	require.Equal(t, 40*time.Second, policy.backoff(4))
+	require.Equal(t, maxRetryBackoff, policy.backoff(20))
+
+	policy.RetryBackoff = 0
+	require.Zero(t, policy.backoff(5))
+}
+
+func TestRenderPolicy_FrameTimeout(t *testing.T) {
+	policy := RenderPolicy{FrameTimeout: 60, MaxAttempts: 1}
+	require.Equal(t, time.Minute, policy.frameTimeout(DefaultRenderSettings()))
+
+	// tasks override the timeout of the worker
+	settings := DefaultRenderSettings()
+	settings.FrameTimeout = 600
+	require.Equal(t, 10*time.Minute, policy.frameTimeout(settings))
+	require.Equal(t, DefaultRenderSettings().Fingerprint(), settings.Fingerprint())
+}
+
+func TestRenderVideoFrame_RetriesFailedRenders(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	machine.Policy = RenderPolicy{FrameTimeout: 60, MaxAttempts: 3}
+	id, path := "thread123", t.TempDir()
+	mockDB := new(mocks.DB)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockDB.On("AddRenderDuration", id, 42, mock.Anything).Return(nil)
+	mockDB.On("AddRenderedFrame", id, int64(42)).Return(nil)
+
+	// blender crashes once, then writes nothing, then renders the frame
+	attempts := 0
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		attempts++
+		switch attempts {
+		case 1:
+			return "Segmentation fault", 139, nil
+		case 2:
+			return "", 0, nil
+		}
+		writeTestFrame(t, filepath.Join(path, "output", FormatFrameFilename(42, "png")))
+		return "Saved frame", 0, nil
+	}
+
+	err := machine.renderVideoFrame(ctx, "bafkscene", 42, id, path, testRenderSettings(), mockDB)
+	require.NoError(t, err)
+	require.Len(t, fake.Started(), 3)
+	require.Nil(t, fake.Container(RendererContainerName(id, path)))
+	mockDB.AssertExpectations(t)
+}
+
+func TestRenderVideoFrame_Unrenderable(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	machine.Policy = RenderPolicy{FrameTimeout: 60, MaxAttempts: 3}
+	mockDB := new(mocks.DB)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// blender exits without writing the frame on every attempt
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		return "Nothing rendered", 0, nil
+	}
+
+	err := machine.renderVideoFrame(ctx, "bafkscene", 42, "thread123", t.TempDir(), testRenderSettings(), mockDB)
+	var failure *FrameFailure
+	require.ErrorAs(t, err, &failure)
+	require.Equal(t, int64(42), failure.Frame)
+	require.Equal(t, FailureMissingOutput, failure.Kind)
+	require.Equal(t, 3, failure.Attempts)
+	require.Equal(t, "Nothing rendered", failure.Logs)
+	require.Len(t, fake.Started(), 3)
+	mockDB.AssertNotCalled(t, "AddRenderedFrame", mock.Anything, mock.Anything)
+}
+
+func TestRenderVideoFrame_Timeout(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	fake.Hang = true
+	machine.Policy = RenderPolicy{FrameTimeout: 3600, MaxAttempts: 1}
+	id, path := "thread123", t.TempDir()
+	mockDB := new(mocks.DB)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// the timeout of the task overrides the one of the worker
+	settings := testRenderSettings()
+	settings.FrameTimeout = 1
+	started := time.Now()
+	err := machine.renderVideoFrame(ctx, "bafkscene", 42, id, path, settings, mockDB)
+
+	var failure *FrameFailure
+	require.ErrorAs(t, err, &failure)
+	require.Equal(t, FailureTimeout, failure.Kind)
+	require.Equal(t, 1, failure.Attempts)
+	require.Less(t, time.Since(started), time.Minute)
+	// the hung container is stopped
+	require.Nil(t, fake.Container(RendererContainerName(id, path)))
+}
+
+func TestRenderVideo_StopsAtUnrenderableFrame(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	machine.Policy = RenderPolicy{FrameTimeout: 60, MaxAttempts: 2}
+	id, path := "thread123", t.TempDir()
+	mockDB := new(mocks.DB)
+	mockDB.On("ReadRenderedFrames", id).Return(map[int64]bool{}, nil)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockDB.On("AddRenderDuration", id, mock.Anything, mock.Anything).Return(nil)
+	mockDB.On("AddRenderedFrame", id, mock.Anything).Return(nil)
+
+	// blender crashes on frame 2
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		frame, err := strconv.Atoi(argValue(spec.Args, "--render-frame"))
+		require.NoError(t, err)
+		if frame == 2 {
+			return "Segmentation fault", 139, nil
+		}
+		writeTestFrame(t, filepath.Join(path, "output", FormatFrameFilename(frame, "png")))
+		return "Saved frame", 0, nil
+	}
+
+	err := machine.RenderVideo(ctx, "bafkscene", []int64{1, 2, 3}, id, path, testRenderSettings(), mockDB)
+	var failure *FrameFailure
+	require.ErrorAs(t, err, &failure)
+	require.Equal(t, int64(2), failure.Frame)
+	require.Equal(t, FailureCrash, failure.Kind)
+	require.Equal(t, 2, failure.Attempts)
+	// frames after the unrenderable one aren't rendered
+	require.Len(t, fake.Started(), 3)
+	_, err = os.Stat(filepath.Join(path, "output", FormatFrameFilename(3, "png")))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestRenderVideo_ReturnsRuntimeErrors(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	fake.StateErr = errors.New("daemon unreachable")
+	id, path := "thread123", t.TempDir()
+	mockDB := new(mocks.DB)
+	mockDB.On("ReadRenderedFrames", id).Return(map[int64]bool{}, nil)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// the worker couldn't render, so the frame isn't unrenderable and the next frames aren't rendered
+	err := machine.RenderVideo(ctx, "bafkscene", []int64{1, 2, 3}, id, path, testRenderSettings(), mockDB)
+	require.ErrorContains(t, err, "daemon unreachable")
+	var failure *FrameFailure
+	require.False(t, errors.As(err, &failure))
+	require.Empty(t, fake.Started())
+	mockDB.AssertNotCalled(t, "AddRenderedFrame", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,170 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// Renderer renders scenes and decodes frames with an approved image, running its containers in the vm of the worker
+type Renderer struct {
+	*VM
+	Image RendererImage
+}
+
+// RendererImage is a blender image approved to render frames, pinned by the digest of its content,
+// so every worker of an architecture renders with the same blender build
+type RendererImage struct {
+	Name           string
+	BlenderVersion string
+	// GOARCH of the workers rendering with the image
+	Architecture string
+	// sha256:<hex> digest of the image in its repository
+	Digest string
+}
+
+// BaselineRendererImages are the images workers render with while no image is approved on chain. They aren't pinned
+// by a digest nor a blender version, so they only render tasks that don't require a version
+var BaselineRendererImages = []RendererImage{
+	{Name: "blendergrid/blender", Architecture: "amd64"},
+	{Name: "blender_render", Architecture: "arm64"},
+}
+
+// Reference returns the name of the image pinned by its digest, or its name if it's a baseline image
+func (i RendererImage) Reference() string {
+	if i.Digest == "" {
+		return i.Name
+	}
+	return i.Name + "@" + i.Digest
+}
+
+// Validate returns an error if the image isn't pinned by a sha256 digest
+func (i RendererImage) Validate() error {
+	if i.Name == "" || i.BlenderVersion == "" || i.Architecture == "" {
+		return fmt.Errorf("renderer image needs a name, blender version and architecture")
+	}
+	if strings.ContainsAny(i.Name, "@ ") {
+		return fmt.Errorf("invalid renderer image name %s", i.Name)
+	}
+	if err := ValidateBlenderVersion(i.BlenderVersion); err != nil {
+		return err
+	}
+	if !isDigest(i.Digest) {
+		return fmt.Errorf("renderer image %s must be pinned by a sha256 digest, got %s", i.Name, i.Digest)
+	}
+	return nil
+}
+
+// isDigest returns true if the digest is a sha256:<hex> digest of an image
+func isDigest(digest string) bool {
+	hex, ok := strings.CutPrefix(digest, "sha256:")
+	return ok && len(hex) == 64 && strings.Trim(hex, "0123456789abcdef") == ""
+}
+
+// pullPinnedImage pulls the image pinned by the digest, and returns an error if the runtime pulled another content
+func (v *VM) pullPinnedImage(ctx context.Context, reference string, digest string) error {
+	digests, err := v.Runtime.PullImage(ctx, reference)
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", reference, err)
+	}
+	if digests == nil {
+		videoRenderingLogger.Logger.Info("runtime doesn't run images, image %s can't be verified", reference)
+	} else if !slices.Contains(digests, digest) {
+		return fmt.Errorf("image %s has digests %v instead of the approved one", reference, digests)
+	}
+	return nil
+}
+
+// Architecture returns the architecture of the images this node renders with
+func Architecture() string {
+	return runtime.GOARCH
+}
+
+// ValidateBlenderVersion returns an error if the version isn't made of numbers separated by dots, like 3.6 or 4.2.1
+func ValidateBlenderVersion(version string) error {
+	for _, component := range strings.Split(version, ".") {
+		if component == "" || strings.Trim(component, "0123456789") != "" {
+			return fmt.Errorf("invalid blender version %q", version)
+		}
+	}
+	return nil
+}
+
+// MatchesVersion returns true if the blender version is the requested one or one of its releases: 4.2 matches 4.2.1,
+// but 4.2.1 doesn't match 4.2. Every version matches an empty request
+func MatchesVersion(requested string, version string) bool {
+	if requested == "" {
+		return true
+	}
+	versions := strings.Split(version, ".")
+	for i, component := range strings.Split(requested, ".") {
+		if i >= len(versions) || !sameNumber(component, versions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameNumber returns true if both version components are the same number, so 04 is 4
+func sameNumber(a, b string) bool {
+	x, errX := strconv.Atoi(a)
+	y, errY := strconv.Atoi(b)
+	if errX != nil || errY != nil {
+		return a == b
+	}
+	return x == y
+}
+
+// SelectRendererImage returns the approved image of the architecture with the latest blender version matching the requested one
+func SelectRendererImage(images []RendererImage, architecture string, blenderVersion string) (RendererImage, bool) {
+	var selected RendererImage
+	found := false
+	for _, image := range images {
+		if image.Architecture != architecture || !MatchesVersion(blenderVersion, image.BlenderVersion) {
+			continue
+		}
+		if !found || compareVersions(image.BlenderVersion, selected.BlenderVersion) > 0 {
+			selected = image
+			found = true
+		}
+	}
+	return selected, found
+}
+
+// compareVersions compares dot separated versions by their numeric components
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			return x - y
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// rendererImage pulls the image blender runs in and returns its reference once its digest is verified
+func (v *VM) rendererImage(ctx context.Context, image RendererImage) (string, error) {
+	if image.Digest == "" {
+		if image.Name != "" && slices.Contains(BaselineRendererImages, image) {
+			// baseline images can't be verified, the runtime pulls them when they aren't there yet
+			return image.Reference(), nil
+		}
+		return "", fmt.Errorf("no renderer image approved for architecture %s", Architecture())
+	}
+	if err := v.pullPinnedImage(ctx, image.Reference(), image.Digest); err != nil {
+		return "", err
+	}
+	return image.Reference(), nil
+}
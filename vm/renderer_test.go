@@ -0,0 +1,152 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/janction/videoRendering/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRendererImage_Validate(t *testing.T) {
+	require.NoError(t, testRendererImage.Validate())
+	require.Equal(t, "blendergrid/blender@sha256:"+strings.Repeat("ab", 32), testRendererImage.Reference())
+
+	for name, image := range map[string]RendererImage{
+		"tag instead of digest": {Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: "amd64", Digest: "latest"},
+		"short digest":          {Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: "amd64", Digest: "sha256:abcd"},
+		"uppercase digest":      {Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: "amd64", Digest: "sha256:" + strings.Repeat("AB", 32)},
+		"pinned name":           {Name: "blendergrid/blender@sha256:ab", BlenderVersion: "4.2.0", Architecture: "amd64", Digest: testRendererImage.Digest},
+		"no architecture":       {Name: "blendergrid/blender", BlenderVersion: "4.2.0", Digest: testRendererImage.Digest},
+		"invalid version":       {Name: "blendergrid/blender", BlenderVersion: "4.2-lts", Architecture: "amd64", Digest: testRendererImage.Digest},
+	} {
+		require.Error(t, image.Validate(), name)
+	}
+}
+
+func TestSelectRendererImage(t *testing.T) {
+	images := []RendererImage{
+		{Name: "blender", BlenderVersion: "4.10.1", Architecture: "amd64", Digest: "sha256:1"},
+		{Name: "blender", BlenderVersion: "4.2.0", Architecture: "amd64", Digest: "sha256:2"},
+		{Name: "blender", BlenderVersion: "5.0", Architecture: "arm64", Digest: "sha256:3"},
+	}
+
+	// versions are compared by their numeric components
+	image, ok := SelectRendererImage(images, "amd64", "")
+	require.True(t, ok)
+	require.Equal(t, "sha256:1", image.Digest)
+
+	image, ok = SelectRendererImage(images, "arm64", "")
+	require.True(t, ok)
+	require.Equal(t, "sha256:3", image.Digest)
+
+	_, ok = SelectRendererImage(images, "riscv64", "")
+	require.False(t, ok)
+
+	// the latest release of the requested version
+	image, ok = SelectRendererImage(images, "amd64", "4.2")
+	require.True(t, ok)
+	require.Equal(t, "sha256:2", image.Digest)
+	image, ok = SelectRendererImage(images, "amd64", "4")
+	require.True(t, ok)
+	require.Equal(t, "sha256:1", image.Digest)
+	_, ok = SelectRendererImage(images, "amd64", "3.6")
+	require.False(t, ok)
+	_, ok = SelectRendererImage(images, "arm64", "4.2")
+	require.False(t, ok)
+}
+
+func TestMatchesVersion(t *testing.T) {
+	require.True(t, MatchesVersion("", "3.6.5"))
+	require.True(t, MatchesVersion("3.6", "3.6.5"))
+	require.True(t, MatchesVersion("3.6.5", "3.6.5"))
+	require.True(t, MatchesVersion("4.02", "4.2.0"))
+	require.False(t, MatchesVersion("3.6.5", "3.6"))
+	require.False(t, MatchesVersion("3.6", "3.60"))
+	require.False(t, MatchesVersion("4.2", "4.20.1"))
+}
+
+func TestValidateBlenderVersion(t *testing.T) {
+	require.NoError(t, ValidateBlenderVersion("4"))
+	require.NoError(t, ValidateBlenderVersion("4.2.1"))
+	for _, version := range []string{"", "4.", ".4", "4.2-lts", "v4.2", "4..2"} {
+		require.Error(t, ValidateBlenderVersion(version), version)
+	}
+}
+
+func TestRendererImage_PullsAndVerifiesDigest(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+
+	// the image pinned by the approved digest
+	reference, err := machine.rendererImage(ctx, testRendererImage)
+	require.NoError(t, err)
+	require.Equal(t, testRendererImage.Reference(), reference)
+
+	// an image whose repository digest isn't the approved one, like a tag that was pushed again
+	fake.ImageDigests = map[string][]string{testRendererImage.Reference(): {"sha256:" + strings.Repeat("cd", 32)}}
+	_, err = machine.rendererImage(ctx, testRendererImage)
+	require.ErrorContains(t, err, "instead of the approved one")
+
+	// the image can't be pulled
+	fake.PullErr = fmt.Errorf("registry unreachable")
+	_, err = machine.rendererImage(ctx, testRendererImage)
+	require.ErrorContains(t, err, "registry unreachable")
+
+	// nothing renders until an image is approved for the architecture
+	_, err = machine.rendererImage(ctx, RendererImage{})
+	require.ErrorContains(t, err, "no renderer image approved")
+	_, err = machine.rendererImage(ctx, RendererImage{Name: "blendergrid/blender", BlenderVersion: "4.2.0", Architecture: "amd64"})
+	require.ErrorContains(t, err, "no renderer image approved")
+	require.Empty(t, fake.Started())
+}
+
+func TestRendererImage_Baseline(t *testing.T) {
+	machine, fake := newFakeVM()
+	// the registry refuses to pull, baseline images are run as they are
+	fake.PullErr = fmt.Errorf("registry unreachable")
+
+	for _, image := range BaselineRendererImages {
+		reference, err := machine.rendererImage(context.Background(), image)
+		require.NoError(t, err)
+		require.Equal(t, image.Name, reference)
+	}
+}
+
+func TestEncoderImage_PullsAndVerifiesDigest(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+
+	// the image pinned by the approved digest
+	reference, err := machine.encoderImage(ctx, testEncoderImage)
+	require.NoError(t, err)
+	require.Equal(t, testEncoderImage, reference)
+
+	// an image whose repository digest isn't the pinned one
+	fake.ImageDigests = map[string][]string{testEncoderImage: {"sha256:" + strings.Repeat("cd", 32)}}
+	_, err = machine.encoderImage(ctx, testEncoderImage)
+	require.ErrorContains(t, err, "instead of the approved one")
+
+	// tags aren't pinned
+	for _, image := range []string{"", "jrottenberg/ffmpeg:6.1-ubuntu", "jrottenberg/ffmpeg@sha256:abc", "@sha256:" + strings.Repeat("ab", 32)} {
+		_, err = machine.encoderImage(ctx, image)
+		require.ErrorContains(t, err, "must be pinned", image)
+	}
+	require.Empty(t, fake.Started())
+}
+
+func TestRenderVideoFrame_UnapprovedImage(t *testing.T) {
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	fake.ImageDigests = map[string][]string{testRendererImage.Reference(): {}}
+	mockDB := new(mocks.DB)
+	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// an image built locally has no repository digest
+	err := machine.renderVideoFrame(ctx, "bafkscene", 1, "thread123", t.TempDir(), testRenderSettings(), mockDB)
+	require.ErrorContains(t, err, "instead of the approved one")
+	require.Empty(t, fake.Started())
+}
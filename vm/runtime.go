@@ -0,0 +1,173 @@
+package vm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// names of the container runtimes that can be selected in videoRendering.toml
+const (
+	// docker engine API over its unix socket
+	DockerRuntime = "docker"
+	// podman binary, with the same commands as the docker cli
+	PodmanRuntime = "podman"
+	// blender and ffmpeg binaries of the host, without containers
+	LocalRuntime = "local"
+	// synthetic frames and videos, without blender nor ffmpeg
+	SimulatedRuntime = "simulated"
+)
+
+// default unix socket of the docker engine API
+const defaultDockerHost = "/var/run/docker.sock"
+
+// program of the renderer images
+const rendererProgram = "blender"
+
+// Mount binds a directory or file of the host into a container
+type Mount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+// ContainerSpec describes a container running a program of an image with some arguments
+type ContainerSpec struct {
+	Name  string
+	Image string
+	// program of the image receiving the arguments, so runtimes without containers know which binary to run
+	Program string
+	Args    []string
+	Mounts  []Mount
+	// restrictions of containers running untrusted files, nil runs them unrestricted
+	Sandbox *Sandbox
+}
+
+// command returns the command of the container. ARM renderer images have no blender entrypoint, so it is called explicitly
+func (s ContainerSpec) command() []string {
+	if isARM64() && s.Program == rendererProgram {
+		return append([]string{s.Program}, s.Args...)
+	}
+	return s.Args
+}
+
+// ContainerState is the state of a container in its runtime
+type ContainerState int
+
+const (
+	ContainerMissing ContainerState = iota
+	ContainerRunning
+	ContainerExited
+)
+
+// ContainerRuntime runs the renderer and encoder images of the vm
+type ContainerRuntime interface {
+	// Start creates and starts a container without waiting for it to exit
+	Start(ctx context.Context, spec ContainerSpec) error
+	// Wait blocks until the container exits and returns its exit code
+	Wait(ctx context.Context, name string) (int, error)
+	// Logs returns the output of the container
+	Logs(ctx context.Context, name string) (string, error)
+	// State returns if the container is running, exited or doesn't exist
+	State(ctx context.Context, name string) (ContainerState, error)
+	// Remove removes the container, stopping it if it is running. Removing a missing container isn't an error
+	Remove(ctx context.Context, name string) error
+	// PullImage pulls the image if it's missing and returns the digests of its repositories. Runtimes without images return none
+	PullImage(ctx context.Context, image string) ([]string, error)
+}
+
+// RuntimeConfiguration selects the container runtime of the worker in videoRendering.toml
+type RuntimeConfiguration struct {
+	Name string `toml:"name"`
+	// unix socket of the docker engine API
+	DockerHost string `toml:"docker_host"`
+	// binaries of the local runtime
+	BlenderPath string `toml:"blender_path"`
+	FfmpegPath  string `toml:"ffmpeg_path"`
+	// the local runtime can't sandbox scenes, it only runs them with the access of the node if this is set
+	Unsandboxed bool `toml:"unsandboxed"`
+	// milliseconds every container of the simulated runtime takes, and probability of its renders failing
+	SimulatedLatency     int64   `toml:"simulated_latency"`
+	SimulatedFailureRate float64 `toml:"simulated_failure_rate"`
+	// restrictions of the containers rendering scenes and decoding frames
+	Sandbox Sandbox `toml:"sandbox"`
+}
+
+// DefaultRuntimeConfiguration returns the configuration of workers that don't select a runtime
+func DefaultRuntimeConfiguration() RuntimeConfiguration {
+	return RuntimeConfiguration{
+		Name:        DockerRuntime,
+		DockerHost:  defaultDockerHost,
+		BlenderPath: "blender",
+		FfmpegPath:  "ffmpeg",
+		Sandbox:     DefaultSandbox(),
+	}
+}
+
+// NewContainerRuntime returns the runtime selected by the configuration. Empty values are the default ones
+func NewContainerRuntime(c RuntimeConfiguration) (ContainerRuntime, error) {
+	defaults := DefaultRuntimeConfiguration()
+	if err := c.Sandbox.Validate(); err != nil {
+		return nil, err
+	}
+	switch c.Name {
+	case "", DockerRuntime:
+		if c.DockerHost == "" {
+			c.DockerHost = defaults.DockerHost
+		}
+		return NewDockerRuntime(c.DockerHost), nil
+	case PodmanRuntime:
+		return NewPodmanRuntime(), nil
+	case LocalRuntime:
+		if c.BlenderPath == "" {
+			c.BlenderPath = defaults.BlenderPath
+		}
+		if c.FfmpegPath == "" {
+			c.FfmpegPath = defaults.FfmpegPath
+		}
+		if !c.Unsandboxed {
+			return nil, fmt.Errorf("local runtime can't sandbox scenes, set unsandboxed to run them with the access of the node")
+		}
+		videoRenderingLogger.Logger.Error("local runtime doesn't sandbox its programs, scenes run with the access of the node")
+		return NewLocalRuntime(map[string]string{"blender": c.BlenderPath, "ffmpeg": c.FfmpegPath}, true), nil
+	case SimulatedRuntime:
+		if c.SimulatedFailureRate < 0 || c.SimulatedFailureRate >= 1 {
+			return nil, fmt.Errorf("simulated failure rate must be between 0 and 1, got %v", c.SimulatedFailureRate)
+		}
+		return NewSimulatedRuntime(time.Duration(c.SimulatedLatency)*time.Millisecond, c.SimulatedFailureRate, time.Now().UnixNano()), nil
+	}
+	return nil, fmt.Errorf("unknown container runtime %s", c.Name)
+}
+
+// runContainer runs a container until it exits and removes it, returning its logs. A non zero exit code is an error
+func (v *VM) runContainer(ctx context.Context, spec ContainerSpec) (string, error) {
+	// a container left by an interrupted run would keep the name taken
+	v.Runtime.Remove(ctx, spec.Name)
+	if err := v.Runtime.Start(ctx, spec); err != nil {
+		return "", err
+	}
+	defer v.Runtime.Remove(context.Background(), spec.Name)
+
+	exitCode, err := v.Runtime.Wait(ctx, spec.Name)
+	if err != nil {
+		return "", err
+	}
+	logs, err := v.Runtime.Logs(ctx, spec.Name)
+	if err != nil {
+		return "", err
+	}
+	if exitCode != 0 {
+		return logs, fmt.Errorf("container %s exited with code %v", spec.Name, exitCode)
+	}
+	return logs, nil
+}
+
+// containerName returns the name of a container working on the given path, since containers of the same kind run concurrently
+func containerName(prefix string, path string) string {
+	hash := sha256.Sum256([]byte(path))
+	return prefix + hex.EncodeToString(hash[:6])
+}
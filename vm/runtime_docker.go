@@ -0,0 +1,302 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// version of the docker engine API the runtime talks
+const dockerAPIVersion = "v1.41"
+
+// dockerRuntime runs containers with the docker engine API over its unix socket
+type dockerRuntime struct {
+	client *http.Client
+}
+
+// NewDockerRuntime returns a runtime talking to the docker engine API listening at the unix socket
+func NewDockerRuntime(socket string) ContainerRuntime {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &dockerRuntime{client: &http.Client{Transport: transport}}
+}
+
+// errNotFound is returned by the engine API when the container or image doesn't exist
+var errNotFound = errors.New("not found")
+
+// do sends a request to the engine API and returns the response of successful status codes
+func (r *dockerRuntime) do(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	// the host is ignored, requests go to the socket
+	u := url.URL{Scheme: "http", Host: "docker", Path: "/" + dockerAPIVersion + path, RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("docker %s %s failed: %w", method, path, err)
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotModified {
+		defer resp.Body.Close()
+		var message struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&message)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("docker %s %s: %s: %w", method, path, message.Message, errNotFound)
+		}
+		return nil, fmt.Errorf("docker %s %s returned %v: %s", method, path, resp.StatusCode, message.Message)
+	}
+	return resp, nil
+}
+
+func (r *dockerRuntime) Start(ctx context.Context, spec ContainerSpec) error {
+	binds := make([]string, 0, len(spec.Mounts))
+	for _, mount := range spec.Mounts {
+		bind := mount.Source + ":" + mount.Target
+		if mount.ReadOnly {
+			bind += ":ro"
+		}
+		binds = append(binds, bind)
+	}
+	hostConfig := map[string]any{"Binds": binds}
+	container := map[string]any{
+		"Image":      spec.Image,
+		"Cmd":        spec.command(),
+		"HostConfig": hostConfig,
+	}
+	if sandbox := spec.Sandbox; sandbox != nil {
+		container["User"] = sandbox.ContainerUser()
+		container["Env"] = []string{"HOME=" + sandboxTmpDir}
+		hostConfig["ReadonlyRootfs"] = true
+		hostConfig["Tmpfs"] = map[string]string{sandboxTmpDir: "rw,nosuid,nodev"}
+		hostConfig["SecurityOpt"] = []string{"no-new-privileges"}
+		hostConfig["CapDrop"] = sandbox.CapDrop
+		if sandbox.Network != "" {
+			hostConfig["NetworkMode"] = sandbox.Network
+		}
+		if sandbox.CPUs > 0 {
+			hostConfig["NanoCpus"] = int64(sandbox.CPUs * 1e9)
+		}
+		if sandbox.MemoryMB > 0 {
+			// without swap, so the limit can't be exceeded
+			hostConfig["Memory"] = sandbox.MemoryMB << 20
+			hostConfig["MemorySwap"] = sandbox.MemoryMB << 20
+		}
+		if sandbox.PidsLimit > 0 {
+			hostConfig["PidsLimit"] = sandbox.PidsLimit
+		}
+	}
+
+	query := url.Values{"name": {spec.Name}}
+	resp, err := r.do(ctx, http.MethodPost, "/containers/create", query, container)
+	if errors.Is(err, errNotFound) {
+		// unlike the cli, the engine API doesn't pull missing images
+		if err := r.pull(ctx, spec.Image); err != nil {
+			return err
+		}
+		resp, err = r.do(ctx, http.MethodPost, "/containers/create", query, container)
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	resp, err = r.do(ctx, http.MethodPost, "/containers/"+url.PathEscape(spec.Name)+"/start", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// pull pulls the image, failing if any of the progress messages is an error
+func (r *dockerRuntime) pull(ctx context.Context, image string) error {
+	videoRenderingLogger.Logger.Info("Pulling image %s", image)
+	query := url.Values{"fromImage": {image}}
+	if name, digest, ok := strings.Cut(image, "@"); ok {
+		// images pinned by digest are pulled with the digest as their tag
+		query = url.Values{"fromImage": {name}, "tag": {digest}}
+	}
+	resp, err := r.do(ctx, http.MethodPost, "/images/create", query, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var progress struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&progress); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to pull image %s: %w", image, err)
+		}
+		if progress.Error != "" {
+			return fmt.Errorf("failed to pull image %s: %s", image, progress.Error)
+		}
+	}
+}
+
+func (r *dockerRuntime) PullImage(ctx context.Context, image string) ([]string, error) {
+	digests, err := r.imageDigests(ctx, image)
+	if errors.Is(err, errNotFound) {
+		if err := r.pull(ctx, image); err != nil {
+			return nil, err
+		}
+		digests, err = r.imageDigests(ctx, image)
+	}
+	return digests, err
+}
+
+// imageDigests inspects the image and returns the digests of its repositories
+func (r *dockerRuntime) imageDigests(ctx context.Context, image string) ([]string, error) {
+	resp, err := r.do(ctx, http.MethodGet, "/images/"+image+"/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var inspect struct {
+		RepoDigests []string `json:"RepoDigests"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&inspect); err != nil {
+		return nil, fmt.Errorf("failed to inspect image %s: %w", image, err)
+	}
+	return repoDigests(inspect.RepoDigests), nil
+}
+
+// repoDigests returns the digests of the name@digest references of an image. Images without any, like the ones built
+// locally, return an empty list so they never match an approved digest
+func repoDigests(references []string) []string {
+	digests := []string{}
+	for _, reference := range references {
+		if _, digest, ok := strings.Cut(reference, "@"); ok {
+			digests = append(digests, digest)
+		}
+	}
+	return digests
+}
+
+func (r *dockerRuntime) Wait(ctx context.Context, name string) (int, error) {
+	resp, err := r.do(ctx, http.MethodPost, "/containers/"+url.PathEscape(name)+"/wait", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		StatusCode int `json:"StatusCode"`
+		Error      *struct {
+			Message string `json:"Message"`
+		} `json:"Error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to wait for container %s: %w", name, err)
+	}
+	if result.Error != nil && result.Error.Message != "" {
+		return 0, fmt.Errorf("failed to wait for container %s: %s", name, result.Error.Message)
+	}
+	return result.StatusCode, nil
+}
+
+func (r *dockerRuntime) Logs(ctx context.Context, name string) (string, error) {
+	resp, err := r.do(ctx, http.MethodGet, "/containers/"+url.PathEscape(name)+"/logs", url.Values{"stdout": {"1"}, "stderr": {"1"}}, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	return demuxLogs(resp.Body)
+}
+
+// demuxLogs joins the stdout and stderr frames of the logs of a container without tty.
+// Every frame has a header with the stream in its first byte and the big endian size of the frame in the last four
+func demuxLogs(reader io.Reader) (string, error) {
+	var logs strings.Builder
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(reader, header); err == io.EOF {
+			return logs.String(), nil
+		} else if err != nil {
+			return "", fmt.Errorf("failed to read container logs: %w", err)
+		}
+		size := int64(binary.BigEndian.Uint32(header[4:]))
+		if _, err := io.CopyN(&logs, reader, size); err != nil {
+			return "", fmt.Errorf("failed to read container logs: %w", err)
+		}
+	}
+}
+
+func (r *dockerRuntime) State(ctx context.Context, name string) (ContainerState, error) {
+	resp, err := r.do(ctx, http.MethodGet, "/containers/"+url.PathEscape(name)+"/json", nil, nil)
+	if errors.Is(err, errNotFound) {
+		return ContainerMissing, nil
+	}
+	if err != nil {
+		return ContainerMissing, err
+	}
+	defer resp.Body.Close()
+
+	var container struct {
+		State struct {
+			Status string `json:"Status"`
+		} `json:"State"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&container); err != nil {
+		return ContainerMissing, fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+	return parseContainerStatus(container.State.Status), nil
+}
+
+// parseContainerStatus returns the state of a container from its docker or podman status
+func parseContainerStatus(status string) ContainerState {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "exited", "dead", "stopped":
+		return ContainerExited
+	case "":
+		return ContainerMissing
+	}
+	// created, running, restarting and paused containers haven't finished
+	return ContainerRunning
+}
+
+func (r *dockerRuntime) Remove(ctx context.Context, name string) error {
+	resp, err := r.do(ctx, http.MethodDelete, "/containers/"+url.PathEscape(name), url.Values{"force": {"1"}}, nil)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
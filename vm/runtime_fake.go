@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FakeRuntime is an in-memory ContainerRuntime for tests. Containers exit as soon as they start,
+// after OnStart produces what the program would: its logs, exit code and any file in the mounts
+type FakeRuntime struct {
+	// OnStart runs the program of a started container. Containers exit with empty logs when it's nil,
+	// and fail to start when it returns an error
+	OnStart func(spec ContainerSpec) (logs string, exitCode int, err error)
+	// Hang keeps started containers running, so waiting for them only returns when its context is done
+	Hang bool
+	// errors returned by the other methods, to simulate failures of the runtime
+	StateErr, WaitErr, LogsErr, RemoveErr, PullErr error
+	// repository digests of the images by reference. Images pinned by digest have it when they aren't set
+	ImageDigests map[string][]string
+
+	mu         sync.Mutex
+	containers map[string]*FakeContainer
+	started    []ContainerSpec
+}
+
+// FakeContainer is a container of the fake runtime
+type FakeContainer struct {
+	Spec     ContainerSpec
+	State    ContainerState
+	Logs     string
+	ExitCode int
+}
+
+// NewFakeRuntime returns an empty fake runtime
+func NewFakeRuntime() *FakeRuntime {
+	return &FakeRuntime{containers: make(map[string]*FakeContainer)}
+}
+
+// AddContainer adds a container in the given state, as if it was started before
+func (r *FakeRuntime) AddContainer(name string, state ContainerState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.containers[name] = &FakeContainer{Spec: ContainerSpec{Name: name}, State: state}
+}
+
+// Container returns the container with the name, or nil if there is none
+func (r *FakeRuntime) Container(name string) *FakeContainer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.containers[name]
+}
+
+// Started returns the specs of every started container, in order
+func (r *FakeRuntime) Started() []ContainerSpec {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]ContainerSpec(nil), r.started...)
+}
+
+func (r *FakeRuntime) Start(ctx context.Context, spec ContainerSpec) error {
+	r.mu.Lock()
+	if _, exists := r.containers[spec.Name]; exists {
+		r.mu.Unlock()
+		return fmt.Errorf("container %s already exists", spec.Name)
+	}
+	r.started = append(r.started, spec)
+	r.mu.Unlock()
+
+	container := &FakeContainer{Spec: spec, State: ContainerExited}
+	if r.Hang {
+		container.State = ContainerRunning
+	}
+	if r.OnStart != nil {
+		logs, exitCode, err := r.OnStart(spec)
+		if err != nil {
+			return err
+		}
+		container.Logs = logs
+		container.ExitCode = exitCode
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.containers[spec.Name] = container
+	return nil
+}
+
+func (r *FakeRuntime) Wait(ctx context.Context, name string) (int, error) {
+	if r.WaitErr != nil {
+		return 0, r.WaitErr
+	}
+	container := r.Container(name)
+	if container == nil {
+		return 0, fmt.Errorf("no such container %s", name)
+	}
+	if container.State == ContainerRunning {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	return container.ExitCode, nil
+}
+
+func (r *FakeRuntime) Logs(ctx context.Context, name string) (string, error) {
+	if r.LogsErr != nil {
+		return "", r.LogsErr
+	}
+	container := r.Container(name)
+	if container == nil {
+		return "", fmt.Errorf("no such container %s", name)
+	}
+	return container.Logs, nil
+}
+
+func (r *FakeRuntime) State(ctx context.Context, name string) (ContainerState, error) {
+	if r.StateErr != nil {
+		return ContainerMissing, r.StateErr
+	}
+	container := r.Container(name)
+	if container == nil {
+		return ContainerMissing, nil
+	}
+	return container.State, nil
+}
+
+func (r *FakeRuntime) Remove(ctx context.Context, name string) error {
+	if r.RemoveErr != nil {
+		return r.RemoveErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.containers, name)
+	return nil
+}
+
+func (r *FakeRuntime) PullImage(ctx context.Context, image string) ([]string, error) {
+	if r.PullErr != nil {
+		return nil, r.PullErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if digests, ok := r.ImageDigests[image]; ok {
+		return digests, nil
+	}
+	return repoDigests([]string{image}), nil
+}
@@ -0,0 +1,184 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// localRuntime runs the programs of the images with binaries of the host, without containers.
+// Mounts aren't isolated: arguments with paths inside a mount are rewritten to the path in the host, and read only mounts aren't enforced
+type localRuntime struct {
+	// binary of the host running each program
+	binaries map[string]string
+	// sandboxed specs run without their sandbox only if the worker accepted it
+	unsandboxed bool
+
+	mu        sync.Mutex
+	processes map[string]*localProcess
+}
+
+// localProcess is a program started by the local runtime. Its output is kept until it's removed
+type localProcess struct {
+	cmd      *exec.Cmd
+	output   lockedBuffer
+	done     chan struct{}
+	exitCode int
+	err      error
+}
+
+// lockedBuffer is written by the process while its logs are read
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// NewLocalRuntime returns a runtime running every program with the given binary of the host. Specs with a sandbox are
+// refused unless unsandboxed is set, since the local runtime can't enforce it
+func NewLocalRuntime(binaries map[string]string, unsandboxed bool) ContainerRuntime {
+	return &localRuntime{binaries: binaries, unsandboxed: unsandboxed, processes: make(map[string]*localProcess)}
+}
+
+// hostArg rewrites an argument with a path inside a mount to the path in the host
+func hostArg(arg string, mounts []Mount) string {
+	for _, mount := range mounts {
+		if arg == mount.Target {
+			return mount.Source
+		}
+		if strings.HasPrefix(arg, mount.Target+"/") {
+			return filepath.Join(mount.Source, strings.TrimPrefix(arg, mount.Target+"/"))
+		}
+	}
+	return arg
+}
+
+func (r *localRuntime) Start(ctx context.Context, spec ContainerSpec) error {
+	binary, ok := r.binaries[spec.Program]
+	if !ok {
+		return fmt.Errorf("no local binary for %s", spec.Program)
+	}
+	if spec.Sandbox != nil {
+		if !r.unsandboxed {
+			return fmt.Errorf("local runtime can't sandbox %s, set unsandboxed to run it with the access of the node", spec.Name)
+		}
+		videoRenderingLogger.Logger.Error("running %s without its sandbox, it has the network, the keys and the files of the node", spec.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.processes[spec.Name]; exists {
+		return fmt.Errorf("container %s already exists", spec.Name)
+	}
+
+	args := make([]string, len(spec.Args))
+	for i, arg := range spec.Args {
+		args[i] = hostArg(arg, spec.Mounts)
+	}
+
+	// like a detached container, the process outlives the context that started it
+	process := &localProcess{cmd: exec.Command(binary, args...), done: make(chan struct{})}
+	process.cmd.Stdout = &process.output
+	process.cmd.Stderr = &process.output
+	if err := process.cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start %s: %w", binary, err)
+	}
+	r.processes[spec.Name] = process
+
+	go func() {
+		err := process.cmd.Wait()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			process.exitCode = exitErr.ExitCode()
+		} else {
+			process.err = err
+		}
+		close(process.done)
+	}()
+	return nil
+}
+
+func (r *localRuntime) process(name string) (*localProcess, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	process, ok := r.processes[name]
+	if !ok {
+		return nil, fmt.Errorf("no such container %s", name)
+	}
+	return process, nil
+}
+
+func (r *localRuntime) Wait(ctx context.Context, name string) (int, error) {
+	process, err := r.process(name)
+	if err != nil {
+		return 0, err
+	}
+	select {
+	case <-process.done:
+		return process.exitCode, process.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (r *localRuntime) Logs(ctx context.Context, name string) (string, error) {
+	process, err := r.process(name)
+	if err != nil {
+		return "", err
+	}
+	return process.output.String(), nil
+}
+
+func (r *localRuntime) State(ctx context.Context, name string) (ContainerState, error) {
+	process, err := r.process(name)
+	if err != nil {
+		return ContainerMissing, nil
+	}
+	select {
+	case <-process.done:
+		return ContainerExited, nil
+	default:
+		return ContainerRunning, nil
+	}
+}
+
+func (r *localRuntime) Remove(ctx context.Context, name string) error {
+	process, err := r.process(name)
+	if err != nil {
+		return nil
+	}
+	select {
+	case <-process.done:
+	default:
+		process.cmd.Process.Kill()
+		<-process.done
+	}
+
+	r.mu.Lock()
+	delete(r.processes, name)
+	r.mu.Unlock()
+	return nil
+}
+
+// PullImage doesn't pull anything, the binaries of the host have no digest
+func (r *localRuntime) PullImage(ctx context.Context, image string) ([]string, error) {
+	return nil, nil
+}
@@ -0,0 +1,131 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+)
+
+// cliRuntime runs containers with a binary accepting the commands of the docker cli
+type cliRuntime struct {
+	binary string
+}
+
+// NewPodmanRuntime returns a runtime running containers with the podman binary
+func NewPodmanRuntime() ContainerRuntime {
+	return &cliRuntime{binary: "podman"}
+}
+
+// run executes a command of the binary and returns its output
+func (r *cliRuntime) run(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, r.binary, args...)
+	videoRenderingLogger.Logger.Debug("Executing %s", cmd.String())
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("%s %s failed: %s, %w", r.binary, args[0], strings.TrimSpace(string(output)), err)
+	}
+	return string(output), nil
+}
+
+// isMissing returns true if the output of a failed command says the container doesn't exist
+func isMissing(output string) bool {
+	return strings.Contains(strings.ToLower(output), "no such")
+}
+
+func (r *cliRuntime) Start(ctx context.Context, spec ContainerSpec) error {
+	args := []string{"run", "--name", spec.Name}
+	for _, mount := range spec.Mounts {
+		volume := mount.Source + ":" + mount.Target
+		if mount.ReadOnly {
+			volume += ":ro"
+		}
+		args = append(args, "-v", volume)
+	}
+	args = append(args, sandboxArgs(spec.Sandbox)...)
+	args = append(args, "-d", spec.Image)
+	args = append(args, spec.command()...)
+
+	_, err := r.run(ctx, args...)
+	return err
+}
+
+// sandboxArgs returns the flags of the docker cli restricting a container to the sandbox
+func sandboxArgs(sandbox *Sandbox) []string {
+	if sandbox == nil {
+		return nil
+	}
+	args := []string{"--user", sandbox.ContainerUser(), "--env", "HOME=" + sandboxTmpDir}
+	args = append(args, "--read-only", "--tmpfs", sandboxTmpDir+":rw,nosuid,nodev")
+	args = append(args, "--security-opt", "no-new-privileges")
+	for _, capability := range sandbox.CapDrop {
+		args = append(args, "--cap-drop", capability)
+	}
+	if sandbox.Network != "" {
+		args = append(args, "--network", sandbox.Network)
+	}
+	if sandbox.CPUs > 0 {
+		args = append(args, "--cpus", strconv.FormatFloat(sandbox.CPUs, 'f', -1, 64))
+	}
+	if sandbox.MemoryMB > 0 {
+		// without swap, so the limit can't be exceeded
+		memory := strconv.FormatInt(sandbox.MemoryMB, 10) + "m"
+		args = append(args, "--memory", memory, "--memory-swap", memory)
+	}
+	if sandbox.PidsLimit > 0 {
+		args = append(args, "--pids-limit", strconv.FormatInt(sandbox.PidsLimit, 10))
+	}
+	return args
+}
+
+func (r *cliRuntime) Wait(ctx context.Context, name string) (int, error) {
+	output, err := r.run(ctx, "wait", name)
+	if err != nil {
+		return 0, err
+	}
+	exitCode, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, fmt.Errorf("invalid exit code of container %s: %s", name, output)
+	}
+	return exitCode, nil
+}
+
+func (r *cliRuntime) Logs(ctx context.Context, name string) (string, error) {
+	return r.run(ctx, "logs", name)
+}
+
+func (r *cliRuntime) State(ctx context.Context, name string) (ContainerState, error) {
+	output, err := r.run(ctx, "inspect", "--format", "{{.State.Status}}", name)
+	if err != nil {
+		if isMissing(output) {
+			return ContainerMissing, nil
+		}
+		return ContainerMissing, err
+	}
+	return parseContainerStatus(output), nil
+}
+
+func (r *cliRuntime) Remove(ctx context.Context, name string) error {
+	output, err := r.run(ctx, "rm", "-f", name)
+	if err != nil && !isMissing(output) {
+		return err
+	}
+	return nil
+}
+
+func (r *cliRuntime) PullImage(ctx context.Context, image string) ([]string, error) {
+	if _, err := r.run(ctx, "image", "exists", image); err != nil {
+		videoRenderingLogger.Logger.Info("Pulling image %s", image)
+		if _, err := r.run(ctx, "pull", image); err != nil {
+			return nil, err
+		}
+	}
+	output, err := r.run(ctx, "image", "inspect", "--format", "{{range .RepoDigests}}{{println .}}{{end}}", image)
+	if err != nil {
+		return nil, err
+	}
+	return repoDigests(strings.Fields(output)), nil
+}
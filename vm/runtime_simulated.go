@@ -0,0 +1,342 @@
+package vm
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// size of the simulated frames of scenes that keep the resolution of the .blend file
+const (
+	simulatedFrameWidth  = 64
+	simulatedFrameHeight = 36
+)
+
+// simulatedRuntime runs the programs of the images without containers, blender nor ffmpeg, so the whole task lifecycle
+// can run in local devnets and tests. Renders write a synthetic frame derived only from the cid and the frame number,
+// so every worker renders the same pixels, and encodes write a digest of their frames
+type simulatedRuntime struct {
+	// time every container takes to exit
+	latency time.Duration
+	// probability of a render exiting with an error without writing its frame
+	failureRate float64
+
+	mu         sync.Mutex
+	random     *rand.Rand
+	containers map[string]*simulatedContainer
+}
+
+// simulatedContainer is a program run by the simulated runtime
+type simulatedContainer struct {
+	done     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	logs     string
+	exitCode int
+}
+
+// NewSimulatedRuntime returns a runtime simulating the programs of the images. Containers take the latency to exit,
+// and renders fail with the given probability, drawn from the seed so failures are reproducible
+func NewSimulatedRuntime(latency time.Duration, failureRate float64, seed int64) ContainerRuntime {
+	return &simulatedRuntime{
+		latency:     latency,
+		failureRate: failureRate,
+		random:      rand.New(rand.NewSource(seed)),
+		containers:  make(map[string]*simulatedContainer),
+	}
+}
+
+func (r *simulatedRuntime) Start(ctx context.Context, spec ContainerSpec) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.containers[spec.Name]; exists {
+		return fmt.Errorf("container %s already exists", spec.Name)
+	}
+
+	var program func(spec ContainerSpec) (string, error)
+	switch spec.Program {
+	case rendererProgram:
+		program = simulateRender
+		if r.random.Float64() < r.failureRate {
+			program = func(ContainerSpec) (string, error) { return "", fmt.Errorf("simulated render failure") }
+		}
+	case "ffmpeg":
+		program = simulateEncode
+	default:
+		return fmt.Errorf("program %s can't be simulated", spec.Program)
+	}
+
+	container := &simulatedContainer{done: make(chan struct{}), stop: make(chan struct{})}
+	r.containers[spec.Name] = container
+
+	go func() {
+		defer close(container.done)
+		select {
+		case <-time.After(r.latency):
+		case <-container.stop:
+			container.exitCode = 137
+			return
+		}
+		logs, err := program(spec)
+		container.logs = logs
+		if err != nil {
+			container.logs += err.Error()
+			container.exitCode = 1
+		}
+	}()
+	return nil
+}
+
+func (r *simulatedRuntime) container(name string) (*simulatedContainer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	container, ok := r.containers[name]
+	if !ok {
+		return nil, fmt.Errorf("no such container %s", name)
+	}
+	return container, nil
+}
+
+func (r *simulatedRuntime) Wait(ctx context.Context, name string) (int, error) {
+	container, err := r.container(name)
+	if err != nil {
+		return 0, err
+	}
+	select {
+	case <-container.done:
+		return container.exitCode, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (r *simulatedRuntime) Logs(ctx context.Context, name string) (string, error) {
+	container, err := r.container(name)
+	if err != nil {
+		return "", err
+	}
+	select {
+	case <-container.done:
+		return container.logs, nil
+	default:
+		return "", nil
+	}
+}
+
+func (r *simulatedRuntime) State(ctx context.Context, name string) (ContainerState, error) {
+	container, err := r.container(name)
+	if err != nil {
+		return ContainerMissing, nil
+	}
+	select {
+	case <-container.done:
+		return ContainerExited, nil
+	default:
+		return ContainerRunning, nil
+	}
+}
+
+func (r *simulatedRuntime) Remove(ctx context.Context, name string) error {
+	container, err := r.container(name)
+	if err != nil {
+		return nil
+	}
+	select {
+	case <-container.done:
+	default:
+		container.stopOnce.Do(func() { close(container.stop) })
+		<-container.done
+	}
+
+	r.mu.Lock()
+	delete(r.containers, name)
+	r.mu.Unlock()
+	return nil
+}
+
+// argValue returns the value following the flag in the arguments, or an empty string if the flag isn't there
+func argValue(args []string, flag string) string {
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == flag {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// simulateRender writes the frame blender would render with the arguments of renderVideoFrame
+func simulateRender(spec ContainerSpec) (string, error) {
+	args := spec.Args
+	separator := len(args)
+	for i, arg := range args {
+		if arg == "--" {
+			separator = i
+			break
+		}
+	}
+	blenderArgs, scriptArgs := args[:separator], args[min(separator+1, len(args)):]
+
+	frame, err := strconv.ParseInt(argValue(blenderArgs, "--render-frame"), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("simulated runtime only renders frames: %v", args)
+	}
+	output := argValue(blenderArgs, "--render-output")
+	if output == "" {
+		return "", fmt.Errorf("missing render output")
+	}
+
+	// the scene is the file downloaded from the cid, right after --background
+	scene := hostArg(argValue(blenderArgs, "--background"), spec.Mounts)
+	if _, err := os.Stat(scene); err != nil {
+		return "", fmt.Errorf("unable to open scene: %w", err)
+	}
+
+	settings := simulatedSettings(scriptArgs)
+	extension := FrameExtension(settings.Format)
+	framePath := hostArg(strings.Replace(output, "######", fmt.Sprintf("%06d", frame), 1), spec.Mounts) + "." + extension
+	if err := os.MkdirAll(filepath.Dir(framePath), 0755); err != nil {
+		return "", err
+	}
+
+	if err := writeSimulatedFrame(framePath, filepath.Base(scene), frame, settings); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Saved: '%s'\n", framePath), nil
+}
+
+// simulatedSettings reads the render settings from the arguments of the render script
+func simulatedSettings(args []string) RenderSettings {
+	settings := DefaultRenderSettings()
+	number := func(flag string, value int) int {
+		if n, err := strconv.Atoi(argValue(args, flag)); err == nil {
+			return n
+		}
+		return value
+	}
+	if format := argValue(args, "--format"); format != "" {
+		settings.Format = format
+	}
+	if depth := argValue(args, "--color-depth"); depth != "" {
+		settings.ColorDepth = depth
+	}
+	settings.ResolutionX = number("--resolution-x", 0)
+	settings.ResolutionY = number("--resolution-y", 0)
+	settings.ResolutionPercentage = number("--resolution-percentage", 0)
+	settings.TileColumns = number("--tile-columns", 1)
+	settings.TileRows = number("--tile-rows", 1)
+	settings.Tile = number("--tile", 0)
+	return settings
+}
+
+// writeSimulatedFrame writes the tile of the synthetic frame of the scene in the output format of the settings
+func writeSimulatedFrame(path string, scene string, frame int64, settings RenderSettings) error {
+	width, height := simulatedFrameWidth, simulatedFrameHeight
+	if settings.ResolutionX > 0 && settings.ResolutionY > 0 {
+		width, height = settings.ResolutionX, settings.ResolutionY
+	}
+	if settings.ResolutionPercentage > 0 {
+		width = max(width*settings.ResolutionPercentage/100, 1)
+		height = max(height*settings.ResolutionPercentage/100, 1)
+	}
+
+	// tiles are cropped from the whole frame, so stitching them rebuilds it
+	columns, rows := max(settings.TileColumns, 1), max(settings.TileRows, 1)
+	column, row := settings.Tile%columns, settings.Tile/columns
+	bounds := image.Rect(width*column/columns, height*row/rows, width*(column+1)/columns, height*(row+1)/rows)
+
+	seed := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", scene, frame)))
+	deep := settings.ColorDepth == "16"
+	var img image.Image
+	if deep {
+		rgba := image.NewRGBA64(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				r, g, b := simulatedPixel(seed, x, y)
+				rgba.SetRGBA64(x-bounds.Min.X, y-bounds.Min.Y, color.RGBA64{R: uint16(r) * 257, G: uint16(g) * 257, B: uint16(b) * 257, A: 0xffff})
+			}
+		}
+		img = rgba
+	} else {
+		rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				r, g, b := simulatedPixel(seed, x, y)
+				rgba.SetRGBA(x-bounds.Min.X, y-bounds.Min.Y, color.RGBA{R: r, G: g, B: b, A: 0xff})
+			}
+		}
+		img = rgba
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch FrameExtension(settings.Format) {
+	case "png":
+		return png.Encode(file, img)
+	case "jpg":
+		return jpeg.Encode(file, img, &jpeg.Options{Quality: 90})
+	}
+	return fmt.Errorf("simulated runtime can't write %s frames", settings.Format)
+}
+
+// simulatedPixel returns the color of a pixel of the frame with the seed: gradients offset by the seed
+func simulatedPixel(seed [sha256.Size]byte, x, y int) (uint8, uint8, uint8) {
+	return seed[0] + uint8(x*int(seed[3]|1)), seed[1] + uint8(y*int(seed[4]|1)), seed[2] + uint8((x+y)*int(seed[5]|1))
+}
+
+// simulateEncode writes as video the digest of the frames ffmpeg would encode with the arguments of EncodeVideo
+func simulateEncode(spec ContainerSpec) (string, error) {
+	args := spec.Args
+	if len(args) == 0 {
+		return "", fmt.Errorf("missing output")
+	}
+	pattern := hostArg(argValue(args, "-i"), spec.Mounts)
+	start, err := strconv.Atoi(argValue(args, "-start_number"))
+	if pattern == "" || err != nil {
+		return "", fmt.Errorf("missing input frames")
+	}
+
+	// like ffmpeg, frames are read until the first missing one
+	h := sha256.New()
+	frames := 0
+	for frame := start; ; frame++ {
+		data, err := os.ReadFile(fmt.Sprintf(pattern, frame))
+		if err != nil {
+			break
+		}
+		h.Write(data)
+		frames++
+	}
+	if frames == 0 {
+		return "", fmt.Errorf("no frames at %s", pattern)
+	}
+
+	output := hostArg(args[len(args)-1], spec.Mounts)
+	if err := os.WriteFile(output, []byte(fmt.Sprintf("simulated video of %d frames %x\n", frames, h.Sum(nil))), 0644); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("encoded %d frames\n", frames), nil
+}
+
+// PullImage returns the digest the image is pinned by, as a registry pulling it by digest would
+func (r *simulatedRuntime) PullImage(ctx context.Context, reference string) ([]string, error) {
+	_, digest, ok := strings.Cut(reference, "@")
+	if !ok {
+		return nil, fmt.Errorf("image %s isn't pinned by digest", reference)
+	}
+	return []string{digest}, nil
+}
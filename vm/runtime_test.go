@@ -0,0 +1,585 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for the docker engine API runtime ---
+
+// fakeEngine serves the endpoints of the docker engine API used by the runtime
+type fakeEngine struct {
+	mu         sync.Mutex
+	images     map[string]bool
+	containers map[string]map[string]any
+	requests   []string
+}
+
+func (e *fakeEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests = append(e.requests, r.Method+" "+r.URL.Path)
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+dockerAPIVersion)
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"message": "not found"})
+	}
+	switch {
+	case path == "/images/create":
+		image := r.URL.Query().Get("fromImage")
+		if tag := r.URL.Query().Get("tag"); strings.HasPrefix(tag, "sha256:") {
+			image += "@" + tag
+		}
+		e.images[image] = true
+		fmt.Fprintln(w, `{"status":"Pulling"}`)
+	case strings.HasPrefix(path, "/images/") && strings.HasSuffix(path, "/json"):
+		image := strings.TrimSuffix(strings.TrimPrefix(path, "/images/"), "/json")
+		if !e.images[image] {
+			notFound()
+			return
+		}
+		var digests []string
+		if strings.Contains(image, "@") {
+			digests = append(digests, image)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"RepoDigests": digests})
+	case path == "/containers/create":
+		var container map[string]any
+		json.NewDecoder(r.Body).Decode(&container)
+		if !e.images[container["Image"].(string)] {
+			notFound()
+			return
+		}
+		container["Status"] = "created"
+		e.containers[r.URL.Query().Get("name")] = container
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"Id":"1234"}`)
+	default:
+		parts := strings.Split(strings.TrimPrefix(path, "/containers/"), "/")
+		container, ok := e.containers[parts[0]]
+		if !ok {
+			notFound()
+			return
+		}
+		action := ""
+		if len(parts) > 1 {
+			action = parts[1]
+		}
+		switch action {
+		case "start":
+			container["Status"] = "exited"
+			w.WriteHeader(http.StatusNoContent)
+		case "wait":
+			fmt.Fprint(w, `{"StatusCode":3}`)
+		case "logs":
+			w.Write(logFrame(1, "Saved frame\n"))
+			w.Write(logFrame(2, "warning\n"))
+		case "json":
+			json.NewEncoder(w).Encode(map[string]any{"State": map[string]string{"Status": container["Status"].(string)}})
+		case "":
+			delete(e.containers, parts[0])
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+// logFrame returns a frame of the logs of a container without tty
+func logFrame(stream byte, data string) []byte {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(data)))
+	return append(header, data...)
+}
+
+// startFakeEngine serves a fake engine API at a unix socket
+func startFakeEngine(t *testing.T) (*fakeEngine, string) {
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	engine := &fakeEngine{images: make(map[string]bool), containers: make(map[string]map[string]any)}
+	server := httptest.NewUnstartedServer(engine)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+	return engine, socket
+}
+
+func TestDockerRuntime(t *testing.T) {
+	// 1. Setup
+	ctx := context.Background()
+	engine, socket := startFakeEngine(t)
+	runtime := NewDockerRuntime(socket)
+	spec := ContainerSpec{
+		Name:    "myFfmpeg1234",
+		Image:   "linuxserver/ffmpeg",
+		Program: "ffmpeg",
+		Args:    []string{"-i", "/workspace/frames"},
+		Mounts:  []Mount{{Source: "/tmp/frames", Target: "/workspace"}, {Source: "/tmp/script.py", Target: "/script.py", ReadOnly: true}},
+	}
+
+	// 2. The container doesn't exist
+	state, err := runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerMissing, state)
+
+	// 3. The missing image is pulled before creating the container
+	require.NoError(t, runtime.Start(ctx, spec))
+	require.Equal(t, []string{
+		"GET /v1.41/containers/myFfmpeg1234/json",
+		"POST /v1.41/containers/create",
+		"POST /v1.41/images/create",
+		"POST /v1.41/containers/create",
+		"POST /v1.41/containers/myFfmpeg1234/start",
+	}, engine.requests)
+	container := engine.containers[spec.Name]
+	require.Equal(t, []any{"-i", "/workspace/frames"}, container["Cmd"])
+	require.Equal(t, []any{"/tmp/frames:/workspace", "/tmp/script.py:/script.py:ro"}, container["HostConfig"].(map[string]any)["Binds"])
+
+	// 4. The exit code, logs and state of the container
+	exitCode, err := runtime.Wait(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, 3, exitCode)
+	logs, err := runtime.Logs(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, "Saved frame\nwarning\n", logs)
+	state, err = runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerExited, state)
+
+	// 5. Removing the container twice isn't an error
+	require.NoError(t, runtime.Remove(ctx, spec.Name))
+	require.NoError(t, runtime.Remove(ctx, spec.Name))
+	_, err = runtime.Wait(ctx, spec.Name)
+	require.ErrorIs(t, err, errNotFound)
+}
+
+func TestDockerRuntime_Sandbox(t *testing.T) {
+	ctx := context.Background()
+	engine, socket := startFakeEngine(t)
+	engine.images["renderer"] = true
+	runtime := NewDockerRuntime(socket)
+	sandbox := Sandbox{Network: "none", CPUs: 1.5, MemoryMB: 512, PidsLimit: 64, CapDrop: []string{"ALL"}, User: "1000:1000"}
+
+	require.NoError(t, runtime.Start(ctx, ContainerSpec{Name: "sandboxed", Image: "renderer", Sandbox: &sandbox}))
+	container := engine.containers["sandboxed"]
+	require.Equal(t, "1000:1000", container["User"])
+	require.Equal(t, []any{"HOME=/tmp"}, container["Env"])
+	hostConfig := container["HostConfig"].(map[string]any)
+	require.Equal(t, "none", hostConfig["NetworkMode"])
+	require.Equal(t, float64(1500000000), hostConfig["NanoCpus"])
+	require.Equal(t, float64(512<<20), hostConfig["Memory"])
+	require.Equal(t, float64(512<<20), hostConfig["MemorySwap"])
+	require.Equal(t, float64(64), hostConfig["PidsLimit"])
+	require.Equal(t, []any{"ALL"}, hostConfig["CapDrop"])
+	require.Equal(t, []any{"no-new-privileges"}, hostConfig["SecurityOpt"])
+	require.Equal(t, true, hostConfig["ReadonlyRootfs"])
+	require.Contains(t, hostConfig["Tmpfs"], "/tmp")
+
+	// containers without sandbox aren't restricted
+	require.NoError(t, runtime.Start(ctx, ContainerSpec{Name: "trusted", Image: "renderer"}))
+	require.Equal(t, map[string]any{"Binds": []any{}}, engine.containers["trusted"]["HostConfig"])
+}
+
+func TestDockerRuntime_PullImage(t *testing.T) {
+	ctx := context.Background()
+	engine, socket := startFakeEngine(t)
+	runtime := NewDockerRuntime(socket)
+	reference := testRendererImage.Reference()
+
+	// the missing image is pulled by its digest, and inspected again
+	digests, err := runtime.PullImage(ctx, reference)
+	require.NoError(t, err)
+	require.Equal(t, []string{testRendererImage.Digest}, digests)
+	require.Equal(t, []string{
+		"GET /v1.41/images/" + reference + "/json",
+		"POST /v1.41/images/create",
+		"GET /v1.41/images/" + reference + "/json",
+	}, engine.requests)
+
+	// a present image isn't pulled again
+	_, err = runtime.PullImage(ctx, reference)
+	require.NoError(t, err)
+	require.Len(t, engine.requests, 4)
+
+	// images built locally have no repository digest
+	engine.images["blender_render"] = true
+	digests, err = runtime.PullImage(ctx, "blender_render")
+	require.NoError(t, err)
+	require.Empty(t, digests)
+	require.NotNil(t, digests)
+}
+
+func TestDemuxLogs(t *testing.T) {
+	logs, err := demuxLogs(bytes.NewReader(append(logFrame(1, "out"), logFrame(2, "err")...)))
+	require.NoError(t, err)
+	require.Equal(t, "outerr", logs)
+
+	// a truncated frame
+	_, err = demuxLogs(bytes.NewReader(logFrame(1, "out")[:9]))
+	require.Error(t, err)
+}
+
+func TestParseContainerStatus(t *testing.T) {
+	require.Equal(t, ContainerExited, parseContainerStatus("exited\n"))
+	require.Equal(t, ContainerExited, parseContainerStatus("Stopped"))
+	require.Equal(t, ContainerRunning, parseContainerStatus("running"))
+	require.Equal(t, ContainerRunning, parseContainerStatus("created"))
+	require.Equal(t, ContainerMissing, parseContainerStatus(""))
+}
+
+// --- Test for the local runtime ---
+
+func TestHostArg(t *testing.T) {
+	mounts := []Mount{{Source: "/tmp/thread1", Target: "/workspace"}}
+
+	require.Equal(t, "/tmp/thread1", hostArg("/workspace", mounts))
+	require.Equal(t, "/tmp/thread1/output/frame_######", hostArg("/workspace/output/frame_######", mounts))
+	require.Equal(t, "/workspaces", hostArg("/workspaces", mounts))
+	require.Equal(t, "-b", hostArg("-b", mounts))
+}
+
+func TestLocalRuntime(t *testing.T) {
+	// 1. Setup
+	ctx := context.Background()
+	dir := t.TempDir()
+	runtime := NewLocalRuntime(map[string]string{"sh": "sh"}, false)
+	spec := ContainerSpec{
+		Name:    "local1",
+		Program: "sh",
+		Args:    []string{"-c", "echo rendered > $1; echo done; exit 2", "sh", "/workspace/frame.txt"},
+		Mounts:  []Mount{{Source: dir, Target: "/workspace"}},
+	}
+
+	// 2. The program runs with the paths of the host
+	require.NoError(t, runtime.Start(ctx, spec))
+	require.Error(t, runtime.Start(ctx, spec))
+
+	exitCode, err := runtime.Wait(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, 2, exitCode)
+	logs, err := runtime.Logs(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, "done\n", logs)
+	require.FileExists(t, filepath.Join(dir, "frame.txt"))
+
+	state, err := runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerExited, state)
+
+	// 3. Removed containers are missing
+	require.NoError(t, runtime.Remove(ctx, spec.Name))
+	state, err = runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerMissing, state)
+	require.NoError(t, runtime.Remove(ctx, spec.Name))
+
+	// 4. Programs without a binary can't start
+	require.Error(t, runtime.Start(ctx, ContainerSpec{Name: "local2", Program: "blender"}))
+}
+
+func TestLocalRuntime_Sandbox(t *testing.T) {
+	ctx := context.Background()
+	spec := ContainerSpec{Name: "sandboxed", Program: "sh", Args: []string{"-c", "exit 0"}, Sandbox: DefaultVM().sandboxProfile()}
+
+	// 1. Sandboxed specs are refused, the local runtime can't enforce them
+	runtime := NewLocalRuntime(map[string]string{"sh": "sh"}, false)
+	require.ErrorContains(t, runtime.Start(ctx, spec), "can't sandbox")
+	state, err := runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerMissing, state)
+
+	// 2. Unless the worker accepted running them unsandboxed
+	runtime = NewLocalRuntime(map[string]string{"sh": "sh"}, true)
+	require.NoError(t, runtime.Start(ctx, spec))
+	exitCode, err := runtime.Wait(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, 0, exitCode)
+}
+
+func TestLocalRuntime_RemoveKillsRunningProcess(t *testing.T) {
+	ctx := context.Background()
+	runtime := NewLocalRuntime(map[string]string{"sleep": "sleep"}, false)
+	require.NoError(t, runtime.Start(ctx, ContainerSpec{Name: "sleeper", Program: "sleep", Args: []string{"60"}}))
+
+	state, err := runtime.State(ctx, "sleeper")
+	require.NoError(t, err)
+	require.Equal(t, ContainerRunning, state)
+
+	require.NoError(t, runtime.Remove(ctx, "sleeper"))
+	state, err = runtime.State(ctx, "sleeper")
+	require.NoError(t, err)
+	require.Equal(t, ContainerMissing, state)
+}
+
+// --- Test for the simulated runtime ---
+
+// simulatedRender returns the spec renderVideoFrame would run to render the frame of the scene in dir
+func simulatedRender(dir string, scene string, frame int, settings RenderSettings) ContainerSpec {
+	args := []string{"--disable-autoexec", "--background", "/input/" + scene, "--render-format", settings.Format, "--render-output", "/output/frame_######", "--render-frame", fmt.Sprint(frame), "--"}
+	return ContainerSpec{
+		Name:    RendererContainerName("1", dir),
+		Program: "blender",
+		Args:    append(args, settings.Args()...),
+		Mounts:  []Mount{{Source: filepath.Join(dir, scene), Target: "/input/" + scene, ReadOnly: true}, {Source: filepath.Join(dir, "output"), Target: "/output"}},
+	}
+}
+
+// newScene returns a directory with the scene of the cid
+func newScene(t *testing.T, cid string) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, cid), []byte("scene"), 0644))
+	return dir
+}
+
+func decodeFrame(t *testing.T, path string) image.Image {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	img, err := png.Decode(file)
+	require.NoError(t, err)
+	return img
+}
+
+func TestSimulatedRuntime_RendersDeterministicFrames(t *testing.T) {
+	// 1. Setup: two workers with the same scene
+	ctx := context.Background()
+	machine := newTestVM(NewSimulatedRuntime(0, 0, 1))
+	settings := DefaultRenderSettings()
+	worker1, worker2 := newScene(t, "bafkscene"), newScene(t, "bafkscene")
+
+	// 2. Both render the same frame
+	_, err := machine.runContainer(ctx, simulatedRender(worker1, "bafkscene", 3, settings))
+	require.NoError(t, err)
+	_, err = machine.runContainer(ctx, simulatedRender(worker2, "bafkscene", 3, settings))
+	require.NoError(t, err)
+	_, err = machine.runContainer(ctx, simulatedRender(worker1, "bafkscene", 4, settings))
+	require.NoError(t, err)
+
+	frame1, err := os.ReadFile(filepath.Join(worker1, "output", "frame_000003.png"))
+	require.NoError(t, err)
+	frame2, err := os.ReadFile(filepath.Join(worker2, "output", "frame_000003.png"))
+	require.NoError(t, err)
+	require.Equal(t, frame1, frame2)
+	require.NoError(t, ValidateFrame(filepath.Join(worker1, "output", "frame_000003.png")))
+
+	// 3. Other frames have other pixels
+	frame4, err := os.ReadFile(filepath.Join(worker1, "output", "frame_000004.png"))
+	require.NoError(t, err)
+	require.NotEqual(t, frame1, frame4)
+
+	// 4. Scenes that weren't downloaded can't be rendered
+	_, err = machine.runContainer(ctx, simulatedRender(t.TempDir(), "bafkscene", 3, settings))
+	require.Error(t, err)
+}
+
+func TestSimulatedRuntime_TilesStitchIntoTheFrame(t *testing.T) {
+	ctx := context.Background()
+	machine := newTestVM(NewSimulatedRuntime(0, 0, 1))
+	settings := DefaultRenderSettings()
+	settings.ResolutionX, settings.ResolutionY = 40, 20
+
+	whole := newScene(t, "bafkscene")
+	_, err := machine.runContainer(ctx, simulatedRender(whole, "bafkscene", 1, settings))
+	require.NoError(t, err)
+	frame := decodeFrame(t, filepath.Join(whole, "output", "frame_000001.png"))
+	require.Equal(t, image.Rect(0, 0, 40, 20), frame.Bounds())
+
+	// a 2x2 grid, tiles are numbered by rows from the top
+	settings.TileColumns, settings.TileRows = 2, 2
+	for tile := 0; tile < 4; tile++ {
+		settings.Tile = tile
+		dir := newScene(t, "bafkscene")
+		_, err := machine.runContainer(ctx, simulatedRender(dir, "bafkscene", 1, settings))
+		require.NoError(t, err)
+
+		img := decodeFrame(t, filepath.Join(dir, "output", "frame_000001.png"))
+		require.Equal(t, image.Rect(0, 0, 20, 10), img.Bounds())
+		offsetX, offsetY := tile%2*20, tile/2*10
+		for y := 0; y < 10; y++ {
+			for x := 0; x < 20; x++ {
+				require.Equal(t, frame.At(x+offsetX, y+offsetY), img.At(x, y))
+			}
+		}
+	}
+}
+
+func TestSimulatedRuntime_InjectsFailures(t *testing.T) {
+	ctx := context.Background()
+	dir := newScene(t, "bafkscene")
+	settings := DefaultRenderSettings()
+
+	// every render fails, without writing its frame
+	machine := newTestVM(NewSimulatedRuntime(0, 0.99, 1))
+	_, err := machine.runContainer(ctx, simulatedRender(dir, "bafkscene", 1, settings))
+	require.ErrorContains(t, err, "exited with code 1")
+	require.NoFileExists(t, filepath.Join(dir, "output", "frame_000001.png"))
+
+	// failures are drawn from the seed
+	failures := func() []bool {
+		runtime := NewSimulatedRuntime(0, 0.5, 42)
+		var failed []bool
+		for i := 0; i < 10; i++ {
+			spec := simulatedRender(dir, "bafkscene", 1, settings)
+			require.NoError(t, runtime.Start(ctx, spec))
+			exitCode, err := runtime.Wait(ctx, spec.Name)
+			require.NoError(t, err)
+			require.NoError(t, runtime.Remove(ctx, spec.Name))
+			failed = append(failed, exitCode != 0)
+		}
+		return failed
+	}
+	first := failures()
+	require.Equal(t, first, failures())
+	require.Contains(t, first, true)
+	require.Contains(t, first, false)
+}
+
+func TestSimulatedRuntime_RemoveStopsLatency(t *testing.T) {
+	ctx := context.Background()
+	runtime := NewSimulatedRuntime(time.Hour, 0, 1)
+	spec := simulatedRender(newScene(t, "bafkscene"), "bafkscene", 1, DefaultRenderSettings())
+	require.NoError(t, runtime.Start(ctx, spec))
+	require.Error(t, runtime.Start(ctx, spec))
+
+	state, err := runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerRunning, state)
+
+	require.NoError(t, runtime.Remove(ctx, spec.Name))
+	state, err = runtime.State(ctx, spec.Name)
+	require.NoError(t, err)
+	require.Equal(t, ContainerMissing, state)
+
+	// only blender and ffmpeg are simulated
+	require.Error(t, runtime.Start(ctx, ContainerSpec{Name: "sh", Program: "sh"}))
+}
+
+func TestSimulatedRuntime_EncodesFrames(t *testing.T) {
+	// 1. Setup: an assembly with three frames
+	ctx := context.Background()
+	machine := newTestVM(NewSimulatedRuntime(0, 0, 1))
+	dir := t.TempDir()
+	frames := filepath.Join(dir, AssemblyFramesDir)
+	require.NoError(t, os.MkdirAll(frames, 0755))
+	for frame := 5; frame <= 7; frame++ {
+		require.NoError(t, os.WriteFile(filepath.Join(frames, FormatFrameFilename(frame, "png")), []byte{byte(frame)}, 0644))
+	}
+
+	// 2. The video is the digest of the frames, so re-encoding it gets the same file
+	video, err := machine.EncodeVideo(ctx, testEncoderImage, dir, 5, 24, "png")
+	require.NoError(t, err)
+	encoded, err := os.ReadFile(video)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded), "simulated video of 3 frames")
+
+	again, err := machine.EncodeVideo(ctx, testEncoderImage, dir, 5, 24, "png")
+	require.NoError(t, err)
+	reencoded, err := os.ReadFile(again)
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+
+	// 3. Without frames there is no video
+	_, err = machine.EncodeVideo(ctx, testEncoderImage, dir, 1, 24, "png")
+	require.Error(t, err)
+}
+
+// --- Test for the selection of the runtime ---
+
+func TestNewContainerRuntime(t *testing.T) {
+	runtime, err := NewContainerRuntime(RuntimeConfiguration{})
+	require.NoError(t, err)
+	require.IsType(t, &dockerRuntime{}, runtime)
+
+	runtime, err = NewContainerRuntime(RuntimeConfiguration{Name: PodmanRuntime})
+	require.NoError(t, err)
+	require.Equal(t, &cliRuntime{binary: "podman"}, runtime)
+
+	_, err = NewContainerRuntime(RuntimeConfiguration{Name: LocalRuntime, BlenderPath: "/opt/blender/blender"})
+	require.ErrorContains(t, err, "can't sandbox")
+
+	runtime, err = NewContainerRuntime(RuntimeConfiguration{Name: LocalRuntime, BlenderPath: "/opt/blender/blender", Unsandboxed: true})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"blender": "/opt/blender/blender", "ffmpeg": "ffmpeg"}, runtime.(*localRuntime).binaries)
+
+	runtime, err = NewContainerRuntime(RuntimeConfiguration{Name: SimulatedRuntime, SimulatedLatency: 5, SimulatedFailureRate: 0.1})
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Millisecond, runtime.(*simulatedRuntime).latency)
+	require.Equal(t, 0.1, runtime.(*simulatedRuntime).failureRate)
+
+	_, err = NewContainerRuntime(RuntimeConfiguration{Name: SimulatedRuntime, SimulatedFailureRate: 1})
+	require.Error(t, err)
+
+	_, err = NewContainerRuntime(RuntimeConfiguration{Sandbox: Sandbox{MemoryMB: -1}})
+	require.Error(t, err)
+
+	_, err = NewContainerRuntime(RuntimeConfiguration{Name: "lxc"})
+	require.Error(t, err)
+}
+
+func TestNewVM(t *testing.T) {
+	sandbox := Sandbox{Network: "none", CPUs: 2}
+	machine, err := NewVM(RuntimeConfiguration{Name: PodmanRuntime, Sandbox: sandbox}, DefaultRenderPolicy())
+	require.NoError(t, err)
+	require.Equal(t, &VM{Runtime: &cliRuntime{binary: "podman"}, Sandbox: sandbox, Policy: DefaultRenderPolicy()}, machine)
+
+	// invalid runtimes and policies are refused before the worker renders anything
+	_, err = NewVM(RuntimeConfiguration{Name: "lxc"}, DefaultRenderPolicy())
+	require.Error(t, err)
+	_, err = NewVM(DefaultRuntimeConfiguration(), RenderPolicy{FrameTimeout: 60})
+	require.ErrorContains(t, err, "render policy")
+}
+
+// --- Test for runContainer ---
+
+func TestRunContainer(t *testing.T) {
+	// 1. Setup
+	ctx := context.Background()
+	machine, fake := newFakeVM()
+	// a container left by an interrupted run
+	fake.AddContainer("myFfmpeg1234", ContainerExited)
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		return "encoded", 0, nil
+	}
+
+	// 2. The container runs and is removed
+	logs, err := machine.runContainer(ctx, ContainerSpec{Name: "myFfmpeg1234", Program: "ffmpeg"})
+	require.NoError(t, err)
+	require.Equal(t, "encoded", logs)
+	require.Len(t, fake.Started(), 1)
+	require.Nil(t, fake.Container("myFfmpeg1234"))
+
+	// 3. A non zero exit code is an error, and the logs are still returned
+	fake.OnStart = func(spec ContainerSpec) (string, int, error) {
+		return "invalid frame", 1, nil
+	}
+	logs, err = machine.runContainer(ctx, ContainerSpec{Name: "myFfmpeg1234", Program: "ffmpeg"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exited with code 1")
+	require.Equal(t, "invalid frame", logs)
+	require.Nil(t, fake.Container("myFfmpeg1234"))
+}
+
+func TestContainerName(t *testing.T) {
+	name := containerName("myFfmpeg", "/tmp/renders/1")
+	require.Len(t, name, len("myFfmpeg")+12)
+	require.Equal(t, name, containerName("myFfmpeg", "/tmp/renders/1"))
+	require.NotEqual(t, name, containerName("myFfmpeg", "/tmp/renders/2"))
+}
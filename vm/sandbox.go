@@ -0,0 +1,83 @@
+package vm
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+const (
+	// user and group running sandboxed programs of nodes running as root
+	nobodyUser = "65534:65534"
+	// writable directory of sandboxed containers, since their root filesystem is read only
+	sandboxTmpDir = "/tmp"
+)
+
+// Sandbox restricts the containers running untrusted files. Scenes of requesters may embed python, and frames of other
+// workers are decoded, so their containers can't reach the network, the keys of the worker nor the rest of the host
+type Sandbox struct {
+	// network of the containers, none isolates them
+	Network string `toml:"network"`
+	// cpus and megabytes of memory containers can use. 0 doesn't limit them
+	CPUs     float64 `toml:"cpus"`
+	MemoryMB int64   `toml:"memory_mb"`
+	// processes containers can run. 0 doesn't limit them
+	PidsLimit int64 `toml:"pids_limit"`
+	// capabilities dropped from containers
+	CapDrop []string `toml:"cap_drop"`
+	// user:group running the programs. Empty runs them with the user of the node, or as nobody if the node runs as root
+	User string `toml:"user"`
+}
+
+// DefaultSandbox returns the sandbox of workers that don't configure one. A core and some memory are left to the node
+func DefaultSandbox() Sandbox {
+	return Sandbox{
+		Network:   "none",
+		CPUs:      float64(max(runtime.NumCPU()-1, 1)),
+		MemoryMB:  8192,
+		PidsLimit: 512,
+		CapDrop:   []string{"ALL"},
+	}
+}
+
+// Validate returns an error if the limits of the sandbox are negative
+func (s Sandbox) Validate() error {
+	if s.CPUs < 0 || s.MemoryMB < 0 || s.PidsLimit < 0 {
+		return fmt.Errorf("sandbox limits can't be negative, got %v cpus, %vMB of memory and %v pids", s.CPUs, s.MemoryMB, s.PidsLimit)
+	}
+	return nil
+}
+
+// nodeUser returns the user:group of the node
+func nodeUser() string {
+	return fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid())
+}
+
+// ContainerUser returns the user:group running the programs of the sandbox. Sandboxed containers never run as root
+func (s Sandbox) ContainerUser() string {
+	if s.User != "" {
+		return s.User
+	}
+	if os.Getuid() > 0 {
+		return nodeUser()
+	}
+	return nobodyUser
+}
+
+// sandboxProfile returns the sandbox of the next container
+func (v *VM) sandboxProfile() *Sandbox {
+	sandbox := v.Sandbox
+	return &sandbox
+}
+
+// outputDir creates the directory a sandboxed container writes to. Containers running with another user than the
+// node can write to it too
+func outputDir(dir string, sandbox *Sandbox) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if sandbox != nil && sandbox.ContainerUser() != nodeUser() {
+		return os.Chmod(dir, 0777)
+	}
+	return nil
+}
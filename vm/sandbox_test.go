@@ -0,0 +1,65 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandbox_ContainerUser(t *testing.T) {
+	// a configured user is always used
+	require.Equal(t, "1000:1000", Sandbox{User: "1000:1000"}.ContainerUser())
+
+	// otherwise containers run with the user of the node, but never as root
+	user := Sandbox{}.ContainerUser()
+	if os.Getuid() == 0 {
+		require.Equal(t, nobodyUser, user)
+	} else {
+		require.Equal(t, nodeUser(), user)
+	}
+	require.NotEqual(t, "0:0", user)
+}
+
+func TestSandbox_Validate(t *testing.T) {
+	require.NoError(t, DefaultSandbox().Validate())
+	require.NoError(t, Sandbox{}.Validate())
+	require.Error(t, Sandbox{CPUs: -1}.Validate())
+	require.Error(t, Sandbox{PidsLimit: -1}.Validate())
+}
+
+func TestOutputDir(t *testing.T) {
+	// directories of containers running with another user are writable by it
+	dir := filepath.Join(t.TempDir(), "output")
+	require.NoError(t, outputDir(dir, &Sandbox{User: "4242:4242"}))
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0777), info.Mode().Perm())
+
+	// containers running with the user of the node don't need it
+	dir = filepath.Join(t.TempDir(), "output")
+	require.NoError(t, outputDir(dir, &Sandbox{User: nodeUser()}))
+	info, err = os.Stat(dir)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+}
+
+func TestSandboxArgs(t *testing.T) {
+	require.Empty(t, sandboxArgs(nil))
+
+	sandbox := Sandbox{Network: "none", CPUs: 1.5, MemoryMB: 512, PidsLimit: 64, CapDrop: []string{"ALL"}, User: "1000:1000"}
+	require.Equal(t, []string{
+		"--user", "1000:1000", "--env", "HOME=/tmp",
+		"--read-only", "--tmpfs", "/tmp:rw,nosuid,nodev",
+		"--security-opt", "no-new-privileges",
+		"--cap-drop", "ALL",
+		"--network", "none",
+		"--cpus", "1.5",
+		"--memory", "512m", "--memory-swap", "512m",
+		"--pids-limit", "64",
+	}, sandboxArgs(&sandbox))
+
+	// unlimited resources don't have flags
+	require.NotContains(t, sandboxArgs(&Sandbox{}), "--cpus")
+}
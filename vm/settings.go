@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// script that pins the render settings of the scene before rendering
+//
+//go:embed deterministic_render.py
+var deterministicRenderScript []byte
+
+const (
+	// location of the script inside the container
+	renderScriptContainerPath = "/janction/deterministic_render.py"
+	// directory of the thread where the script is written before being mounted
+	renderScriptDir = ".janction"
+)
+
+// RenderSettings are the settings enforced on every scene, overriding the ones of the .blend file
+type RenderSettings struct {
+	Seed     int
+	Threads  int
+	Denoiser string
+	TileSize int
+
+	// settings chosen by the task. Zero values keep the ones of the .blend file
+	Engine               string
+	ResolutionX          int
+	ResolutionY          int
+	ResolutionPercentage int
+	Samples              int
+	Scene                string
+	Camera               string
+	ViewLayer            string
+	Format               string
+	ColorDepth           string
+
+	// tile of the frame rendered with border rendering, numbered row by row from the top left one
+	TileColumns int
+	TileRows    int
+	Tile        int
+
+	// seconds a frame renders before it's stopped, overriding the timeout of the worker. It isn't an argument of the
+	// script, so it doesn't change the fingerprint of the frames
+	FrameTimeout int64
+
+	// image blender runs in, selected once for the whole thread so governance changes don't alter running renders.
+	// Its digest is proposed next to the fingerprint
+	Image RendererImage
+}
+
+// extension blender gives to the frames of each output format
+var frameExtensions = map[string]string{
+	"PNG":                 "png",
+	"JPEG":                "jpg",
+	"TIFF":                "tif",
+	"OPEN_EXR":            "exr",
+	"OPEN_EXR_MULTILAYER": "exr",
+}
+
+// FrameExtension returns the extension of the frames rendered in the given output format. Unknown formats are PNG
+func FrameExtension(format string) string {
+	if extension, ok := frameExtensions[format]; ok {
+		return extension
+	}
+	return frameExtensions["PNG"]
+}
+
+// IsFrameExtension returns true if frames of some output format have the given extension
+func IsFrameExtension(extension string) bool {
+	for _, frameExtension := range frameExtensions {
+		if frameExtension == extension {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRenderSettings returns the settings every worker renders with
+func DefaultRenderSettings() RenderSettings {
+	return RenderSettings{
+		Seed:        0,
+		Threads:     4,
+		Denoiser:    "NONE",
+		TileSize:    256,
+		Engine:      "CYCLES",
+		Format:      "PNG",
+		ColorDepth:  "8",
+		TileColumns: 1,
+		TileRows:    1,
+	}
+}
+
+// Args returns the arguments the render script reads after "--"
+func (s RenderSettings) Args() []string {
+	return []string{
+		"--seed", strconv.Itoa(s.Seed),
+		"--threads", strconv.Itoa(s.Threads),
+		"--denoiser", s.Denoiser,
+		"--tile-size", strconv.Itoa(s.TileSize),
+		"--engine", s.Engine,
+		"--resolution-x", strconv.Itoa(s.ResolutionX),
+		"--resolution-y", strconv.Itoa(s.ResolutionY),
+		"--resolution-percentage", strconv.Itoa(s.ResolutionPercentage),
+		"--samples", strconv.Itoa(s.Samples),
+		"--scene-name", s.Scene,
+		"--camera", s.Camera,
+		"--view-layer", s.ViewLayer,
+		"--format", s.Format,
+		"--color-depth", s.ColorDepth,
+		"--tile-columns", strconv.Itoa(s.TileColumns),
+		"--tile-rows", strconv.Itoa(s.TileRows),
+		"--tile", strconv.Itoa(s.Tile),
+	}
+}
+
+// Fingerprint returns the hex encoded sha256 of the render script and the settings it enforces.
+// Frames are only comparable if they were rendered with the same fingerprint.
+func (s RenderSettings) Fingerprint() string {
+	h := sha256.New()
+	h.Write(deterministicRenderScript)
+	for _, arg := range s.Args() {
+		h.Write([]byte{0})
+		h.Write([]byte(arg))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeRenderScript writes the render script in the thread directory and returns the read only mount of it
+func writeRenderScript(path string) (Mount, error) {
+	dir := filepath.Join(path, renderScriptDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return Mount{}, err
+	}
+
+	scriptPath := filepath.Join(dir, filepath.Base(renderScriptContainerPath))
+	if err := os.WriteFile(scriptPath, deterministicRenderScript, 0644); err != nil {
+		return Mount{}, err
+	}
+	return Mount{Source: scriptPath, Target: renderScriptContainerPath, ReadOnly: true}, nil
+}
@@ -0,0 +1,68 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSettingsFingerprint(t *testing.T) {
+	settings := DefaultRenderSettings()
+	fingerprint := settings.Fingerprint()
+
+	require.Len(t, fingerprint, 64)
+	require.Equal(t, fingerprint, DefaultRenderSettings().Fingerprint())
+
+	// any enforced setting changes the fingerprint
+	settings.Seed = 1
+	require.NotEqual(t, fingerprint, settings.Fingerprint())
+	settings = DefaultRenderSettings()
+	settings.Threads = 8
+	require.NotEqual(t, fingerprint, settings.Fingerprint())
+
+	// and so does any setting of the task
+	settings = DefaultRenderSettings()
+	settings.Camera = "Camera.001"
+	require.NotEqual(t, fingerprint, settings.Fingerprint())
+}
+
+func TestRenderSettingsArgs(t *testing.T) {
+	args := DefaultRenderSettings().Args()
+
+	require.Equal(t, []string{"--seed", "0", "--threads", "4", "--denoiser", "NONE", "--tile-size", "256"}, args[:8])
+	require.Equal(t, []string{"--engine", "CYCLES"}, args[8:10])
+	format := slices.Index(args, "--format")
+	require.Equal(t, []string{"--format", "PNG", "--color-depth", "8"}, args[format:format+4])
+	tiles := slices.Index(args, "--tile-columns")
+	require.Equal(t, []string{"--tile-columns", "1", "--tile-rows", "1", "--tile", "0"}, args[tiles:tiles+6])
+}
+
+func TestFrameExtension(t *testing.T) {
+	require.Equal(t, "png", FrameExtension("PNG"))
+	require.Equal(t, "jpg", FrameExtension("JPEG"))
+	require.Equal(t, "tif", FrameExtension("TIFF"))
+	require.Equal(t, "exr", FrameExtension("OPEN_EXR"))
+	require.Equal(t, "exr", FrameExtension("OPEN_EXR_MULTILAYER"))
+	require.Equal(t, "png", FrameExtension("BMP"))
+
+	require.True(t, IsFrameExtension("exr"))
+	require.False(t, IsFrameExtension("gif"))
+}
+
+func TestWriteRenderScript(t *testing.T) {
+	path := t.TempDir()
+
+	mount, err := writeRenderScript(path)
+
+	require.NoError(t, err)
+	scriptPath := filepath.Join(path, renderScriptDir, "deterministic_render.py")
+	require.Equal(t, Mount{Source: scriptPath, Target: renderScriptContainerPath, ReadOnly: true}, mount)
+	script, err := os.ReadFile(scriptPath)
+	require.NoError(t, err)
+	require.Equal(t, deterministicRenderScript, script)
+	require.True(t, strings.Contains(string(script), "use_animated_seed = False"))
+}
@@ -0,0 +1,26 @@
+package vm
+
+// VM runs the containers of a worker: it renders scenes, encodes videos and decodes frames with its runtime,
+// restricting them with its sandbox, and renders every frame as its policy says
+type VM struct {
+	Runtime ContainerRuntime
+	Sandbox Sandbox
+	Policy  RenderPolicy
+}
+
+// NewVM returns the vm of the runtime and render policy of a worker configuration, or an error if they're invalid
+func NewVM(config RuntimeConfiguration, policy RenderPolicy) (*VM, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	runtime, err := NewContainerRuntime(config)
+	if err != nil {
+		return nil, err
+	}
+	return &VM{Runtime: runtime, Sandbox: config.Sandbox, Policy: policy}, nil
+}
+
+// DefaultVM returns the vm of nodes that don't configure one: docker with the default sandbox and render policy
+func DefaultVM() *VM {
+	return &VM{Runtime: NewDockerRuntime(defaultDockerHost), Sandbox: DefaultSandbox(), Policy: DefaultRenderPolicy()}
+}
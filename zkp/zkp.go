@@ -2,89 +2,400 @@ package zkp
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	nativeMimc "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/hash/mimc"
 )
 
-// Circuit defining the proof logic
+// size in bytes of the commitments and merkle nodes, which are field elements
+const ElementSize = fr.Bytes
+
+// Depth of the biggest merkle tree the circuit proves. Solutions of threads with more frames are proposed without proof
+const MerkleDepth = 4
+
+// MaxProofFrames is the amount of frames of the biggest thread a solution proof can be generated for
+const MaxProofFrames = 1 << MerkleDepth
+
+// Circuit proving knowledge of the frame hashes and salt committed in the merkle root of a solution, bound to the node address.
+// Every frame commitment is MiMC(HashHi, HashLo, SaltHi, SaltLo, NodeAddress), its leaf MiMC(frame, commitment), and inner nodes
+// MiMC(left, right). Leaves are padded with zeros up to the next power of two, and the root is MiMC(frames, top of the tree).
+// Hashes and salt don't fit in a field element, so their sha256 digests are split in two 128 bits limbs.
 type FrameProofCircuit struct {
-	ProvidedCID frontend.Variable `gnark:",public"` // CID provided as public input
-	NodeAddress frontend.Variable `gnark:",public"` // Node address as public input
-	ComputedCID frontend.Variable // CID computed from the file hash
+	MerkleRoot  frontend.Variable                 `gnark:",public"` // root of the solution
+	NodeAddress frontend.Variable                 `gnark:",public"` // Node address as public input
+	StartFrame  frontend.Variable                 // number of the first frame of the thread
+	Depth       frontend.Variable                 // depth of the tree of the thread
+	SaltHi      frontend.Variable                 // first half of the salt digest
+	SaltLo      frontend.Variable                 // second half of the salt digest
+	HashHi      [MaxProofFrames]frontend.Variable // first half of the frame hash digests
+	HashLo      [MaxProofFrames]frontend.Variable // second half of the frame hash digests
+	Padding     [MaxProofFrames]frontend.Variable // 1 for the leaves after the last frame
 }
 
 func (c *FrameProofCircuit) Define(api frontend.API) error {
-	// Ensure the computed hash matches the provided one
-	api.AssertIsEqual(c.ComputedCID, c.ProvidedCID)
+	// limbs must be 128 bits, so there is a single way of splitting a digest
+	api.ToBinary(c.SaltHi, 128)
+	api.ToBinary(c.SaltLo, 128)
+
+	level := make([]frontend.Variable, MaxProofFrames)
+	frames := frontend.Variable(0)
+	for i := range level {
+		api.ToBinary(c.HashHi[i], 128)
+		api.ToBinary(c.HashLo[i], 128)
+
+		// padding leaves go after every frame
+		api.AssertIsBoolean(c.Padding[i])
+		if i > 0 {
+			api.AssertIsEqual(api.Mul(c.Padding[i-1], api.Sub(1, c.Padding[i])), 0)
+		}
+
+		commitment, err := hashVariables(api, c.HashHi[i], c.HashLo[i], c.SaltHi, c.SaltLo, c.NodeAddress)
+		if err != nil {
+			return err
+		}
+		leaf, err := hashVariables(api, api.Add(c.StartFrame, i), commitment)
+		if err != nil {
+			return err
+		}
+		level[i] = api.Select(c.Padding[i], 0, leaf)
+		frames = api.Add(frames, api.Sub(1, c.Padding[i]))
+	}
+
+	// the top of the tree of every depth is the first node of its level
+	tops := []frontend.Variable{level[0]}
+	for len(level) > 1 {
+		next := make([]frontend.Variable, len(level)/2)
+		for i := range next {
+			node, err := hashVariables(api, level[2*i], level[2*i+1])
+			if err != nil {
+				return err
+			}
+			next[i] = node
+		}
+		tops = append(tops, next[0])
+		level = next
+	}
+
+	// the depth must be the one of the amount of frames, the smallest one the frames fit in
+	var top, minFrames, maxFrames frontend.Variable = 0, 0, 0
+	matches := frontend.Variable(0)
+	for depth := 0; depth <= MerkleDepth; depth++ {
+		match := api.IsZero(api.Sub(c.Depth, depth))
+		matches = api.Add(matches, match)
+		top = api.Select(match, tops[depth], top)
+		minFrames = api.Select(match, 1<<depth/2+1, minFrames)
+		maxFrames = api.Select(match, 1<<depth, maxFrames)
+	}
+	api.AssertIsEqual(matches, 1)
+	api.AssertIsLessOrEqual(minFrames, frames)
+	api.AssertIsLessOrEqual(frames, maxFrames)
+
+	// Ensure the root is the one of the known hashes and this node
+	root, err := hashVariables(api, frames, top)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(root, c.MerkleRoot)
 	return nil
 }
 
-// InitGnark generates proving and verification keys and returns the proving key path
-func InitGnark(path string) error {
-	r1cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &FrameProofCircuit{})
+// hashes the variables with MiMC inside the circuit
+func hashVariables(api frontend.API, values ...frontend.Variable) (frontend.Variable, error) {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(values...)
+	return h.Sum(), nil
+}
+
+var (
+	compileOnce   sync.Once
+	compiledCS    constraint.ConstraintSystem
+	compiledErr   error
+	circuitDigest string
+)
+
+// compiledCircuit compiles the frame proof circuit the first time it's needed and caches it
+func compiledCircuit() (constraint.ConstraintSystem, string, error) {
+	compileOnce.Do(func() {
+		cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &FrameProofCircuit{})
+		if err != nil {
+			compiledErr = fmt.Errorf("failed to compile circuit: %w", err)
+			return
+		}
+
+		// the serialized constraint system is deterministic, so its hash identifies the circuit
+		h := sha256.New()
+		if _, err := cs.WriteTo(h); err != nil {
+			compiledErr = fmt.Errorf("failed to serialize circuit: %w", err)
+			return
+		}
+		compiledCS = cs
+		circuitDigest = hex.EncodeToString(h.Sum(nil))
+	})
+	return compiledCS, circuitDigest, compiledErr
+}
+
+// CircuitVersion returns the version of the frame proof circuit, the hex encoded sha256 of its compiled constraint system.
+// Keys are only valid for the circuit version they were generated for.
+func CircuitVersion() (string, error) {
+	_, version, err := compiledCircuit()
+	return version, err
+}
+
+// KeysPath returns the directory where the keys of the circuit version are stored
+func KeysPath(rootPath, version string) string {
+	return filepath.Join(rootPath, "zkp", version)
+}
+
+// ProvingKeyPath returns the location of the proving key of the circuit version
+func ProvingKeyPath(rootPath, version string) string {
+	return filepath.Join(KeysPath(rootPath, version), "proving_key.pk")
+}
+
+// VerifyingKeyPath returns the location of the verifying key of the circuit version
+func VerifyingKeyPath(rootPath, version string) string {
+	return filepath.Join(KeysPath(rootPath, version), "verifying_key.vk")
+}
+
+// Setup runs the groth16 setup of the current circuit version and returns the version.
+// Existing keys are never overwritten, since proofs are only accepted if they match the verifying key registered on chain.
+func Setup(rootPath string) (string, error) {
+	cs, version, err := compiledCircuit()
 	if err != nil {
-		return fmt.Errorf("failed to compile circuit: %w", err)
+		return "", err
+	}
+
+	provingKeyPath := ProvingKeyPath(rootPath, version)
+	if _, err := os.Stat(provingKeyPath); err == nil {
+		return "", fmt.Errorf("keys of circuit version %s already exist", version)
 	}
 
-	provingKey, verifyingKey, err := groth16.Setup(r1cs)
+	provingKey, verifyingKey, err := groth16.Setup(cs)
 	if err != nil {
-		return fmt.Errorf("failed to generate keys: %w", err)
+		return "", fmt.Errorf("failed to generate keys: %w", err)
 	}
 
-	provingKeyPath := filepath.Join(path, "proving_key.pk")
-	verifyingKeyPath := filepath.Join(path, "verifying_key.vk")
+	if err := os.MkdirAll(KeysPath(rootPath, version), 0755); err != nil {
+		return "", fmt.Errorf("failed to create keys directory: %w", err)
+	}
+
+	if err := writeKey(provingKeyPath, provingKey); err != nil {
+		return "", fmt.Errorf("failed to write proving key: %w", err)
+	}
 
-	pkFile, err := os.Create(provingKeyPath)
+	if err := writeKey(VerifyingKeyPath(rootPath, version), verifyingKey); err != nil {
+		return "", fmt.Errorf("failed to write verifying key: %w", err)
+	}
+
+	return version, nil
+}
+
+// ExportProvingKey writes the proving key of the circuit version, so it can be shared with other nodes
+func ExportProvingKey(rootPath, version string, w io.Writer) error {
+	pkFile, err := os.Open(ProvingKeyPath(rootPath, version))
 	if err != nil {
-		return fmt.Errorf("failed to create proving key file: %w", err)
+		return fmt.Errorf("failed to open proving key file: %w", err)
 	}
 	defer pkFile.Close()
 
-	vkFile, err := os.Create(verifyingKeyPath)
+	if _, err := io.Copy(w, pkFile); err != nil {
+		return fmt.Errorf("failed to export proving key: %w", err)
+	}
+	return nil
+}
+
+// ImportProvingKey stores a proving key generated by another node for the circuit version.
+// The version must be the one of the circuit this node compiles, and the key must be the one of the setup of the verifying key
+// registered on chain for the version, otherwise the proofs of the node would never verify.
+func ImportProvingKey(rootPath, version string, verifyingKey []byte, r io.Reader) error {
+	current, err := CircuitVersion()
 	if err != nil {
-		return fmt.Errorf("failed to create verifying key file: %w", err)
+		return err
+	}
+	if version != current {
+		return fmt.Errorf("proving key is for circuit version %s, but node circuit version is %s", version, current)
 	}
-	defer vkFile.Close()
 
-	if _, err := provingKey.WriteTo(pkFile); err != nil {
+	provingKey := groth16.NewProvingKey(ecc.BN254)
+	if _, err := provingKey.ReadFrom(r); err != nil {
+		return fmt.Errorf("failed to read proving key: %w", err)
+	}
+
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if _, err := vk.ReadFrom(bytes.NewReader(verifyingKey)); err != nil {
+		return fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	if !matchingKeys(provingKey, vk) {
+		return fmt.Errorf("proving key doesn't match the verifying key of circuit version %s", version)
+	}
+
+	if err := os.MkdirAll(KeysPath(rootPath, version), 0755); err != nil {
+		return fmt.Errorf("failed to create keys directory: %w", err)
+	}
+
+	if err := writeKey(ProvingKeyPath(rootPath, version), provingKey); err != nil {
 		return fmt.Errorf("failed to write proving key: %w", err)
 	}
+	return nil
+}
 
-	if _, err := verifyingKey.WriteTo(vkFile); err != nil {
-		return fmt.Errorf("failed to write verifying key: %w", err)
+// keys of the same setup share the points of its alpha, beta and delta
+func matchingKeys(provingKey groth16.ProvingKey, verifyingKey groth16.VerifyingKey) bool {
+	pk, ok := provingKey.(*groth16bn254.ProvingKey)
+	if !ok {
+		return false
 	}
+	vk, ok := verifyingKey.(*groth16bn254.VerifyingKey)
+	if !ok {
+		return false
+	}
+	return pk.G1.Alpha.Equal(&vk.G1.Alpha) && pk.G1.Beta.Equal(&vk.G1.Beta) && pk.G1.Delta.Equal(&vk.G1.Delta) &&
+		pk.G2.Beta.Equal(&vk.G2.Beta) && pk.G2.Delta.Equal(&vk.G2.Delta)
+}
 
+// ValidateVerifyingKey returns an error if the serialized verifying key can't be read
+func ValidateVerifyingKey(verifyingKey []byte) error {
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if _, err := vk.ReadFrom(bytes.NewReader(verifyingKey)); err != nil {
+		return fmt.Errorf("failed to read verifying key: %w", err)
+	}
 	return nil
 }
 
-// Function to generate ZK proof after rendering a frame
-func GenerateFrameProof(cid string, nodeAddr string, provingKeyPath string) (string, error) {
-	// Convert CID and Node Address hex string to big.Int
-	cidBigInt := new(big.Int)
-	cidBigInt.SetString(cid, 16)
+// writes the key to a new file
+func writeKey(path string, key io.WriterTo) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
 
-	nodeAddrBigInt := new(big.Int)
-	nodeAddrBigInt.SetString(nodeAddr, 16)
+	_, err = key.WriteTo(file)
+	return err
+}
+
+// splits the sha256 digest of the value in the two limbs used by the circuit
+func digestLimbs(value string) (*big.Int, *big.Int) {
+	digest := sha256.Sum256([]byte(value))
+	return new(big.Int).SetBytes(digest[:16]), new(big.Int).SetBytes(digest[16:])
+}
+
+// converts the node address to a field element
+func addressElement(nodeAddress string) *big.Int {
+	digest := sha256.Sum256([]byte(nodeAddress))
+	var element fr.Element
+	element.SetBytes(digest[:])
+	return element.BigInt(new(big.Int))
+}
+
+// hashes the values with MiMC out of the circuit. Every value is a fixed width field element
+func hashElements(values ...*big.Int) []byte {
+	h := nativeMimc.NewMiMC()
+	for _, value := range values {
+		var element fr.Element
+		element.SetBigInt(value)
+		block := element.Bytes()
+		h.Write(block[:])
+	}
+	return h.Sum(nil)
+}
+
+// parses a hex encoded field element
+func parseElement(value string) (*big.Int, error) {
+	element, ok := new(big.Int).SetString(value, 16)
+	if !ok || element.Sign() < 0 || element.Cmp(fr.Modulus()) >= 0 {
+		return nil, fmt.Errorf("invalid field element %s", value)
+	}
+	return element, nil
+}
+
+// FrameCommitment computes, out of the circuit, the hex encoded commitment of the frame hash and salt bound to the node address
+func FrameCommitment(hash, salt, nodeAddress string) string {
+	hashHi, hashLo := digestLimbs(hash)
+	saltHi, saltLo := digestLimbs(salt)
+	return hex.EncodeToString(hashElements(hashHi, hashLo, saltHi, saltLo, addressElement(nodeAddress)))
+}
+
+// MerkleLeaf returns the leaf of the hex encoded commitment of a frame
+func MerkleLeaf(frame int64, commitment string) ([]byte, error) {
+	element, err := parseElement(commitment)
+	if err != nil {
+		return nil, err
+	}
+	return hashElements(big.NewInt(frame), element), nil
+}
+
+// MerkleNode returns the parent of two nodes of the merkle tree
+func MerkleNode(left, right []byte) []byte {
+	return hashElements(new(big.Int).SetBytes(left), new(big.Int).SetBytes(right))
+}
+
+// MerkleRoot returns the root of a tree of frames with the given top, binding the amount of frames to the root
+func MerkleRoot(frames int, top []byte) []byte {
+	return hashElements(big.NewInt(int64(frames)), new(big.Int).SetBytes(top))
+}
+
+// MerkleDepthOf returns the depth of the tree of the amount of frames, the smallest one the frames fit in
+func MerkleDepthOf(frames int) int {
+	depth := 0
+	for 1<<depth < frames {
+		depth++
+	}
+	return depth
+}
+
+// GenerateFrameProof generates the ZK proof of the hashes of the frames of a thread committed in the hex encoded merkle root,
+// ordered by frame number from startFrame. Returns the hex encoded proof
+func GenerateFrameProof(merkleRoot string, startFrame int64, hashes []string, salt, nodeAddress, provingKeyPath string) (string, error) {
+	if len(hashes) == 0 || len(hashes) > MaxProofFrames {
+		return "", fmt.Errorf("unable to prove %v frames, circuit proves from 1 to %v", len(hashes), MaxProofFrames)
+	}
+
+	root, err := parseElement(merkleRoot)
+	if err != nil {
+		return "", err
+	}
 
 	// Step 1: Build the witness
+	saltHi, saltLo := digestLimbs(salt)
 	assignment := FrameProofCircuit{
-		ProvidedCID: frontend.Variable(cidBigInt),
-		NodeAddress: frontend.Variable(nodeAddrBigInt),
-		ComputedCID: frontend.Variable(cidBigInt), // Simulating computed hash
+		MerkleRoot:  frontend.Variable(root),
+		NodeAddress: frontend.Variable(addressElement(nodeAddress)),
+		StartFrame:  frontend.Variable(startFrame),
+		Depth:       frontend.Variable(MerkleDepthOf(len(hashes))),
+		SaltHi:      frontend.Variable(saltHi),
+		SaltLo:      frontend.Variable(saltLo),
+	}
+	for i := 0; i < MaxProofFrames; i++ {
+		if i >= len(hashes) {
+			assignment.HashHi[i], assignment.HashLo[i], assignment.Padding[i] = 0, 0, 1
+			continue
+		}
+		hashHi, hashLo := digestLimbs(hashes[i])
+		assignment.HashHi[i], assignment.HashLo[i], assignment.Padding[i] = hashHi, hashLo, 0
 	}
 
-	// Step 2: Compile circuit
-	r1cs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &FrameProofCircuit{})
+	// Step 2: Get the compiled circuit
+	cs, _, err := compiledCircuit()
 	if err != nil {
-		return "", fmt.Errorf("failed to compile circuit: %w", err)
+		return "", err
 	}
 
 	// Step 3: Load the proving key
@@ -106,7 +417,7 @@ func GenerateFrameProof(cid string, nodeAddr string, provingKeyPath string) (str
 	}
 
 	// Step 5: Generate proof
-	proof, err := groth16.Prove(r1cs, provingKey, witness)
+	proof, err := groth16.Prove(cs, provingKey, witness)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate proof: %w", err)
 	}
@@ -117,34 +428,24 @@ func GenerateFrameProof(cid string, nodeAddr string, provingKeyPath string) (str
 		return "", fmt.Errorf("failed to serialize proof: %w", err)
 	}
 
-	proofHex := hex.EncodeToString(buf.Bytes())
-
-	return proofHex, nil
+	return hex.EncodeToString(buf.Bytes()), nil
 }
 
-// Function to verify the proof
-func VerifyFrameProof(proof string, verifyingKeyPath string, fakeCID string, fakeNodeAddr string) error {
-	// Convert CID and Node Address hex string to big.Int
-	cidBigInt := new(big.Int)
-	cidBigInt.SetString(fakeCID, 16)
-
-	nodeAddrBigInt := new(big.Int)
-	nodeAddrBigInt.SetString(fakeNodeAddr, 16)
-
-	verifyingKeyFile, err := os.Open(verifyingKeyPath)
+// Function to verify the proof of the merkle root of the node against the serialized verifying key
+func VerifyFrameProof(proof string, verifyingKey []byte, merkleRoot string, nodeAddress string) error {
+	root, err := parseElement(merkleRoot)
 	if err != nil {
-		return fmt.Errorf("failed to open verifying key file: %w", err)
+		return err
 	}
-	defer verifyingKeyFile.Close()
 
-	verifyingKey := groth16.NewVerifyingKey(ecc.BN254)
-	if _, err := verifyingKey.ReadFrom(verifyingKeyFile); err != nil {
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if _, err := vk.ReadFrom(bytes.NewReader(verifyingKey)); err != nil {
 		return fmt.Errorf("failed to read verifying key: %w", err)
 	}
 
 	publicWitness, err := frontend.NewWitness(&FrameProofCircuit{
-		ProvidedCID: frontend.Variable(cidBigInt),
-		NodeAddress: frontend.Variable(nodeAddrBigInt),
+		MerkleRoot:  frontend.Variable(root),
+		NodeAddress: frontend.Variable(addressElement(nodeAddress)),
 	}, ecc.BN254.ScalarField(), frontend.PublicOnly())
 	if err != nil {
 		return fmt.Errorf("failed to create public witness: %w", err)
@@ -161,7 +462,7 @@ func VerifyFrameProof(proof string, verifyingKeyPath string, fakeCID string, fak
 		return fmt.Errorf("failed to read proof: %w", err)
 	}
 
-	if err := groth16.Verify(proofStruct, verifyingKey, publicWitness); err != nil {
+	if err := groth16.Verify(proofStruct, vk, publicWitness); err != nil {
 		return fmt.Errorf("proof verification failed: %w", err)
 	}
 
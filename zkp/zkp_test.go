@@ -1,29 +1,252 @@
 package zkp_test
 
 import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"sync"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
 	"github.com/janction/videoRendering/zkp"
 )
 
-// Test function for GenerateFrameProof
+// builds the hex encoded merkle root of the frames, the same way the chain does
+func merkleRoot(t *testing.T, startFrame int64, hashes []string, salt, address string) string {
+	var level [][]byte
+	for i, hash := range hashes {
+		leaf, err := zkp.MerkleLeaf(startFrame+int64(i), zkp.FrameCommitment(hash, salt, address))
+		if err != nil {
+			t.Fatalf("Failed to compute leaf: %v", err)
+		}
+		level = append(level, leaf)
+	}
+	for len(level) < 1<<zkp.MerkleDepthOf(len(hashes)) {
+		level = append(level, make([]byte, 32))
+	}
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, zkp.MerkleNode(level[i], level[i+1]))
+		}
+		level = next
+	}
+	return hex.EncodeToString(zkp.MerkleRoot(len(hashes), level[0]))
+}
+
+var (
+	setupOnce    sync.Once
+	setupPath    string
+	setupVersion string
+	setupErr     error
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if setupPath != "" {
+		os.RemoveAll(setupPath)
+	}
+	os.Exit(code)
+}
+
+// setupKeys runs the groth16 setup once for all the tests, since it's slow for the size of the circuit
+func setupKeys(t *testing.T) (string, string) {
+	setupOnce.Do(func() {
+		setupPath, setupErr = os.MkdirTemp("", "zkp")
+		if setupErr == nil {
+			setupVersion, setupErr = zkp.Setup(setupPath)
+		}
+	})
+	if setupErr != nil {
+		t.Fatalf("Failed to initialize gnark: %v", setupErr)
+	}
+	return setupPath, setupVersion
+}
+
 // Test function for GenerateFrameProof
 func TestGenerateFrameProof(t *testing.T) {
-	fakeCID := "aabbccddeeff00112233445566778899" // Example invented CID
-	fakeAddress := "cosmosAddress"                // Example invented CID
-	err := zkp.InitGnark("./")
+	path, version := setupKeys(t)
+	hashes := []string{"hash10", "hash11", "hash12"} // Example invented frame hashes
+	salt := "salt"
+	fakeAddress := "cosmosAddress1234567" // Example invented address
+	root := merkleRoot(t, 10, hashes, salt, fakeAddress)
+
+	proof, err := zkp.GenerateFrameProof(root, 10, hashes, salt, fakeAddress, zkp.ProvingKeyPath(path, version))
 	if err != nil {
-		t.Fatalf("Failed to initialize gnark: %v", err)
+		t.Fatalf("Failed to generate proof: %v", err)
 	}
 
-	proof, err := zkp.GenerateFrameProof(fakeCID, fakeAddress, "./proving_key.pk")
+	verifyingKey, err := os.ReadFile(zkp.VerifyingKeyPath(path, version))
 	if err != nil {
-		t.Fatalf("Failed to generate proof: %v", err)
+		t.Fatalf("Failed to read verifying key: %v", err)
 	}
 
-	if err := zkp.VerifyFrameProof(proof, "./verifying_key.vk", fakeCID, fakeAddress); err != nil {
+	if err := zkp.VerifyFrameProof(proof, verifyingKey, root, fakeAddress); err != nil {
 		t.Fatalf("Proof verification failed: %v", err)
 	}
 
+	// the proof is bound to the node address
+	if err := zkp.VerifyFrameProof(proof, verifyingKey, root, "otherAddress12345678"); err == nil {
+		t.Fatalf("Proof verification should fail for another address")
+	}
+
+	// and to the committed root
+	otherRoot := merkleRoot(t, 10, []string{"hash10", "hash11", "other"}, salt, fakeAddress)
+	if err := zkp.VerifyFrameProof(proof, verifyingKey, otherRoot, fakeAddress); err == nil {
+		t.Fatalf("Proof verification should fail for another root")
+	}
+
+	// hashes must be the ones of the root
+	if _, err := zkp.GenerateFrameProof(otherRoot, 10, hashes, salt, fakeAddress, zkp.ProvingKeyPath(path, version)); err == nil {
+		t.Fatalf("Expected error proving hashes of another root")
+	}
+
+	// and frames must be the ones of the root
+	if _, err := zkp.GenerateFrameProof(root, 11, hashes, salt, fakeAddress, zkp.ProvingKeyPath(path, version)); err == nil {
+		t.Fatalf("Expected error proving other frames of the root")
+	}
+	if _, err := zkp.GenerateFrameProof(root, 10, hashes[:2], salt, fakeAddress, zkp.ProvingKeyPath(path, version)); err == nil {
+		t.Fatalf("Expected error proving less frames of the root")
+	}
+
 	t.Logf("Generated proof: %s", proof)
 }
+
+func TestGenerateFrameProofInvalidFrames(t *testing.T) {
+	if _, err := zkp.GenerateFrameProof("01", 0, nil, "salt", "cosmosAddress", "pk"); err == nil {
+		t.Fatalf("Expected error for a thread without frames")
+	}
+
+	hashes := make([]string, zkp.MaxProofFrames+1)
+	if _, err := zkp.GenerateFrameProof("01", 0, hashes, "salt", "cosmosAddress", "pk"); err == nil {
+		t.Fatalf("Expected error for a thread bigger than the circuit")
+	}
+}
+
+func TestFrameCommitment(t *testing.T) {
+	commitment := zkp.FrameCommitment("hash", "salt", "cosmosAddress")
+	if len(commitment) != 64 {
+		t.Fatalf("Expected a hex encoded field element, got %s", commitment)
+	}
+
+	// fields are hashed to fixed width elements, so moving bytes from a field to the next one changes the commitment
+	if commitment == zkp.FrameCommitment("has", "hsalt", "cosmosAddress") {
+		t.Fatalf("Expected a different commitment for another split of the fields")
+	}
+
+	if _, err := zkp.MerkleLeaf(0, "not hex"); err == nil {
+		t.Fatalf("Expected error for an invalid commitment")
+	}
+}
+
+func TestCircuitVersionIsDeterministic(t *testing.T) {
+	version, err := zkp.CircuitVersion()
+	if err != nil {
+		t.Fatalf("Failed to get circuit version: %v", err)
+	}
+	if len(version) != 64 {
+		t.Fatalf("Expected a hex encoded sha256 version, got %s", version)
+	}
+
+	again, err := zkp.CircuitVersion()
+	if err != nil || again != version {
+		t.Fatalf("Expected the same circuit version, got %s and %s", version, again)
+	}
+}
+
+func TestSetupDoesNotOverwriteKeys(t *testing.T) {
+	path, _ := setupKeys(t)
+
+	if _, err := zkp.Setup(path); err == nil {
+		t.Fatalf("Expected error when keys of the circuit version already exist")
+	}
+}
+
+func TestExportImportProvingKey(t *testing.T) {
+	source, version := setupKeys(t)
+
+	var exported bytes.Buffer
+	if err := zkp.ExportProvingKey(source, version, &exported); err != nil {
+		t.Fatalf("Failed to export proving key: %v", err)
+	}
+
+	verifyingKey, err := os.ReadFile(zkp.VerifyingKeyPath(source, version))
+	if err != nil {
+		t.Fatalf("Failed to read verifying key: %v", err)
+	}
+
+	// another node imports the key and its proofs verify with the key of the setup
+	destination := t.TempDir()
+	if err := zkp.ImportProvingKey(destination, version, verifyingKey, bytes.NewReader(exported.Bytes())); err != nil {
+		t.Fatalf("Failed to import proving key: %v", err)
+	}
+
+	hashes := []string{"hash0"}
+	address := "cosmosAddress1234567"
+	root := merkleRoot(t, 0, hashes, "salt", address)
+	proof, err := zkp.GenerateFrameProof(root, 0, hashes, "salt", address, zkp.ProvingKeyPath(destination, version))
+	if err != nil {
+		t.Fatalf("Failed to generate proof: %v", err)
+	}
+
+	if err := zkp.ValidateVerifyingKey(verifyingKey); err != nil {
+		t.Fatalf("Verifying key should be valid: %v", err)
+	}
+	if err := zkp.VerifyFrameProof(proof, verifyingKey, root, address); err != nil {
+		t.Fatalf("Proof verification failed: %v", err)
+	}
+}
+
+func TestImportProvingKeyRejectsInvalidKeys(t *testing.T) {
+	source, version := setupKeys(t)
+	verifyingKey, err := os.ReadFile(zkp.VerifyingKeyPath(source, version))
+	if err != nil {
+		t.Fatalf("Failed to read verifying key: %v", err)
+	}
+
+	if err := zkp.ImportProvingKey(t.TempDir(), "otherVersion", verifyingKey, bytes.NewReader(nil)); err == nil {
+		t.Fatalf("Expected error for a key of another circuit version")
+	}
+
+	if err := zkp.ImportProvingKey(t.TempDir(), version, verifyingKey, bytes.NewReader([]byte("not a key"))); err == nil {
+		t.Fatalf("Expected error for an invalid key")
+	}
+
+	if err := zkp.ValidateVerifyingKey([]byte("not a key")); err == nil {
+		t.Fatalf("Expected error for an invalid verifying key")
+	}
+}
+
+func TestImportProvingKeyRejectsKeyOfAnotherSetup(t *testing.T) {
+	source, version := setupKeys(t)
+
+	var exported bytes.Buffer
+	if err := zkp.ExportProvingKey(source, version, &exported); err != nil {
+		t.Fatalf("Failed to export proving key: %v", err)
+	}
+
+	// the verifying key registered on chain comes from a setup with another delta
+	verifyingKey, err := os.ReadFile(zkp.VerifyingKeyPath(source, version))
+	if err != nil {
+		t.Fatalf("Failed to read verifying key: %v", err)
+	}
+	vk := groth16.NewVerifyingKey(ecc.BN254).(*groth16bn254.VerifyingKey)
+	if _, err := vk.ReadFrom(bytes.NewReader(verifyingKey)); err != nil {
+		t.Fatalf("Failed to read verifying key: %v", err)
+	}
+	vk.G2.Delta.Double(&vk.G2.Delta)
+	var registered bytes.Buffer
+	if _, err := vk.WriteTo(&registered); err != nil {
+		t.Fatalf("Failed to write verifying key: %v", err)
+	}
+
+	destination := t.TempDir()
+	if err := zkp.ImportProvingKey(destination, version, registered.Bytes(), bytes.NewReader(exported.Bytes())); err == nil {
+		t.Fatalf("Expected error for a key that doesn't match the registered verifying key")
+	}
+	if _, err := os.Stat(zkp.ProvingKeyPath(destination, version)); !os.IsNotExist(err) {
+		t.Fatalf("Rejected key shouldn't be stored")
+	}
+}
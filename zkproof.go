@@ -0,0 +1,44 @@
+package videoRendering
+
+import (
+	"errors"
+	"os"
+
+	"github.com/janction/videoRendering/videoRenderingLogger"
+	"github.com/janction/videoRendering/vm"
+	"github.com/janction/videoRendering/zkp"
+)
+
+// generateSolutionProof proves knowledge of the frame hashes and salt committed in the merkle root of the solution, bound to the
+// worker address. The chain can't recompute them, since only sampled frames are revealed.
+// Returns the proof and the circuit version it was generated with.
+// Proofs are optional, so nothing is generated when the node doesn't have a proving key for the current circuit version,
+// or the thread has more frames than the circuit proves.
+func (t VideoRenderingThread) generateSolutionProof(merkleRoot string, hashes map[string]string, salt, workerAddress, rootPath string) (string, string, error) {
+	version, err := zkp.CircuitVersion()
+	if err != nil {
+		return "", "", err
+	}
+
+	provingKey := zkp.ProvingKeyPath(rootPath, version)
+	if _, err := os.Stat(provingKey); errors.Is(err, os.ErrNotExist) {
+		videoRenderingLogger.Logger.Info("no proving key at %s, solution of thread %s is proposed without zk proof", provingKey, t.ThreadId)
+		return "", "", nil
+	}
+
+	if frames := t.EndFrame - t.StartFrame + 1; frames > zkp.MaxProofFrames {
+		videoRenderingLogger.Logger.Info("thread %s has %v frames, more than the %v of the circuit. Solution is proposed without zk proof", t.ThreadId, frames, zkp.MaxProofFrames)
+		return "", "", nil
+	}
+
+	var ordered []string
+	for frame := t.StartFrame; frame <= t.EndFrame; frame++ {
+		ordered = append(ordered, hashes[vm.FormatFrameFilename(int(frame), t.FrameExtension())])
+	}
+
+	proof, err := zkp.GenerateFrameProof(merkleRoot, t.StartFrame, ordered, salt, workerAddress, provingKey)
+	if err != nil {
+		return "", "", err
+	}
+	return proof, version, nil
+}
@@ -0,0 +1,79 @@
+package videoRendering
+
+import (
+	"os"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/janction/videoRendering/zkp"
+	"github.com/stretchr/testify/require"
+)
+
+const merkleRoot = "0f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+var proofHashes = map[string]string{"frame_000000.png": "hash0", "frame_000001.png": "hash1"}
+
+// writes a fake proving key of the current circuit version
+func writeProvingKey(t *testing.T, rootPath string) string {
+	circuitVersion, err := zkp.CircuitVersion()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(zkp.KeysPath(rootPath, circuitVersion), 0755))
+	require.NoError(t, os.WriteFile(zkp.ProvingKeyPath(rootPath, circuitVersion), []byte("pk"), 0644))
+	return circuitVersion
+}
+
+// --- Test for generateSolutionProof ---
+func TestGenerateSolutionProof_NoProvingKey(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", StartFrame: 0, EndFrame: 1}
+
+	proof, version, err := thread.generateSolutionProof(merkleRoot, proofHashes, testCommitmentSalt, "cosmos1abcdefg1234567", t.TempDir())
+
+	// proofs are optional
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.Empty(t, version)
+}
+
+func TestGenerateSolutionProof_ProvingKeyOk(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", StartFrame: 0, EndFrame: 1}
+	rootPath := t.TempDir()
+	circuitVersion := writeProvingKey(t, rootPath)
+
+	var proven []any
+	patch1 := monkey.Patch(zkp.GenerateFrameProof, func(merkleRoot string, startFrame int64, hashes []string, salt, nodeAddress, provingKeyPath string) (string, error) {
+		// the hashes don't escape the real function, so they're copied before the caller's frame is gone
+		proven = []any{merkleRoot, startFrame, append([]string(nil), hashes...), salt, nodeAddress}
+		return "proof", nil
+	})
+	defer patch1.Unpatch()
+
+	proof, version, err := thread.generateSolutionProof(merkleRoot, proofHashes, testCommitmentSalt, "cosmos1abcdefg1234567", rootPath)
+
+	// the hashes of every frame and the salt are proven against the merkle root, with the key of the current circuit version
+	require.NoError(t, err)
+	require.Equal(t, "proof", proof)
+	require.Equal(t, circuitVersion, version)
+	require.Equal(t, []any{merkleRoot, int64(0), []string{"hash0", "hash1"}, testCommitmentSalt, "cosmos1abcdefg1234567"}, proven)
+}
+
+func TestGenerateSolutionProof_TooManyFrames(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", StartFrame: 0, EndFrame: zkp.MaxProofFrames}
+	rootPath := t.TempDir()
+	writeProvingKey(t, rootPath)
+
+	proof, version, err := thread.generateSolutionProof(merkleRoot, proofHashes, testCommitmentSalt, "cosmos1abcdefg1234567", rootPath)
+
+	// the thread is bigger than the circuit, so the solution is proposed without proof
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.Empty(t, version)
+}
+
+func TestGenerateSolutionProof_InvalidMerkleRoot(t *testing.T) {
+	thread := VideoRenderingThread{ThreadId: "thread123", StartFrame: 0, EndFrame: 1}
+	rootPath := t.TempDir()
+	writeProvingKey(t, rootPath)
+
+	_, _, err := thread.generateSolutionProof("not hex", proofHashes, testCommitmentSalt, "cosmos1abcdefg1234567", rootPath)
+	require.Error(t, err)
+}